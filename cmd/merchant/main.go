@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net"
@@ -8,11 +9,13 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/merchant/client"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/merchant/handler"
 	merchantProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/merchant/proto"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/merchant/repo"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/merchant/repo/model"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/merchant/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/cache"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
@@ -40,11 +43,28 @@ func main() {
 		}
 	}()
 
+	// 初始化订单服务客户端
+	client.InitOrderClient()
+
 	// 依赖注入
 	merchantRepo := repo.NewMerchantRepo()
-	merchantService := service.NewMerchantService(merchantRepo)
+	geoRepo := repo.NewMerchantGeoRepo()
+	merchantCache := cache.New(config.Cfg.Cache)
+	merchantService := service.NewMerchantService(merchantRepo, geoRepo, merchantCache)
 	merchantHandler := handler.NewMerchantHandler(merchantService)
 
+	// 重建商家位置索引（Redis数据丢失或坐标同步失败时兜底）
+	if count, err := merchantService.RebuildGeoIndex(context.Background()); err != nil {
+		zap.L().Error("重建商家位置索引失败", zap.Error(err))
+	} else {
+		zap.L().Info("重建商家位置索引成功", zap.Int("merchants", count))
+	}
+
+	// 启动评分更新消费者
+	bgCtx, cancelBg := context.WithCancel(context.Background())
+	defer cancelBg()
+	kafka.StartConsumer(bgCtx, "merchant-service", []string{kafka.TopicRatingUpdated}, merchantService.HandleRatingUpdated)
+
 	// 启动gRPC服务
 	grpcPort := config.Cfg.GRPC.MerchantPort // 配置文件中添加商家服务端口（如50053）
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
@@ -1,12 +1,14 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/client"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/handler"
@@ -30,7 +32,9 @@ func main() {
 	config.InitConfig(*configPath)
 	defer zap.L().Sync()
 	db.InitMysql()
-	if err := db.Mysql.AutoMigrate(&model.Order{}, &model.OrderItem{}); err != nil {
+	if err := db.Mysql.AutoMigrate(&model.Order{}, &model.OrderItem{}, &model.Coupon{}, &model.UserCoupon{}, &model.OrderDiscount{},
+		&model.MerchantDailyStat{}, &model.MerchantDailyProductStat{}, &model.StatRollup{},
+		&model.Review{}, &model.ReviewItem{}, &model.RatingSummary{}); err != nil {
 		zap.L().Fatal("订单表迁移失败", zap.Error(err))
 	}
 	redis.InitRedis()
@@ -41,13 +45,65 @@ func main() {
 		}
 	}()
 
-	// 初始化商品服务客户端
+	// 初始化商品、商家、用户服务客户端
 	client.InitProductClient()
+	client.InitMerchantClient()
+	client.InitUserClient()
 
 	// 依赖注入
 	orderRepo := repo.NewOrderRepo()
-	orderService := service.NewOrderService(orderRepo)
-	orderHandler := handler.NewOrderHandler(orderService)
+	couponRepo := repo.NewCouponRepo()
+	statsRepo := repo.NewStatsRepo()
+	reviewRepo := repo.NewReviewRepo()
+	orderService := service.NewOrderService(orderRepo, couponRepo)
+	couponService := service.NewCouponService(couponRepo)
+	statsService := service.NewStatsService(statsRepo)
+	reviewService := service.NewReviewService(orderRepo, reviewRepo)
+	orderHandler := handler.NewOrderHandler(orderService, couponService, statsService, reviewService)
+
+	// 启动库存恢复补偿、支付成功消费者
+	bgCtx, cancelBg := context.WithCancel(middleware.WithServiceCredential(context.Background())) // 后台任务以服务身份调用下游
+	defer cancelBg()
+	kafka.StartConsumer(bgCtx, "order-service", []string{kafka.TopicStockRestore}, service.HandleStockRestore)
+	kafka.StartConsumer(bgCtx, "order-service-payment", []string{kafka.TopicPaymentPaid}, orderService.HandlePaymentPaid)
+
+	// 启动超时未支付订单取消、库存恢复重试、到期结算消息投递任务
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-bgCtx.Done():
+				return
+			case <-ticker.C:
+				if _, err := orderService.ExpireUnpaidOrders(bgCtx); err != nil {
+					zap.L().Error("取消超时未支付订单失败", zap.Error(err))
+				}
+				if _, err := orderService.RetryStockRestore(bgCtx); err != nil {
+					zap.L().Error("重试恢复订单库存失败", zap.Error(err))
+				}
+				if _, err := orderService.PublishDueSettlements(bgCtx); err != nil {
+					zap.L().Error("投递订单完成消息失败", zap.Error(err))
+				}
+			}
+		}
+	}()
+
+	// 启动商家日统计汇总任务
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-bgCtx.Done():
+				return
+			case <-ticker.C:
+				if _, err := statsService.RollupDailyStats(bgCtx); err != nil {
+					zap.L().Error("汇总商家日统计失败", zap.Error(err))
+				}
+			}
+		}
+	}()
 
 	// 启动gRPC服务
 	grpcPort := config.Cfg.GRPC.OrderPort // 配置文件添加OrderPort: 50054
@@ -62,6 +118,7 @@ func main() {
 	// 创建gRPC服务器（添加JWT鉴权）
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(middleware.GRPCJwtMiddleware()),
+		grpc.StreamInterceptor(middleware.GRPCJwtStreamMiddleware()),
 	)
 	orderProto.RegisterOrderServiceServer(grpcServer, orderHandler)
 
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/client"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/gateway"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/handler"
+	paymentProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/redis"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+var configPath = flag.String("config", "config.yaml", "配置文件路径")
+
+func main() {
+	// 初始化配置和依赖
+	config.InitConfig(*configPath)
+	defer zap.L().Sync()
+	db.InitMysql()
+	if err := db.Mysql.AutoMigrate(&model.Payment{}, &model.Refund{}, &model.MerchantCommission{},
+		&model.SettlementItem{}, &model.SettlementStatement{}, &model.LedgerJournal{}, &model.LedgerEntry{}); err != nil {
+		zap.L().Fatal("支付表迁移失败", zap.Error(err))
+	}
+	redis.InitRedis()
+	kafka.InitKafkaProducer()
+	defer func() {
+		if kafka.Producer != nil {
+			_ = kafka.Producer.Close()
+		}
+	}()
+
+	// 初始化订单服务客户端
+	client.InitOrderClient()
+
+	// 初始化支付网关（目前仅支持mock）
+	if config.Cfg.Payment.Gateway != "" && config.Cfg.Payment.Gateway != "mock" {
+		zap.L().Fatal("不支持的支付网关", zap.String("gateway", config.Cfg.Payment.Gateway))
+	}
+	mockGateway := gateway.NewMockGateway(config.Cfg.Payment.MockSecret, config.Cfg.Payment.MockAutoPay)
+
+	// 依赖注入
+	paymentRepo := repo.NewPaymentRepo()
+	refundRepo := repo.NewRefundRepo()
+	settlementRepo := repo.NewSettlementRepo()
+	paymentService := service.NewPaymentService(paymentRepo, refundRepo, mockGateway)
+	settlementService := service.NewSettlementService(settlementRepo)
+	paymentHandler := handler.NewPaymentHandler(paymentService, settlementService)
+	mockGateway.SetNotify(func(ctx context.Context, param gateway.CallbackParam) error {
+		return paymentService.PaymentCallback(ctx, service.PaymentCallbackParam{
+			PaymentNo:   param.PaymentNo,
+			TradeNo:     param.TradeNo,
+			TradeStatus: param.TradeStatus,
+			Amount:      param.Amount,
+			Sign:        param.Sign,
+		})
+	})
+
+	// 启动订单退款、订单完成结算消费者
+	bgCtx, cancelBg := context.WithCancel(context.Background())
+	defer cancelBg()
+	kafka.StartConsumer(bgCtx, "payment-service", []string{kafka.TopicOrderRefund}, paymentService.HandleOrderRefund)
+	kafka.StartConsumer(bgCtx, "payment-service-settlement", []string{kafka.TopicOrderCompleted}, settlementService.HandleOrderCompleted)
+
+	// 定时生成商家日结算单（每小时执行，迟到的明细并入待打款结算单，已打款时另出补充结算单）
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-bgCtx.Done():
+				return
+			case <-ticker.C:
+				if _, err := settlementService.GenerateDailyStatements(bgCtx); err != nil {
+					zap.L().Error("生成商家日结算单失败", zap.Error(err))
+				}
+			}
+		}
+	}()
+
+	// 启动gRPC服务
+	grpcPort := config.Cfg.GRPC.PaymentPort // 配置文件添加PaymentPort: 50056
+	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
+	if err != nil {
+		zap.L().Fatal("支付服务gRPC监听失败", zap.Error(err), zap.Int("port", grpcPort))
+	}
+	defer func() {
+		_ = listen.Close()
+	}()
+
+	// 创建gRPC服务器（添加JWT鉴权，回调接口免鉴权）
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(middleware.GRPCJwtMiddleware()),
+	)
+	paymentProto.RegisterPaymentServiceServer(grpcServer, paymentHandler)
+
+	zap.L().Info("支付服务启动成功", zap.String("addr", fmt.Sprintf("localhost:%d", grpcPort)))
+
+	// 优雅退出
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigChan
+		zap.L().Info("支付服务开始关闭...")
+		grpcServer.GracefulStop()
+		zap.L().Info("支付服务已关闭")
+	}()
+
+	// 启动服务
+	if err = grpcServer.Serve(listen); err != nil {
+		zap.L().Fatal("支付服务启动失败", zap.Error(err))
+	}
+}
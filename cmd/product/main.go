@@ -1,17 +1,22 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/client"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/handler"
 	productProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/product/proto"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo/model"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/cache"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
@@ -28,10 +33,12 @@ func main() {
 	_ = config.InitConfig(*configPath)
 	defer zap.L().Sync()
 	db.InitMysql()
-	// 迁移创建商品表
-	//if err := db.Mysql.AutoMigrate(&model.Product{}); err != nil {
-	//	zap.L().Fatal("商品表迁移失败", zap.Error(err))
-	//}
+	// 迁移创建商品表、菜单分类表、规格属性表、秒杀表
+	if err := db.Mysql.AutoMigrate(&model.Product{}, &model.Category{}, &model.ProductCategory{},
+		&model.ProductSku{}, &model.ProductOptionGroup{}, &model.ProductOption{},
+		&model.FlashSale{}, &model.FlashStockLog{}, &model.StockRestoreLog{}); err != nil {
+		zap.L().Fatal("商品表迁移失败", zap.Error(err))
+	}
 
 	redis.InitRedis()
 	kafka.InitKafkaProducer()
@@ -41,10 +48,60 @@ func main() {
 		}
 	}()
 
+	// 初始化订单服务客户端（购物车结算用）
+	client.InitOrderClient()
+
 	// 依赖注入
 	productRepo := repo.NewProductRepo()
-	productService := service.NewProductService(productRepo)
-	productHandler := handler.NewProductHandler(productService)
+	categoryRepo := repo.NewCategoryRepo()
+	specRepo := repo.NewSpecRepo()
+	productCache := cache.New(config.Cfg.Cache)
+	flashSaleRepo := repo.NewFlashSaleRepo()
+	flashStockRepo := repo.NewFlashStockRepo()
+	productService := service.NewProductService(productRepo, specRepo, flashSaleRepo, flashStockRepo, productCache)
+	categoryService := service.NewCategoryService(categoryRepo, productRepo, specRepo)
+	productHandler := handler.NewProductHandler(productService, categoryService)
+	cartRepo := repo.NewCartRepo()
+	cartService := service.NewCartService(cartRepo, productRepo)
+	cartHandler := handler.NewCartHandler(cartService)
+
+	// 启动评分更新、秒杀库存流水消费者
+	bgCtx, cancelBg := context.WithCancel(context.Background())
+	defer cancelBg()
+	kafka.StartConsumer(bgCtx, "product-service", []string{kafka.TopicRatingUpdated}, productService.HandleRatingUpdated)
+	kafka.StartConsumer(bgCtx, "product-service-flash", []string{kafka.TopicFlashStock}, productService.HandleFlashStock)
+
+	// 启动秒杀库存对账任务
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-bgCtx.Done():
+				return
+			case <-ticker.C:
+				if _, err := productService.ReconcileActiveFlashSales(bgCtx); err != nil {
+					zap.L().Error("秒杀库存对账失败", zap.Error(err))
+				}
+			}
+		}
+	}()
+
+	// 启动每日补货任务（每分钟检查已到补货时间的商品）
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-bgCtx.Done():
+				return
+			case <-ticker.C:
+				if _, err := productService.RestockDailyProducts(bgCtx); err != nil {
+					zap.L().Error("每日补货失败", zap.Error(err))
+				}
+			}
+		}
+	}()
 
 	// 启动gRPC服务
 	grpcPort := config.Cfg.GRPC.ProductPort
@@ -59,8 +116,10 @@ func main() {
 	// 创建gRPC服务器（添加JWT鉴权）
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(middleware.GRPCJwtMiddleware()),
+		grpc.StreamInterceptor(middleware.GRPCJwtStreamMiddleware()),
 	)
 	productProto.RegisterProductServiceServer(grpcServer, productHandler)
+	productProto.RegisterCartServiceServer(grpcServer, cartHandler)
 
 	zap.L().Info("商品服务启动成功", zap.String("addr", fmt.Sprintf("localhost:%d", grpcPort)))
 
@@ -1,17 +1,20 @@
 package rider
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/client"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/handler"
 	riderProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/proto"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/repo/model"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/service"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
@@ -29,6 +32,9 @@ func main() {
 	config.InitConfig(*configPath)
 	defer zap.L().Sync()
 	db.InitMysql()
+	if err := db.Mysql.AutoMigrate(&model.Rider{}, &model.DeliveryOrder{}, &model.RiderEarning{}, &model.RiderStatement{}); err != nil {
+		zap.L().Fatal("骑手表迁移失败", zap.Error(err))
+	}
 	redis.InitRedis()
 	kafka.InitKafkaProducer()
 	defer func() {
@@ -42,8 +48,31 @@ func main() {
 
 	// 依赖注入
 	riderRepo := repo.NewRiderRepo()
+	earningRepo := repo.NewEarningRepo()
 	riderService := service.NewRiderService(riderRepo)
-	riderHandler := handler.NewRiderHandler(riderService)
+	earningService := service.NewEarningService(earningRepo)
+	riderHandler := handler.NewRiderHandler(riderService, earningService)
+
+	// 启动评分更新消费者
+	bgCtx, cancelBg := context.WithCancel(context.Background())
+	defer cancelBg()
+	kafka.StartConsumer(bgCtx, "rider-service", []string{kafka.TopicRatingUpdated}, riderService.HandleRatingUpdated)
+
+	// 定时生成骑手周结算单（每小时执行，迟到的流水会并入已有结算单）
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-bgCtx.Done():
+				return
+			case <-ticker.C:
+				if _, err := earningService.GenerateWeeklyStatements(bgCtx); err != nil {
+					zap.L().Error("生成骑手周结算单失败", zap.Error(err))
+				}
+			}
+		}
+	}()
 
 	// 启动gRPC服务
 	grpcPort := config.Cfg.GRPC.RiderPort // 配置文件添加RiderPort: 50055
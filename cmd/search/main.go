@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/search/handler"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/search/indexer"
+	searchProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/search/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/search/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/search/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+var (
+	configPath = flag.String("config", "config.yaml", "配置文件路径")
+	reindex    = flag.Bool("reindex", false, "全量重建搜索索引后退出")
+)
+
+func main() {
+	flag.Parse()
+
+	// 初始化配置和依赖（只读商家、商品源表，不做迁移）
+	_ = config.InitConfig(*configPath)
+	defer zap.L().Sync()
+	db.InitMysql()
+
+	// 依赖注入
+	esIndexer := indexer.NewESIndexer(config.Cfg.ES)
+	sourceRepo := repo.NewSourceRepo()
+	searchService := service.NewSearchService(sourceRepo, esIndexer)
+	searchHandler := handler.NewSearchHandler(searchService)
+
+	// 全量重建索引
+	if *reindex {
+		result, err := searchService.Reindex(context.Background())
+		if err != nil {
+			zap.L().Fatal("重建搜索索引失败", zap.Error(err))
+		}
+		zap.L().Info("重建搜索索引成功", zap.Int("merchants", result.Merchants), zap.Int("products", result.Products))
+		return
+	}
+	if err := esIndexer.EnsureIndices(context.Background()); err != nil {
+		zap.L().Fatal("初始化搜索索引失败", zap.Error(err))
+	}
+
+	// 启动索引同步消费者（处理失败的消息投递到死信Topic）
+	kafka.InitKafkaProducer()
+	defer func() {
+		if kafka.Producer != nil {
+			_ = kafka.Producer.Close()
+		}
+	}()
+	bgCtx, cancelBg := context.WithCancel(context.Background())
+	defer cancelBg()
+	kafka.StartConsumer(bgCtx, "search-service", []string{kafka.TopicSearchSync}, searchService.HandleSearchSync)
+
+	// 启动gRPC服务
+	grpcPort := config.Cfg.GRPC.SearchPort // 配置文件添加search_port: 50057
+	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
+	if err != nil {
+		zap.L().Fatal("搜索服务gRPC监听失败", zap.Error(err), zap.Int("port", grpcPort))
+	}
+	defer func() {
+		_ = listen.Close()
+	}()
+
+	// 创建gRPC服务器（添加JWT鉴权）
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(middleware.GRPCJwtMiddleware()),
+	)
+	searchProto.RegisterSearchServiceServer(grpcServer, searchHandler)
+
+	zap.L().Info("搜索服务启动成功", zap.String("addr", fmt.Sprintf("localhost:%d", grpcPort)))
+
+	// 优雅退出
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigChan
+		zap.L().Info("搜索服务开始关闭...")
+		grpcServer.GracefulStop()
+		zap.L().Info("搜索服务已关闭")
+	}()
+
+	// 启动服务
+	if err = grpcServer.Serve(listen); err != nil {
+		zap.L().Fatal("搜索服务启动失败", zap.Error(err))
+	}
+}
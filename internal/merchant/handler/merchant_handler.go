@@ -27,12 +27,16 @@ func NewMerchantHandler(merchantService service.MerchantService) *MerchantHandle
 func (h *MerchantHandler) MerchantRegister(ctx context.Context, req *merchantProto.MerchantRegisterRequest) (*merchantProto.MerchantRegisterResponse, error) {
 	// proto → service参数
 	param := service.MerchantRegisterParam{
-		Name:          req.Name,
-		Phone:         req.Phone,
-		Password:      req.Password,
-		Address:       req.Address,
-		Logo:          req.Logo,
-		BusinessHours: req.BusinessHours,
+		Name:           req.Name,
+		Phone:          req.Phone,
+		Password:       req.Password,
+		Address:        req.Address,
+		Logo:           req.Logo,
+		BusinessHours:  req.BusinessHours,
+		Longitude:      req.Longitude,
+		Latitude:       req.Latitude,
+		MinOrderAmount: float64(req.MinOrderAmount),
+		DeliveryRadius: req.DeliveryRadius,
 	}
 
 	// 调用service
@@ -130,17 +134,21 @@ func (h *MerchantHandler) GetMerchantInfo(ctx context.Context, req *merchantProt
 
 	// 转换为proto响应
 	merchant := &merchantProto.Merchant{
-		MerchantId:    result.MerchantID,
-		Name:          result.Name,
-		Phone:         result.Phone,
-		Address:       result.Address,
-		Logo:          result.Logo,
-		BusinessHours: result.BusinessHours,
-		Score:         float32(result.Score),
-		OrderCount:    result.OrderCount,
-		IsOpen:        result.IsOpen,
-		CreatedAt:     result.CreatedAt,
-		UpdatedAt:     result.UpdatedAt,
+		MerchantId:     result.MerchantID,
+		Name:           result.Name,
+		Phone:          result.Phone,
+		Address:        result.Address,
+		Logo:           result.Logo,
+		BusinessHours:  result.BusinessHours,
+		Longitude:      result.Longitude,
+		Latitude:       result.Latitude,
+		MinOrderAmount: float32(result.MinOrderAmount),
+		DeliveryRadius: result.DeliveryRadius,
+		Score:          float32(result.Score),
+		OrderCount:     result.OrderCount,
+		IsOpen:         result.IsOpen,
+		CreatedAt:      result.CreatedAt,
+		UpdatedAt:      result.UpdatedAt,
 	}
 
 	return &merchantProto.GetMerchantInfoResponse{
@@ -154,13 +162,17 @@ func (h *MerchantHandler) GetMerchantInfo(ctx context.Context, req *merchantProt
 func (h *MerchantHandler) UpdateMerchantInfo(ctx context.Context, req *merchantProto.UpdateMerchantInfoRequest) (*merchantProto.CommonResponse, error) {
 	// proto → service参数
 	param := service.UpdateMerchantInfoParam{
-		MerchantID:    req.MerchantId,
-		Name:          req.Name,
-		Phone:         req.Phone,
-		Address:       req.Address,
-		Logo:          req.Logo,
-		BusinessHours: req.BusinessHours,
-		IsOpen:        req.IsOpen,
+		MerchantID:     req.MerchantId,
+		Name:           req.Name,
+		Phone:          req.Phone,
+		Address:        req.Address,
+		Logo:           req.Logo,
+		BusinessHours:  req.BusinessHours,
+		Longitude:      req.Longitude,
+		Latitude:       req.Latitude,
+		MinOrderAmount: float64(req.MinOrderAmount),
+		DeliveryRadius: req.DeliveryRadius,
+		IsOpen:         req.IsOpen,
 	}
 
 	// 调用service
@@ -260,6 +272,17 @@ func (h *MerchantHandler) ListMerchantOrders(ctx context.Context, req *merchantP
 		Status:     req.Status,
 		Page:       req.Page,
 		PageSize:   req.PageSize,
+		Cursor:     req.Cursor,
+		WithTotal:  req.WithTotal,
+
+		Statuses:      req.Statuses,
+		StartTime:     req.StartTime,
+		EndTime:       req.EndTime,
+		OrderNoPrefix: req.OrderNoPrefix,
+		UserPhone:     req.UserPhone,
+		MinAmount:     float64(req.MinAmount),
+		MaxAmount:     float64(req.MaxAmount),
+		SortBy:        req.SortBy,
 	}
 
 	// 调用service
@@ -296,11 +319,130 @@ func (h *MerchantHandler) ListMerchantOrders(ctx context.Context, req *merchantP
 	}
 
 	return &merchantProto.ListMerchantOrdersResponse{
-		Code:     utils.ErrCodeSuccess,
-		Msg:      "查询成功",
-		Orders:   protoOrders,
-		Total:    result.Total,
-		Page:     result.Page,
-		PageSize: result.PageSize,
+		Code:       utils.ErrCodeSuccess,
+		Msg:        "查询成功",
+		Orders:     protoOrders,
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// GetMerchantStats 查询经营数据看板
+func (h *MerchantHandler) GetMerchantStats(ctx context.Context, req *merchantProto.GetMerchantStatsRequest) (*merchantProto.GetMerchantStatsResponse, error) {
+	// 调用service
+	result, err := h.merchantService.GetMerchantStats(ctx, service.GetMerchantStatsParam{
+		MerchantID: req.MerchantId,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		TopN:       req.TopN,
+	})
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("查询商家统计未知错误", zap.Error(err), zap.Int64("merchant_id", req.MerchantId))
+			return &merchantProto.GetMerchantStatsResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &merchantProto.GetMerchantStatsResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	// 转换为proto响应
+	stats := &merchantProto.MerchantStats{
+		MerchantId:       result.MerchantID,
+		StartDate:        result.StartDate,
+		EndDate:          result.EndDate,
+		OrderCount:       result.OrderCount,
+		ValidOrderCount:  result.ValidOrderCount,
+		Gmv:              float32(result.GMV),
+		AvgOrderValue:    float32(result.AvgOrderValue),
+		CancelRate:       float32(result.CancelRate),
+		RejectRate:       float32(result.RejectRate),
+		AvgAcceptSeconds: result.AvgAcceptSeconds,
+	}
+	for _, sc := range result.StatusCounts {
+		stats.StatusCounts = append(stats.StatusCounts, &merchantProto.StatusCount{Status: sc.Status, Count: sc.Count})
+	}
+	for _, d := range result.Daily {
+		stats.Daily = append(stats.Daily, &merchantProto.DailyStat{
+			Date:            d.Date,
+			OrderCount:      d.OrderCount,
+			ValidOrderCount: d.ValidOrderCount,
+			Gmv:             float32(d.GMV),
+		})
+	}
+	for _, p := range result.TopProducts {
+		stats.TopProducts = append(stats.TopProducts, &merchantProto.ProductSales{
+			ProductId:   p.ProductID,
+			ProductName: p.ProductName,
+			Quantity:    p.Quantity,
+			Amount:      float32(p.Amount),
+		})
+	}
+
+	return &merchantProto.GetMerchantStatsResponse{
+		Code:  utils.ErrCodeSuccess,
+		Msg:   "查询成功",
+		Stats: stats,
+	}, nil
+}
+
+// ListNearbyMerchants 查询附近可配送的营业中商家
+func (h *MerchantHandler) ListNearbyMerchants(ctx context.Context, req *merchantProto.ListNearbyMerchantsRequest) (*merchantProto.ListNearbyMerchantsResponse, error) {
+	// 调用service
+	result, err := h.merchantService.ListNearbyMerchants(ctx, service.ListNearbyMerchantsParam{
+		Longitude: req.Longitude,
+		Latitude:  req.Latitude,
+		SortBy:    req.SortBy,
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+	})
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("查询附近商家未知错误", zap.Error(err))
+			return &merchantProto.ListNearbyMerchantsResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &merchantProto.ListNearbyMerchantsResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	// 转换为proto响应
+	merchants := make([]*merchantProto.NearbyMerchant, 0, len(result.Merchants))
+	for _, m := range result.Merchants {
+		merchants = append(merchants, &merchantProto.NearbyMerchant{
+			MerchantId:     m.MerchantID,
+			Name:           m.Name,
+			Address:        m.Address,
+			Logo:           m.Logo,
+			BusinessHours:  m.BusinessHours,
+			Score:          float32(m.Score),
+			OrderCount:     m.OrderCount,
+			MinOrderAmount: float32(m.MinOrderAmount),
+			DeliveryRadius: m.DeliveryRadius,
+			Distance:       m.Distance,
+		})
+	}
+
+	return &merchantProto.ListNearbyMerchantsResponse{
+		Code:      utils.ErrCodeSuccess,
+		Msg:       "查询成功",
+		Merchants: merchants,
+		Total:     result.Total,
+		Page:      result.Page,
+		PageSize:  result.PageSize,
 	}, nil
 }
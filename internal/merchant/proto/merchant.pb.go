@@ -25,21 +25,25 @@ const (
 
 // 商家信息
 type Merchant struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`         // 商家ID
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                        // 商家名称
-	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`                                      // 商家电话
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`                                // 密码（加密后，前端不返回）
-	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`                                  // 商家地址
-	Logo          string                 `protobuf:"bytes,6,opt,name=logo,proto3" json:"logo,omitempty"`                                        // 商家logo
-	BusinessHours string                 `protobuf:"bytes,7,opt,name=business_hours,json=businessHours,proto3" json:"business_hours,omitempty"` // 营业时间
-	Score         float32                `protobuf:"fixed32,8,opt,name=score,proto3" json:"score,omitempty"`                                    // 商家评分（默认5.0）
-	OrderCount    int32                  `protobuf:"varint,9,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`         // 订单数
-	IsOpen        bool                   `protobuf:"varint,10,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`                    // 是否营业
-	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`            // 创建时间
-	UpdatedAt     string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`            // 更新时间
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId     int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`                 // 商家ID
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                // 商家名称
+	Phone          string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`                                              // 商家电话
+	Password       string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`                                        // 密码（加密后，前端不返回）
+	Address        string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`                                          // 商家地址
+	Logo           string                 `protobuf:"bytes,6,opt,name=logo,proto3" json:"logo,omitempty"`                                                // 商家logo
+	BusinessHours  string                 `protobuf:"bytes,7,opt,name=business_hours,json=businessHours,proto3" json:"business_hours,omitempty"`         // 营业时间
+	Score          float32                `protobuf:"fixed32,8,opt,name=score,proto3" json:"score,omitempty"`                                            // 商家评分（默认5.0）
+	OrderCount     int32                  `protobuf:"varint,9,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`                 // 订单数
+	IsOpen         bool                   `protobuf:"varint,10,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`                            // 是否营业
+	CreatedAt      string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                    // 创建时间
+	UpdatedAt      string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                    // 更新时间
+	Longitude      float64                `protobuf:"fixed64,13,opt,name=longitude,proto3" json:"longitude,omitempty"`                                   // 经度
+	Latitude       float64                `protobuf:"fixed64,14,opt,name=latitude,proto3" json:"latitude,omitempty"`                                     // 纬度
+	MinOrderAmount float32                `protobuf:"fixed32,15,opt,name=min_order_amount,json=minOrderAmount,proto3" json:"min_order_amount,omitempty"` // 起送价
+	DeliveryRadius int32                  `protobuf:"varint,16,opt,name=delivery_radius,json=deliveryRadius,proto3" json:"delivery_radius,omitempty"`    // 配送半径（米）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Merchant) Reset() {
@@ -156,6 +160,34 @@ func (x *Merchant) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Merchant) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Merchant) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Merchant) GetMinOrderAmount() float32 {
+	if x != nil {
+		return x.MinOrderAmount
+	}
+	return 0
+}
+
+func (x *Merchant) GetDeliveryRadius() int32 {
+	if x != nil {
+		return x.DeliveryRadius
+	}
+	return 0
+}
+
 // 订单简要信息（商家端）
 type MerchantOrder struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -312,15 +344,19 @@ func (x *CommonResponse) GetMsg() string {
 
 // 商家注册请求
 type MerchantRegisterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	Logo          string                 `protobuf:"bytes,5,opt,name=logo,proto3" json:"logo,omitempty"`
-	BusinessHours string                 `protobuf:"bytes,6,opt,name=business_hours,json=businessHours,proto3" json:"business_hours,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Phone          string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Password       string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Address        string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Logo           string                 `protobuf:"bytes,5,opt,name=logo,proto3" json:"logo,omitempty"`
+	BusinessHours  string                 `protobuf:"bytes,6,opt,name=business_hours,json=businessHours,proto3" json:"business_hours,omitempty"`
+	Longitude      float64                `protobuf:"fixed64,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude       float64                `protobuf:"fixed64,8,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	MinOrderAmount float32                `protobuf:"fixed32,9,opt,name=min_order_amount,json=minOrderAmount,proto3" json:"min_order_amount,omitempty"` // 起送价
+	DeliveryRadius int32                  `protobuf:"varint,10,opt,name=delivery_radius,json=deliveryRadius,proto3" json:"delivery_radius,omitempty"`   // 配送半径（米，0取默认3000）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *MerchantRegisterRequest) Reset() {
@@ -395,6 +431,34 @@ func (x *MerchantRegisterRequest) GetBusinessHours() string {
 	return ""
 }
 
+func (x *MerchantRegisterRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *MerchantRegisterRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *MerchantRegisterRequest) GetMinOrderAmount() float32 {
+	if x != nil {
+		return x.MinOrderAmount
+	}
+	return 0
+}
+
+func (x *MerchantRegisterRequest) GetDeliveryRadius() int32 {
+	if x != nil {
+		return x.DeliveryRadius
+	}
+	return 0
+}
+
 // 商家注册响应
 type MerchantRegisterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -702,16 +766,20 @@ func (x *GetMerchantInfoResponse) GetMerchant() *Merchant {
 
 // 更新商家信息请求
 type UpdateMerchantInfoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	Logo          string                 `protobuf:"bytes,5,opt,name=logo,proto3" json:"logo,omitempty"`
-	BusinessHours string                 `protobuf:"bytes,6,opt,name=business_hours,json=businessHours,proto3" json:"business_hours,omitempty"`
-	IsOpen        bool                   `protobuf:"varint,7,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId     int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phone          string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Address        string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Logo           string                 `protobuf:"bytes,5,opt,name=logo,proto3" json:"logo,omitempty"`
+	BusinessHours  string                 `protobuf:"bytes,6,opt,name=business_hours,json=businessHours,proto3" json:"business_hours,omitempty"`
+	IsOpen         bool                   `protobuf:"varint,7,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	Longitude      float64                `protobuf:"fixed64,8,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude       float64                `protobuf:"fixed64,9,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	MinOrderAmount float32                `protobuf:"fixed32,10,opt,name=min_order_amount,json=minOrderAmount,proto3" json:"min_order_amount,omitempty"` // 起送价
+	DeliveryRadius int32                  `protobuf:"varint,11,opt,name=delivery_radius,json=deliveryRadius,proto3" json:"delivery_radius,omitempty"`    // 配送半径（米，0取默认3000）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateMerchantInfoRequest) Reset() {
@@ -793,6 +861,34 @@ func (x *UpdateMerchantInfoRequest) GetIsOpen() bool {
 	return false
 }
 
+func (x *UpdateMerchantInfoRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *UpdateMerchantInfoRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdateMerchantInfoRequest) GetMinOrderAmount() float32 {
+	if x != nil {
+		return x.MinOrderAmount
+	}
+	return 0
+}
+
+func (x *UpdateMerchantInfoRequest) GetDeliveryRadius() int32 {
+	if x != nil {
+		return x.DeliveryRadius
+	}
+	return 0
+}
+
 // 接单请求
 type AcceptOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -912,8 +1008,18 @@ type ListMerchantOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
 	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // 订单状态（可选，空表示所有）
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`    // 页码（0表示游标分页）
 	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`                                       // 游标（上一页返回的next_cursor，传入时忽略page）
+	WithTotal     bool                   `protobuf:"varint,6,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"`               // 游标分页时是否统计总数
+	Statuses      []string               `protobuf:"bytes,7,rep,name=statuses,proto3" json:"statuses,omitempty"`                                   // 订单状态（多选，与status合并）
+	StartTime     string                 `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                // 下单开始时间（格式：2006-01-02 15:04:05）
+	EndTime       string                 `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                      // 下单结束时间（格式：2006-01-02 15:04:05）
+	OrderNoPrefix string                 `protobuf:"bytes,10,opt,name=order_no_prefix,json=orderNoPrefix,proto3" json:"order_no_prefix,omitempty"` // 订单号前缀
+	UserPhone     string                 `protobuf:"bytes,11,opt,name=user_phone,json=userPhone,proto3" json:"user_phone,omitempty"`               // 顾客手机号（完整11位或尾号4位）
+	MinAmount     float32                `protobuf:"fixed32,12,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`             // 最低实付金额（0表示不限）
+	MaxAmount     float32                `protobuf:"fixed32,13,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`             // 最高实付金额（0表示不限）
+	SortBy        string                 `protobuf:"bytes,14,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                        // 排序：create_time_desc（默认）/create_time_asc/amount_desc/amount_asc
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -976,15 +1082,86 @@ func (x *ListMerchantOrdersRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListMerchantOrdersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListMerchantOrdersRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+func (x *ListMerchantOrdersRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListMerchantOrdersRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ListMerchantOrdersRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ListMerchantOrdersRequest) GetOrderNoPrefix() string {
+	if x != nil {
+		return x.OrderNoPrefix
+	}
+	return ""
+}
+
+func (x *ListMerchantOrdersRequest) GetUserPhone() string {
+	if x != nil {
+		return x.UserPhone
+	}
+	return ""
+}
+
+func (x *ListMerchantOrdersRequest) GetMinAmount() float32 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *ListMerchantOrdersRequest) GetMaxAmount() float32 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *ListMerchantOrdersRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
 // 查询商家订单列表响应
 type ListMerchantOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
 	Orders        []*MerchantOrder       `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"` // 总条数（未统计时为-1）
 	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,7,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标（为空表示没有更多）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1061,108 +1238,965 @@ func (x *ListMerchantOrdersResponse) GetPageSize() int32 {
 	return 0
 }
 
-var File_merchant_proto protoreflect.FileDescriptor
+func (x *ListMerchantOrdersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
 
-const file_merchant_proto_rawDesc = "" +
-	"\n" +
-	"\x0emerchant.proto\x12\bmerchant\x1a\x1bgoogle/protobuf/empty.proto\x1a\x0evalidate.proto\"\xd4\x02\n" +
-	"\bMerchant\x12\x1f\n" +
-	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
-	"merchantId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x1a\n" +
-	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x18\n" +
-	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x12\n" +
-	"\x04logo\x18\x06 \x01(\tR\x04logo\x12%\n" +
-	"\x0ebusiness_hours\x18\a \x01(\tR\rbusinessHours\x12\x14\n" +
-	"\x05score\x18\b \x01(\x02R\x05score\x12\x1f\n" +
-	"\vorder_count\x18\t \x01(\x05R\n" +
-	"orderCount\x12\x17\n" +
-	"\ais_open\x18\n" +
-	" \x01(\bR\x06isOpen\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\f \x01(\tR\tupdatedAt\"\x8d\x02\n" +
-	"\rMerchantOrder\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1b\n" +
-	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x1d\n" +
-	"\n" +
-	"user_phone\x18\x04 \x01(\tR\tuserPhone\x12!\n" +
-	"\ftotal_amount\x18\x05 \x01(\x02R\vtotalAmount\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1f\n" +
-	"\vcreate_time\x18\a \x01(\tR\n" +
-	"createTime\x120\n" +
-	"\x14expect_delivery_time\x18\b \x01(\tR\x12expectDeliveryTime\"6\n" +
-	"\x0eCommonResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg\"\xff\x01\n" +
-	"\x17MerchantRegisterRequest\x12\x1d\n" +
-	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x18@R\x04name\x12*\n" +
-	"\x05phone\x18\x02 \x01(\tB\x14\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$R\x05phone\x12%\n" +
-	"\bpassword\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x14R\bpassword\x12$\n" +
-	"\aaddress\x18\x04 \x01(\tB\n" +
-	"\xfaB\ar\x05\x10\x05\x18\xff\x01R\aaddress\x12\x1c\n" +
-	"\x04logo\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\x04logo\x12.\n" +
-	"\x0ebusiness_hours\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x10\x05R\rbusinessHours\"w\n" +
-	"\x18MerchantRegisterResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x1f\n" +
-	"\vmerchant_id\x18\x03 \x01(\x03R\n" +
-	"merchantId\x12\x14\n" +
-	"\x05token\x18\x04 \x01(\tR\x05token\"i\n" +
-	"\x14MerchantLoginRequest\x12*\n" +
-	"\x05phone\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$R\x05phone\x12%\n" +
-	"\bpassword\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x14R\bpassword\"\x88\x01\n" +
-	"\x15MerchantLoginResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x1f\n" +
-	"\vmerchant_id\x18\x03 \x01(\x03R\n" +
-	"merchantId\x12\x12\n" +
-	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
-	"\x05token\x18\x05 \x01(\tR\x05token\"B\n" +
-	"\x16GetMerchantInfoRequest\x12(\n" +
-	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
-	"merchantId\"o\n" +
-	"\x17GetMerchantInfoResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg\x12.\n" +
-	"\bmerchant\x18\x03 \x01(\v2\x12.merchant.MerchantR\bmerchant\"\xa6\x02\n" +
-	"\x19UpdateMerchantInfoRequest\x12(\n" +
-	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
-	"merchantId\x12\x1d\n" +
-	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x18@R\x04name\x12*\n" +
-	"\x05phone\x18\x03 \x01(\tB\x14\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$R\x05phone\x12$\n" +
-	"\aaddress\x18\x04 \x01(\tB\n" +
-	"\xfaB\ar\x05\x10\x05\x18\xff\x01R\aaddress\x12\x1c\n" +
-	"\x04logo\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\x04logo\x12.\n" +
-	"\x0ebusiness_hours\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x10\x05R\rbusinessHours\x12 \n" +
-	"\ais_open\x18\a \x01(\bB\a\xfaB\x04j\x02\b\x01R\x06isOpen\"b\n" +
-	"\x12AcceptOrderRequest\x12\"\n" +
-	"\border_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\x12(\n" +
-	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
-	"merchantId\"\x86\x01\n" +
-	"\x12RejectOrderRequest\x12\"\n" +
-	"\border_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\x12(\n" +
-	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
-	"merchantId\x12\"\n" +
-	"\x06reason\x18\x03 \x01(\tB\n" +
-	"\xfaB\ar\x05\x10\x02\x18\x80\x01R\x06reason\"\xa2\x01\n" +
-	"\x19ListMerchantOrdersRequest\x12(\n" +
-	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
-	"merchantId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
-	"\x04page\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04page\x12&\n" +
-	"\tpage_size\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\n" +
-	"R\bpageSize\"\xba\x01\n" +
-	"\x1aListMerchantOrdersResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg\x12/\n" +
-	"\x06orders\x18\x03 \x03(\v2\x17.merchant.MerchantOrderR\x06orders\x12\x14\n" +
+// 查询商家经营统计请求
+type GetMerchantStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 开始日期（格式：2006-01-02，按下单日期统计）
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 结束日期（含当天，跨度不超过366天）
+	TopN          int32                  `protobuf:"varint,4,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`               // 热销商品数（0取默认10，最大50）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMerchantStatsRequest) Reset() {
+	*x = GetMerchantStatsRequest{}
+	mi := &file_merchant_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMerchantStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMerchantStatsRequest) ProtoMessage() {}
+
+func (x *GetMerchantStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMerchantStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMerchantStatsRequest) Descriptor() ([]byte, []int) {
+	return file_merchant_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetMerchantStatsRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *GetMerchantStatsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetMerchantStatsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetMerchantStatsRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+// 订单状态计数
+type StatusCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusCount) Reset() {
+	*x = StatusCount{}
+	mi := &file_merchant_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusCount) ProtoMessage() {}
+
+func (x *StatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusCount.ProtoReflect.Descriptor instead.
+func (*StatusCount) Descriptor() ([]byte, []int) {
+	return file_merchant_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StatusCount) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatusCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 每日经营数据
+type DailyStat struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Date            string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	OrderCount      int64                  `protobuf:"varint,2,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`                  // 已支付订单数
+	ValidOrderCount int64                  `protobuf:"varint,3,opt,name=valid_order_count,json=validOrderCount,proto3" json:"valid_order_count,omitempty"` // 有效订单数
+	Gmv             float32                `protobuf:"fixed32,4,opt,name=gmv,proto3" json:"gmv,omitempty"`                                                 // 有效订单实付金额（扣除退款）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DailyStat) Reset() {
+	*x = DailyStat{}
+	mi := &file_merchant_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyStat) ProtoMessage() {}
+
+func (x *DailyStat) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyStat.ProtoReflect.Descriptor instead.
+func (*DailyStat) Descriptor() ([]byte, []int) {
+	return file_merchant_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DailyStat) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyStat) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *DailyStat) GetValidOrderCount() int64 {
+	if x != nil {
+		return x.ValidOrderCount
+	}
+	return 0
+}
+
+func (x *DailyStat) GetGmv() float32 {
+	if x != nil {
+		return x.Gmv
+	}
+	return 0
+}
+
+// 商品销量
+type ProductSales struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // 销量（扣除退款）
+	Amount        float32                `protobuf:"fixed32,4,opt,name=amount,proto3" json:"amount,omitempty"`    // 销售额（扣除退款）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSales) Reset() {
+	*x = ProductSales{}
+	mi := &file_merchant_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSales) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSales) ProtoMessage() {}
+
+func (x *ProductSales) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSales.ProtoReflect.Descriptor instead.
+func (*ProductSales) Descriptor() ([]byte, []int) {
+	return file_merchant_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProductSales) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductSales) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ProductSales) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ProductSales) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 商家经营统计
+type MerchantStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId       int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	StartDate        string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate          string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	OrderCount       int64                  `protobuf:"varint,4,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`                  // 已支付订单数
+	ValidOrderCount  int64                  `protobuf:"varint,5,opt,name=valid_order_count,json=validOrderCount,proto3" json:"valid_order_count,omitempty"` // 有效订单数（未取消/拒单）
+	StatusCounts     []*StatusCount         `protobuf:"bytes,6,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty"`
+	Gmv              float32                `protobuf:"fixed32,7,opt,name=gmv,proto3" json:"gmv,omitempty"`                                                     // 有效订单实付金额（扣除退款）
+	AvgOrderValue    float32                `protobuf:"fixed32,8,opt,name=avg_order_value,json=avgOrderValue,proto3" json:"avg_order_value,omitempty"`          // 客单价
+	CancelRate       float32                `protobuf:"fixed32,9,opt,name=cancel_rate,json=cancelRate,proto3" json:"cancel_rate,omitempty"`                     // 取消率（0~1）
+	RejectRate       float32                `protobuf:"fixed32,10,opt,name=reject_rate,json=rejectRate,proto3" json:"reject_rate,omitempty"`                    // 拒单率（0~1）
+	AvgAcceptSeconds int64                  `protobuf:"varint,11,opt,name=avg_accept_seconds,json=avgAcceptSeconds,proto3" json:"avg_accept_seconds,omitempty"` // 平均接单时长（秒，支付到接单）
+	Daily            []*DailyStat           `protobuf:"bytes,12,rep,name=daily,proto3" json:"daily,omitempty"`                                                  // 每日趋势
+	TopProducts      []*ProductSales        `protobuf:"bytes,13,rep,name=top_products,json=topProducts,proto3" json:"top_products,omitempty"`                   // 热销商品
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MerchantStats) Reset() {
+	*x = MerchantStats{}
+	mi := &file_merchant_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MerchantStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerchantStats) ProtoMessage() {}
+
+func (x *MerchantStats) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerchantStats.ProtoReflect.Descriptor instead.
+func (*MerchantStats) Descriptor() ([]byte, []int) {
+	return file_merchant_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MerchantStats) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *MerchantStats) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *MerchantStats) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetValidOrderCount() int64 {
+	if x != nil {
+		return x.ValidOrderCount
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetStatusCounts() []*StatusCount {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+func (x *MerchantStats) GetGmv() float32 {
+	if x != nil {
+		return x.Gmv
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetAvgOrderValue() float32 {
+	if x != nil {
+		return x.AvgOrderValue
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetCancelRate() float32 {
+	if x != nil {
+		return x.CancelRate
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetRejectRate() float32 {
+	if x != nil {
+		return x.RejectRate
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetAvgAcceptSeconds() int64 {
+	if x != nil {
+		return x.AvgAcceptSeconds
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetDaily() []*DailyStat {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *MerchantStats) GetTopProducts() []*ProductSales {
+	if x != nil {
+		return x.TopProducts
+	}
+	return nil
+}
+
+// 查询商家经营统计响应
+type GetMerchantStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Stats         *MerchantStats         `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMerchantStatsResponse) Reset() {
+	*x = GetMerchantStatsResponse{}
+	mi := &file_merchant_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMerchantStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMerchantStatsResponse) ProtoMessage() {}
+
+func (x *GetMerchantStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMerchantStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetMerchantStatsResponse) Descriptor() ([]byte, []int) {
+	return file_merchant_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetMerchantStatsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetMerchantStatsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetMerchantStatsResponse) GetStats() *MerchantStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// 附近商家查询请求
+type ListNearbyMerchantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Longitude     float64                `protobuf:"fixed64,1,opt,name=longitude,proto3" json:"longitude,omitempty"`              // 用户经度
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`                // 用户纬度
+	SortBy        string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`        // 排序：distance（默认）/score/sales（仅在最近的500个候选商家内排序）
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`                         // 页码（0取默认1）
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页条数（0取默认20，最大50）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNearbyMerchantsRequest) Reset() {
+	*x = ListNearbyMerchantsRequest{}
+	mi := &file_merchant_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNearbyMerchantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNearbyMerchantsRequest) ProtoMessage() {}
+
+func (x *ListNearbyMerchantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNearbyMerchantsRequest.ProtoReflect.Descriptor instead.
+func (*ListNearbyMerchantsRequest) Descriptor() ([]byte, []int) {
+	return file_merchant_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListNearbyMerchantsRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *ListNearbyMerchantsRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ListNearbyMerchantsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListNearbyMerchantsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListNearbyMerchantsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 附近商家
+type NearbyMerchant struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId     int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address        string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Logo           string                 `protobuf:"bytes,4,opt,name=logo,proto3" json:"logo,omitempty"`
+	BusinessHours  string                 `protobuf:"bytes,5,opt,name=business_hours,json=businessHours,proto3" json:"business_hours,omitempty"`
+	Score          float32                `protobuf:"fixed32,6,opt,name=score,proto3" json:"score,omitempty"`
+	OrderCount     int32                  `protobuf:"varint,7,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`                // 订单数（销量）
+	MinOrderAmount float32                `protobuf:"fixed32,8,opt,name=min_order_amount,json=minOrderAmount,proto3" json:"min_order_amount,omitempty"` // 起送价
+	DeliveryRadius int32                  `protobuf:"varint,9,opt,name=delivery_radius,json=deliveryRadius,proto3" json:"delivery_radius,omitempty"`    // 配送半径（米）
+	Distance       int32                  `protobuf:"varint,10,opt,name=distance,proto3" json:"distance,omitempty"`                                     // 与用户距离（米）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NearbyMerchant) Reset() {
+	*x = NearbyMerchant{}
+	mi := &file_merchant_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyMerchant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyMerchant) ProtoMessage() {}
+
+func (x *NearbyMerchant) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyMerchant.ProtoReflect.Descriptor instead.
+func (*NearbyMerchant) Descriptor() ([]byte, []int) {
+	return file_merchant_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NearbyMerchant) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *NearbyMerchant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NearbyMerchant) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NearbyMerchant) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *NearbyMerchant) GetBusinessHours() string {
+	if x != nil {
+		return x.BusinessHours
+	}
+	return ""
+}
+
+func (x *NearbyMerchant) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *NearbyMerchant) GetOrderCount() int32 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *NearbyMerchant) GetMinOrderAmount() float32 {
+	if x != nil {
+		return x.MinOrderAmount
+	}
+	return 0
+}
+
+func (x *NearbyMerchant) GetDeliveryRadius() int32 {
+	if x != nil {
+		return x.DeliveryRadius
+	}
+	return 0
+}
+
+func (x *NearbyMerchant) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+// 附近商家查询响应
+type ListNearbyMerchantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Merchants     []*NearbyMerchant      `protobuf:"bytes,3,rep,name=merchants,proto3" json:"merchants,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"` // 总条数（不超过候选商家数500）
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNearbyMerchantsResponse) Reset() {
+	*x = ListNearbyMerchantsResponse{}
+	mi := &file_merchant_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNearbyMerchantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNearbyMerchantsResponse) ProtoMessage() {}
+
+func (x *ListNearbyMerchantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNearbyMerchantsResponse.ProtoReflect.Descriptor instead.
+func (*ListNearbyMerchantsResponse) Descriptor() ([]byte, []int) {
+	return file_merchant_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListNearbyMerchantsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListNearbyMerchantsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListNearbyMerchantsResponse) GetMerchants() []*NearbyMerchant {
+	if x != nil {
+		return x.Merchants
+	}
+	return nil
+}
+
+func (x *ListNearbyMerchantsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListNearbyMerchantsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListNearbyMerchantsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+var File_merchant_proto protoreflect.FileDescriptor
+
+const file_merchant_proto_rawDesc = "" +
+	"\n" +
+	"\x0emerchant.proto\x12\bmerchant\x1a\x1bgoogle/protobuf/empty.proto\x1a\x0evalidate.proto\"\xe1\x03\n" +
+	"\bMerchant\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04logo\x18\x06 \x01(\tR\x04logo\x12%\n" +
+	"\x0ebusiness_hours\x18\a \x01(\tR\rbusinessHours\x12\x14\n" +
+	"\x05score\x18\b \x01(\x02R\x05score\x12\x1f\n" +
+	"\vorder_count\x18\t \x01(\x05R\n" +
+	"orderCount\x12\x17\n" +
+	"\ais_open\x18\n" +
+	" \x01(\bR\x06isOpen\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\x12\x1c\n" +
+	"\tlongitude\x18\r \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\blatitude\x18\x0e \x01(\x01R\blatitude\x12(\n" +
+	"\x10min_order_amount\x18\x0f \x01(\x02R\x0eminOrderAmount\x12'\n" +
+	"\x0fdelivery_radius\x18\x10 \x01(\x05R\x0edeliveryRadius\"\x8d\x02\n" +
+	"\rMerchantOrder\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_phone\x18\x04 \x01(\tR\tuserPhone\x12!\n" +
+	"\ftotal_amount\x18\x05 \x01(\x02R\vtotalAmount\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1f\n" +
+	"\vcreate_time\x18\a \x01(\tR\n" +
+	"createTime\x120\n" +
+	"\x14expect_delivery_time\x18\b \x01(\tR\x12expectDeliveryTime\"6\n" +
+	"\x0eCommonResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\"\xd7\x03\n" +
+	"\x17MerchantRegisterRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x18@R\x04name\x12*\n" +
+	"\x05phone\x18\x02 \x01(\tB\x14\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$R\x05phone\x12%\n" +
+	"\bpassword\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x14R\bpassword\x12$\n" +
+	"\aaddress\x18\x04 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x05\x18\xff\x01R\aaddress\x12\x1c\n" +
+	"\x04logo\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\x04logo\x12.\n" +
+	"\x0ebusiness_hours\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x10\x05R\rbusinessHours\x125\n" +
+	"\tlongitude\x18\a \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\x123\n" +
+	"\blatitude\x18\b \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\x124\n" +
+	"\x10min_order_amount\x18\t \x01(\x02B\n" +
+	"\xfaB\a\n" +
+	"\x05-\x00\x00\x00\x00R\x0eminOrderAmount\x124\n" +
+	"\x0fdelivery_radius\x18\n" +
+	" \x01(\x05B\v\xfaB\b\x1a\x06\x18\xa0\x9c\x01(\x00R\x0edeliveryRadius\"w\n" +
+	"\x18MerchantRegisterResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x1f\n" +
+	"\vmerchant_id\x18\x03 \x01(\x03R\n" +
+	"merchantId\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\"i\n" +
+	"\x14MerchantLoginRequest\x12*\n" +
+	"\x05phone\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$R\x05phone\x12%\n" +
+	"\bpassword\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x14R\bpassword\"\x88\x01\n" +
+	"\x15MerchantLoginResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x1f\n" +
+	"\vmerchant_id\x18\x03 \x01(\x03R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05token\x18\x05 \x01(\tR\x05token\"B\n" +
+	"\x16GetMerchantInfoRequest\x12(\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\"o\n" +
+	"\x17GetMerchantInfoResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12.\n" +
+	"\bmerchant\x18\x03 \x01(\v2\x12.merchant.MerchantR\bmerchant\"\xfe\x03\n" +
+	"\x19UpdateMerchantInfoRequest\x12(\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x18@R\x04name\x12*\n" +
+	"\x05phone\x18\x03 \x01(\tB\x14\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$R\x05phone\x12$\n" +
+	"\aaddress\x18\x04 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x05\x18\xff\x01R\aaddress\x12\x1c\n" +
+	"\x04logo\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\x04logo\x12.\n" +
+	"\x0ebusiness_hours\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x10\x05R\rbusinessHours\x12 \n" +
+	"\ais_open\x18\a \x01(\bB\a\xfaB\x04j\x02\b\x01R\x06isOpen\x125\n" +
+	"\tlongitude\x18\b \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\x123\n" +
+	"\blatitude\x18\t \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\x124\n" +
+	"\x10min_order_amount\x18\n" +
+	" \x01(\x02B\n" +
+	"\xfaB\a\n" +
+	"\x05-\x00\x00\x00\x00R\x0eminOrderAmount\x124\n" +
+	"\x0fdelivery_radius\x18\v \x01(\x05B\v\xfaB\b\x1a\x06\x18\xa0\x9c\x01(\x00R\x0edeliveryRadius\"b\n" +
+	"\x12AcceptOrderRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\"\x86\x01\n" +
+	"\x12RejectOrderRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12\"\n" +
+	"\x06reason\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x02\x18\x80\x01R\x06reason\"\xcd\x03\n" +
+	"\x19ListMerchantOrdersRequest\x12(\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\x04page\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12&\n" +
+	"\tpage_size\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\n" +
+	"R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"with_total\x18\x06 \x01(\bR\twithTotal\x12\x1a\n" +
+	"\bstatuses\x18\a \x03(\tR\bstatuses\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\b \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\t \x01(\tR\aendTime\x12&\n" +
+	"\x0forder_no_prefix\x18\n" +
+	" \x01(\tR\rorderNoPrefix\x12\x1d\n" +
+	"\n" +
+	"user_phone\x18\v \x01(\tR\tuserPhone\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\f \x01(\x02R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\r \x01(\x02R\tmaxAmount\x12\x17\n" +
+	"\asort_by\x18\x0e \x01(\tR\x06sortBy\"\xdb\x01\n" +
+	"\x1aListMerchantOrdersResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12/\n" +
+	"\x06orders\x18\x03 \x03(\v2\x17.merchant.MerchantOrderR\x06orders\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vnext_cursor\x18\a \x01(\tR\n" +
+	"nextCursor\"\x92\x01\n" +
+	"\x17GetMerchantStatsRequest\x12(\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x13\n" +
+	"\x05top_n\x18\x04 \x01(\x05R\x04topN\";\n" +
+	"\vStatusCount\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"~\n" +
+	"\tDailyStat\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1f\n" +
+	"\vorder_count\x18\x02 \x01(\x03R\n" +
+	"orderCount\x12*\n" +
+	"\x11valid_order_count\x18\x03 \x01(\x03R\x0fvalidOrderCount\x12\x10\n" +
+	"\x03gmv\x18\x04 \x01(\x02R\x03gmv\"\x84\x01\n" +
+	"\fProductSales\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x02R\x06amount\"\x83\x04\n" +
+	"\rMerchantStats\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x1f\n" +
+	"\vorder_count\x18\x04 \x01(\x03R\n" +
+	"orderCount\x12*\n" +
+	"\x11valid_order_count\x18\x05 \x01(\x03R\x0fvalidOrderCount\x12:\n" +
+	"\rstatus_counts\x18\x06 \x03(\v2\x15.merchant.StatusCountR\fstatusCounts\x12\x10\n" +
+	"\x03gmv\x18\a \x01(\x02R\x03gmv\x12&\n" +
+	"\x0favg_order_value\x18\b \x01(\x02R\ravgOrderValue\x12\x1f\n" +
+	"\vcancel_rate\x18\t \x01(\x02R\n" +
+	"cancelRate\x12\x1f\n" +
+	"\vreject_rate\x18\n" +
+	" \x01(\x02R\n" +
+	"rejectRate\x12,\n" +
+	"\x12avg_accept_seconds\x18\v \x01(\x03R\x10avgAcceptSeconds\x12)\n" +
+	"\x05daily\x18\f \x03(\v2\x13.merchant.DailyStatR\x05daily\x129\n" +
+	"\ftop_products\x18\r \x03(\v2\x16.merchant.ProductSalesR\vtopProducts\"o\n" +
+	"\x18GetMerchantStatsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12-\n" +
+	"\x05stats\x18\x03 \x01(\v2\x17.merchant.MerchantStatsR\x05stats\"\xd2\x01\n" +
+	"\x1aListNearbyMerchantsRequest\x125\n" +
+	"\tlongitude\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\x123\n" +
+	"\blatitude\x18\x02 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\x12\x17\n" +
+	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\xc0\x02\n" +
+	"\x0eNearbyMerchant\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04logo\x18\x04 \x01(\tR\x04logo\x12%\n" +
+	"\x0ebusiness_hours\x18\x05 \x01(\tR\rbusinessHours\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x02R\x05score\x12\x1f\n" +
+	"\vorder_count\x18\a \x01(\x05R\n" +
+	"orderCount\x12(\n" +
+	"\x10min_order_amount\x18\b \x01(\x02R\x0eminOrderAmount\x12'\n" +
+	"\x0fdelivery_radius\x18\t \x01(\x05R\x0edeliveryRadius\x12\x1a\n" +
+	"\bdistance\x18\n" +
+	" \x01(\x05R\bdistance\"\xc2\x01\n" +
+	"\x1bListNearbyMerchantsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x126\n" +
+	"\tmerchants\x18\x03 \x03(\v2\x18.merchant.NearbyMerchantR\tmerchants\x12\x14\n" +
 	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x06 \x01(\x05R\bpageSize2\xda\x04\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize2\x99\x06\n" +
 	"\x0fMerchantService\x12Y\n" +
 	"\x10MerchantRegister\x12!.merchant.MerchantRegisterRequest\x1a\".merchant.MerchantRegisterResponse\x12P\n" +
 	"\rMerchantLogin\x12\x1e.merchant.MerchantLoginRequest\x1a\x1f.merchant.MerchantLoginResponse\x12V\n" +
@@ -1170,7 +2204,9 @@ const file_merchant_proto_rawDesc = "" +
 	"\x12UpdateMerchantInfo\x12#.merchant.UpdateMerchantInfoRequest\x1a\x18.merchant.CommonResponse\x12E\n" +
 	"\vAcceptOrder\x12\x1c.merchant.AcceptOrderRequest\x1a\x18.merchant.CommonResponse\x12E\n" +
 	"\vRejectOrder\x12\x1c.merchant.RejectOrderRequest\x1a\x18.merchant.CommonResponse\x12_\n" +
-	"\x12ListMerchantOrders\x12#.merchant.ListMerchantOrdersRequest\x1a$.merchant.ListMerchantOrdersResponseB)Z'./internal/merchant/proto;merchantProtob\x06proto3"
+	"\x12ListMerchantOrders\x12#.merchant.ListMerchantOrdersRequest\x1a$.merchant.ListMerchantOrdersResponse\x12Y\n" +
+	"\x10GetMerchantStats\x12!.merchant.GetMerchantStatsRequest\x1a\".merchant.GetMerchantStatsResponse\x12b\n" +
+	"\x13ListNearbyMerchants\x12$.merchant.ListNearbyMerchantsRequest\x1a%.merchant.ListNearbyMerchantsResponseB)Z'./internal/merchant/proto;merchantProtob\x06proto3"
 
 var (
 	file_merchant_proto_rawDescOnce sync.Once
@@ -1184,45 +2220,63 @@ func file_merchant_proto_rawDescGZIP() []byte {
 	return file_merchant_proto_rawDescData
 }
 
-var file_merchant_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_merchant_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_merchant_proto_goTypes = []any{
-	(*Merchant)(nil),                   // 0: merchant.Merchant
-	(*MerchantOrder)(nil),              // 1: merchant.MerchantOrder
-	(*CommonResponse)(nil),             // 2: merchant.CommonResponse
-	(*MerchantRegisterRequest)(nil),    // 3: merchant.MerchantRegisterRequest
-	(*MerchantRegisterResponse)(nil),   // 4: merchant.MerchantRegisterResponse
-	(*MerchantLoginRequest)(nil),       // 5: merchant.MerchantLoginRequest
-	(*MerchantLoginResponse)(nil),      // 6: merchant.MerchantLoginResponse
-	(*GetMerchantInfoRequest)(nil),     // 7: merchant.GetMerchantInfoRequest
-	(*GetMerchantInfoResponse)(nil),    // 8: merchant.GetMerchantInfoResponse
-	(*UpdateMerchantInfoRequest)(nil),  // 9: merchant.UpdateMerchantInfoRequest
-	(*AcceptOrderRequest)(nil),         // 10: merchant.AcceptOrderRequest
-	(*RejectOrderRequest)(nil),         // 11: merchant.RejectOrderRequest
-	(*ListMerchantOrdersRequest)(nil),  // 12: merchant.ListMerchantOrdersRequest
-	(*ListMerchantOrdersResponse)(nil), // 13: merchant.ListMerchantOrdersResponse
+	(*Merchant)(nil),                    // 0: merchant.Merchant
+	(*MerchantOrder)(nil),               // 1: merchant.MerchantOrder
+	(*CommonResponse)(nil),              // 2: merchant.CommonResponse
+	(*MerchantRegisterRequest)(nil),     // 3: merchant.MerchantRegisterRequest
+	(*MerchantRegisterResponse)(nil),    // 4: merchant.MerchantRegisterResponse
+	(*MerchantLoginRequest)(nil),        // 5: merchant.MerchantLoginRequest
+	(*MerchantLoginResponse)(nil),       // 6: merchant.MerchantLoginResponse
+	(*GetMerchantInfoRequest)(nil),      // 7: merchant.GetMerchantInfoRequest
+	(*GetMerchantInfoResponse)(nil),     // 8: merchant.GetMerchantInfoResponse
+	(*UpdateMerchantInfoRequest)(nil),   // 9: merchant.UpdateMerchantInfoRequest
+	(*AcceptOrderRequest)(nil),          // 10: merchant.AcceptOrderRequest
+	(*RejectOrderRequest)(nil),          // 11: merchant.RejectOrderRequest
+	(*ListMerchantOrdersRequest)(nil),   // 12: merchant.ListMerchantOrdersRequest
+	(*ListMerchantOrdersResponse)(nil),  // 13: merchant.ListMerchantOrdersResponse
+	(*GetMerchantStatsRequest)(nil),     // 14: merchant.GetMerchantStatsRequest
+	(*StatusCount)(nil),                 // 15: merchant.StatusCount
+	(*DailyStat)(nil),                   // 16: merchant.DailyStat
+	(*ProductSales)(nil),                // 17: merchant.ProductSales
+	(*MerchantStats)(nil),               // 18: merchant.MerchantStats
+	(*GetMerchantStatsResponse)(nil),    // 19: merchant.GetMerchantStatsResponse
+	(*ListNearbyMerchantsRequest)(nil),  // 20: merchant.ListNearbyMerchantsRequest
+	(*NearbyMerchant)(nil),              // 21: merchant.NearbyMerchant
+	(*ListNearbyMerchantsResponse)(nil), // 22: merchant.ListNearbyMerchantsResponse
 }
 var file_merchant_proto_depIdxs = []int32{
 	0,  // 0: merchant.GetMerchantInfoResponse.merchant:type_name -> merchant.Merchant
 	1,  // 1: merchant.ListMerchantOrdersResponse.orders:type_name -> merchant.MerchantOrder
-	3,  // 2: merchant.MerchantService.MerchantRegister:input_type -> merchant.MerchantRegisterRequest
-	5,  // 3: merchant.MerchantService.MerchantLogin:input_type -> merchant.MerchantLoginRequest
-	7,  // 4: merchant.MerchantService.GetMerchantInfo:input_type -> merchant.GetMerchantInfoRequest
-	9,  // 5: merchant.MerchantService.UpdateMerchantInfo:input_type -> merchant.UpdateMerchantInfoRequest
-	10, // 6: merchant.MerchantService.AcceptOrder:input_type -> merchant.AcceptOrderRequest
-	11, // 7: merchant.MerchantService.RejectOrder:input_type -> merchant.RejectOrderRequest
-	12, // 8: merchant.MerchantService.ListMerchantOrders:input_type -> merchant.ListMerchantOrdersRequest
-	4,  // 9: merchant.MerchantService.MerchantRegister:output_type -> merchant.MerchantRegisterResponse
-	6,  // 10: merchant.MerchantService.MerchantLogin:output_type -> merchant.MerchantLoginResponse
-	8,  // 11: merchant.MerchantService.GetMerchantInfo:output_type -> merchant.GetMerchantInfoResponse
-	2,  // 12: merchant.MerchantService.UpdateMerchantInfo:output_type -> merchant.CommonResponse
-	2,  // 13: merchant.MerchantService.AcceptOrder:output_type -> merchant.CommonResponse
-	2,  // 14: merchant.MerchantService.RejectOrder:output_type -> merchant.CommonResponse
-	13, // 15: merchant.MerchantService.ListMerchantOrders:output_type -> merchant.ListMerchantOrdersResponse
-	9,  // [9:16] is the sub-list for method output_type
-	2,  // [2:9] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	15, // 2: merchant.MerchantStats.status_counts:type_name -> merchant.StatusCount
+	16, // 3: merchant.MerchantStats.daily:type_name -> merchant.DailyStat
+	17, // 4: merchant.MerchantStats.top_products:type_name -> merchant.ProductSales
+	18, // 5: merchant.GetMerchantStatsResponse.stats:type_name -> merchant.MerchantStats
+	21, // 6: merchant.ListNearbyMerchantsResponse.merchants:type_name -> merchant.NearbyMerchant
+	3,  // 7: merchant.MerchantService.MerchantRegister:input_type -> merchant.MerchantRegisterRequest
+	5,  // 8: merchant.MerchantService.MerchantLogin:input_type -> merchant.MerchantLoginRequest
+	7,  // 9: merchant.MerchantService.GetMerchantInfo:input_type -> merchant.GetMerchantInfoRequest
+	9,  // 10: merchant.MerchantService.UpdateMerchantInfo:input_type -> merchant.UpdateMerchantInfoRequest
+	10, // 11: merchant.MerchantService.AcceptOrder:input_type -> merchant.AcceptOrderRequest
+	11, // 12: merchant.MerchantService.RejectOrder:input_type -> merchant.RejectOrderRequest
+	12, // 13: merchant.MerchantService.ListMerchantOrders:input_type -> merchant.ListMerchantOrdersRequest
+	14, // 14: merchant.MerchantService.GetMerchantStats:input_type -> merchant.GetMerchantStatsRequest
+	20, // 15: merchant.MerchantService.ListNearbyMerchants:input_type -> merchant.ListNearbyMerchantsRequest
+	4,  // 16: merchant.MerchantService.MerchantRegister:output_type -> merchant.MerchantRegisterResponse
+	6,  // 17: merchant.MerchantService.MerchantLogin:output_type -> merchant.MerchantLoginResponse
+	8,  // 18: merchant.MerchantService.GetMerchantInfo:output_type -> merchant.GetMerchantInfoResponse
+	2,  // 19: merchant.MerchantService.UpdateMerchantInfo:output_type -> merchant.CommonResponse
+	2,  // 20: merchant.MerchantService.AcceptOrder:output_type -> merchant.CommonResponse
+	2,  // 21: merchant.MerchantService.RejectOrder:output_type -> merchant.CommonResponse
+	13, // 22: merchant.MerchantService.ListMerchantOrders:output_type -> merchant.ListMerchantOrdersResponse
+	19, // 23: merchant.MerchantService.GetMerchantStats:output_type -> merchant.GetMerchantStatsResponse
+	22, // 24: merchant.MerchantService.ListNearbyMerchants:output_type -> merchant.ListNearbyMerchantsResponse
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_merchant_proto_init() }
@@ -1236,7 +2290,7 @@ func file_merchant_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_merchant_proto_rawDesc), len(file_merchant_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
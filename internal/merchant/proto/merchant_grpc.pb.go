@@ -19,13 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MerchantService_MerchantRegister_FullMethodName   = "/merchant.MerchantService/MerchantRegister"
-	MerchantService_MerchantLogin_FullMethodName      = "/merchant.MerchantService/MerchantLogin"
-	MerchantService_GetMerchantInfo_FullMethodName    = "/merchant.MerchantService/GetMerchantInfo"
-	MerchantService_UpdateMerchantInfo_FullMethodName = "/merchant.MerchantService/UpdateMerchantInfo"
-	MerchantService_AcceptOrder_FullMethodName        = "/merchant.MerchantService/AcceptOrder"
-	MerchantService_RejectOrder_FullMethodName        = "/merchant.MerchantService/RejectOrder"
-	MerchantService_ListMerchantOrders_FullMethodName = "/merchant.MerchantService/ListMerchantOrders"
+	MerchantService_MerchantRegister_FullMethodName    = "/merchant.MerchantService/MerchantRegister"
+	MerchantService_MerchantLogin_FullMethodName       = "/merchant.MerchantService/MerchantLogin"
+	MerchantService_GetMerchantInfo_FullMethodName     = "/merchant.MerchantService/GetMerchantInfo"
+	MerchantService_UpdateMerchantInfo_FullMethodName  = "/merchant.MerchantService/UpdateMerchantInfo"
+	MerchantService_AcceptOrder_FullMethodName         = "/merchant.MerchantService/AcceptOrder"
+	MerchantService_RejectOrder_FullMethodName         = "/merchant.MerchantService/RejectOrder"
+	MerchantService_ListMerchantOrders_FullMethodName  = "/merchant.MerchantService/ListMerchantOrders"
+	MerchantService_GetMerchantStats_FullMethodName    = "/merchant.MerchantService/GetMerchantStats"
+	MerchantService_ListNearbyMerchants_FullMethodName = "/merchant.MerchantService/ListNearbyMerchants"
 )
 
 // MerchantServiceClient is the client API for MerchantService service.
@@ -46,6 +48,10 @@ type MerchantServiceClient interface {
 	RejectOrder(ctx context.Context, in *RejectOrderRequest, opts ...grpc.CallOption) (*CommonResponse, error)
 	// 查询商家订单列表
 	ListMerchantOrders(ctx context.Context, in *ListMerchantOrdersRequest, opts ...grpc.CallOption) (*ListMerchantOrdersResponse, error)
+	// 经营数据看板
+	GetMerchantStats(ctx context.Context, in *GetMerchantStatsRequest, opts ...grpc.CallOption) (*GetMerchantStatsResponse, error)
+	// 附近可配送的营业中商家
+	ListNearbyMerchants(ctx context.Context, in *ListNearbyMerchantsRequest, opts ...grpc.CallOption) (*ListNearbyMerchantsResponse, error)
 }
 
 type merchantServiceClient struct {
@@ -126,6 +132,26 @@ func (c *merchantServiceClient) ListMerchantOrders(ctx context.Context, in *List
 	return out, nil
 }
 
+func (c *merchantServiceClient) GetMerchantStats(ctx context.Context, in *GetMerchantStatsRequest, opts ...grpc.CallOption) (*GetMerchantStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMerchantStatsResponse)
+	err := c.cc.Invoke(ctx, MerchantService_GetMerchantStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) ListNearbyMerchants(ctx context.Context, in *ListNearbyMerchantsRequest, opts ...grpc.CallOption) (*ListNearbyMerchantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNearbyMerchantsResponse)
+	err := c.cc.Invoke(ctx, MerchantService_ListNearbyMerchants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MerchantServiceServer is the server API for MerchantService service.
 // All implementations must embed UnimplementedMerchantServiceServer
 // for forward compatibility.
@@ -144,6 +170,10 @@ type MerchantServiceServer interface {
 	RejectOrder(context.Context, *RejectOrderRequest) (*CommonResponse, error)
 	// 查询商家订单列表
 	ListMerchantOrders(context.Context, *ListMerchantOrdersRequest) (*ListMerchantOrdersResponse, error)
+	// 经营数据看板
+	GetMerchantStats(context.Context, *GetMerchantStatsRequest) (*GetMerchantStatsResponse, error)
+	// 附近可配送的营业中商家
+	ListNearbyMerchants(context.Context, *ListNearbyMerchantsRequest) (*ListNearbyMerchantsResponse, error)
 	mustEmbedUnimplementedMerchantServiceServer()
 }
 
@@ -175,6 +205,12 @@ func (UnimplementedMerchantServiceServer) RejectOrder(context.Context, *RejectOr
 func (UnimplementedMerchantServiceServer) ListMerchantOrders(context.Context, *ListMerchantOrdersRequest) (*ListMerchantOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMerchantOrders not implemented")
 }
+func (UnimplementedMerchantServiceServer) GetMerchantStats(context.Context, *GetMerchantStatsRequest) (*GetMerchantStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantStats not implemented")
+}
+func (UnimplementedMerchantServiceServer) ListNearbyMerchants(context.Context, *ListNearbyMerchantsRequest) (*ListNearbyMerchantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNearbyMerchants not implemented")
+}
 func (UnimplementedMerchantServiceServer) mustEmbedUnimplementedMerchantServiceServer() {}
 func (UnimplementedMerchantServiceServer) testEmbeddedByValue()                         {}
 
@@ -322,6 +358,42 @@ func _MerchantService_ListMerchantOrders_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MerchantService_GetMerchantStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMerchantStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).GetMerchantStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_GetMerchantStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).GetMerchantStats(ctx, req.(*GetMerchantStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_ListNearbyMerchants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNearbyMerchantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).ListNearbyMerchants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_ListNearbyMerchants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).ListNearbyMerchants(ctx, req.(*ListNearbyMerchantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MerchantService_ServiceDesc is the grpc.ServiceDesc for MerchantService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -357,6 +429,14 @@ var MerchantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMerchantOrders",
 			Handler:    _MerchantService_ListMerchantOrders_Handler,
 		},
+		{
+			MethodName: "GetMerchantStats",
+			Handler:    _MerchantService_GetMerchantStats_Handler,
+		},
+		{
+			MethodName: "ListNearbyMerchants",
+			Handler:    _MerchantService_ListNearbyMerchants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "merchant.proto",
@@ -0,0 +1,86 @@
+package repo
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/redis"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	goredis "github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// merchantGeoKey 商家位置索引key（Redis GEO，member为商家ID）
+const merchantGeoKey = "merchant:geo"
+
+// GeoHit 地理检索命中的商家
+type GeoHit struct {
+	MerchantID int64
+	Distance   float64 // 与检索中心点的距离（米）
+}
+
+// MerchantGeoRepo 商家位置索引接口
+type MerchantGeoRepo interface {
+	SetLocation(ctx context.Context, merchantID int64, lng, lat float64) error
+	RemoveLocation(ctx context.Context, merchantID int64) error
+	SearchNearby(ctx context.Context, lng, lat, radius float64, limit int) ([]GeoHit, error) // 半径（米）内的商家，按距离升序，最多limit个
+}
+
+type merchantGeoRepo struct{}
+
+// NewMerchantGeoRepo 创建实例
+func NewMerchantGeoRepo() MerchantGeoRepo {
+	return &merchantGeoRepo{}
+}
+
+// SetLocation 写入（覆盖）商家坐标
+func (r *merchantGeoRepo) SetLocation(ctx context.Context, merchantID int64, lng, lat float64) error {
+	err := redis.RedisClient.GeoAdd(ctx, merchantGeoKey, &goredis.GeoLocation{
+		Name:      strconv.FormatInt(merchantID, 10),
+		Longitude: lng,
+		Latitude:  lat,
+	}).Err()
+	if err != nil {
+		zap.L().Error("写入商家位置索引失败", zap.Int64("merchant_id", merchantID), zap.Float64("lng", lng), zap.Float64("lat", lat), zap.Error(err))
+		return utils.NewDBError("写入商家位置失败：" + err.Error())
+	}
+	return nil
+}
+
+// RemoveLocation 移除商家坐标（GEO底层为ZSet）
+func (r *merchantGeoRepo) RemoveLocation(ctx context.Context, merchantID int64) error {
+	if err := redis.RedisClient.ZRem(ctx, merchantGeoKey, strconv.FormatInt(merchantID, 10)).Err(); err != nil {
+		zap.L().Error("移除商家位置索引失败", zap.Int64("merchant_id", merchantID), zap.Error(err))
+		return utils.NewDBError("移除商家位置失败：" + err.Error())
+	}
+	return nil
+}
+
+// SearchNearby 查询半径内的商家（GEOSEARCH，按距离升序）
+func (r *merchantGeoRepo) SearchNearby(ctx context.Context, lng, lat, radius float64, limit int) ([]GeoHit, error) {
+	locations, err := redis.RedisClient.GeoSearchLocation(ctx, merchantGeoKey, &goredis.GeoSearchLocationQuery{
+		GeoSearchQuery: goredis.GeoSearchQuery{
+			Longitude:  lng,
+			Latitude:   lat,
+			Radius:     radius,
+			RadiusUnit: "m",
+			Sort:       "ASC",
+			Count:      limit,
+		},
+		WithDist: true,
+	}).Result()
+	if err != nil {
+		zap.L().Error("查询附近商家失败", zap.Float64("lng", lng), zap.Float64("lat", lat), zap.Float64("radius", radius), zap.Error(err))
+		return nil, utils.NewDBError("查询附近商家失败：" + err.Error())
+	}
+	hits := make([]GeoHit, 0, len(locations))
+	for _, loc := range locations {
+		merchantID, err := strconv.ParseInt(loc.Name, 10, 64)
+		if err != nil {
+			zap.L().Warn("商家位置索引成员格式错误", zap.String("member", loc.Name))
+			continue
+		}
+		hits = append(hits, GeoHit{MerchantID: merchantID, Distance: loc.Dist})
+	}
+	return hits, nil
+}
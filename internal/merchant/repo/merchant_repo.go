@@ -17,7 +17,10 @@ type MerchantRepo interface {
 	GetMerchantByPhone(ctx context.Context, phone string) (*model.Merchant, error)
 	GetMerchantByID(ctx context.Context, merchantID int64) (*model.Merchant, error)
 	UpdateMerchant(ctx context.Context, merchant *model.Merchant) error
-	UpdateOrderCount(ctx context.Context, merchantID int64, num int32) error // 更新订单数
+	UpdateOrderCount(ctx context.Context, merchantID int64, num int32) error                   // 更新订单数
+	UpdateScore(ctx context.Context, merchantID int64, score float64, ratingCount int64) error // 更新评分（仅评分次数增加时生效）
+	GetMerchantsByIDs(ctx context.Context, merchantIDs []int64) ([]*model.Merchant, error)     // 批量查询（不存在的ID忽略）
+	ListMerchants(ctx context.Context, afterID int64, limit int) ([]*model.Merchant, error)    // 按ID升序分批读取（重建地理索引用）
 }
 
 // merchantRepo 实现
@@ -73,12 +76,16 @@ func (r *merchantRepo) UpdateMerchant(ctx context.Context, merchant *model.Merch
 	tx := db.Mysql.WithContext(ctx).Model(&model.Merchant{}).
 		Where("merchant_id = ?", merchant.MerchantID).
 		Updates(map[string]interface{}{
-			"name":           merchant.Name,
-			"phone":          merchant.Phone,
-			"address":        merchant.Address,
-			"logo":           merchant.Logo,
-			"business_hours": merchant.BusinessHours,
-			"is_open":        merchant.IsOpen,
+			"name":             merchant.Name,
+			"phone":            merchant.Phone,
+			"address":          merchant.Address,
+			"logo":             merchant.Logo,
+			"business_hours":   merchant.BusinessHours,
+			"longitude":        merchant.Longitude,
+			"latitude":         merchant.Latitude,
+			"min_order_amount": merchant.MinOrderAmount,
+			"delivery_radius":  merchant.DeliveryRadius,
+			"is_open":          merchant.IsOpen,
 		})
 	if tx.Error != nil {
 		zap.L().Error("更新商家信息失败", zap.Any("merchant", merchant), zap.Error(tx.Error))
@@ -104,3 +111,43 @@ func (r *merchantRepo) UpdateOrderCount(ctx context.Context, merchantID int64, n
 	}
 	return nil
 }
+
+// UpdateScore 更新商家评分（消息可能乱序或重复，仅当评分次数大于当前值时更新）
+func (r *merchantRepo) UpdateScore(ctx context.Context, merchantID int64, score float64, ratingCount int64) error {
+	tx := db.Mysql.WithContext(ctx).Model(&model.Merchant{}).
+		Where("merchant_id = ? AND rating_count < ?", merchantID, ratingCount).
+		Updates(map[string]interface{}{
+			"score":        score,
+			"rating_count": ratingCount,
+		})
+	if tx.Error != nil {
+		zap.L().Error("更新商家评分失败", zap.Int64("merchant_id", merchantID), zap.Float64("score", score), zap.Error(tx.Error))
+		return utils.NewDBError("更新商家评分失败：" + tx.Error.Error())
+	}
+	return nil
+}
+
+// GetMerchantsByIDs 批量查询商家（不存在的ID忽略，结果不保证顺序）
+func (r *merchantRepo) GetMerchantsByIDs(ctx context.Context, merchantIDs []int64) ([]*model.Merchant, error) {
+	var merchants []*model.Merchant
+	if len(merchantIDs) == 0 {
+		return merchants, nil
+	}
+	tx := db.Mysql.WithContext(ctx).Where("merchant_id IN ?", merchantIDs).Find(&merchants)
+	if tx.Error != nil {
+		zap.L().Error("批量查询商家失败", zap.Int64s("merchant_ids", merchantIDs), zap.Error(tx.Error))
+		return nil, utils.NewDBError("查询商家失败：" + tx.Error.Error())
+	}
+	return merchants, nil
+}
+
+// ListMerchants 按ID升序分批查询商家
+func (r *merchantRepo) ListMerchants(ctx context.Context, afterID int64, limit int) ([]*model.Merchant, error) {
+	var merchants []*model.Merchant
+	tx := db.Mysql.WithContext(ctx).Where("merchant_id > ?", afterID).Order("merchant_id").Limit(limit).Find(&merchants)
+	if tx.Error != nil {
+		zap.L().Error("分批查询商家失败", zap.Int64("after_id", afterID), zap.Error(tx.Error))
+		return nil, utils.NewDBError("查询商家失败：" + tx.Error.Error())
+	}
+	return merchants, nil
+}
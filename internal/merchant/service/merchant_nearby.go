@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/merchant/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultDeliveryRadius = 3000  // 默认配送半径（米）
+	maxDeliveryRadius     = 20000 // 配送半径上限（米），附近商家按此半径检索候选
+	nearbyCandidateLimit  = 500   // 附近商家候选数上限（按距离取最近的N个，评分、销量排序仅在候选内进行）
+	geoRebuildBatchSize   = 500   // 重建位置索引时每批读取的商家数
+)
+
+// 附近商家排序方式
+const (
+	NearbySortDistance = "distance" // 距离由近到远
+	NearbySortScore    = "score"    // 评分由高到低
+	NearbySortSales    = "sales"    // 订单数由高到低
+)
+
+// 入参结构体（领域层）
+type ListNearbyMerchantsParam struct {
+	Longitude float64 `validate:"gte=-180,lte=180"`
+	Latitude  float64 `validate:"gte=-90,lte=90"`
+	SortBy    string  `validate:"omitempty,oneof=distance score sales"`
+	Page      int32   `validate:"gte=0"`        // 0取默认1
+	PageSize  int32   `validate:"gte=0,lte=50"` // 0取默认20
+}
+
+// 响应结构体（领域层）
+type NearbyMerchantResult struct {
+	MerchantID     int64   `json:"merchant_id"`
+	Name           string  `json:"name"`
+	Address        string  `json:"address"`
+	Logo           string  `json:"logo"`
+	BusinessHours  string  `json:"business_hours"`
+	Score          float64 `json:"score"`
+	OrderCount     int32   `json:"order_count"`
+	MinOrderAmount float64 `json:"min_order_amount"`
+	DeliveryRadius int32   `json:"delivery_radius"`
+	Distance       int32   `json:"distance"` // 与用户距离（米）
+}
+
+type ListNearbyMerchantsResult struct {
+	Merchants []NearbyMerchantResult `json:"merchants"`
+	Total     int32                  `json:"total"`
+	Page      int32                  `json:"page"`
+	PageSize  int32                  `json:"page_size"`
+}
+
+// ListNearbyMerchants 查询附近可配送的营业中商家
+// 先从Redis GEO按平台最大配送半径取最近的候选商家，再按商家自身配送半径、营业状态及营业时段过滤
+// 候选最多取最近的nearbyCandidateLimit个：商家密集区域按评分、销量排序时，更远处的高分商家不参与排序，
+// Total也仅统计候选内的商家；需要全量排序时使用搜索服务（按距离筛选后排序）
+func (s *merchantService) ListNearbyMerchants(ctx context.Context, param ListNearbyMerchantsParam) (ListNearbyMerchantsResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("查询附近商家参数校验失败", zap.Any("param", param), zap.Error(err))
+		return ListNearbyMerchantsResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+	if param.Longitude == 0 && param.Latitude == 0 {
+		return ListNearbyMerchantsResult{}, utils.NewParamError("请传入用户坐标")
+	}
+	if param.SortBy == "" {
+		param.SortBy = NearbySortDistance
+	}
+	if param.Page == 0 {
+		param.Page = 1
+	}
+	if param.PageSize == 0 {
+		param.PageSize = 20
+	}
+
+	// 2. 地理索引检索候选商家（按距离升序）
+	hits, err := s.geoRepo.SearchNearby(ctx, param.Longitude, param.Latitude, maxDeliveryRadius, nearbyCandidateLimit)
+	if err != nil {
+		return ListNearbyMerchantsResult{}, err
+	}
+	merchantIDs := make([]int64, 0, len(hits))
+	for _, hit := range hits {
+		merchantIDs = append(merchantIDs, hit.MerchantID)
+	}
+	merchants, err := s.merchantRepo.GetMerchantsByIDs(ctx, merchantIDs)
+	if err != nil {
+		return ListNearbyMerchantsResult{}, err
+	}
+	merchantMap := make(map[int64]*model.Merchant, len(merchants))
+	for _, m := range merchants {
+		merchantMap[m.MerchantID] = m
+	}
+
+	// 3. 过滤：商家存在、营业中、当前在营业时段内、用户在配送半径内
+	now := time.Now()
+	minute := utils.MinuteOfDay(now)
+	var list []NearbyMerchantResult
+	for _, hit := range hits {
+		m, ok := merchantMap[hit.MerchantID]
+		if !ok || !m.IsOpen || !utils.ParseOpenHours(m.BusinessHours).Contains(minute) {
+			continue
+		}
+		radius := deliveryRadiusOrDefault(m.DeliveryRadius)
+		if hit.Distance > float64(radius) {
+			continue
+		}
+		list = append(list, NearbyMerchantResult{
+			MerchantID:     m.MerchantID,
+			Name:           m.Name,
+			Address:        m.Address,
+			Logo:           m.Logo,
+			BusinessHours:  m.BusinessHours,
+			Score:          m.Score,
+			OrderCount:     m.OrderCount,
+			MinOrderAmount: m.MinOrderAmount,
+			DeliveryRadius: radius,
+			Distance:       int32(math.Round(hit.Distance)),
+		})
+	}
+
+	// 4. 排序（候选已按距离升序，稳定排序保证同分时近者在前）
+	switch param.SortBy {
+	case NearbySortScore:
+		sort.SliceStable(list, func(i, j int) bool { return list[i].Score > list[j].Score })
+	case NearbySortSales:
+		sort.SliceStable(list, func(i, j int) bool { return list[i].OrderCount > list[j].OrderCount })
+	}
+
+	// 5. 分页
+	result := ListNearbyMerchantsResult{
+		Merchants: []NearbyMerchantResult{},
+		Total:     int32(len(list)),
+		Page:      param.Page,
+		PageSize:  param.PageSize,
+	}
+	start := int((param.Page - 1) * param.PageSize)
+	if start < len(list) {
+		end := start + int(param.PageSize)
+		if end > len(list) {
+			end = len(list)
+		}
+		result.Merchants = list[start:end]
+	}
+	return result, nil
+}
+
+// RebuildGeoIndex 按商家表全量写入位置索引（坐标缺失的商家从索引中移除）
+func (s *merchantService) RebuildGeoIndex(ctx context.Context) (int, error) {
+	var afterID int64
+	count := 0
+	for {
+		merchants, err := s.merchantRepo.ListMerchants(ctx, afterID, geoRebuildBatchSize)
+		if err != nil {
+			return count, err
+		}
+		for _, m := range merchants {
+			if !hasLocation(m) {
+				if err = s.geoRepo.RemoveLocation(ctx, m.MerchantID); err != nil {
+					return count, err
+				}
+				continue
+			}
+			if err = s.geoRepo.SetLocation(ctx, m.MerchantID, m.Longitude, m.Latitude); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if len(merchants) < geoRebuildBatchSize {
+			return count, nil
+		}
+		afterID = merchants[len(merchants)-1].MerchantID
+	}
+}
+
+// syncLocation 商家坐标变更后同步位置索引（失败仅记录日志，服务重启时全量重建兜底）
+func (s *merchantService) syncLocation(ctx context.Context, merchant *model.Merchant) {
+	var err error
+	if hasLocation(merchant) {
+		err = s.geoRepo.SetLocation(ctx, merchant.MerchantID, merchant.Longitude, merchant.Latitude)
+	} else {
+		err = s.geoRepo.RemoveLocation(ctx, merchant.MerchantID)
+	}
+	if err != nil {
+		zap.L().Warn("同步商家位置索引失败", zap.Int64("merchant_id", merchant.MerchantID), zap.Error(err))
+	}
+}
+
+// hasLocation 商家是否已设置坐标（经纬度均为0视为未设置）
+func hasLocation(m *model.Merchant) bool {
+	return m.Longitude != 0 || m.Latitude != 0
+}
+
+// deliveryRadiusOrDefault 配送半径未设置时取默认值
+func deliveryRadiusOrDefault(radius int32) int32 {
+	if radius <= 0 {
+		return defaultDeliveryRadius
+	}
+	return radius
+}
@@ -2,12 +2,18 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strconv"
 
+	"github.com/IBM/sarama"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/merchant/client"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/merchant/repo"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/merchant/repo/model"
 	orderProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/order/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/cache"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
 	"github.com/go-playground/validator/v10"
 	"go.uber.org/zap"
@@ -15,12 +21,16 @@ import (
 
 // 入参结构体（领域层）
 type MerchantRegisterParam struct {
-	Name          string `validate:"required,min=2,max=64"`
-	Phone         string `validate:"required,regexp=^1[3-9]\\d{9}$"`
-	Password      string `validate:"required,min=6,max=20"`
-	Address       string `validate:"required,min=5,max=255"`
-	Logo          string `validate:"required,url"`
-	BusinessHours string `validate:"required,min=5"`
+	Name           string  `validate:"required,min=2,max=64"`
+	Phone          string  `validate:"required,regexp=^1[3-9]\\d{9}$"`
+	Password       string  `validate:"required,min=6,max=20"`
+	Address        string  `validate:"required,min=5,max=255"`
+	Logo           string  `validate:"required,url"`
+	BusinessHours  string  `validate:"required,min=5"`
+	Longitude      float64 `validate:"gte=-180,lte=180"`
+	Latitude       float64 `validate:"gte=-90,lte=90"`
+	MinOrderAmount float64 `validate:"gte=0"`
+	DeliveryRadius int32   `validate:"gte=0,lte=20000"` // 配送半径（米），0取默认值
 }
 
 type MerchantLoginParam struct {
@@ -29,13 +39,17 @@ type MerchantLoginParam struct {
 }
 
 type UpdateMerchantInfoParam struct {
-	MerchantID    int64  `validate:"required,gt=0"`
-	Name          string `validate:"required,min=2,max=64"`
-	Phone         string `validate:"required,regexp=^1[3-9]\\d{9}$"`
-	Address       string `validate:"required,min=5,max=255"`
-	Logo          string `validate:"required,url"`
-	BusinessHours string `validate:"required,min=5"`
-	IsOpen        bool   `validate:"required"`
+	MerchantID     int64   `validate:"required,gt=0"`
+	Name           string  `validate:"required,min=2,max=64"`
+	Phone          string  `validate:"required,regexp=^1[3-9]\\d{9}$"`
+	Address        string  `validate:"required,min=5,max=255"`
+	Logo           string  `validate:"required,url"`
+	BusinessHours  string  `validate:"required,min=5"`
+	Longitude      float64 `validate:"gte=-180,lte=180"`
+	Latitude       float64 `validate:"gte=-90,lte=90"`
+	MinOrderAmount float64 `validate:"gte=0"`
+	DeliveryRadius int32   `validate:"gte=0,lte=20000"` // 配送半径（米），0取默认值
+	IsOpen         bool    `validate:"required"`
 }
 
 type AcceptOrderParam struct {
@@ -52,8 +66,19 @@ type RejectOrderParam struct {
 type ListMerchantOrdersParam struct {
 	MerchantID int64  `validate:"required,gt=0"`
 	Status     string `validate:"omitempty"`
-	Page       int32  `validate:"required,gte=1"`
+	Page       int32  `validate:"gte=0"` // 0表示游标分页
 	PageSize   int32  `validate:"required,gte=10,lte=100"`
+	Cursor     string `validate:"omitempty,max=256"`
+	WithTotal  bool
+	// 高级筛选条件（由订单服务校验）
+	Statuses      []string
+	StartTime     string
+	EndTime       string
+	OrderNoPrefix string
+	UserPhone     string // 完整手机号或尾号4位
+	MinAmount     float64
+	MaxAmount     float64
+	SortBy        string
 }
 
 // 响应结构体（领域层）
@@ -64,17 +89,21 @@ type MerchantLoginResult struct {
 }
 
 type MerchantInfoResult struct {
-	MerchantID    int64   `json:"merchant_id"`
-	Name          string  `json:"name"`
-	Phone         string  `json:"phone"`
-	Address       string  `json:"address"`
-	Logo          string  `json:"logo"`
-	BusinessHours string  `json:"business_hours"`
-	Score         float64 `json:"score"`
-	OrderCount    int32   `json:"order_count"`
-	IsOpen        bool    `json:"is_open"`
-	CreatedAt     string  `json:"created_at"`
-	UpdatedAt     string  `json:"updated_at"`
+	MerchantID     int64   `json:"merchant_id"`
+	Name           string  `json:"name"`
+	Phone          string  `json:"phone"`
+	Address        string  `json:"address"`
+	Logo           string  `json:"logo"`
+	BusinessHours  string  `json:"business_hours"`
+	Longitude      float64 `json:"longitude"`
+	Latitude       float64 `json:"latitude"`
+	MinOrderAmount float64 `json:"min_order_amount"`
+	DeliveryRadius int32   `json:"delivery_radius"`
+	Score          float64 `json:"score"`
+	OrderCount     int32   `json:"order_count"`
+	IsOpen         bool    `json:"is_open"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
 }
 
 type MerchantOrderResult struct {
@@ -89,10 +118,11 @@ type MerchantOrderResult struct {
 }
 
 type ListMerchantOrdersResult struct {
-	Orders   []MerchantOrderResult `json:"orders"`
-	Total    int32                 `json:"total"`
-	Page     int32                 `json:"page"`
-	PageSize int32                 `json:"page_size"`
+	Orders     []MerchantOrderResult `json:"orders"`
+	Total      int32                 `json:"total"` // 未统计时为-1
+	Page       int32                 `json:"page"`
+	PageSize   int32                 `json:"page_size"`
+	NextCursor string                `json:"next_cursor"`
 }
 
 // MerchantService 商家业务逻辑接口
@@ -104,22 +134,35 @@ type MerchantService interface {
 	AcceptOrder(ctx context.Context, param AcceptOrderParam) error // 接单
 	RejectOrder(ctx context.Context, param RejectOrderParam) error // 拒单
 	ListMerchantOrders(ctx context.Context, param ListMerchantOrdersParam) (ListMerchantOrdersResult, error)
+	GetMerchantStats(ctx context.Context, param GetMerchantStatsParam) (MerchantStatsResult, error) // 经营数据看板
+	HandleRatingUpdated(ctx context.Context, msg *sarama.ConsumerMessage) error                     // 消费评分更新消息，同步商家评分
+	ListNearbyMerchants(ctx context.Context, param ListNearbyMerchantsParam) (ListNearbyMerchantsResult, error)
+	RebuildGeoIndex(ctx context.Context) (int, error) // 按商家表全量重建位置索引（服务启动时调用），返回写入的商家数
 }
 
 // merchantService 实现
 type merchantService struct {
 	merchantRepo repo.MerchantRepo
+	geoRepo      repo.MerchantGeoRepo
+	cache        *cache.Cache
 	validate     *validator.Validate
 }
 
 // NewMerchantService 创建实例
-func NewMerchantService(merchantRepo repo.MerchantRepo) MerchantService {
+func NewMerchantService(merchantRepo repo.MerchantRepo, geoRepo repo.MerchantGeoRepo, merchantCache *cache.Cache) MerchantService {
 	return &merchantService{
 		merchantRepo: merchantRepo,
+		geoRepo:      geoRepo,
+		cache:        merchantCache,
 		validate:     validator.New(),
 	}
 }
 
+// merchantCacheKey 商家信息缓存key：merchant:info:{商家ID}
+func merchantCacheKey(merchantID int64) string {
+	return fmt.Sprintf("merchant:info:%d", merchantID)
+}
+
 // MerchantRegister 商家入驻（注册）
 func (s *merchantService) MerchantRegister(ctx context.Context, param MerchantRegisterParam) (int64, string, error) {
 	// 1. 参数校验
@@ -139,13 +182,17 @@ func (s *merchantService) MerchantRegister(ctx context.Context, param MerchantRe
 
 	// 3. 转换为模型
 	merchant := &model.Merchant{
-		Name:          param.Name,
-		Phone:         param.Phone,
-		Password:      param.Password, // BeforeCreate钩子自动加密
-		Address:       param.Address,
-		Logo:          param.Logo,
-		BusinessHours: param.BusinessHours,
-		IsOpen:        true, // 默认营业
+		Name:           param.Name,
+		Phone:          param.Phone,
+		Password:       param.Password, // BeforeCreate钩子自动加密
+		Address:        param.Address,
+		Logo:           param.Logo,
+		BusinessHours:  param.BusinessHours,
+		Longitude:      param.Longitude,
+		Latitude:       param.Latitude,
+		MinOrderAmount: param.MinOrderAmount,
+		DeliveryRadius: deliveryRadiusOrDefault(param.DeliveryRadius),
+		IsOpen:         true, // 默认营业
 	}
 
 	// 4. 调用Repo创建商家
@@ -167,6 +214,9 @@ func (s *merchantService) MerchantRegister(ctx context.Context, param MerchantRe
 	}
 
 	zap.L().Info("商家入驻成功", zap.Int64("merchant_id", merchant.MerchantID), zap.String("phone", param.Phone))
+	s.syncLocation(ctx, merchant)
+	s.cache.Delete(ctx, merchantCacheKey(merchant.MerchantID)) // 清除入驻前可能缓存的空值
+	kafka.NotifySearchSync(kafka.SearchTargetMerchant, merchant.MerchantID)
 	return merchant.MerchantID, token, nil
 }
 
@@ -223,25 +273,35 @@ func (s *merchantService) GetMerchantInfo(ctx context.Context, merchantID int64)
 		return MerchantInfoResult{}, utils.NewParamError("商家ID不能为空且大于0")
 	}
 
-	// 2. 查询商家
+	// 2. 查询商家（优先读缓存）
+	return cache.Load(ctx, s.cache, merchantCacheKey(merchantID), func(ctx context.Context) (MerchantInfoResult, error) {
+		return s.loadMerchantInfo(ctx, merchantID)
+	})
+}
+
+// loadMerchantInfo 从数据库查询商家信息
+func (s *merchantService) loadMerchantInfo(ctx context.Context, merchantID int64) (MerchantInfoResult, error) {
 	merchant, err := s.merchantRepo.GetMerchantByID(ctx, merchantID)
 	if err != nil {
 		return MerchantInfoResult{}, err
 	}
 
-	// 3. 组装结果
 	result := MerchantInfoResult{
-		MerchantID:    merchant.MerchantID,
-		Name:          merchant.Name,
-		Phone:         merchant.Phone,
-		Address:       merchant.Address,
-		Logo:          merchant.Logo,
-		BusinessHours: merchant.BusinessHours,
-		Score:         merchant.Score,
-		OrderCount:    merchant.OrderCount,
-		IsOpen:        merchant.IsOpen,
-		CreatedAt:     merchant.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:     merchant.UpdatedAt.Format("2006-01-02 15:04:05"),
+		MerchantID:     merchant.MerchantID,
+		Name:           merchant.Name,
+		Phone:          merchant.Phone,
+		Address:        merchant.Address,
+		Logo:           merchant.Logo,
+		BusinessHours:  merchant.BusinessHours,
+		Longitude:      merchant.Longitude,
+		Latitude:       merchant.Latitude,
+		MinOrderAmount: merchant.MinOrderAmount,
+		DeliveryRadius: merchant.DeliveryRadius,
+		Score:          merchant.Score,
+		OrderCount:     merchant.OrderCount,
+		IsOpen:         merchant.IsOpen,
+		CreatedAt:      merchant.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:      merchant.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
 
 	return result, nil
@@ -255,19 +315,34 @@ func (s *merchantService) UpdateMerchantInfo(ctx context.Context, param UpdateMe
 		return utils.NewParamError("参数错误：" + err.Error())
 	}
 
+	// 仅允许商家本人修改（含坐标、配送半径）
+	if err := middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+		return err
+	}
+
 	// 2. 转换为模型
 	merchant := &model.Merchant{
-		MerchantID:    param.MerchantID,
-		Name:          param.Name,
-		Phone:         param.Phone,
-		Address:       param.Address,
-		Logo:          param.Logo,
-		BusinessHours: param.BusinessHours,
-		IsOpen:        param.IsOpen,
+		MerchantID:     param.MerchantID,
+		Name:           param.Name,
+		Phone:          param.Phone,
+		Address:        param.Address,
+		Logo:           param.Logo,
+		BusinessHours:  param.BusinessHours,
+		Longitude:      param.Longitude,
+		Latitude:       param.Latitude,
+		MinOrderAmount: param.MinOrderAmount,
+		DeliveryRadius: deliveryRadiusOrDefault(param.DeliveryRadius),
+		IsOpen:         param.IsOpen,
+	}
+
+	// 3. 调用Repo更新（商家信息冗余在商品索引中，按商家同步）
+	if err := s.merchantRepo.UpdateMerchant(ctx, merchant); err != nil {
+		return err
 	}
-
-	// 3. 调用Repo更新
-	return s.merchantRepo.UpdateMerchant(ctx, merchant)
+	s.syncLocation(ctx, merchant)
+	s.cache.Delete(ctx, merchantCacheKey(param.MerchantID))
+	kafka.NotifySearchSync(kafka.SearchTargetMerchant, param.MerchantID)
+	return nil
 }
 
 // AcceptOrder 商家接单（核心逻辑：后续对接订单服务更新订单状态）
@@ -287,22 +362,32 @@ func (s *merchantService) AcceptOrder(ctx context.Context, param AcceptOrderPara
 		return utils.NewBizError("商家已歇业，无法接单")
 	}
 
+	// 3. 校验订单归属及状态
+	if _, err = s.getPendingOrder(ctx, param.OrderID, param.MerchantID); err != nil {
+		return err
+	}
+
 	updateStatusReq := &orderProto.UpdateOrderStatusRequest{
 		OrderId:  param.OrderID,
 		Status:   "已接单",
 		Operator: "merchant_" + strconv.FormatInt(param.MerchantID, 10),
 	}
 
-	_, err = client.OrderClient.UpdateOrderStatus(ctx, updateStatusReq)
+	updateResp, err := client.OrderClient.UpdateOrderStatus(ctx, updateStatusReq)
 	if err != nil {
 		zap.L().Error("调用订单服务更新状态失败", zap.Any("param", param), zap.Error(err))
 		return utils.NewSystemError("接单失败,订单服务异常")
 	}
+	if updateResp.Code != utils.ErrCodeSuccess {
+		return utils.NewAppError(int(updateResp.Code), updateResp.Msg)
+	}
 
 	// 4. 更新商家订单数+1
 	if err = s.merchantRepo.UpdateOrderCount(ctx, param.MerchantID, 1); err != nil {
 		zap.L().Warn("更新商家订单数失败", zap.Int64("merchant_id", param.MerchantID), zap.Error(err))
 		// 不影响接单逻辑，仅日志警告
+	} else {
+		s.cache.Delete(ctx, merchantCacheKey(param.MerchantID))
 	}
 	zap.L().Info("商家接单成功", zap.Int64("order_id", param.OrderID), zap.Int64("merchant_id", param.MerchantID))
 	return nil
@@ -322,23 +407,52 @@ func (s *merchantService) RejectOrder(ctx context.Context, param RejectOrderPara
 		return err
 	}
 
+	// 3. 校验订单归属及状态
+	if _, err = s.getPendingOrder(ctx, param.OrderID, param.MerchantID); err != nil {
+		return err
+	}
+
 	updateStatusReq := &orderProto.UpdateOrderStatusRequest{
 		OrderId:  param.OrderID,
 		Status:   "已拒单",
 		Operator: "merchant_" + strconv.FormatInt(param.MerchantID, 10),
 		Remark:   param.Reason,
 	}
-	_, err = client.OrderClient.UpdateOrderStatus(ctx, updateStatusReq)
+	updateResp, err := client.OrderClient.UpdateOrderStatus(ctx, updateStatusReq)
 	if err != nil {
 		zap.L().Error("调用订单服务更新状态失败", zap.Any("param", param), zap.Error(err))
 		return utils.NewSystemError("拒单失败，订单服务异常")
 	}
+	if updateResp.Code != utils.ErrCodeSuccess {
+		return utils.NewAppError(int(updateResp.Code), updateResp.Msg)
+	}
 
 	zap.L().Info("商家拒单", zap.Int64("order_id", param.OrderID), zap.Int64("merchant_id", param.MerchantID), zap.String("reason", param.Reason))
 
 	return nil
 }
 
+// getPendingOrder 查询订单并校验归属当前商家且处于待接单状态
+func (s *merchantService) getPendingOrder(ctx context.Context, orderID, merchantID int64) (*orderProto.Order, error) {
+	orderResp, err := client.OrderClient.GetOrderByID(ctx, &orderProto.GetOrderRequest{OrderId: orderID})
+	if err != nil {
+		zap.L().Error("调用订单服务查询订单失败", zap.Int64("order_id", orderID), zap.Error(err))
+		return nil, utils.NewSystemError("查询订单失败，订单服务异常")
+	}
+	if orderResp.Code != utils.ErrCodeSuccess {
+		return nil, utils.NewAppError(int(orderResp.Code), orderResp.Msg)
+	}
+	order := orderResp.Order
+	if order.MerchantId != merchantID {
+		zap.L().Warn("商家操作非本店订单", zap.Int64("order_id", orderID), zap.Int64("merchant_id", merchantID), zap.Int64("order_merchant_id", order.MerchantId))
+		return nil, utils.NewAuthError("订单不属于该商家")
+	}
+	if order.Status != "待接单" {
+		return nil, utils.NewBizError("当前订单状态为" + order.Status + "，无法操作")
+	}
+	return order, nil
+}
+
 // ListMerchantOrders 查询商家订单列表（TODO：后续对接订单服务获取真实订单数据）
 func (s *merchantService) ListMerchantOrders(ctx context.Context, param ListMerchantOrdersParam) (ListMerchantOrdersResult, error) {
 	// 1. 参数校验
@@ -353,19 +467,34 @@ func (s *merchantService) ListMerchantOrders(ctx context.Context, param ListMerc
 		Status:     param.Status,
 		Page:       param.Page,
 		PageSize:   param.PageSize,
+		Cursor:     param.Cursor,
+		WithTotal:  param.WithTotal,
+		Filter: &orderProto.OrderFilter{
+			Statuses:      param.Statuses,
+			StartTime:     param.StartTime,
+			EndTime:       param.EndTime,
+			OrderNoPrefix: param.OrderNoPrefix,
+			UserPhone:     param.UserPhone,
+			MinAmount:     float32(param.MinAmount),
+			MaxAmount:     float32(param.MaxAmount),
+			SortBy:        param.SortBy,
+		},
 	}
 	listResp, err := client.OrderClient.ListMerchantOrders(ctx, listReq)
 	if err != nil {
 		zap.L().Error("调用订单服务查询订单失败", zap.Int64("merchant_id", param.MerchantID), zap.Error(err))
 		return ListMerchantOrdersResult{}, utils.NewSystemError("查询订单失败，订单服务异常")
 	}
+	if listResp.Code != utils.ErrCodeSuccess {
+		return ListMerchantOrdersResult{}, utils.NewAppError(int(listResp.Code), listResp.Msg)
+	}
 	var orders []MerchantOrderResult
 	for _, o := range listResp.Orders {
 		orders = append(orders, MerchantOrderResult{
 			OrderID:            o.OrderId,
 			UserID:             o.UserId,
 			UserName:           o.UserName,
-			UserPhone:          o.UserPhone,
+			UserPhone:          utils.MaskPhone(o.UserPhone), // 商家侧仅展示脱敏手机号
 			TotalAmount:        float64(o.TotalAmount),
 			Status:             o.Status,
 			CreateTime:         o.CreateTime,
@@ -374,11 +503,30 @@ func (s *merchantService) ListMerchantOrders(ctx context.Context, param ListMerc
 	}
 
 	result := ListMerchantOrdersResult{
-		Orders:   orders,
-		Total:    listResp.Total,
-		Page:     listResp.Page,
-		PageSize: listResp.PageSize,
+		Orders:     orders,
+		Total:      listResp.Total,
+		Page:       listResp.Page,
+		PageSize:   listResp.PageSize,
+		NextCursor: listResp.NextCursor,
 	}
 
 	return result, nil
 }
+
+// HandleRatingUpdated 消费评分更新消息（订单服务按评价增量计算评分后投递），仅处理商家评分
+func (s *merchantService) HandleRatingUpdated(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var event kafka.RatingUpdatedEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil || event.TargetID == 0 {
+		zap.L().Error("评分更新消息格式错误", zap.ByteString("value", msg.Value), zap.Error(err))
+		return nil // 格式错误无法重试，直接跳过
+	}
+	if event.TargetType != kafka.RatingTargetMerchant {
+		return nil
+	}
+	if err := s.merchantRepo.UpdateScore(ctx, event.TargetID, event.Score, event.RatingCount); err != nil {
+		return err
+	}
+	s.cache.Delete(ctx, merchantCacheKey(event.TargetID))
+	kafka.NotifySearchSync(kafka.SearchTargetMerchant, event.TargetID)
+	return nil
+}
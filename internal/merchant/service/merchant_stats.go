@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/merchant/client"
+	orderProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/order/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// 入参结构体（领域层）
+type GetMerchantStatsParam struct {
+	MerchantID int64  `validate:"required,gt=0"`
+	StartDate  string `validate:"required"`
+	EndDate    string `validate:"required"`
+	TopN       int32  `validate:"gte=0,lte=50"`
+}
+
+// 响应结构体（领域层）
+type MerchantStatsResult struct {
+	MerchantID       int64                `json:"merchant_id"`
+	StartDate        string               `json:"start_date"`
+	EndDate          string               `json:"end_date"`
+	OrderCount       int64                `json:"order_count"`
+	ValidOrderCount  int64                `json:"valid_order_count"`
+	StatusCounts     []StatusCountResult  `json:"status_counts"`
+	GMV              float64              `json:"gmv"`
+	AvgOrderValue    float64              `json:"avg_order_value"`
+	CancelRate       float64              `json:"cancel_rate"`
+	RejectRate       float64              `json:"reject_rate"`
+	AvgAcceptSeconds int64                `json:"avg_accept_seconds"`
+	Daily            []DailyStatResult    `json:"daily"`
+	TopProducts      []ProductSalesResult `json:"top_products"`
+}
+
+type StatusCountResult struct {
+	Status string `json:"status"`
+	Count  int64  `json:"count"`
+}
+
+type DailyStatResult struct {
+	Date            string  `json:"date"`
+	OrderCount      int64   `json:"order_count"`
+	ValidOrderCount int64   `json:"valid_order_count"`
+	GMV             float64 `json:"gmv"`
+}
+
+type ProductSalesResult struct {
+	ProductID   int64   `json:"product_id"`
+	ProductName string  `json:"product_name"`
+	Quantity    int64   `json:"quantity"`
+	Amount      float64 `json:"amount"`
+}
+
+// GetMerchantStats 查询商家经营统计（数据由订单服务计算）
+func (s *merchantService) GetMerchantStats(ctx context.Context, param GetMerchantStatsParam) (MerchantStatsResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("查询商家统计参数校验失败", zap.Any("param", param), zap.Error(err))
+		return MerchantStatsResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+
+	// 2. 调用订单服务
+	resp, err := client.OrderClient.GetMerchantStats(ctx, &orderProto.GetMerchantStatsRequest{
+		MerchantId: param.MerchantID,
+		StartDate:  param.StartDate,
+		EndDate:    param.EndDate,
+		TopN:       param.TopN,
+	})
+	if err != nil {
+		zap.L().Error("调用订单服务查询商家统计失败", zap.Int64("merchant_id", param.MerchantID), zap.Error(err))
+		return MerchantStatsResult{}, utils.NewSystemError("查询经营数据失败，订单服务异常")
+	}
+	if resp.Code != utils.ErrCodeSuccess {
+		return MerchantStatsResult{}, utils.NewAppError(int(resp.Code), resp.Msg)
+	}
+
+	// 3. 转换结果
+	stats := resp.Stats
+	result := MerchantStatsResult{
+		MerchantID:       stats.GetMerchantId(),
+		StartDate:        stats.GetStartDate(),
+		EndDate:          stats.GetEndDate(),
+		OrderCount:       stats.GetOrderCount(),
+		ValidOrderCount:  stats.GetValidOrderCount(),
+		GMV:              float64(stats.GetGmv()),
+		AvgOrderValue:    float64(stats.GetAvgOrderValue()),
+		CancelRate:       float64(stats.GetCancelRate()),
+		RejectRate:       float64(stats.GetRejectRate()),
+		AvgAcceptSeconds: stats.GetAvgAcceptSeconds(),
+	}
+	for _, sc := range stats.GetStatusCounts() {
+		result.StatusCounts = append(result.StatusCounts, StatusCountResult{Status: sc.Status, Count: sc.Count})
+	}
+	for _, d := range stats.GetDaily() {
+		result.Daily = append(result.Daily, DailyStatResult{
+			Date:            d.Date,
+			OrderCount:      d.OrderCount,
+			ValidOrderCount: d.ValidOrderCount,
+			GMV:             float64(d.Gmv),
+		})
+	}
+	for _, p := range stats.GetTopProducts() {
+		result.TopProducts = append(result.TopProducts, ProductSalesResult{
+			ProductID:   p.ProductId,
+			ProductName: p.ProductName,
+			Quantity:    p.Quantity,
+			Amount:      float64(p.Amount),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	merchantProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/merchant/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var MerchantClient merchantProto.MerchantServiceClient // 全局商家服务客户端
+
+// InitMerchantClient 初始化商家服务gRPC客户端
+func InitMerchantClient() {
+	// 商家服务地址
+	addr := "localhost:50053"
+
+	// 连接商家服务
+	conn, err := grpc.Dial(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(middleware.GRPCAuthForwardInterceptor()),
+	)
+	if err != nil {
+		zap.L().Fatal("连接商家服务失败", zap.String("addr", addr), zap.Error(err))
+	}
+
+	// 创建客户端
+	MerchantClient = merchantProto.NewMerchantServiceClient(conn)
+	zap.L().Info("商家服务客户端初始化成功", zap.String("addr", addr))
+}
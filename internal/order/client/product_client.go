@@ -2,6 +2,7 @@ package client
 
 import (
 	productProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/product/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -15,7 +16,10 @@ func InitProductClient() {
 	addr := "localhost:50052"
 
 	// 连接商品服务
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(middleware.GRPCAuthForwardInterceptor()),
+	)
 	if err != nil {
 		zap.L().Fatal("连接商品服务失败", zap.String("addr", addr), zap.Error(err))
 	}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	userProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/user/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var UserClient userProto.UserServiceClient // 全局用户服务客户端
+
+// InitUserClient 初始化用户服务gRPC客户端（查询收货地址）
+func InitUserClient() {
+	// 用户服务地址
+	addr := "localhost:50051"
+
+	// 连接用户服务
+	conn, err := grpc.Dial(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(middleware.GRPCAuthForwardInterceptor()),
+	)
+	if err != nil {
+		zap.L().Fatal("连接用户服务失败", zap.String("addr", addr), zap.Error(err))
+	}
+
+	// 创建客户端
+	UserClient = userProto.NewUserServiceClient(conn)
+	zap.L().Info("用户服务客户端初始化成功", zap.String("addr", addr))
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	orderProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/order/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// CreateCoupon 创建优惠券
+func (h *OrderHandler) CreateCoupon(ctx context.Context, req *orderProto.CreateCouponRequest) (*orderProto.CreateCouponResponse, error) {
+	// proto → service参数
+	param := service.CreateCouponParam{
+		Operator:    req.Operator,
+		Name:        req.Name,
+		Type:        req.Type,
+		MerchantID:  req.MerchantId,
+		Threshold:   float64(req.Threshold),
+		Amount:      float64(req.Amount),
+		Rate:        float64(req.Rate),
+		MaxDiscount: float64(req.MaxDiscount),
+		Total:       req.Total,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+	}
+
+	// 调用service
+	result, err := h.couponService.CreateCoupon(ctx, param)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("创建优惠券未知错误", zap.Error(err), zap.String("operator", req.Operator))
+			return &orderProto.CreateCouponResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &orderProto.CreateCouponResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	return &orderProto.CreateCouponResponse{
+		Code:   utils.ErrCodeSuccess,
+		Msg:    "创建优惠券成功",
+		Coupon: toProtoCoupon(result),
+	}, nil
+}
+
+// ClaimCoupon 领取优惠券
+func (h *OrderHandler) ClaimCoupon(ctx context.Context, req *orderProto.ClaimCouponRequest) (*orderProto.ClaimCouponResponse, error) {
+	// 调用service
+	result, err := h.couponService.ClaimCoupon(ctx, service.ClaimCouponParam{
+		CouponID: req.CouponId,
+		UserID:   req.UserId,
+	})
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("领取优惠券未知错误", zap.Error(err), zap.Int64("coupon_id", req.CouponId), zap.Int64("user_id", req.UserId))
+			return &orderProto.ClaimCouponResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &orderProto.ClaimCouponResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	return &orderProto.ClaimCouponResponse{
+		Code:       utils.ErrCodeSuccess,
+		Msg:        "领取成功",
+		UserCoupon: toProtoUserCoupon(result),
+	}, nil
+}
+
+// ListUserCoupons 查询用户优惠券
+func (h *OrderHandler) ListUserCoupons(ctx context.Context, req *orderProto.ListUserCouponsRequest) (*orderProto.ListUserCouponsResponse, error) {
+	// 调用service
+	results, err := h.couponService.ListUserCoupons(ctx, service.ListUserCouponsParam{
+		UserID: req.UserId,
+		Status: req.Status,
+	})
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("查询用户优惠券未知错误", zap.Error(err), zap.Int64("user_id", req.UserId))
+			return &orderProto.ListUserCouponsResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &orderProto.ListUserCouponsResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	var userCoupons []*orderProto.UserCoupon
+	for _, r := range results {
+		userCoupons = append(userCoupons, toProtoUserCoupon(r))
+	}
+	return &orderProto.ListUserCouponsResponse{
+		Code:        utils.ErrCodeSuccess,
+		Msg:         "查询成功",
+		UserCoupons: userCoupons,
+	}, nil
+}
+
+// toProtoCoupon 领域层结果 → proto
+func toProtoCoupon(result service.CouponResult) *orderProto.Coupon {
+	return &orderProto.Coupon{
+		CouponId:    result.CouponID,
+		Name:        result.Name,
+		Type:        result.Type,
+		MerchantId:  result.MerchantID,
+		Threshold:   float32(result.Threshold),
+		Amount:      float32(result.Amount),
+		Rate:        float32(result.Rate),
+		MaxDiscount: float32(result.MaxDiscount),
+		Total:       result.Total,
+		Claimed:     result.Claimed,
+		StartTime:   result.StartTime,
+		EndTime:     result.EndTime,
+	}
+}
+
+// toProtoUserCoupon 领域层结果 → proto
+func toProtoUserCoupon(result service.UserCouponResult) *orderProto.UserCoupon {
+	return &orderProto.UserCoupon{
+		UserCouponId: result.UserCouponID,
+		UserId:       result.UserID,
+		Status:       result.Status,
+		OrderId:      result.OrderID,
+		UsedTime:     result.UsedTime,
+		ClaimTime:    result.ClaimTime,
+		Coupon:       toProtoCoupon(result.Coupon),
+	}
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	orderProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/order/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/export"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// exportChunkSize 导出文件每个分块的大小
+const exportChunkSize = 64 * 1024
+
+// ExportMerchantOrders 导出商家订单（服务端流式）
+func (h *OrderHandler) ExportMerchantOrders(req *orderProto.ExportMerchantOrdersRequest, stream orderProto.OrderService_ExportMerchantOrdersServer) error {
+	// proto → service参数
+	param := service.ExportMerchantOrdersParam{
+		MerchantID: req.MerchantId,
+		Format:     req.Format,
+		Columns:    req.Columns,
+		Filter:     toOrderFilterParam(req.Filter),
+	}
+
+	// 调用service，内容经分块写入器推送
+	w := &chunkWriter{
+		stream:      stream,
+		fileName:    exportFileName(req),
+		contentType: export.ContentType(req.Format),
+	}
+	result, err := h.orderService.ExportMerchantOrders(stream.Context(), param, w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("导出订单未知错误", zap.Error(err), zap.Int64("merchant_id", req.MerchantId))
+			return stream.Send(&orderProto.ExportMerchantOrdersResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			})
+		}
+		return stream.Send(&orderProto.ExportMerchantOrdersResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		})
+	}
+
+	return stream.Send(&orderProto.ExportMerchantOrdersResponse{
+		Code: utils.ErrCodeSuccess,
+		Msg:  "导出成功",
+		Done: true,
+		Rows: result.Rows,
+	})
+}
+
+// exportFileName 导出文件名（商家ID+下单日期范围）
+func exportFileName(req *orderProto.ExportMerchantOrdersRequest) string {
+	day := func(t string) string {
+		if len(t) < 10 {
+			return t
+		}
+		return strings.ReplaceAll(t[:10], "-", "")
+	}
+	return fmt.Sprintf("orders_%d_%s_%s.%s", req.MerchantId,
+		day(req.Filter.GetStartTime()), day(req.Filter.GetEndTime()), req.Format)
+}
+
+// chunkWriter 将写入内容按exportChunkSize分块推送到流（首块携带文件名及类型）
+type chunkWriter struct {
+	stream      orderProto.OrderService_ExportMerchantOrdersServer
+	fileName    string
+	contentType string
+	buf         []byte
+	sent        bool
+}
+
+// Write 写入缓冲区，满一块即推送
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= exportChunkSize {
+		if err := w.send(w.buf[:exportChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = append(w.buf[:0], w.buf[exportChunkSize:]...)
+	}
+	return len(p), nil
+}
+
+// Flush 推送剩余内容
+func (w *chunkWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	err := w.send(w.buf)
+	w.buf = w.buf[:0]
+	return err
+}
+
+// send 推送一个分块（复制一份，避免复用缓冲区影响已发送消息）
+func (w *chunkWriter) send(data []byte) error {
+	chunk := make([]byte, len(data))
+	copy(chunk, data)
+	resp := &orderProto.ExportMerchantOrdersResponse{
+		Code:  utils.ErrCodeSuccess,
+		Chunk: chunk,
+	}
+	if !w.sent {
+		resp.FileName = w.fileName
+		resp.ContentType = w.contentType
+		w.sent = true
+	}
+	if err := w.stream.Send(resp); err != nil {
+		return utils.NewSystemError("推送导出内容失败：" + err.Error())
+	}
+	return nil
+}
@@ -13,13 +13,19 @@ import (
 // OrderHandler 订单gRPC接口实现
 type OrderHandler struct {
 	orderProto.UnimplementedOrderServiceServer
-	orderService service.OrderService
+	orderService  service.OrderService
+	couponService service.CouponService
+	statsService  service.StatsService
+	reviewService service.ReviewService
 }
 
 // NewOrderHandler 创建实例
-func NewOrderHandler(orderService service.OrderService) *OrderHandler {
+func NewOrderHandler(orderService service.OrderService, couponService service.CouponService, statsService service.StatsService, reviewService service.ReviewService) *OrderHandler {
 	return &OrderHandler{
-		orderService: orderService,
+		orderService:  orderService,
+		couponService: couponService,
+		statsService:  statsService,
+		reviewService: reviewService,
 	}
 }
 
@@ -34,19 +40,21 @@ func (h *OrderHandler) CreateOrder(ctx context.Context, req *orderProto.CreateOr
 			Price:       float64(item.Price),
 			Quantity:    item.Quantity,
 			TotalPrice:  float64(item.TotalPrice),
+			SkuID:       item.SkuId,
+			OptionIDs:   item.OptionIds,
 		})
 	}
 
 	// 2. proto → service参数
 	param := service.CreateOrderParam{
 		UserID:             req.UserId,
-		UserName:           req.UserName,
-		UserPhone:          req.UserPhone,
 		MerchantID:         req.MerchantId,
 		Items:              items,
 		TotalAmount:        float64(req.TotalAmount),
-		Address:            req.Address,
+		AddressID:          req.AddressId,
 		ExpectDeliveryTime: req.ExpectDeliveryTime,
+		CouponIDs:          req.CouponIds,
+		TipAmount:          float64(req.TipAmount),
 	}
 
 	// 3. 调用service
@@ -76,6 +84,7 @@ func (h *OrderHandler) CreateOrder(ctx context.Context, req *orderProto.CreateOr
 		Msg:     "创建订单成功",
 		OrderId: result.OrderID,
 		OrderNo: result.OrderNo,
+		Fee:     toProtoFeeDetail(result.Fee),
 	}, nil
 }
 
@@ -117,10 +126,12 @@ func (h *OrderHandler) UpdateOrderStatus(ctx context.Context, req *orderProto.Up
 func (h *OrderHandler) ListUserOrders(ctx context.Context, req *orderProto.ListUserOrdersRequest) (*orderProto.ListUserOrdersResponse, error) {
 	// proto → service参数
 	param := service.ListUserOrdersParam{
-		UserID:   req.UserId,
-		Status:   req.Status,
-		Page:     req.Page,
-		PageSize: req.PageSize,
+		UserID:    req.UserId,
+		Status:    req.Status,
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		Cursor:    req.Cursor,
+		WithTotal: req.WithTotal,
 	}
 
 	// 调用service
@@ -142,49 +153,19 @@ func (h *OrderHandler) ListUserOrders(ctx context.Context, req *orderProto.ListU
 	}
 
 	// 转换为proto响应
-	var protoOrders []*orderProto.Order
+	protoOrders := make([]*orderProto.Order, 0, len(result.Orders))
 	for _, o := range result.Orders {
-		// 转换订单项
-		var protoItems []*orderProto.OrderItem
-		for _, item := range o.Items {
-			protoItems = append(protoItems, &orderProto.OrderItem{
-				ItemId:      item.ItemID,
-				OrderId:     item.OrderID,
-				ProductId:   item.ProductID,
-				ProductName: item.ProductName,
-				Price:       float32(item.Price),
-				Quantity:    item.Quantity,
-				TotalPrice:  float32(item.TotalPrice),
-			})
-		}
-
-		// 转换订单
-		protoOrders = append(protoOrders, &orderProto.Order{
-			OrderId:            o.OrderID,
-			OrderNo:            o.OrderNo,
-			UserId:             o.UserID,
-			UserName:           o.UserName,
-			UserPhone:          o.UserPhone,
-			MerchantId:         o.MerchantID,
-			MerchantName:       o.MerchantName,
-			Items:              protoItems,
-			TotalAmount:        float32(o.TotalAmount),
-			Status:             o.Status,
-			Address:            o.Address,
-			CreateTime:         o.CreateTime,
-			UpdateTime:         o.UpdateTime,
-			ExpectDeliveryTime: o.ExpectDeliveryTime,
-			Remark:             o.Remark,
-		})
+		protoOrders = append(protoOrders, toProtoOrder(o))
 	}
 
 	return &orderProto.ListUserOrdersResponse{
-		Code:     utils.ErrCodeSuccess,
-		Msg:      "查询成功",
-		Orders:   protoOrders,
-		Total:    result.Total,
-		Page:     result.Page,
-		PageSize: result.PageSize,
+		Code:       utils.ErrCodeSuccess,
+		Msg:        "查询成功",
+		Orders:     protoOrders,
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		NextCursor: result.NextCursor,
 	}, nil
 }
 
@@ -196,6 +177,9 @@ func (h *OrderHandler) ListMerchantOrders(ctx context.Context, req *orderProto.L
 		Status:     req.Status,
 		Page:       req.Page,
 		PageSize:   req.PageSize,
+		Cursor:     req.Cursor,
+		WithTotal:  req.WithTotal,
+		Filter:     toOrderFilterParam(req.Filter),
 	}
 
 	// 调用service
@@ -217,52 +201,84 @@ func (h *OrderHandler) ListMerchantOrders(ctx context.Context, req *orderProto.L
 	}
 
 	// 转换为proto响应
-	var protoOrders []*orderProto.Order
+	protoOrders := make([]*orderProto.Order, 0, len(result.Orders))
 	for _, o := range result.Orders {
-		// 转换订单项
-		var protoItems []*orderProto.OrderItem
-		for _, item := range o.Items {
-			protoItems = append(protoItems, &orderProto.OrderItem{
-				ItemId:      item.ItemID,
-				OrderId:     item.OrderID,
-				ProductId:   item.ProductID,
-				ProductName: item.ProductName,
-				Price:       float32(item.Price),
-				Quantity:    item.Quantity,
-				TotalPrice:  float32(item.TotalPrice),
-			})
+		protoOrders = append(protoOrders, toProtoOrder(o))
+	}
+
+	return &orderProto.ListMerchantOrdersResponse{
+		Code:       utils.ErrCodeSuccess,
+		Msg:        "查询成功",
+		Orders:     protoOrders,
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// SearchOrders 订单检索（平台客服）
+func (h *OrderHandler) SearchOrders(ctx context.Context, req *orderProto.SearchOrdersRequest) (*orderProto.SearchOrdersResponse, error) {
+	// proto → service参数
+	param := service.SearchOrdersParam{
+		UserID:     req.UserId,
+		MerchantID: req.MerchantId,
+		Filter:     toOrderFilterParam(req.Filter),
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		Cursor:     req.Cursor,
+		WithTotal:  req.WithTotal,
+	}
+
+	// 调用service
+	result, err := h.orderService.SearchOrders(ctx, param)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("检索订单未知错误", zap.Error(err), zap.Int64("user_id", req.UserId), zap.Int64("merchant_id", req.MerchantId))
+			return &orderProto.SearchOrdersResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
 		}
+		return &orderProto.SearchOrdersResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
 
-		// 转换订单
-		protoOrders = append(protoOrders, &orderProto.Order{
-			OrderId:            o.OrderID,
-			OrderNo:            o.OrderNo,
-			UserId:             o.UserID,
-			UserName:           o.UserName,
-			UserPhone:          o.UserPhone,
-			MerchantId:         o.MerchantID,
-			MerchantName:       o.MerchantName,
-			Items:              protoItems,
-			TotalAmount:        float32(o.TotalAmount),
-			Status:             o.Status,
-			Address:            o.Address,
-			CreateTime:         o.CreateTime,
-			UpdateTime:         o.UpdateTime,
-			ExpectDeliveryTime: o.ExpectDeliveryTime,
-			Remark:             o.Remark,
-		})
+	// 转换为proto响应
+	protoOrders := make([]*orderProto.Order, 0, len(result.Orders))
+	for _, o := range result.Orders {
+		protoOrders = append(protoOrders, toProtoOrder(o))
 	}
 
-	return &orderProto.ListMerchantOrdersResponse{
-		Code:     utils.ErrCodeSuccess,
-		Msg:      "查询成功",
-		Orders:   protoOrders,
-		Total:    result.Total,
-		Page:     result.Page,
-		PageSize: result.PageSize,
+	return &orderProto.SearchOrdersResponse{
+		Code:       utils.ErrCodeSuccess,
+		Msg:        "查询成功",
+		Orders:     protoOrders,
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		NextCursor: result.NextCursor,
 	}, nil
 }
 
+// toOrderFilterParam proto筛选条件 → service参数（未传时为空条件）
+func toOrderFilterParam(f *orderProto.OrderFilter) service.OrderFilterParam {
+	return service.OrderFilterParam{
+		Statuses:      f.GetStatuses(),
+		StartTime:     f.GetStartTime(),
+		EndTime:       f.GetEndTime(),
+		OrderNoPrefix: f.GetOrderNoPrefix(),
+		UserPhone:     f.GetUserPhone(),
+		MinAmount:     float64(f.GetMinAmount()),
+		MaxAmount:     float64(f.GetMaxAmount()),
+		SortBy:        f.GetSortBy(),
+	}
+}
+
 // GetOrderByID 查询订单详情
 func (h *OrderHandler) GetOrderByID(ctx context.Context, req *orderProto.GetOrderRequest) (*orderProto.GetOrderResponse, error) {
 	// 调用service
@@ -283,43 +299,10 @@ func (h *OrderHandler) GetOrderByID(ctx context.Context, req *orderProto.GetOrde
 		}, nil
 	}
 
-	// 转换订单项
-	var protoItems []*orderProto.OrderItem
-	for _, item := range result.Items {
-		protoItems = append(protoItems, &orderProto.OrderItem{
-			ItemId:      item.ItemID,
-			OrderId:     item.OrderID,
-			ProductId:   item.ProductID,
-			ProductName: item.ProductName,
-			Price:       float32(item.Price),
-			Quantity:    item.Quantity,
-			TotalPrice:  float32(item.TotalPrice),
-		})
-	}
-
-	// 转换订单
-	protoOrder := &orderProto.Order{
-		OrderId:            result.OrderID,
-		OrderNo:            result.OrderNo,
-		UserId:             result.UserID,
-		UserName:           result.UserName,
-		UserPhone:          result.UserPhone,
-		MerchantId:         result.MerchantID,
-		MerchantName:       result.MerchantName,
-		Items:              protoItems,
-		TotalAmount:        float32(result.TotalAmount),
-		Status:             result.Status,
-		Address:            result.Address,
-		CreateTime:         result.CreateTime,
-		UpdateTime:         result.UpdateTime,
-		ExpectDeliveryTime: result.ExpectDeliveryTime,
-		Remark:             result.Remark,
-	}
-
 	return &orderProto.GetOrderResponse{
 		Code:  utils.ErrCodeSuccess,
 		Msg:   "查询成功",
-		Order: protoOrder,
+		Order: toProtoOrder(result),
 	}, nil
 }
 
@@ -355,3 +338,214 @@ func (h *OrderHandler) CancelOrder(ctx context.Context, req *orderProto.CancelOr
 		Msg:  "取消订单成功",
 	}, nil
 }
+
+// RefundOrderItems 缺货部分退款
+func (h *OrderHandler) RefundOrderItems(ctx context.Context, req *orderProto.RefundOrderItemsRequest) (*orderProto.RefundOrderItemsResponse, error) {
+	// proto → service参数
+	var items []service.RefundOrderItemParam
+	for _, item := range req.Items {
+		items = append(items, service.RefundOrderItemParam{
+			ItemID:   item.ItemId,
+			Quantity: item.Quantity,
+		})
+	}
+	param := service.RefundOrderItemsParam{
+		OrderID:  req.OrderId,
+		Operator: req.Operator,
+		Items:    items,
+		Reason:   req.Reason,
+	}
+
+	// 调用service
+	result, err := h.orderService.RefundOrderItems(ctx, param)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("部分退款未知错误", zap.Error(err), zap.Int64("order_id", req.OrderId))
+			return &orderProto.RefundOrderItemsResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &orderProto.RefundOrderItemsResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	return &orderProto.RefundOrderItemsResponse{
+		Code:     utils.ErrCodeSuccess,
+		Msg:      "退款申请成功",
+		RefundNo: result.RefundNo,
+		Amount:   float32(result.Amount),
+	}, nil
+}
+
+// PreviewOrder 订单预览
+func (h *OrderHandler) PreviewOrder(ctx context.Context, req *orderProto.PreviewOrderRequest) (*orderProto.PreviewOrderResponse, error) {
+	// proto → service参数
+	var items []service.PreviewItemParam
+	for _, item := range req.Items {
+		items = append(items, service.PreviewItemParam{
+			ProductID: item.ProductId,
+			Quantity:  item.Quantity,
+			SkuID:     item.SkuId,
+			OptionIDs: item.OptionIds,
+		})
+	}
+	param := service.PreviewOrderParam{
+		UserID:     req.UserId,
+		MerchantID: req.MerchantId,
+		Items:      items,
+		AddressID:  req.AddressId,
+		CouponIDs:  req.CouponIds,
+		TipAmount:  float64(req.TipAmount),
+	}
+
+	// 调用service
+	result, err := h.orderService.PreviewOrder(ctx, param)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("订单预览未知错误", zap.Error(err), zap.Int64("user_id", req.UserId))
+			return &orderProto.PreviewOrderResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &orderProto.PreviewOrderResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	// 响应转换
+	protoItems := make([]*orderProto.PreviewItemResult, 0, len(result.Items))
+	for _, item := range result.Items {
+		protoItems = append(protoItems, &orderProto.PreviewItemResult{
+			ProductId:         item.ProductID,
+			ProductName:       item.ProductName,
+			Price:             float32(item.Price),
+			PackingFee:        float32(item.PackingFee),
+			Quantity:          item.Quantity,
+			TotalPrice:        float32(item.TotalPrice),
+			Stock:             item.Stock,
+			Available:         item.Available,
+			UnavailableReason: item.UnavailableReason,
+			SkuId:             item.SkuID,
+			SkuName:           item.SkuName,
+			Options:           toProtoItemOptions(item.Options),
+		})
+	}
+	return &orderProto.PreviewOrderResponse{
+		Code:               utils.ErrCodeSuccess,
+		Msg:                "预览成功",
+		Items:              protoItems,
+		Fee:                toProtoFeeDetail(result.Fee),
+		Discounts:          toProtoDiscounts(result.Discounts),
+		EtaMinutes:         result.EtaMinutes,
+		ExpectDeliveryTime: result.ExpectDeliveryTime,
+		Address:            result.Address,
+		Errors:             result.Errors,
+		CanOrder:           result.CanOrder,
+	}, nil
+}
+
+// toProtoFeeDetail 费用明细转换
+func toProtoFeeDetail(fee service.FeeDetailResult) *orderProto.FeeDetail {
+	return &orderProto.FeeDetail{
+		GoodsAmount:      float32(fee.GoodsAmount),
+		PackingFee:       float32(fee.PackingFee),
+		DeliveryFee:      float32(fee.DeliveryFee),
+		DeliveryDistance: fee.DeliveryDistance,
+		DiscountAmount:   float32(fee.DiscountAmount),
+		TotalAmount:      float32(fee.TotalAmount),
+		TipAmount:        float32(fee.TipAmount),
+	}
+}
+
+// toProtoDiscounts 优惠明细转换
+func toProtoDiscounts(discounts []service.OrderDiscountResult) []*orderProto.OrderDiscount {
+	var protoDiscounts []*orderProto.OrderDiscount
+	for _, d := range discounts {
+		protoDiscounts = append(protoDiscounts, &orderProto.OrderDiscount{
+			UserCouponId: d.UserCouponID,
+			CouponId:     d.CouponID,
+			CouponName:   d.CouponName,
+			Type:         d.Type,
+			Amount:       float32(d.Amount),
+		})
+	}
+	return protoDiscounts
+}
+
+// toProtoItemOptions 订单项属性转换
+func toProtoItemOptions(options []service.ItemOptionResult) []*orderProto.OrderItemOption {
+	protoOptions := make([]*orderProto.OrderItemOption, 0, len(options))
+	for _, opt := range options {
+		protoOptions = append(protoOptions, &orderProto.OrderItemOption{
+			OptionId:  opt.OptionID,
+			GroupName: opt.GroupName,
+			Name:      opt.Name,
+			Price:     float32(opt.Price),
+		})
+	}
+	return protoOptions
+}
+
+// toProtoOrder 订单转换（含订单项、优惠明细）
+func toProtoOrder(o service.OrderInfoResult) *orderProto.Order {
+	protoItems := make([]*orderProto.OrderItem, 0, len(o.Items))
+	for _, item := range o.Items {
+		protoItems = append(protoItems, &orderProto.OrderItem{
+			ItemId:      item.ItemID,
+			OrderId:     item.OrderID,
+			ProductId:   item.ProductID,
+			ProductName: item.ProductName,
+			Price:       float32(item.Price),
+			Quantity:    item.Quantity,
+			TotalPrice:  float32(item.TotalPrice),
+			RefundedQty: item.RefundedQty,
+			SkuId:       item.SkuID,
+			SkuName:     item.SkuName,
+			Options:     toProtoItemOptions(item.Options),
+		})
+	}
+
+	return &orderProto.Order{
+		OrderId:            o.OrderID,
+		OrderNo:            o.OrderNo,
+		UserId:             o.UserID,
+		UserName:           o.UserName,
+		UserPhone:          o.UserPhone,
+		MerchantId:         o.MerchantID,
+		MerchantName:       o.MerchantName,
+		Items:              protoItems,
+		TotalAmount:        float32(o.TotalAmount),
+		Status:             o.Status,
+		Address:            o.Address,
+		AddressId:          o.AddressID,
+		Province:           o.Province,
+		City:               o.City,
+		District:           o.District,
+		AddressDetail:      o.AddressDetail,
+		Longitude:          o.Longitude,
+		Latitude:           o.Latitude,
+		CreateTime:         o.CreateTime,
+		UpdateTime:         o.UpdateTime,
+		ExpectDeliveryTime: o.ExpectDeliveryTime,
+		Remark:             o.Remark,
+		PaidTime:           o.PaidTime,
+		RefundAmount:       float32(o.RefundAmount),
+		GoodsAmount:        float32(o.GoodsAmount),
+		DiscountAmount:     float32(o.DiscountAmount),
+		PackingFee:         float32(o.PackingFee),
+		DeliveryFee:        float32(o.DeliveryFee),
+		DeliveryDistance:   o.DeliveryDistance,
+		TipAmount:          float32(o.TipAmount),
+		RiderId:            o.RiderID,
+		Discounts:          toProtoDiscounts(o.Discounts),
+	}
+}
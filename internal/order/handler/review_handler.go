@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	orderProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/order/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// CreateReview 评价订单
+func (h *OrderHandler) CreateReview(ctx context.Context, req *orderProto.CreateReviewRequest) (*orderProto.CreateReviewResponse, error) {
+	// proto → service参数
+	param := service.CreateReviewParam{
+		OrderID:        req.OrderId,
+		UserID:         req.UserId,
+		MerchantRating: req.MerchantRating,
+		Content:        req.Content,
+		Tags:           req.Tags,
+		RiderRating:    req.RiderRating,
+		RiderTags:      req.RiderTags,
+	}
+	for _, item := range req.Items {
+		param.Items = append(param.Items, service.ReviewItemParam{
+			ProductID: item.ProductId,
+			Rating:    item.Rating,
+			Content:   item.Content,
+		})
+	}
+
+	// 调用service
+	reviewID, err := h.reviewService.CreateReview(ctx, param)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("评价订单未知错误", zap.Error(err), zap.Int64("order_id", req.OrderId))
+			return &orderProto.CreateReviewResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &orderProto.CreateReviewResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	return &orderProto.CreateReviewResponse{
+		Code:     utils.ErrCodeSuccess,
+		Msg:      "评价成功",
+		ReviewId: reviewID,
+	}, nil
+}
+
+// ListReviews 查询评价
+func (h *OrderHandler) ListReviews(ctx context.Context, req *orderProto.ListReviewsRequest) (*orderProto.ListReviewsResponse, error) {
+	// 调用service
+	result, err := h.reviewService.ListReviews(ctx, service.ListReviewsParam{
+		UserID:     req.UserId,
+		MerchantID: req.MerchantId,
+		ProductID:  req.ProductId,
+		RiderID:    req.RiderId,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		Cursor:     req.Cursor,
+		WithTotal:  req.WithTotal,
+	})
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("查询评价未知错误", zap.Error(err), zap.Int64("merchant_id", req.MerchantId), zap.Int64("product_id", req.ProductId))
+			return &orderProto.ListReviewsResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &orderProto.ListReviewsResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	// 转换为proto响应
+	protoReviews := make([]*orderProto.Review, 0, len(result.Reviews))
+	for _, r := range result.Reviews {
+		protoReviews = append(protoReviews, toProtoReview(r))
+	}
+
+	return &orderProto.ListReviewsResponse{
+		Code:       utils.ErrCodeSuccess,
+		Msg:        "查询成功",
+		Reviews:    protoReviews,
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// ReplyReview 商家回复评价
+func (h *OrderHandler) ReplyReview(ctx context.Context, req *orderProto.ReplyReviewRequest) (*orderProto.CommonResponse, error) {
+	// 调用service
+	err := h.reviewService.ReplyReview(ctx, service.ReplyReviewParam{
+		ReviewID:   req.ReviewId,
+		MerchantID: req.MerchantId,
+		Reply:      req.Reply,
+	})
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("回复评价未知错误", zap.Error(err), zap.Int64("review_id", req.ReviewId))
+			return &orderProto.CommonResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &orderProto.CommonResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	return &orderProto.CommonResponse{
+		Code: utils.ErrCodeSuccess,
+		Msg:  "回复成功",
+	}, nil
+}
+
+// toProtoReview service结果 → proto
+func toProtoReview(r service.ReviewResult) *orderProto.Review {
+	review := &orderProto.Review{
+		ReviewId:       r.ReviewID,
+		OrderId:        r.OrderID,
+		UserId:         r.UserID,
+		UserName:       r.UserName,
+		MerchantId:     r.MerchantID,
+		MerchantRating: r.MerchantRating,
+		Content:        r.Content,
+		Tags:           r.Tags,
+		RiderId:        r.RiderID,
+		RiderRating:    r.RiderRating,
+		RiderTags:      r.RiderTags,
+		Reply:          r.Reply,
+		ReplyTime:      r.ReplyTime,
+		CreateTime:     r.CreateTime,
+	}
+	for _, item := range r.Items {
+		review.Items = append(review.Items, &orderProto.ReviewItem{
+			ProductId:   item.ProductID,
+			ProductName: item.ProductName,
+			Rating:      item.Rating,
+			Content:     item.Content,
+		})
+	}
+	return review
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	orderProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/order/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// GetMerchantStats 查询商家经营统计
+func (h *OrderHandler) GetMerchantStats(ctx context.Context, req *orderProto.GetMerchantStatsRequest) (*orderProto.GetMerchantStatsResponse, error) {
+	// 调用service
+	result, err := h.statsService.GetMerchantStats(ctx, service.GetMerchantStatsParam{
+		MerchantID: req.MerchantId,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		TopN:       req.TopN,
+	})
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("查询商家统计未知错误", zap.Error(err), zap.Int64("merchant_id", req.MerchantId))
+			return &orderProto.GetMerchantStatsResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &orderProto.GetMerchantStatsResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	return &orderProto.GetMerchantStatsResponse{
+		Code:  utils.ErrCodeSuccess,
+		Msg:   "查询成功",
+		Stats: toProtoMerchantStats(result),
+	}, nil
+}
+
+// toProtoMerchantStats service结果 → proto
+func toProtoMerchantStats(r service.MerchantStatsResult) *orderProto.MerchantStats {
+	stats := &orderProto.MerchantStats{
+		MerchantId:       r.MerchantID,
+		StartDate:        r.StartDate,
+		EndDate:          r.EndDate,
+		OrderCount:       r.OrderCount,
+		ValidOrderCount:  r.ValidOrderCount,
+		Gmv:              float32(r.GMV),
+		AvgOrderValue:    float32(r.AvgOrderValue),
+		CancelRate:       float32(r.CancelRate),
+		RejectRate:       float32(r.RejectRate),
+		AvgAcceptSeconds: r.AvgAcceptSeconds,
+	}
+	for _, sc := range r.StatusCounts {
+		stats.StatusCounts = append(stats.StatusCounts, &orderProto.StatusCount{Status: sc.Status, Count: sc.Count})
+	}
+	for _, d := range r.Daily {
+		stats.Daily = append(stats.Daily, &orderProto.DailyStat{
+			Date:            d.Date,
+			OrderCount:      d.OrderCount,
+			ValidOrderCount: d.ValidOrderCount,
+			Gmv:             float32(d.GMV),
+		})
+	}
+	for _, p := range r.TopProducts {
+		stats.TopProducts = append(stats.TopProducts, &orderProto.ProductSales{
+			ProductId:   p.ProductID,
+			ProductName: p.ProductName,
+			Quantity:    p.Quantity,
+			Amount:      float32(p.Amount),
+		})
+	}
+	return stats
+}
@@ -0,0 +1,111 @@
+package pricing
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+)
+
+// Item 计费商品项
+type Item struct {
+	Price      float64
+	PackingFee float64 // 单件打包费
+	Quantity   int32
+}
+
+// Param 计费参数
+type Param struct {
+	Items          []Item
+	MinOrderAmount float64 // 商家起送价
+	MerchantLng    float64
+	MerchantLat    float64
+	UserLng        float64
+	UserLat        float64
+	DeliveryRadius float64 // 商家配送半径（米），0表示仅按平台最远配送距离校验
+	OrderTime      time.Time
+}
+
+// Breakdown 费用明细（不含优惠）
+type Breakdown struct {
+	GoodsAmount      float64
+	PackingFee       float64
+	DeliveryFee      float64
+	DeliveryDistance int32 // 配送距离（米）
+}
+
+// Calculate 计算商品金额、打包费、配送费，并校验起送价及配送范围
+// 校验失败时仍返回已算出的部分明细，供订单预览展示
+func Calculate(cfg config.PricingConfig, param Param) (Breakdown, error) {
+	cfg = cfg.WithDefaults()
+	var b Breakdown
+
+	// 1. 商品金额、打包费
+	for _, item := range param.Items {
+		b.GoodsAmount += utils.RoundMoney(item.Price * float64(item.Quantity))
+		b.PackingFee += utils.RoundMoney(item.PackingFee * float64(item.Quantity))
+	}
+	b.GoodsAmount = utils.RoundMoney(b.GoodsAmount)
+	b.PackingFee = utils.RoundMoney(b.PackingFee)
+
+	// 2. 起送价（按商品金额判断，不含打包费）
+	if b.GoodsAmount < param.MinOrderAmount {
+		return b, utils.NewBizError("未达到商家起送价" + strconv.FormatFloat(param.MinOrderAmount, 'f', 2, 64) + "元")
+	}
+
+	// 3. 配送距离（任一方坐标缺失时无法校验配送范围，拒绝下单）
+	if !hasLocation(param.MerchantLng, param.MerchantLat) {
+		return b, utils.NewBizError("商家未设置位置，暂不支持配送")
+	}
+	if !hasLocation(param.UserLng, param.UserLat) {
+		return b, utils.NewBizError("收货地址缺少定位，请更新地址后下单")
+	}
+	meters := utils.Distance(param.MerchantLng, param.MerchantLat, param.UserLng, param.UserLat)
+	b.DeliveryDistance = int32(math.Round(meters))
+	distanceKm := meters / 1000
+	if distanceKm > cfg.MaxDistanceKm || (param.DeliveryRadius > 0 && distanceKm*1000 > param.DeliveryRadius) {
+		return b, utils.NewBizError("超出商家配送范围")
+	}
+
+	// 4. 配送费 = 起步价 + 超距加价（不足1公里按1公里） + 时段加价
+	fee := cfg.BaseDeliveryFee
+	if distanceKm > cfg.BaseDistanceKm {
+		fee += math.Ceil(distanceKm-cfg.BaseDistanceKm) * cfg.PerKmFee
+	}
+	fee += peakSurcharge(cfg.PeakSurcharges, param.OrderTime)
+	b.DeliveryFee = utils.RoundMoney(fee)
+	return b, nil
+}
+
+// 送达时间预估参数
+const (
+	prepareMinutes     = 15  // 商家备餐时间（分钟）
+	riderMetersPerMin  = 250 // 骑手平均速度（米/分钟，约15km/h）
+	defaultRideMinutes = 15  // 距离未知时的默认配送时长（分钟）
+)
+
+// EstimateMinutes 预估送达时长（分钟）= 备餐时间 + 配送时间
+func EstimateMinutes(distanceMeters int32) int32 {
+	if distanceMeters <= 0 {
+		return prepareMinutes + defaultRideMinutes
+	}
+	return prepareMinutes + int32(math.Ceil(float64(distanceMeters)/riderMetersPerMin))
+}
+
+// peakSurcharge 计算下单时间命中的时段加价（多个时段命中时累加）
+func peakSurcharge(peaks []config.PeakSurcharge, t time.Time) float64 {
+	var fee float64
+	for _, p := range peaks {
+		if utils.InClockRange(p.Start, p.End, t) {
+			fee += p.Fee
+		}
+	}
+	return fee
+}
+
+// hasLocation 坐标是否有效（0,0视为未设置）
+func hasLocation(lng, lat float64) bool {
+	return lng != 0 || lat != 0
+}
@@ -0,0 +1,138 @@
+package pricing
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 商家坐标；用户坐标沿经线向北偏移指定米数
+const (
+	testMerchantLng = 116.397
+	testMerchantLat = 39.908
+)
+
+// northOf 商家正北方向meters米处的纬度
+func northOf(meters float64) float64 {
+	return testMerchantLat + meters/(6371000.0*math.Pi/180)
+}
+
+// at 测试日期的指定时刻
+func at(hour, minute int) time.Time {
+	return time.Date(2026, 10, 18, hour, minute, 0, 0, time.Local)
+}
+
+func TestCalculateGoodsAmount(t *testing.T) {
+	tests := []struct {
+		name           string
+		items          []Item
+		minOrderAmount float64
+		wantGoods      float64
+		wantPacking    float64
+		wantErr        bool
+	}{
+		{"商品金额及打包费按件数累加",
+			[]Item{{Price: 12.5, PackingFee: 1, Quantity: 2}, {Price: 3.33, PackingFee: 0.5, Quantity: 3}},
+			20, 34.99, 3.5, false},
+		{"恰好达到起送价", []Item{{Price: 10, Quantity: 2}}, 20, 20, 0, false},
+		{"起送价不含打包费", []Item{{Price: 19.99, PackingFee: 2, Quantity: 1}}, 20, 19.99, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := Calculate(config.PricingConfig{}, Param{
+				Items:          tt.items,
+				MinOrderAmount: tt.minOrderAmount,
+				MerchantLng:    testMerchantLng,
+				MerchantLat:    testMerchantLat,
+				UserLng:        testMerchantLng,
+				UserLat:        northOf(1000),
+				OrderTime:      at(15, 0),
+			})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			// 校验失败时仍返回商品明细
+			assert.Equal(t, tt.wantGoods, b.GoodsAmount)
+			assert.Equal(t, tt.wantPacking, b.PackingFee)
+		})
+	}
+}
+
+func TestCalculateDeliveryFee(t *testing.T) {
+	// 默认配置：起步3元/3公里，超出每公里1元，最远10公里，11:00-13:00加1元，22:00-06:00加2元
+	tests := []struct {
+		name     string
+		meters   float64
+		radius   float64
+		time     time.Time
+		wantFee  float64
+		wantDist int32
+		wantErr  bool
+	}{
+		{"起步距离内", 2990, 0, at(15, 0), 3, 2990, false},
+		{"超出起步距离不足1公里按1公里", 3010, 0, at(15, 0), 4, 3010, false},
+		{"超出起步距离1.2公里按2公里", 4200, 0, at(15, 0), 5, 4200, false},
+		{"接近最远配送距离", 9990, 0, at(15, 0), 10, 9990, false},
+		{"超出最远配送距离", 10010, 0, at(15, 0), 0, 10010, true},
+		{"商家配送半径内", 1990, 2000, at(15, 0), 3, 1990, false},
+		{"超出商家配送半径", 2010, 2000, at(15, 0), 0, 2010, true},
+		{"午高峰开始时刻加价", 1000, 0, at(11, 0), 4, 1000, false},
+		{"午高峰结束前加价", 1000, 0, at(12, 59), 4, 1000, false},
+		{"午高峰结束时刻不加价", 1000, 0, at(13, 0), 3, 1000, false},
+		{"午高峰开始前不加价", 1000, 0, at(10, 59), 3, 1000, false},
+		{"夜间开始时刻加价", 1000, 0, at(22, 0), 5, 1000, false},
+		{"夜间跨天加价", 1000, 0, at(5, 59), 5, 1000, false},
+		{"夜间结束时刻不加价", 1000, 0, at(6, 0), 3, 1000, false},
+		{"超距与时段加价叠加", 3500, 0, at(23, 30), 6, 3500, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := Calculate(config.PricingConfig{}, Param{
+				Items:          []Item{{Price: 30, Quantity: 1}},
+				MerchantLng:    testMerchantLng,
+				MerchantLat:    testMerchantLat,
+				UserLng:        testMerchantLng,
+				UserLat:        northOf(tt.meters),
+				DeliveryRadius: tt.radius,
+				OrderTime:      tt.time,
+			})
+			assert.Equal(t, tt.wantDist, b.DeliveryDistance)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFee, b.DeliveryFee)
+		})
+	}
+}
+
+func TestCalculateMissingLocation(t *testing.T) {
+	tests := []struct {
+		name                     string
+		merchantLng, merchantLat float64
+		userLng, userLat         float64
+	}{
+		{"商家未设置位置", 0, 0, testMerchantLng, testMerchantLat},
+		{"收货地址缺少定位", testMerchantLng, testMerchantLat, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Calculate(config.PricingConfig{}, Param{
+				Items:       []Item{{Price: 30, Quantity: 1}},
+				MerchantLng: tt.merchantLng,
+				MerchantLat: tt.merchantLat,
+				UserLng:     tt.userLng,
+				UserLat:     tt.userLat,
+				OrderTime:   at(15, 0),
+			})
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package promotion
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+)
+
+// 优惠券类型
+const (
+	TypeFullReduction = "满减"
+	TypeDiscount      = "折扣"
+	TypeVoucher       = "代金券"
+)
+
+// MinPayAmount 订单最低实付金额
+const MinPayAmount = 0.01
+
+// Coupon 参与计算的优惠券
+type Coupon struct {
+	UserCouponID int64
+	CouponID     int64
+	Name         string
+	Type         string
+	MerchantID   int64 // 0为平台券
+	Threshold    float64
+	Amount       float64
+	Rate         float64
+	MaxDiscount  float64
+	StartTime    time.Time
+	EndTime      time.Time
+}
+
+// Line 优惠明细
+type Line struct {
+	UserCouponID int64
+	CouponID     int64
+	Name         string
+	Type         string
+	Amount       float64
+}
+
+// Result 计算结果
+type Result struct {
+	GoodsAmount    float64
+	DiscountAmount float64
+	PayAmount      float64
+	Lines          []Line
+}
+
+// Calculate 计算订单优惠：平台券、商家券各限一张，先用商家券再用平台券，门槛按优惠前商品金额判断
+func Calculate(goodsAmount float64, merchantID int64, coupons []Coupon, now time.Time) (Result, error) {
+	result := Result{GoodsAmount: utils.RoundMoney(goodsAmount), PayAmount: utils.RoundMoney(goodsAmount)}
+	if len(coupons) == 0 {
+		return result, nil
+	}
+
+	// 1. 逐张校验有效期、适用商家、门槛及叠加规则
+	var platformUsed, merchantUsed bool
+	for _, c := range coupons {
+		if now.Before(c.StartTime) || now.After(c.EndTime) {
+			return Result{}, utils.NewBizError("优惠券不在有效期内：" + c.Name)
+		}
+		if c.MerchantID != 0 && c.MerchantID != merchantID {
+			return Result{}, utils.NewBizError("优惠券不适用于该商家：" + c.Name)
+		}
+		if goodsAmount < c.Threshold {
+			return Result{}, utils.NewBizError("未达到优惠券使用门槛：" + c.Name)
+		}
+		if c.MerchantID == 0 {
+			if platformUsed {
+				return Result{}, utils.NewBizError("每单仅可使用一张平台优惠券")
+			}
+			platformUsed = true
+		} else {
+			if merchantUsed {
+				return Result{}, utils.NewBizError("每单仅可使用一张商家优惠券")
+			}
+			merchantUsed = true
+		}
+	}
+
+	// 2. 商家券优先计算
+	sorted := make([]Coupon, len(coupons))
+	copy(sorted, coupons)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].MerchantID != 0 && sorted[j].MerchantID == 0
+	})
+
+	// 3. 依次计算优惠（实付不低于最低金额）
+	remain := result.GoodsAmount
+	for _, c := range sorted {
+		var discount float64
+		switch c.Type {
+		case TypeFullReduction, TypeVoucher:
+			discount = c.Amount
+		case TypeDiscount:
+			discount = remain * (1 - c.Rate)
+			if c.MaxDiscount > 0 && discount > c.MaxDiscount {
+				discount = c.MaxDiscount
+			}
+		default:
+			return Result{}, utils.NewBizError("不支持的优惠券类型：" + c.Type)
+		}
+		discount = utils.RoundMoney(math.Min(discount, remain-MinPayAmount))
+		if discount <= 0 {
+			continue
+		}
+		remain = utils.RoundMoney(remain - discount)
+		result.DiscountAmount = utils.RoundMoney(result.DiscountAmount + discount)
+		result.Lines = append(result.Lines, Line{
+			UserCouponID: c.UserCouponID,
+			CouponID:     c.CouponID,
+			Name:         c.Name,
+			Type:         c.Type,
+			Amount:       discount,
+		})
+	}
+	result.PayAmount = remain
+	return result, nil
+}
@@ -0,0 +1,108 @@
+package promotion
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+// coupon 测试当天有效的优惠券（merchantID为0表示平台券）
+func coupon(id, merchantID int64, typ string, threshold, amount, rate, maxDiscount float64) Coupon {
+	return Coupon{
+		UserCouponID: id * 10,
+		CouponID:     id,
+		Name:         "测试券",
+		Type:         typ,
+		MerchantID:   merchantID,
+		Threshold:    threshold,
+		Amount:       amount,
+		Rate:         rate,
+		MaxDiscount:  maxDiscount,
+		StartTime:    testNow.AddDate(0, 0, -1),
+		EndTime:      testNow.AddDate(0, 0, 1),
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	tests := []struct {
+		name         string
+		goodsAmount  float64
+		coupons      []Coupon
+		wantDiscount float64
+		wantPay      float64
+		wantLines    map[int64]float64 // 券ID -> 优惠金额
+		wantOrder    []int64           // 优惠明细的券ID顺序
+	}{
+		{"未使用优惠券", 35.555, nil, 0, 35.56, nil, nil},
+		{"满减券", 50, []Coupon{coupon(1, 0, TypeFullReduction, 30, 5, 0, 0)}, 5, 45,
+			map[int64]float64{1: 5}, []int64{1}},
+		{"先商家券后平台折扣券：折扣按商家券后金额计算", 50,
+			[]Coupon{coupon(1, 0, TypeDiscount, 0, 0, 0.9, 0), coupon(2, 7, TypeFullReduction, 30, 5, 0, 0)},
+			9.5, 40.5, map[int64]float64{2: 5, 1: 4.5}, []int64{2, 1}},
+		{"折扣券封顶", 100, []Coupon{coupon(1, 0, TypeDiscount, 0, 0, 0.8, 10)}, 10, 90,
+			map[int64]float64{1: 10}, []int64{1}},
+		{"折扣券不封顶", 100, []Coupon{coupon(1, 0, TypeDiscount, 0, 0, 0.8, 0)}, 20, 80,
+			map[int64]float64{1: 20}, []int64{1}},
+		{"门槛按优惠前商品金额判断", 30,
+			[]Coupon{coupon(1, 7, TypeFullReduction, 30, 10, 0, 0), coupon(2, 0, TypeFullReduction, 25, 3, 0, 0)},
+			13, 17, map[int64]float64{1: 10, 2: 3}, []int64{1, 2}},
+		{"代金券超过商品金额时实付保留最低金额", 15, []Coupon{coupon(1, 0, TypeVoucher, 0, 20, 0, 0)}, 14.99, MinPayAmount,
+			map[int64]float64{1: 14.99}, []int64{1}},
+		{"代金券恰好抵扣至最低金额", 10.01, []Coupon{coupon(1, 7, TypeVoucher, 0, 10, 0, 0)}, 10, MinPayAmount,
+			map[int64]float64{1: 10}, []int64{1}},
+		{"达到最低金额后平台券不再生效", 10,
+			[]Coupon{coupon(1, 0, TypeFullReduction, 0, 5, 0, 0), coupon(2, 7, TypeVoucher, 0, 10, 0, 0)},
+			9.99, MinPayAmount, map[int64]float64{2: 9.99}, []int64{2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Calculate(tt.goodsAmount, 7, tt.coupons, testNow)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDiscount, result.DiscountAmount)
+			assert.Equal(t, tt.wantPay, result.PayAmount)
+			assert.Equal(t, result.GoodsAmount, result.PayAmount+result.DiscountAmount)
+
+			var order []int64
+			lines := make(map[int64]float64, len(result.Lines))
+			for _, line := range result.Lines {
+				order = append(order, line.CouponID)
+				lines[line.CouponID] = line.Amount
+				assert.Equal(t, line.CouponID*10, line.UserCouponID)
+			}
+			assert.Equal(t, tt.wantOrder, order)
+			if tt.wantLines != nil {
+				assert.Equal(t, tt.wantLines, lines)
+			}
+		})
+	}
+}
+
+func TestCalculateInvalidCoupons(t *testing.T) {
+	expired := coupon(1, 0, TypeFullReduction, 0, 5, 0, 0)
+	expired.EndTime = testNow.Add(-time.Second)
+	notStarted := coupon(1, 0, TypeFullReduction, 0, 5, 0, 0)
+	notStarted.StartTime = testNow.Add(time.Second)
+
+	tests := []struct {
+		name    string
+		coupons []Coupon
+	}{
+		{"优惠券已过期", []Coupon{expired}},
+		{"优惠券未生效", []Coupon{notStarted}},
+		{"其他商家的优惠券", []Coupon{coupon(1, 8, TypeFullReduction, 0, 5, 0, 0)}},
+		{"未达到门槛", []Coupon{coupon(1, 0, TypeFullReduction, 50.01, 5, 0, 0)}},
+		{"两张平台券", []Coupon{coupon(1, 0, TypeFullReduction, 0, 5, 0, 0), coupon(2, 0, TypeVoucher, 0, 3, 0, 0)}},
+		{"两张商家券", []Coupon{coupon(1, 7, TypeFullReduction, 0, 5, 0, 0), coupon(2, 7, TypeVoucher, 0, 3, 0, 0)}},
+		{"不支持的券类型", []Coupon{coupon(1, 0, "免单", 0, 5, 0, 0)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Calculate(50, 7, tt.coupons, testNow)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -26,13 +26,18 @@ const (
 // 订单项（商品）
 type OrderItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItemId        int64                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`               // 订单项ID
-	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`            // 订单ID
-	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`      // 商品ID
-	ProductName   string                 `protobuf:"bytes,4,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // 商品名称
-	Price         float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`                              // 商品单价
-	Quantity      int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`                         // 购买数量
-	TotalPrice    float32                `protobuf:"fixed32,7,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`  // 商品总价
+	ItemId        int64                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                  // 订单项ID
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`               // 订单ID
+	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`         // 商品ID
+	ProductName   string                 `protobuf:"bytes,4,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`    // 商品名称
+	Price         float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`                                 // 商品单价
+	Quantity      int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`                            // 购买数量
+	TotalPrice    float32                `protobuf:"fixed32,7,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`     // 商品总价
+	RefundedQty   int32                  `protobuf:"varint,8,opt,name=refunded_qty,json=refundedQty,proto3" json:"refunded_qty,omitempty"`   // 已退款数量
+	SkuId         int64                  `protobuf:"varint,9,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                     // 规格ID（多规格商品必填）
+	SkuName       string                 `protobuf:"bytes,10,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`               // 规格名称（仅响应返回）
+	OptionIds     []int64                `protobuf:"varint,11,rep,packed,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"` // 所选属性选项ID（下单时传入）
+	Options       []*OrderItemOption     `protobuf:"bytes,12,rep,name=options,proto3" json:"options,omitempty"`                              // 所选属性快照（仅响应返回）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -116,31 +121,152 @@ func (x *OrderItem) GetTotalPrice() float32 {
 	return 0
 }
 
+func (x *OrderItem) GetRefundedQty() int32 {
+	if x != nil {
+		return x.RefundedQty
+	}
+	return 0
+}
+
+func (x *OrderItem) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *OrderItem) GetOptionIds() []int64 {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+func (x *OrderItem) GetOptions() []*OrderItemOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// 订单项属性快照
+type OrderItemOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OptionId      int64                  `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"` // 属性组名称
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                            // 选项名称
+	Price         float32                `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`                        // 加价
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItemOption) Reset() {
+	*x = OrderItemOption{}
+	mi := &file_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItemOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItemOption) ProtoMessage() {}
+
+func (x *OrderItemOption) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItemOption.ProtoReflect.Descriptor instead.
+func (*OrderItemOption) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderItemOption) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *OrderItemOption) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *OrderItemOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderItemOption) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
 // 订单基础信息
 type Order struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	OrderId            int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                                    // 订单ID
 	OrderNo            string                 `protobuf:"bytes,2,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`                                     // 订单编号（唯一）
 	UserId             int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                       // 用户ID
-	UserName           string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`                                  // 用户名
-	UserPhone          string                 `protobuf:"bytes,5,opt,name=user_phone,json=userPhone,proto3" json:"user_phone,omitempty"`                               // 用户电话
+	UserName           string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`                                  // 收货人
+	UserPhone          string                 `protobuf:"bytes,5,opt,name=user_phone,json=userPhone,proto3" json:"user_phone,omitempty"`                               // 收货人电话
 	MerchantId         int64                  `protobuf:"varint,6,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`                           // 商家ID
 	MerchantName       string                 `protobuf:"bytes,7,opt,name=merchant_name,json=merchantName,proto3" json:"merchant_name,omitempty"`                      // 商家名称
 	Items              []*OrderItem           `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`                                                        // 订单项列表
 	TotalAmount        float32                `protobuf:"fixed32,9,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`                       // 订单总金额
-	Status             string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`                                                     // 订单状态：待接单/已接单/待配送/已完成/已取消/已拒单
+	Status             string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`                                                     // 订单状态：待支付/待接单/已接单/待配送/配送中/已完成/已取消/已拒单
 	Address            string                 `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`                                                   // 收货地址
 	CreateTime         string                 `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                           // 创建时间
 	UpdateTime         string                 `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`                           // 更新时间
 	ExpectDeliveryTime string                 `protobuf:"bytes,14,opt,name=expect_delivery_time,json=expectDeliveryTime,proto3" json:"expect_delivery_time,omitempty"` // 预计送达时间
 	Remark             string                 `protobuf:"bytes,15,opt,name=remark,proto3" json:"remark,omitempty"`                                                     // 备注（拒单原因/取消原因）
+	PaidTime           string                 `protobuf:"bytes,16,opt,name=paid_time,json=paidTime,proto3" json:"paid_time,omitempty"`                                 // 支付时间（未支付为空）
+	RefundAmount       float32                `protobuf:"fixed32,17,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`                   // 累计退款金额
+	GoodsAmount        float32                `protobuf:"fixed32,18,opt,name=goods_amount,json=goodsAmount,proto3" json:"goods_amount,omitempty"`                      // 商品金额（优惠前）
+	DiscountAmount     float32                `protobuf:"fixed32,19,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`             // 优惠金额
+	Discounts          []*OrderDiscount       `protobuf:"bytes,20,rep,name=discounts,proto3" json:"discounts,omitempty"`                                               // 优惠明细（仅详情返回）
+	PackingFee         float32                `protobuf:"fixed32,21,opt,name=packing_fee,json=packingFee,proto3" json:"packing_fee,omitempty"`                         // 打包费
+	DeliveryFee        float32                `protobuf:"fixed32,22,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`                      // 配送费
+	DeliveryDistance   int32                  `protobuf:"varint,23,opt,name=delivery_distance,json=deliveryDistance,proto3" json:"delivery_distance,omitempty"`        // 配送距离（米）
+	AddressId          int64                  `protobuf:"varint,24,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`                             // 下单时选择的收货地址ID
+	Province           string                 `protobuf:"bytes,25,opt,name=province,proto3" json:"province,omitempty"`                                                 // 省（下单时快照，下同）
+	City               string                 `protobuf:"bytes,26,opt,name=city,proto3" json:"city,omitempty"`                                                         // 市
+	District           string                 `protobuf:"bytes,27,opt,name=district,proto3" json:"district,omitempty"`                                                 // 区
+	AddressDetail      string                 `protobuf:"bytes,28,opt,name=address_detail,json=addressDetail,proto3" json:"address_detail,omitempty"`                  // 详细地址
+	Longitude          float64                `protobuf:"fixed64,29,opt,name=longitude,proto3" json:"longitude,omitempty"`                                             // 收货地址经度
+	Latitude           float64                `protobuf:"fixed64,30,opt,name=latitude,proto3" json:"latitude,omitempty"`                                               // 收货地址纬度
+	RiderId            int64                  `protobuf:"varint,31,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`                                   // 配送骑手ID（骑手接单前为0）
+	TipAmount          float32                `protobuf:"fixed32,32,opt,name=tip_amount,json=tipAmount,proto3" json:"tip_amount,omitempty"`                            // 骑手小费
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Order) Reset() {
 	*x = Order{}
-	mi := &file_order_proto_msgTypes[1]
+	mi := &file_order_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -152,7 +278,7 @@ func (x *Order) String() string {
 func (*Order) ProtoMessage() {}
 
 func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[1]
+	mi := &file_order_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -165,7 +291,7 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Order.ProtoReflect.Descriptor instead.
 func (*Order) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{1}
+	return file_order_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Order) GetOrderId() int64 {
@@ -273,88 +399,153 @@ func (x *Order) GetRemark() string {
 	return ""
 }
 
-// 通用响应
-type CommonResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Order) GetPaidTime() string {
+	if x != nil {
+		return x.PaidTime
+	}
+	return ""
 }
 
-func (x *CommonResponse) Reset() {
-	*x = CommonResponse{}
-	mi := &file_order_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Order) GetRefundAmount() float32 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
 }
 
-func (x *CommonResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Order) GetGoodsAmount() float32 {
+	if x != nil {
+		return x.GoodsAmount
+	}
+	return 0
 }
 
-func (*CommonResponse) ProtoMessage() {}
+func (x *Order) GetDiscountAmount() float32 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
 
-func (x *CommonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[2]
+func (x *Order) GetDiscounts() []*OrderDiscount {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Discounts
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CommonResponse.ProtoReflect.Descriptor instead.
-func (*CommonResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{2}
+func (x *Order) GetPackingFee() float32 {
+	if x != nil {
+		return x.PackingFee
+	}
+	return 0
 }
 
-func (x *CommonResponse) GetCode() int32 {
+func (x *Order) GetDeliveryFee() float32 {
 	if x != nil {
-		return x.Code
+		return x.DeliveryFee
 	}
 	return 0
 }
 
-func (x *CommonResponse) GetMsg() string {
+func (x *Order) GetDeliveryDistance() int32 {
 	if x != nil {
-		return x.Msg
+		return x.DeliveryDistance
+	}
+	return 0
+}
+
+func (x *Order) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+func (x *Order) GetProvince() string {
+	if x != nil {
+		return x.Province
 	}
 	return ""
 }
 
-// 创建订单请求
-type CreateOrderRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	UserId             int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserName           string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserPhone          string                 `protobuf:"bytes,3,opt,name=user_phone,json=userPhone,proto3" json:"user_phone,omitempty"`
-	MerchantId         int64                  `protobuf:"varint,4,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	Items              []*OrderItem           `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
-	TotalAmount        float32                `protobuf:"fixed32,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Address            string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
-	ExpectDeliveryTime string                 `protobuf:"bytes,8,opt,name=expect_delivery_time,json=expectDeliveryTime,proto3" json:"expect_delivery_time,omitempty"` // 可选
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *Order) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
 }
 
-func (x *CreateOrderRequest) Reset() {
-	*x = CreateOrderRequest{}
+func (x *Order) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *Order) GetAddressDetail() string {
+	if x != nil {
+		return x.AddressDetail
+	}
+	return ""
+}
+
+func (x *Order) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Order) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Order) GetRiderId() int64 {
+	if x != nil {
+		return x.RiderId
+	}
+	return 0
+}
+
+func (x *Order) GetTipAmount() float32 {
+	if x != nil {
+		return x.TipAmount
+	}
+	return 0
+}
+
+// 订单费用明细
+type FeeDetail struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GoodsAmount      float32                `protobuf:"fixed32,1,opt,name=goods_amount,json=goodsAmount,proto3" json:"goods_amount,omitempty"`               // 商品金额（优惠前）
+	PackingFee       float32                `protobuf:"fixed32,2,opt,name=packing_fee,json=packingFee,proto3" json:"packing_fee,omitempty"`                  // 打包费
+	DeliveryFee      float32                `protobuf:"fixed32,3,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`               // 配送费（距离+时段）
+	DeliveryDistance int32                  `protobuf:"varint,4,opt,name=delivery_distance,json=deliveryDistance,proto3" json:"delivery_distance,omitempty"` // 配送距离（米）
+	DiscountAmount   float32                `protobuf:"fixed32,5,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`      // 优惠金额
+	TotalAmount      float32                `protobuf:"fixed32,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`               // 实付金额
+	TipAmount        float32                `protobuf:"fixed32,7,opt,name=tip_amount,json=tipAmount,proto3" json:"tip_amount,omitempty"`                     // 骑手小费
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FeeDetail) Reset() {
+	*x = FeeDetail{}
 	mi := &file_order_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderRequest) String() string {
+func (x *FeeDetail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderRequest) ProtoMessage() {}
+func (*FeeDetail) ProtoMessage() {}
 
-func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *FeeDetail) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -366,92 +557,86 @@ func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
-func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FeeDetail.ProtoReflect.Descriptor instead.
+func (*FeeDetail) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateOrderRequest) GetUserId() int64 {
+func (x *FeeDetail) GetGoodsAmount() float32 {
 	if x != nil {
-		return x.UserId
+		return x.GoodsAmount
 	}
 	return 0
 }
 
-func (x *CreateOrderRequest) GetUserName() string {
+func (x *FeeDetail) GetPackingFee() float32 {
 	if x != nil {
-		return x.UserName
+		return x.PackingFee
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateOrderRequest) GetUserPhone() string {
+func (x *FeeDetail) GetDeliveryFee() float32 {
 	if x != nil {
-		return x.UserPhone
+		return x.DeliveryFee
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateOrderRequest) GetMerchantId() int64 {
+func (x *FeeDetail) GetDeliveryDistance() int32 {
 	if x != nil {
-		return x.MerchantId
+		return x.DeliveryDistance
 	}
 	return 0
 }
 
-func (x *CreateOrderRequest) GetItems() []*OrderItem {
+func (x *FeeDetail) GetDiscountAmount() float32 {
 	if x != nil {
-		return x.Items
+		return x.DiscountAmount
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateOrderRequest) GetTotalAmount() float32 {
+func (x *FeeDetail) GetTotalAmount() float32 {
 	if x != nil {
 		return x.TotalAmount
 	}
 	return 0
 }
 
-func (x *CreateOrderRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *CreateOrderRequest) GetExpectDeliveryTime() string {
+func (x *FeeDetail) GetTipAmount() float32 {
 	if x != nil {
-		return x.ExpectDeliveryTime
+		return x.TipAmount
 	}
-	return ""
+	return 0
 }
 
-// 创建订单响应
-type CreateOrderResponse struct {
+// 订单优惠明细
+type OrderDiscount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	OrderId       int64                  `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	OrderNo       string                 `protobuf:"bytes,4,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	UserCouponId  int64                  `protobuf:"varint,1,opt,name=user_coupon_id,json=userCouponId,proto3" json:"user_coupon_id,omitempty"` // 用户优惠券ID
+	CouponId      int64                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`               // 优惠券ID
+	CouponName    string                 `protobuf:"bytes,3,opt,name=coupon_name,json=couponName,proto3" json:"coupon_name,omitempty"`          // 优惠券名称
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                                        // 优惠类型：满减/折扣/代金券
+	Amount        float32                `protobuf:"fixed32,5,opt,name=amount,proto3" json:"amount,omitempty"`                                  // 优惠金额
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrderResponse) Reset() {
-	*x = CreateOrderResponse{}
+func (x *OrderDiscount) Reset() {
+	*x = OrderDiscount{}
 	mi := &file_order_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderResponse) String() string {
+func (x *OrderDiscount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderResponse) ProtoMessage() {}
+func (*OrderDiscount) ProtoMessage() {}
 
-func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
+func (x *OrderDiscount) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -463,53 +648,291 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
-func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderDiscount.ProtoReflect.Descriptor instead.
+func (*OrderDiscount) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateOrderResponse) GetCode() int32 {
+func (x *OrderDiscount) GetUserCouponId() int64 {
 	if x != nil {
-		return x.Code
+		return x.UserCouponId
 	}
 	return 0
 }
 
-func (x *CreateOrderResponse) GetMsg() string {
+func (x *OrderDiscount) GetCouponId() int64 {
 	if x != nil {
-		return x.Msg
+		return x.CouponId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateOrderResponse) GetOrderId() int64 {
+func (x *OrderDiscount) GetCouponName() string {
 	if x != nil {
-		return x.OrderId
+		return x.CouponName
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateOrderResponse) GetOrderNo() string {
+func (x *OrderDiscount) GetType() string {
 	if x != nil {
-		return x.OrderNo
+		return x.Type
 	}
 	return ""
 }
 
-// 更新订单状态请求
-type UpdateOrderStatusRequest struct {
+func (x *OrderDiscount) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 通用响应
+type CommonResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Operator      string                 `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"` // 操作人（merchant_1/user_1）
-	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`     // 备注（可选）
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusRequest) Reset() {
-	*x = UpdateOrderStatusRequest{}
-	mi := &file_order_proto_msgTypes[5]
+func (x *CommonResponse) Reset() {
+	*x = CommonResponse{}
+	mi := &file_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommonResponse) ProtoMessage() {}
+
+func (x *CommonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommonResponse.ProtoReflect.Descriptor instead.
+func (*CommonResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CommonResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CommonResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// 创建订单请求
+type CreateOrderRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MerchantId         int64                  `protobuf:"varint,4,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Items              []*OrderItem           `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	TotalAmount        float32                `protobuf:"fixed32,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`                      // 商品总金额（优惠前，服务端校验）
+	ExpectDeliveryTime string                 `protobuf:"bytes,8,opt,name=expect_delivery_time,json=expectDeliveryTime,proto3" json:"expect_delivery_time,omitempty"` // 可选
+	CouponIds          []int64                `protobuf:"varint,9,rep,packed,name=coupon_ids,json=couponIds,proto3" json:"coupon_ids,omitempty"`                      // 使用的用户优惠券ID（可选，平台券/商家券各一张）
+	AddressId          int64                  `protobuf:"varint,12,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`                            // 用户收货地址ID
+	TipAmount          float32                `protobuf:"fixed32,13,opt,name=tip_amount,json=tipAmount,proto3" json:"tip_amount,omitempty"`                           // 骑手小费（可选，计入实付，全额归骑手）
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateOrderRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetTotalAmount() float32 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetExpectDeliveryTime() string {
+	if x != nil {
+		return x.ExpectDeliveryTime
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetCouponIds() []int64 {
+	if x != nil {
+		return x.CouponIds
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetTipAmount() float32 {
+	if x != nil {
+		return x.TipAmount
+	}
+	return 0
+}
+
+// 创建订单响应
+type CreateOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	OrderId       int64                  `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderNo       string                 `protobuf:"bytes,4,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	Fee           *FeeDetail             `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"` // 费用明细
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderResponse) Reset() {
+	*x = CreateOrderResponse{}
+	mi := &file_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderResponse) ProtoMessage() {}
+
+func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateOrderResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateOrderResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CreateOrderResponse) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CreateOrderResponse) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *CreateOrderResponse) GetFee() *FeeDetail {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+// 更新订单状态请求
+type UpdateOrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Operator      string                 `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"` // 操作人（merchant_1/user_1）
+	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`     // 备注（可选）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusRequest) Reset() {
+	*x = UpdateOrderStatusRequest{}
+	mi := &file_order_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -521,7 +944,7 @@ func (x *UpdateOrderStatusRequest) String() string {
 func (*UpdateOrderStatusRequest) ProtoMessage() {}
 
 func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[5]
+	mi := &file_order_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,7 +957,7 @@ func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{5}
+	return file_order_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateOrderStatusRequest) GetOrderId() int64 {
@@ -570,15 +993,17 @@ type ListUserOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // 订单状态（可选）
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`    // 页码（0表示游标分页）
 	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`                         // 游标（上一页返回的next_cursor，传入时忽略page）
+	WithTotal     bool                   `protobuf:"varint,6,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"` // 游标分页时是否统计总数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUserOrdersRequest) Reset() {
 	*x = ListUserOrdersRequest{}
-	mi := &file_order_proto_msgTypes[6]
+	mi := &file_order_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -590,7 +1015,7 @@ func (x *ListUserOrdersRequest) String() string {
 func (*ListUserOrdersRequest) ProtoMessage() {}
 
 func (x *ListUserOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[6]
+	mi := &file_order_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,7 +1028,7 @@ func (x *ListUserOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserOrdersRequest.ProtoReflect.Descriptor instead.
 func (*ListUserOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{6}
+	return file_order_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListUserOrdersRequest) GetUserId() int64 {
@@ -634,20 +1059,37 @@ func (x *ListUserOrdersRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListUserOrdersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListUserOrdersRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
 // 查询商家订单列表请求
 type ListMerchantOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
 	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // 订单状态（可选）
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`    // 页码（0表示游标分页）
 	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`                         // 游标（上一页返回的next_cursor，传入时忽略page）
+	WithTotal     bool                   `protobuf:"varint,6,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"` // 游标分页时是否统计总数
+	Filter        *OrderFilter           `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`                         // 高级筛选条件（可选，status非空时并入statuses）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListMerchantOrdersRequest) Reset() {
 	*x = ListMerchantOrdersRequest{}
-	mi := &file_order_proto_msgTypes[7]
+	mi := &file_order_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -659,7 +1101,7 @@ func (x *ListMerchantOrdersRequest) String() string {
 func (*ListMerchantOrdersRequest) ProtoMessage() {}
 
 func (x *ListMerchantOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[7]
+	mi := &file_order_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -672,65 +1114,2908 @@ func (x *ListMerchantOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMerchantOrdersRequest.ProtoReflect.Descriptor instead.
 func (*ListMerchantOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{7}
+	return file_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListMerchantOrdersRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *ListMerchantOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListMerchantOrdersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMerchantOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMerchantOrdersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListMerchantOrdersRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+func (x *ListMerchantOrdersRequest) GetFilter() *OrderFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// 查询订单列表响应（用户/商家通用）
+type ListUserOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Orders        []*Order               `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"` // 总条数（未统计时为-1）
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,7,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标（为空表示没有更多）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserOrdersResponse) Reset() {
+	*x = ListUserOrdersResponse{}
+	mi := &file_order_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserOrdersResponse) ProtoMessage() {}
+
+func (x *ListUserOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListUserOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListUserOrdersResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListUserOrdersResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListUserOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListUserOrdersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListUserOrdersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUserOrdersResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserOrdersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListMerchantOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Orders        []*Order               `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"` // 总条数（未统计时为-1）
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,7,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标（为空表示没有更多）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMerchantOrdersResponse) Reset() {
+	*x = ListMerchantOrdersResponse{}
+	mi := &file_order_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMerchantOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMerchantOrdersResponse) ProtoMessage() {}
+
+func (x *ListMerchantOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMerchantOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListMerchantOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListMerchantOrdersResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListMerchantOrdersResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListMerchantOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListMerchantOrdersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListMerchantOrdersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMerchantOrdersResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMerchantOrdersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// 查询订单详情请求
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+// 查询订单详情响应
+type GetOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Order         *Order                 `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderResponse) Reset() {
+	*x = GetOrderResponse{}
+	mi := &file_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderResponse) ProtoMessage() {}
+
+func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetOrderResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetOrderResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// 取消订单请求
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 仅用户可取消
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CancelOrderRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CancelOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 退款订单项
+type RefundItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        int64                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // 订单项ID
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`           // 退款数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundItem) Reset() {
+	*x = RefundItem{}
+	mi := &file_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundItem) ProtoMessage() {}
+
+func (x *RefundItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundItem.ProtoReflect.Descriptor instead.
+func (*RefundItem) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RefundItem) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *RefundItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// 缺货部分退款请求
+type RefundOrderItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"` // 操作人（merchant_1）
+	Items         []*RefundItem          `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundOrderItemsRequest) Reset() {
+	*x = RefundOrderItemsRequest{}
+	mi := &file_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOrderItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOrderItemsRequest) ProtoMessage() {}
+
+func (x *RefundOrderItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOrderItemsRequest.ProtoReflect.Descriptor instead.
+func (*RefundOrderItemsRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RefundOrderItemsRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *RefundOrderItemsRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *RefundOrderItemsRequest) GetItems() []*RefundItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RefundOrderItemsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 缺货部分退款响应
+type RefundOrderItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	RefundNo      string                 `protobuf:"bytes,3,opt,name=refund_no,json=refundNo,proto3" json:"refund_no,omitempty"` // 退款单号
+	Amount        float32                `protobuf:"fixed32,4,opt,name=amount,proto3" json:"amount,omitempty"`                   // 退款金额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundOrderItemsResponse) Reset() {
+	*x = RefundOrderItemsResponse{}
+	mi := &file_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOrderItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOrderItemsResponse) ProtoMessage() {}
+
+func (x *RefundOrderItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOrderItemsResponse.ProtoReflect.Descriptor instead.
+func (*RefundOrderItemsResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RefundOrderItemsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RefundOrderItemsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RefundOrderItemsResponse) GetRefundNo() string {
+	if x != nil {
+		return x.RefundNo
+	}
+	return ""
+}
+
+func (x *RefundOrderItemsResponse) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 优惠券信息
+type Coupon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CouponId      int64                  `protobuf:"varint,1,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`           // 优惠券ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                    // 名称
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                    // 类型：满减/折扣/代金券
+	MerchantId    int64                  `protobuf:"varint,4,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`     // 适用商家ID（0为平台券）
+	Threshold     float32                `protobuf:"fixed32,5,opt,name=threshold,proto3" json:"threshold,omitempty"`                        // 使用门槛
+	Amount        float32                `protobuf:"fixed32,6,opt,name=amount,proto3" json:"amount,omitempty"`                              // 减免金额（满减/代金券）
+	Rate          float32                `protobuf:"fixed32,7,opt,name=rate,proto3" json:"rate,omitempty"`                                  // 折扣率（折扣券）
+	MaxDiscount   float32                `protobuf:"fixed32,8,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"` // 最高优惠金额（折扣券，0不封顶）
+	Total         int32                  `protobuf:"varint,9,opt,name=total,proto3" json:"total,omitempty"`                                 // 发放总量
+	Claimed       int32                  `protobuf:"varint,10,opt,name=claimed,proto3" json:"claimed,omitempty"`                            // 已领取数量
+	StartTime     string                 `protobuf:"bytes,11,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`        // 生效时间
+	EndTime       string                 `protobuf:"bytes,12,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`              // 失效时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Coupon) Reset() {
+	*x = Coupon{}
+	mi := &file_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coupon) ProtoMessage() {}
+
+func (x *Coupon) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coupon.ProtoReflect.Descriptor instead.
+func (*Coupon) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Coupon) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *Coupon) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Coupon) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Coupon) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *Coupon) GetThreshold() float32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Coupon) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Coupon) GetRate() float32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *Coupon) GetMaxDiscount() float32 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *Coupon) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Coupon) GetClaimed() int32 {
+	if x != nil {
+		return x.Claimed
+	}
+	return 0
+}
+
+func (x *Coupon) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Coupon) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+// 用户优惠券
+type UserCoupon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserCouponId  int64                  `protobuf:"varint,1,opt,name=user_coupon_id,json=userCouponId,proto3" json:"user_coupon_id,omitempty"` // 用户优惠券ID（下单时传入）
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 用户ID
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                    // 状态：未使用/已使用
+	OrderId       int64                  `protobuf:"varint,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                  // 使用的订单ID
+	UsedTime      string                 `protobuf:"bytes,5,opt,name=used_time,json=usedTime,proto3" json:"used_time,omitempty"`                // 使用时间
+	ClaimTime     string                 `protobuf:"bytes,6,opt,name=claim_time,json=claimTime,proto3" json:"claim_time,omitempty"`             // 领取时间
+	Coupon        *Coupon                `protobuf:"bytes,7,opt,name=coupon,proto3" json:"coupon,omitempty"`                                    // 优惠券信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserCoupon) Reset() {
+	*x = UserCoupon{}
+	mi := &file_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCoupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCoupon) ProtoMessage() {}
+
+func (x *UserCoupon) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCoupon.ProtoReflect.Descriptor instead.
+func (*UserCoupon) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UserCoupon) GetUserCouponId() int64 {
+	if x != nil {
+		return x.UserCouponId
+	}
+	return 0
+}
+
+func (x *UserCoupon) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserCoupon) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserCoupon) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *UserCoupon) GetUsedTime() string {
+	if x != nil {
+		return x.UsedTime
+	}
+	return ""
+}
+
+func (x *UserCoupon) GetClaimTime() string {
+	if x != nil {
+		return x.ClaimTime
+	}
+	return ""
+}
+
+func (x *UserCoupon) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+// 创建优惠券请求
+type CreateCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operator      string                 `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"` // 操作人（merchant_1/admin_1）
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,4,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"` // 适用商家ID（0为平台券）
+	Threshold     float32                `protobuf:"fixed32,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Amount        float32                `protobuf:"fixed32,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Rate          float32                `protobuf:"fixed32,7,opt,name=rate,proto3" json:"rate,omitempty"`
+	MaxDiscount   float32                `protobuf:"fixed32,8,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	Total         int32                  `protobuf:"varint,9,opt,name=total,proto3" json:"total,omitempty"`
+	StartTime     string                 `protobuf:"bytes,10,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,11,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCouponRequest) Reset() {
+	*x = CreateCouponRequest{}
+	mi := &file_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponRequest) ProtoMessage() {}
+
+func (x *CreateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponRequest.ProtoReflect.Descriptor instead.
+func (*CreateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateCouponRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetThreshold() float32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetRate() float32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetMaxDiscount() float32 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+// 创建优惠券响应
+type CreateCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Coupon        *Coupon                `protobuf:"bytes,3,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCouponResponse) Reset() {
+	*x = CreateCouponResponse{}
+	mi := &file_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponResponse) ProtoMessage() {}
+
+func (x *CreateCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponResponse.ProtoReflect.Descriptor instead.
+func (*CreateCouponResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateCouponResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateCouponResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CreateCouponResponse) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+// 领取优惠券请求
+type ClaimCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CouponId      int64                  `protobuf:"varint,1,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimCouponRequest) Reset() {
+	*x = ClaimCouponRequest{}
+	mi := &file_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimCouponRequest) ProtoMessage() {}
+
+func (x *ClaimCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimCouponRequest.ProtoReflect.Descriptor instead.
+func (*ClaimCouponRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ClaimCouponRequest) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *ClaimCouponRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 领取优惠券响应
+type ClaimCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	UserCoupon    *UserCoupon            `protobuf:"bytes,3,opt,name=user_coupon,json=userCoupon,proto3" json:"user_coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimCouponResponse) Reset() {
+	*x = ClaimCouponResponse{}
+	mi := &file_order_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimCouponResponse) ProtoMessage() {}
+
+func (x *ClaimCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimCouponResponse.ProtoReflect.Descriptor instead.
+func (*ClaimCouponResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ClaimCouponResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ClaimCouponResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ClaimCouponResponse) GetUserCoupon() *UserCoupon {
+	if x != nil {
+		return x.UserCoupon
+	}
+	return nil
+}
+
+// 查询用户优惠券请求
+type ListUserCouponsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // 状态（可选）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserCouponsRequest) Reset() {
+	*x = ListUserCouponsRequest{}
+	mi := &file_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserCouponsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserCouponsRequest) ProtoMessage() {}
+
+func (x *ListUserCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserCouponsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListUserCouponsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListUserCouponsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// 查询用户优惠券响应
+type ListUserCouponsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	UserCoupons   []*UserCoupon          `protobuf:"bytes,3,rep,name=user_coupons,json=userCoupons,proto3" json:"user_coupons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserCouponsResponse) Reset() {
+	*x = ListUserCouponsResponse{}
+	mi := &file_order_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserCouponsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserCouponsResponse) ProtoMessage() {}
+
+func (x *ListUserCouponsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserCouponsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserCouponsResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListUserCouponsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListUserCouponsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListUserCouponsResponse) GetUserCoupons() []*UserCoupon {
+	if x != nil {
+		return x.UserCoupons
+	}
+	return nil
+}
+
+// 预览商品项请求
+type PreviewItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	SkuId         int64                  `protobuf:"varint,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                    // 规格ID（多规格商品必填）
+	OptionIds     []int64                `protobuf:"varint,4,rep,packed,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"` // 所选属性选项ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewItem) Reset() {
+	*x = PreviewItem{}
+	mi := &file_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewItem) ProtoMessage() {}
+
+func (x *PreviewItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewItem.ProtoReflect.Descriptor instead.
+func (*PreviewItem) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PreviewItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *PreviewItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PreviewItem) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *PreviewItem) GetOptionIds() []int64 {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+// 订单预览请求
+type PreviewOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Items         []*PreviewItem         `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	AddressId     int64                  `protobuf:"varint,4,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"` // 收货地址ID（可选，未传时按起步价计算配送费）
+	CouponIds     []int64                `protobuf:"varint,5,rep,packed,name=coupon_ids,json=couponIds,proto3" json:"coupon_ids,omitempty"`
+	TipAmount     float32                `protobuf:"fixed32,6,opt,name=tip_amount,json=tipAmount,proto3" json:"tip_amount,omitempty"` // 骑手小费（可选）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewOrderRequest) Reset() {
+	*x = PreviewOrderRequest{}
+	mi := &file_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewOrderRequest) ProtoMessage() {}
+
+func (x *PreviewOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewOrderRequest.ProtoReflect.Descriptor instead.
+func (*PreviewOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PreviewOrderRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PreviewOrderRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *PreviewOrderRequest) GetItems() []*PreviewItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PreviewOrderRequest) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+func (x *PreviewOrderRequest) GetCouponIds() []int64 {
+	if x != nil {
+		return x.CouponIds
+	}
+	return nil
+}
+
+func (x *PreviewOrderRequest) GetTipAmount() float32 {
+	if x != nil {
+		return x.TipAmount
+	}
+	return 0
+}
+
+// 预览商品项（按当前价格、库存校验）
+type PreviewItemResult struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductId         int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName       string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Price             float32                `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`                             // 当前单价
+	PackingFee        float32                `protobuf:"fixed32,4,opt,name=packing_fee,json=packingFee,proto3" json:"packing_fee,omitempty"` // 单件打包费
+	Quantity          int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	TotalPrice        float32                `protobuf:"fixed32,6,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Stock             int32                  `protobuf:"varint,7,opt,name=stock,proto3" json:"stock,omitempty"`         // 当前库存
+	Available         bool                   `protobuf:"varint,8,opt,name=available,proto3" json:"available,omitempty"` // 是否可购买
+	UnavailableReason string                 `protobuf:"bytes,9,opt,name=unavailable_reason,json=unavailableReason,proto3" json:"unavailable_reason,omitempty"`
+	SkuId             int64                  `protobuf:"varint,10,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	SkuName           string                 `protobuf:"bytes,11,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"` // 规格名称
+	Options           []*OrderItemOption     `protobuf:"bytes,12,rep,name=options,proto3" json:"options,omitempty"`                // 所选属性（单价已含加价）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PreviewItemResult) Reset() {
+	*x = PreviewItemResult{}
+	mi := &file_order_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewItemResult) ProtoMessage() {}
+
+func (x *PreviewItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewItemResult.ProtoReflect.Descriptor instead.
+func (*PreviewItemResult) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PreviewItemResult) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *PreviewItemResult) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *PreviewItemResult) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PreviewItemResult) GetPackingFee() float32 {
+	if x != nil {
+		return x.PackingFee
+	}
+	return 0
+}
+
+func (x *PreviewItemResult) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PreviewItemResult) GetTotalPrice() float32 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *PreviewItemResult) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *PreviewItemResult) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *PreviewItemResult) GetUnavailableReason() string {
+	if x != nil {
+		return x.UnavailableReason
+	}
+	return ""
+}
+
+func (x *PreviewItemResult) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *PreviewItemResult) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *PreviewItemResult) GetOptions() []*OrderItemOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// 订单预览响应
+type PreviewOrderResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Code               int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg                string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Items              []*PreviewItemResult   `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Fee                *FeeDetail             `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"` // 费用明细（仅统计可购买商品）
+	Discounts          []*OrderDiscount       `protobuf:"bytes,5,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	EtaMinutes         int32                  `protobuf:"varint,6,opt,name=eta_minutes,json=etaMinutes,proto3" json:"eta_minutes,omitempty"`                          // 预计送达时长（分钟）
+	ExpectDeliveryTime string                 `protobuf:"bytes,7,opt,name=expect_delivery_time,json=expectDeliveryTime,proto3" json:"expect_delivery_time,omitempty"` // 预计送达时间
+	Address            string                 `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`                                                   // 收货地址
+	Errors             []string               `protobuf:"bytes,9,rep,name=errors,proto3" json:"errors,omitempty"`                                                     // 阻断下单的问题
+	CanOrder           bool                   `protobuf:"varint,10,opt,name=can_order,json=canOrder,proto3" json:"can_order,omitempty"`                               // 是否可以下单
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PreviewOrderResponse) Reset() {
+	*x = PreviewOrderResponse{}
+	mi := &file_order_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewOrderResponse) ProtoMessage() {}
+
+func (x *PreviewOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewOrderResponse.ProtoReflect.Descriptor instead.
+func (*PreviewOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PreviewOrderResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PreviewOrderResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *PreviewOrderResponse) GetItems() []*PreviewItemResult {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PreviewOrderResponse) GetFee() *FeeDetail {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *PreviewOrderResponse) GetDiscounts() []*OrderDiscount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *PreviewOrderResponse) GetEtaMinutes() int32 {
+	if x != nil {
+		return x.EtaMinutes
+	}
+	return 0
+}
+
+func (x *PreviewOrderResponse) GetExpectDeliveryTime() string {
+	if x != nil {
+		return x.ExpectDeliveryTime
+	}
+	return ""
+}
+
+func (x *PreviewOrderResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PreviewOrderResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *PreviewOrderResponse) GetCanOrder() bool {
+	if x != nil {
+		return x.CanOrder
+	}
+	return false
+}
+
+// 订单筛选条件
+type OrderFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []string               `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`                                  // 订单状态（多选）
+	StartTime     string                 `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`               // 下单开始时间（格式：2006-01-02 15:04:05）
+	EndTime       string                 `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                     // 下单结束时间（格式：2006-01-02 15:04:05）
+	OrderNoPrefix string                 `protobuf:"bytes,4,opt,name=order_no_prefix,json=orderNoPrefix,proto3" json:"order_no_prefix,omitempty"` // 订单号前缀
+	UserPhone     string                 `protobuf:"bytes,5,opt,name=user_phone,json=userPhone,proto3" json:"user_phone,omitempty"`               // 收货人手机号（完整11位或尾号4位）
+	MinAmount     float32                `protobuf:"fixed32,6,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`             // 最低实付金额（0表示不限）
+	MaxAmount     float32                `protobuf:"fixed32,7,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`             // 最高实付金额（0表示不限）
+	SortBy        string                 `protobuf:"bytes,8,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                        // 排序：create_time_desc（默认）/create_time_asc/amount_desc/amount_asc（金额排序仅支持页码分页）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderFilter) Reset() {
+	*x = OrderFilter{}
+	mi := &file_order_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderFilter) ProtoMessage() {}
+
+func (x *OrderFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderFilter.ProtoReflect.Descriptor instead.
+func (*OrderFilter) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *OrderFilter) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *OrderFilter) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *OrderFilter) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *OrderFilter) GetOrderNoPrefix() string {
+	if x != nil {
+		return x.OrderNoPrefix
+	}
+	return ""
+}
+
+func (x *OrderFilter) GetUserPhone() string {
+	if x != nil {
+		return x.UserPhone
+	}
+	return ""
+}
+
+func (x *OrderFilter) GetMinAmount() float32 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *OrderFilter) GetMaxAmount() float32 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *OrderFilter) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+// 订单检索请求（平台客服）
+type SearchOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 用户ID（可选）
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"` // 商家ID（可选）
+	Filter        *OrderFilter           `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"` // 页码（0表示游标分页）
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`                         // 游标（上一页返回的next_cursor，传入时忽略page）
+	WithTotal     bool                   `protobuf:"varint,7,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"` // 游标分页时是否统计总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOrdersRequest) Reset() {
+	*x = SearchOrdersRequest{}
+	mi := &file_order_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOrdersRequest) ProtoMessage() {}
+
+func (x *SearchOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOrdersRequest.ProtoReflect.Descriptor instead.
+func (*SearchOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SearchOrdersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SearchOrdersRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *SearchOrdersRequest) GetFilter() *OrderFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchOrdersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchOrdersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchOrdersRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+// 订单检索响应
+type SearchOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Orders        []*Order               `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"` // 总条数（未统计时为-1）
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,7,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标（为空表示没有更多）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOrdersResponse) Reset() {
+	*x = SearchOrdersResponse{}
+	mi := &file_order_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOrdersResponse) ProtoMessage() {}
+
+func (x *SearchOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOrdersResponse.ProtoReflect.Descriptor instead.
+func (*SearchOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SearchOrdersResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SearchOrdersResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SearchOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *SearchOrdersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchOrdersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchOrdersResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchOrdersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// 查询商家经营统计请求
+type GetMerchantStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 开始日期（格式：2006-01-02，按下单日期统计）
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 结束日期（含当天，跨度不超过366天）
+	TopN          int32                  `protobuf:"varint,4,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`               // 热销商品数（0取默认10，最大50）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMerchantStatsRequest) Reset() {
+	*x = GetMerchantStatsRequest{}
+	mi := &file_order_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMerchantStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMerchantStatsRequest) ProtoMessage() {}
+
+func (x *GetMerchantStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMerchantStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMerchantStatsRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetMerchantStatsRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *GetMerchantStatsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetMerchantStatsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetMerchantStatsRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+// 订单状态计数
+type StatusCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusCount) Reset() {
+	*x = StatusCount{}
+	mi := &file_order_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusCount) ProtoMessage() {}
+
+func (x *StatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusCount.ProtoReflect.Descriptor instead.
+func (*StatusCount) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StatusCount) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatusCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 每日经营数据
+type DailyStat struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Date            string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	OrderCount      int64                  `protobuf:"varint,2,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`                  // 已支付订单数
+	ValidOrderCount int64                  `protobuf:"varint,3,opt,name=valid_order_count,json=validOrderCount,proto3" json:"valid_order_count,omitempty"` // 有效订单数
+	Gmv             float32                `protobuf:"fixed32,4,opt,name=gmv,proto3" json:"gmv,omitempty"`                                                 // 有效订单实付金额（扣除退款）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DailyStat) Reset() {
+	*x = DailyStat{}
+	mi := &file_order_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyStat) ProtoMessage() {}
+
+func (x *DailyStat) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyStat.ProtoReflect.Descriptor instead.
+func (*DailyStat) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DailyStat) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyStat) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *DailyStat) GetValidOrderCount() int64 {
+	if x != nil {
+		return x.ValidOrderCount
+	}
+	return 0
+}
+
+func (x *DailyStat) GetGmv() float32 {
+	if x != nil {
+		return x.Gmv
+	}
+	return 0
+}
+
+// 商品销量
+type ProductSales struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // 销量（扣除退款）
+	Amount        float32                `protobuf:"fixed32,4,opt,name=amount,proto3" json:"amount,omitempty"`    // 销售额（扣除退款）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSales) Reset() {
+	*x = ProductSales{}
+	mi := &file_order_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSales) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSales) ProtoMessage() {}
+
+func (x *ProductSales) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSales.ProtoReflect.Descriptor instead.
+func (*ProductSales) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ProductSales) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductSales) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ProductSales) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ProductSales) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 商家经营统计
+type MerchantStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId       int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	StartDate        string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate          string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	OrderCount       int64                  `protobuf:"varint,4,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`                  // 已支付订单数
+	ValidOrderCount  int64                  `protobuf:"varint,5,opt,name=valid_order_count,json=validOrderCount,proto3" json:"valid_order_count,omitempty"` // 有效订单数（未取消/拒单）
+	StatusCounts     []*StatusCount         `protobuf:"bytes,6,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty"`
+	Gmv              float32                `protobuf:"fixed32,7,opt,name=gmv,proto3" json:"gmv,omitempty"`                                                     // 有效订单实付金额（扣除退款）
+	AvgOrderValue    float32                `protobuf:"fixed32,8,opt,name=avg_order_value,json=avgOrderValue,proto3" json:"avg_order_value,omitempty"`          // 客单价
+	CancelRate       float32                `protobuf:"fixed32,9,opt,name=cancel_rate,json=cancelRate,proto3" json:"cancel_rate,omitempty"`                     // 取消率（0~1）
+	RejectRate       float32                `protobuf:"fixed32,10,opt,name=reject_rate,json=rejectRate,proto3" json:"reject_rate,omitempty"`                    // 拒单率（0~1）
+	AvgAcceptSeconds int64                  `protobuf:"varint,11,opt,name=avg_accept_seconds,json=avgAcceptSeconds,proto3" json:"avg_accept_seconds,omitempty"` // 平均接单时长（秒，支付到接单）
+	Daily            []*DailyStat           `protobuf:"bytes,12,rep,name=daily,proto3" json:"daily,omitempty"`                                                  // 每日趋势
+	TopProducts      []*ProductSales        `protobuf:"bytes,13,rep,name=top_products,json=topProducts,proto3" json:"top_products,omitempty"`                   // 热销商品
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MerchantStats) Reset() {
+	*x = MerchantStats{}
+	mi := &file_order_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MerchantStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerchantStats) ProtoMessage() {}
+
+func (x *MerchantStats) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerchantStats.ProtoReflect.Descriptor instead.
+func (*MerchantStats) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MerchantStats) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *MerchantStats) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *MerchantStats) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetValidOrderCount() int64 {
+	if x != nil {
+		return x.ValidOrderCount
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetStatusCounts() []*StatusCount {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+func (x *MerchantStats) GetGmv() float32 {
+	if x != nil {
+		return x.Gmv
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetAvgOrderValue() float32 {
+	if x != nil {
+		return x.AvgOrderValue
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetCancelRate() float32 {
+	if x != nil {
+		return x.CancelRate
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetRejectRate() float32 {
+	if x != nil {
+		return x.RejectRate
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetAvgAcceptSeconds() int64 {
+	if x != nil {
+		return x.AvgAcceptSeconds
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetDaily() []*DailyStat {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *MerchantStats) GetTopProducts() []*ProductSales {
+	if x != nil {
+		return x.TopProducts
+	}
+	return nil
+}
+
+// 查询商家经营统计响应
+type GetMerchantStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Stats         *MerchantStats         `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMerchantStatsResponse) Reset() {
+	*x = GetMerchantStatsResponse{}
+	mi := &file_order_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMerchantStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMerchantStatsResponse) ProtoMessage() {}
+
+func (x *GetMerchantStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMerchantStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetMerchantStatsResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetMerchantStatsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetMerchantStatsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetMerchantStatsResponse) GetStats() *MerchantStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// 导出商家订单请求
+type ExportMerchantOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`   // 导出格式：csv/xlsx
+	Columns       []string               `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"` // 导出列（为空使用默认列，可选值见服务端exportColumns）
+	Filter        *OrderFilter           `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`   // 筛选条件（必须指定start_time和end_time，跨度不超过92天）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMerchantOrdersRequest) Reset() {
+	*x = ExportMerchantOrdersRequest{}
+	mi := &file_order_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMerchantOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMerchantOrdersRequest) ProtoMessage() {}
+
+func (x *ExportMerchantOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMerchantOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ExportMerchantOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExportMerchantOrdersRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *ExportMerchantOrdersRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportMerchantOrdersRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ExportMerchantOrdersRequest) GetFilter() *OrderFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// 导出商家订单响应（流式：首块携带文件名，末条done=true；code非0表示导出失败，已接收内容应丢弃）
+type ExportMerchantOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`          // 文件名（仅首块携带）
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME类型（仅首块携带）
+	Chunk         []byte                 `protobuf:"bytes,5,opt,name=chunk,proto3" json:"chunk,omitempty"`                                // 文件内容分块
+	Done          bool                   `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`                                 // 是否导出完成
+	Rows          int64                  `protobuf:"varint,7,opt,name=rows,proto3" json:"rows,omitempty"`                                 // 导出数据行数（完成时返回）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMerchantOrdersResponse) Reset() {
+	*x = ExportMerchantOrdersResponse{}
+	mi := &file_order_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMerchantOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMerchantOrdersResponse) ProtoMessage() {}
+
+func (x *ExportMerchantOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMerchantOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ExportMerchantOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ExportMerchantOrdersResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ExportMerchantOrdersResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ExportMerchantOrdersResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportMerchantOrdersResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportMerchantOrdersResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ExportMerchantOrdersResponse) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ExportMerchantOrdersResponse) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+// 商品评价
+type ReviewItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // 商品名称（仅响应返回）
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`                             // 评分（1-5）
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                            // 评价内容
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewItem) Reset() {
+	*x = ReviewItem{}
+	mi := &file_order_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewItem) ProtoMessage() {}
+
+func (x *ReviewItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewItem.ProtoReflect.Descriptor instead.
+func (*ReviewItem) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReviewItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReviewItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ReviewItem) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ReviewItem) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// 订单评价
+type Review struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId       int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	OrderId        int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName       string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"` // 用户昵称（脱敏）
+	MerchantId     int64                  `protobuf:"varint,5,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	MerchantRating int32                  `protobuf:"varint,6,opt,name=merchant_rating,json=merchantRating,proto3" json:"merchant_rating,omitempty"` // 商家评分（1-5）
+	Content        string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	Tags           []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`                                    // 商家评价标签
+	RiderId        int64                  `protobuf:"varint,9,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`              // 骑手ID（0表示未评价骑手）
+	RiderRating    int32                  `protobuf:"varint,10,opt,name=rider_rating,json=riderRating,proto3" json:"rider_rating,omitempty"` // 骑手评分（0表示未评价）
+	RiderTags      []string               `protobuf:"bytes,11,rep,name=rider_tags,json=riderTags,proto3" json:"rider_tags,omitempty"`        // 骑手评价标签
+	Items          []*ReviewItem          `protobuf:"bytes,12,rep,name=items,proto3" json:"items,omitempty"`                                 // 商品评价
+	Reply          string                 `protobuf:"bytes,13,opt,name=reply,proto3" json:"reply,omitempty"`                                 // 商家回复
+	ReplyTime      string                 `protobuf:"bytes,14,opt,name=reply_time,json=replyTime,proto3" json:"reply_time,omitempty"`
+	CreateTime     string                 `protobuf:"bytes,15,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_order_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Review) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *Review) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Review) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Review) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Review) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *Review) GetMerchantRating() int32 {
+	if x != nil {
+		return x.MerchantRating
+	}
+	return 0
+}
+
+func (x *Review) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Review) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Review) GetRiderId() int64 {
+	if x != nil {
+		return x.RiderId
+	}
+	return 0
+}
+
+func (x *Review) GetRiderRating() int32 {
+	if x != nil {
+		return x.RiderRating
+	}
+	return 0
+}
+
+func (x *Review) GetRiderTags() []string {
+	if x != nil {
+		return x.RiderTags
+	}
+	return nil
+}
+
+func (x *Review) GetItems() []*ReviewItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Review) GetReply() string {
+	if x != nil {
+		return x.Reply
+	}
+	return ""
+}
+
+func (x *Review) GetReplyTime() string {
+	if x != nil {
+		return x.ReplyTime
+	}
+	return ""
+}
+
+func (x *Review) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+// 评价订单请求
+type CreateReviewRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MerchantRating int32                  `protobuf:"varint,3,opt,name=merchant_rating,json=merchantRating,proto3" json:"merchant_rating,omitempty"` // 商家评分（1-5，必填）
+	Content        string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                                      // 评价内容（最多512字符）
+	Tags           []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                                            // 商家评价标签（最多5个）
+	RiderRating    int32                  `protobuf:"varint,6,opt,name=rider_rating,json=riderRating,proto3" json:"rider_rating,omitempty"`          // 骑手评分（1-5，0表示不评价）
+	RiderTags      []string               `protobuf:"bytes,7,rep,name=rider_tags,json=riderTags,proto3" json:"rider_tags,omitempty"`                 // 骑手评价标签（最多5个）
+	Items          []*ReviewItem          `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`                                          // 商品评价（可选）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateReviewRequest) Reset() {
+	*x = CreateReviewRequest{}
+	mi := &file_order_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewRequest) ProtoMessage() {}
+
+func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateReviewRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-func (x *ListMerchantOrdersRequest) GetMerchantId() int64 {
+func (x *CreateReviewRequest) GetMerchantRating() int32 {
 	if x != nil {
-		return x.MerchantId
+		return x.MerchantRating
 	}
 	return 0
 }
 
-func (x *ListMerchantOrdersRequest) GetStatus() string {
+func (x *CreateReviewRequest) GetContent() string {
 	if x != nil {
-		return x.Status
+		return x.Content
 	}
 	return ""
 }
 
-func (x *ListMerchantOrdersRequest) GetPage() int32 {
+func (x *CreateReviewRequest) GetTags() []string {
 	if x != nil {
-		return x.Page
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-func (x *ListMerchantOrdersRequest) GetPageSize() int32 {
+func (x *CreateReviewRequest) GetRiderRating() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.RiderRating
 	}
 	return 0
 }
 
-// 查询订单列表响应（用户/商家通用）
-type ListUserOrdersResponse struct {
+func (x *CreateReviewRequest) GetRiderTags() []string {
+	if x != nil {
+		return x.RiderTags
+	}
+	return nil
+}
+
+func (x *CreateReviewRequest) GetItems() []*ReviewItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 评价订单响应
+type CreateReviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Orders        []*Order               `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ReviewId      int64                  `protobuf:"varint,3,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserOrdersResponse) Reset() {
-	*x = ListUserOrdersResponse{}
-	mi := &file_order_proto_msgTypes[8]
+func (x *CreateReviewResponse) Reset() {
+	*x = CreateReviewResponse{}
+	mi := &file_order_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserOrdersResponse) String() string {
+func (x *CreateReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserOrdersResponse) ProtoMessage() {}
+func (*CreateReviewResponse) ProtoMessage() {}
 
-func (x *ListUserOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[8]
+func (x *CreateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,80 +4026,62 @@ func (x *ListUserOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListUserOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CreateReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ListUserOrdersResponse) GetCode() int32 {
+func (x *CreateReviewResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *ListUserOrdersResponse) GetMsg() string {
+func (x *CreateReviewResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *ListUserOrdersResponse) GetOrders() []*Order {
-	if x != nil {
-		return x.Orders
-	}
-	return nil
-}
-
-func (x *ListUserOrdersResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListUserOrdersResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListUserOrdersResponse) GetPageSize() int32 {
+func (x *CreateReviewResponse) GetReviewId() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.ReviewId
 	}
 	return 0
 }
 
-type ListMerchantOrdersResponse struct {
+// 查询评价请求（用户/商家/商品/骑手至少指定一个）
+type ListReviewsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Orders        []*Order               `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID（仅本人或平台运营可查）
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RiderId       int64                  `protobuf:"varint,4,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"` // 页码（0表示游标分页）
 	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`                         // 游标（上一页返回的next_cursor，传入时忽略page）
+	WithTotal     bool                   `protobuf:"varint,8,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"` // 游标分页时是否统计总数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMerchantOrdersResponse) Reset() {
-	*x = ListMerchantOrdersResponse{}
-	mi := &file_order_proto_msgTypes[9]
+func (x *ListReviewsRequest) Reset() {
+	*x = ListReviewsRequest{}
+	mi := &file_order_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMerchantOrdersResponse) String() string {
+func (x *ListReviewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMerchantOrdersResponse) ProtoMessage() {}
+func (*ListReviewsRequest) ProtoMessage() {}
 
-func (x *ListMerchantOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[9]
+func (x *ListReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,123 +4092,96 @@ func (x *ListMerchantOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMerchantOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListMerchantOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ListMerchantOrdersResponse) GetCode() int32 {
+func (x *ListReviewsRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Code
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *ListMerchantOrdersResponse) GetMsg() string {
+func (x *ListReviewsRequest) GetMerchantId() int64 {
 	if x != nil {
-		return x.Msg
+		return x.MerchantId
 	}
-	return ""
+	return 0
 }
 
-func (x *ListMerchantOrdersResponse) GetOrders() []*Order {
+func (x *ListReviewsRequest) GetProductId() int64 {
 	if x != nil {
-		return x.Orders
+		return x.ProductId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListMerchantOrdersResponse) GetTotal() int32 {
+func (x *ListReviewsRequest) GetRiderId() int64 {
 	if x != nil {
-		return x.Total
+		return x.RiderId
 	}
 	return 0
 }
 
-func (x *ListMerchantOrdersResponse) GetPage() int32 {
+func (x *ListReviewsRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *ListMerchantOrdersResponse) GetPageSize() int32 {
+func (x *ListReviewsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-// 查询订单详情请求
-type GetOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetOrderRequest) Reset() {
-	*x = GetOrderRequest{}
-	mi := &file_order_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetOrderRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetOrderRequest) ProtoMessage() {}
-
-func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[10]
+func (x *ListReviewsRequest) GetCursor() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Cursor
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{10}
+	return ""
 }
 
-func (x *GetOrderRequest) GetOrderId() int64 {
+func (x *ListReviewsRequest) GetWithTotal() bool {
 	if x != nil {
-		return x.OrderId
+		return x.WithTotal
 	}
-	return 0
+	return false
 }
 
-// 查询订单详情响应
-type GetOrderResponse struct {
+// 查询评价响应
+type ListReviewsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Order         *Order                 `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	Reviews       []*Review              `protobuf:"bytes,3,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"` // 总条数（未统计时为-1）
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,7,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标（为空表示没有更多）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderResponse) Reset() {
-	*x = GetOrderResponse{}
-	mi := &file_order_proto_msgTypes[11]
+func (x *ListReviewsResponse) Reset() {
+	*x = ListReviewsResponse{}
+	mi := &file_order_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderResponse) String() string {
+func (x *ListReviewsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderResponse) ProtoMessage() {}
+func (*ListReviewsResponse) ProtoMessage() {}
 
-func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[11]
+func (x *ListReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -952,57 +4192,85 @@ func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetOrderResponse) GetCode() int32 {
+func (x *ListReviewsResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetOrderResponse) GetMsg() string {
+func (x *ListReviewsResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *GetOrderResponse) GetOrder() *Order {
+func (x *ListReviewsResponse) GetReviews() []*Review {
 	if x != nil {
-		return x.Order
+		return x.Reviews
 	}
 	return nil
 }
 
-// 取消订单请求
-type CancelOrderRequest struct {
+func (x *ListReviewsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListReviewsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReviewsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReviewsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// 商家回复评价请求
+type ReplyReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 仅用户可取消
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Reply         string                 `protobuf:"bytes,3,opt,name=reply,proto3" json:"reply,omitempty"` // 回复内容（最多512字符，每条评价仅可回复一次）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelOrderRequest) Reset() {
-	*x = CancelOrderRequest{}
-	mi := &file_order_proto_msgTypes[12]
+func (x *ReplyReviewRequest) Reset() {
+	*x = ReplyReviewRequest{}
+	mi := &file_order_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelOrderRequest) String() string {
+func (x *ReplyReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderRequest) ProtoMessage() {}
+func (*ReplyReviewRequest) ProtoMessage() {}
 
-func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[12]
+func (x *ReplyReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1013,28 +4281,28 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
-func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ReplyReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReplyReviewRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CancelOrderRequest) GetOrderId() int64 {
+func (x *ReplyReviewRequest) GetReviewId() int64 {
 	if x != nil {
-		return x.OrderId
+		return x.ReviewId
 	}
 	return 0
 }
 
-func (x *CancelOrderRequest) GetUserId() int64 {
+func (x *ReplyReviewRequest) GetMerchantId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *CancelOrderRequest) GetReason() string {
+func (x *ReplyReviewRequest) GetReply() string {
 	if x != nil {
-		return x.Reason
+		return x.Reply
 	}
 	return ""
 }
@@ -1043,7 +4311,7 @@ var File_order_proto protoreflect.FileDescriptor
 
 const file_order_proto_rawDesc = "" +
 	"\n" +
-	"\vorder.proto\x12\x05order\x1a\x1bgoogle/protobuf/empty.proto\x1a\x0evalidate.proto\"\xd4\x01\n" +
+	"\vorder.proto\x12\x05order\x1a\x1bgoogle/protobuf/empty.proto\x1a\x0evalidate.proto\"\xfa\x02\n" +
 	"\tOrderItem\x12\x17\n" +
 	"\aitem_id\x18\x01 \x01(\x03R\x06itemId\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x1d\n" +
@@ -1053,7 +4321,20 @@ const file_order_proto_rawDesc = "" +
 	"\x05price\x18\x05 \x01(\x02R\x05price\x12\x1a\n" +
 	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x1f\n" +
 	"\vtotal_price\x18\a \x01(\x02R\n" +
-	"totalPrice\"\xe1\x03\n" +
+	"totalPrice\x12!\n" +
+	"\frefunded_qty\x18\b \x01(\x05R\vrefundedQty\x12\x15\n" +
+	"\x06sku_id\x18\t \x01(\x03R\x05skuId\x12\x19\n" +
+	"\bsku_name\x18\n" +
+	" \x01(\tR\askuName\x12\x1d\n" +
+	"\n" +
+	"option_ids\x18\v \x03(\x03R\toptionIds\x120\n" +
+	"\aoptions\x18\f \x03(\v2\x16.order.OrderItemOptionR\aoptions\"w\n" +
+	"\x0fOrderItemOption\x12\x1b\n" +
+	"\toption_id\x18\x01 \x01(\x03R\boptionId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x02 \x01(\tR\tgroupName\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x02R\x05price\"\x9a\b\n" +
 	"\x05Order\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x19\n" +
 	"\border_no\x18\x02 \x01(\tR\aorderNo\x12\x17\n" +
@@ -1074,60 +4355,113 @@ const file_order_proto_rawDesc = "" +
 	"\vupdate_time\x18\r \x01(\tR\n" +
 	"updateTime\x120\n" +
 	"\x14expect_delivery_time\x18\x0e \x01(\tR\x12expectDeliveryTime\x12\x16\n" +
-	"\x06remark\x18\x0f \x01(\tR\x06remark\"6\n" +
+	"\x06remark\x18\x0f \x01(\tR\x06remark\x12\x1b\n" +
+	"\tpaid_time\x18\x10 \x01(\tR\bpaidTime\x12#\n" +
+	"\rrefund_amount\x18\x11 \x01(\x02R\frefundAmount\x12!\n" +
+	"\fgoods_amount\x18\x12 \x01(\x02R\vgoodsAmount\x12'\n" +
+	"\x0fdiscount_amount\x18\x13 \x01(\x02R\x0ediscountAmount\x122\n" +
+	"\tdiscounts\x18\x14 \x03(\v2\x14.order.OrderDiscountR\tdiscounts\x12\x1f\n" +
+	"\vpacking_fee\x18\x15 \x01(\x02R\n" +
+	"packingFee\x12!\n" +
+	"\fdelivery_fee\x18\x16 \x01(\x02R\vdeliveryFee\x12+\n" +
+	"\x11delivery_distance\x18\x17 \x01(\x05R\x10deliveryDistance\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x18 \x01(\x03R\taddressId\x12\x1a\n" +
+	"\bprovince\x18\x19 \x01(\tR\bprovince\x12\x12\n" +
+	"\x04city\x18\x1a \x01(\tR\x04city\x12\x1a\n" +
+	"\bdistrict\x18\x1b \x01(\tR\bdistrict\x12%\n" +
+	"\x0eaddress_detail\x18\x1c \x01(\tR\raddressDetail\x12\x1c\n" +
+	"\tlongitude\x18\x1d \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\blatitude\x18\x1e \x01(\x01R\blatitude\x12\x19\n" +
+	"\brider_id\x18\x1f \x01(\x03R\ariderId\x12\x1d\n" +
+	"\n" +
+	"tip_amount\x18  \x01(\x02R\ttipAmount\"\x8a\x02\n" +
+	"\tFeeDetail\x12!\n" +
+	"\fgoods_amount\x18\x01 \x01(\x02R\vgoodsAmount\x12\x1f\n" +
+	"\vpacking_fee\x18\x02 \x01(\x02R\n" +
+	"packingFee\x12!\n" +
+	"\fdelivery_fee\x18\x03 \x01(\x02R\vdeliveryFee\x12+\n" +
+	"\x11delivery_distance\x18\x04 \x01(\x05R\x10deliveryDistance\x12'\n" +
+	"\x0fdiscount_amount\x18\x05 \x01(\x02R\x0ediscountAmount\x12!\n" +
+	"\ftotal_amount\x18\x06 \x01(\x02R\vtotalAmount\x12\x1d\n" +
+	"\n" +
+	"tip_amount\x18\a \x01(\x02R\ttipAmount\"\x9f\x01\n" +
+	"\rOrderDiscount\x12$\n" +
+	"\x0euser_coupon_id\x18\x01 \x01(\x03R\fuserCouponId\x12\x1b\n" +
+	"\tcoupon_id\x18\x02 \x01(\x03R\bcouponId\x12\x1f\n" +
+	"\vcoupon_name\x18\x03 \x01(\tR\n" +
+	"couponName\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x02R\x06amount\"6\n" +
 	"\x0eCommonResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg\"\xf1\x02\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\"\xb6\x03\n" +
 	"\x12CreateOrderRequest\x12 \n" +
-	"\auser_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12$\n" +
-	"\tuser_name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\buserName\x123\n" +
-	"\n" +
-	"user_phone\x18\x03 \x01(\tB\x14\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$R\tuserPhone\x12(\n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12(\n" +
 	"\vmerchant_id\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
 	"merchantId\x120\n" +
 	"\x05items\x18\x05 \x03(\v2\x10.order.OrderItemB\b\xfaB\x05\x92\x01\x02\b\x01R\x05items\x12-\n" +
 	"\ftotal_amount\x18\x06 \x01(\x02B\n" +
 	"\xfaB\a\n" +
-	"\x05%\x00\x00\x00\x00R\vtotalAmount\x12!\n" +
-	"\aaddress\x18\a \x01(\tB\a\xfaB\x04r\x02\x10\x05R\aaddress\x120\n" +
-	"\x14expect_delivery_time\x18\b \x01(\tR\x12expectDeliveryTime\"q\n" +
+	"\x05%\x00\x00\x00\x00R\vtotalAmount\x120\n" +
+	"\x14expect_delivery_time\x18\b \x01(\tR\x12expectDeliveryTime\x12'\n" +
+	"\n" +
+	"coupon_ids\x18\t \x03(\x03B\b\xfaB\x05\x92\x01\x02\x10\x02R\tcouponIds\x12&\n" +
+	"\n" +
+	"address_id\x18\f \x01(\x03B\a\xfaB\x04\"\x02 \x00R\taddressId\x12\x1d\n" +
+	"\n" +
+	"tip_amount\x18\r \x01(\x02R\ttipAmountJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\a\x10\bJ\x04\b\n" +
+	"\x10\vJ\x04\b\v\x10\fR\tuser_nameR\n" +
+	"user_phoneR\aaddressR\tlongitudeR\blatitude\"\x95\x01\n" +
 	"\x13CreateOrderResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x19\n" +
 	"\border_id\x18\x03 \x01(\x03R\aorderId\x12\x19\n" +
-	"\border_no\x18\x04 \x01(\tR\aorderNo\"\x9c\x01\n" +
+	"\border_no\x18\x04 \x01(\tR\aorderNo\x12\"\n" +
+	"\x03fee\x18\x05 \x01(\v2\x10.order.FeeDetailR\x03fee\"\x9c\x01\n" +
 	"\x18UpdateOrderStatusRequest\x12\"\n" +
 	"\border_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\x12\x1f\n" +
 	"\x06status\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\x06status\x12#\n" +
 	"\boperator\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\boperator\x12\x16\n" +
-	"\x06remark\x18\x04 \x01(\tR\x06remark\"\x96\x01\n" +
+	"\x06remark\x18\x04 \x01(\tR\x06remark\"\xcd\x01\n" +
 	"\x15ListUserOrdersRequest\x12 \n" +
 	"\auser_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
-	"\x04page\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\x04page\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12&\n" +
 	"\tpage_size\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\n" +
-	"R\bpageSize\"\xa2\x01\n" +
+	"R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"with_total\x18\x06 \x01(\bR\twithTotal\"\x85\x02\n" +
 	"\x19ListMerchantOrdersRequest\x12(\n" +
 	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
 	"merchantId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
-	"\x04page\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\x04page\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12&\n" +
 	"\tpage_size\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\n" +
-	"R\bpageSize\"\xab\x01\n" +
+	"R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"with_total\x18\x06 \x01(\bR\twithTotal\x12*\n" +
+	"\x06filter\x18\a \x01(\v2\x12.order.OrderFilterR\x06filter\"\xcc\x01\n" +
 	"\x16ListUserOrdersResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x02 \x01(\tR\x03msg\x12$\n" +
 	"\x06orders\x18\x03 \x03(\v2\f.order.OrderR\x06orders\x12\x14\n" +
 	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\"\xaf\x01\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vnext_cursor\x18\a \x01(\tR\n" +
+	"nextCursor\"\xd0\x01\n" +
 	"\x1aListMerchantOrdersResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x02 \x01(\tR\x03msg\x12$\n" +
 	"\x06orders\x18\x03 \x03(\v2\f.order.OrderR\x06orders\x12\x14\n" +
 	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\"5\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vnext_cursor\x18\a \x01(\tR\n" +
+	"nextCursor\"5\n" +
 	"\x0fGetOrderRequest\x12\"\n" +
 	"\border_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\"\\\n" +
 	"\x10GetOrderResponse\x12\x12\n" +
@@ -1137,14 +4471,310 @@ const file_order_proto_rawDesc = "" +
 	"\x12CancelOrderRequest\x12\"\n" +
 	"\border_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\x12 \n" +
 	"\auser_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12\x1f\n" +
-	"\x06reason\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\x06reason2\xcd\x03\n" +
+	"\x06reason\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\x06reason\"S\n" +
+	"\n" +
+	"RefundItem\x12 \n" +
+	"\aitem_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06itemId\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bquantity\"\xb6\x01\n" +
+	"\x17RefundOrderItemsRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\x12#\n" +
+	"\boperator\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\boperator\x121\n" +
+	"\x05items\x18\x03 \x03(\v2\x11.order.RefundItemB\b\xfaB\x05\x92\x01\x02\b\x01R\x05items\x12\x1f\n" +
+	"\x06reason\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\x06reason\"u\n" +
+	"\x18RefundOrderItemsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x1b\n" +
+	"\trefund_no\x18\x03 \x01(\tR\brefundNo\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x02R\x06amount\"\xc5\x02\n" +
+	"\x06Coupon\x12\x1b\n" +
+	"\tcoupon_id\x18\x01 \x01(\x03R\bcouponId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1f\n" +
+	"\vmerchant_id\x18\x04 \x01(\x03R\n" +
+	"merchantId\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x02R\tthreshold\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x02R\x06amount\x12\x12\n" +
+	"\x04rate\x18\a \x01(\x02R\x04rate\x12!\n" +
+	"\fmax_discount\x18\b \x01(\x02R\vmaxDiscount\x12\x14\n" +
+	"\x05total\x18\t \x01(\x05R\x05total\x12\x18\n" +
+	"\aclaimed\x18\n" +
+	" \x01(\x05R\aclaimed\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\v \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\f \x01(\tR\aendTime\"\xe1\x01\n" +
+	"\n" +
+	"UserCoupon\x12$\n" +
+	"\x0euser_coupon_id\x18\x01 \x01(\x03R\fuserCouponId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\x03R\aorderId\x12\x1b\n" +
+	"\tused_time\x18\x05 \x01(\tR\busedTime\x12\x1d\n" +
+	"\n" +
+	"claim_time\x18\x06 \x01(\tR\tclaimTime\x12%\n" +
+	"\x06coupon\x18\a \x01(\v2\r.order.CouponR\x06coupon\"\x86\x03\n" +
+	"\x13CreateCouponRequest\x12#\n" +
+	"\boperator\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\boperator\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\x04name\x124\n" +
+	"\x04type\x18\x03 \x01(\tB \xfaB\x1dr\x1bR\x06满减R\x06折扣R\t代金券R\x04type\x12\x1f\n" +
+	"\vmerchant_id\x18\x04 \x01(\x03R\n" +
+	"merchantId\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x02R\tthreshold\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x02R\x06amount\x12\x12\n" +
+	"\x04rate\x18\a \x01(\x02R\x04rate\x12!\n" +
+	"\fmax_discount\x18\b \x01(\x02R\vmaxDiscount\x12\x1d\n" +
+	"\x05total\x18\t \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x05total\x12&\n" +
+	"\n" +
+	"start_time\x18\n" +
+	" \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tstartTime\x12\"\n" +
+	"\bend_time\x18\v \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aendTime\"c\n" +
+	"\x14CreateCouponResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12%\n" +
+	"\x06coupon\x18\x03 \x01(\v2\r.order.CouponR\x06coupon\"\\\n" +
+	"\x12ClaimCouponRequest\x12$\n" +
+	"\tcoupon_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bcouponId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\"o\n" +
+	"\x13ClaimCouponResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x122\n" +
+	"\vuser_coupon\x18\x03 \x01(\v2\x11.order.UserCouponR\n" +
+	"userCoupon\"R\n" +
+	"\x16ListUserCouponsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"u\n" +
+	"\x17ListUserCouponsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x124\n" +
+	"\fuser_coupons\x18\x03 \x03(\v2\x11.order.UserCouponR\vuserCoupons\"\x90\x01\n" +
+	"\vPreviewItem\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bquantity\x12\x15\n" +
+	"\x06sku_id\x18\x03 \x01(\x03R\x05skuId\x12\x1d\n" +
+	"\n" +
+	"option_ids\x18\x04 \x03(\x03R\toptionIds\"\xfc\x01\n" +
+	"\x13PreviewOrderRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x122\n" +
+	"\x05items\x18\x03 \x03(\v2\x12.order.PreviewItemB\b\xfaB\x05\x92\x01\x02\b\x01R\x05items\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x04 \x01(\x03R\taddressId\x12'\n" +
+	"\n" +
+	"coupon_ids\x18\x05 \x03(\x03B\b\xfaB\x05\x92\x01\x02\x10\x02R\tcouponIds\x12\x1d\n" +
+	"\n" +
+	"tip_amount\x18\x06 \x01(\x02R\ttipAmount\"\x90\x03\n" +
+	"\x11PreviewItemResult\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x02R\x05price\x12\x1f\n" +
+	"\vpacking_fee\x18\x04 \x01(\x02R\n" +
+	"packingFee\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x1f\n" +
+	"\vtotal_price\x18\x06 \x01(\x02R\n" +
+	"totalPrice\x12\x14\n" +
+	"\x05stock\x18\a \x01(\x05R\x05stock\x12\x1c\n" +
+	"\tavailable\x18\b \x01(\bR\tavailable\x12-\n" +
+	"\x12unavailable_reason\x18\t \x01(\tR\x11unavailableReason\x12\x15\n" +
+	"\x06sku_id\x18\n" +
+	" \x01(\x03R\x05skuId\x12\x19\n" +
+	"\bsku_name\x18\v \x01(\tR\askuName\x120\n" +
+	"\aoptions\x18\f \x03(\v2\x16.order.OrderItemOptionR\aoptions\"\xe6\x02\n" +
+	"\x14PreviewOrderResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12.\n" +
+	"\x05items\x18\x03 \x03(\v2\x18.order.PreviewItemResultR\x05items\x12\"\n" +
+	"\x03fee\x18\x04 \x01(\v2\x10.order.FeeDetailR\x03fee\x122\n" +
+	"\tdiscounts\x18\x05 \x03(\v2\x14.order.OrderDiscountR\tdiscounts\x12\x1f\n" +
+	"\veta_minutes\x18\x06 \x01(\x05R\n" +
+	"etaMinutes\x120\n" +
+	"\x14expect_delivery_time\x18\a \x01(\tR\x12expectDeliveryTime\x12\x18\n" +
+	"\aaddress\x18\b \x01(\tR\aaddress\x12\x16\n" +
+	"\x06errors\x18\t \x03(\tR\x06errors\x12\x1b\n" +
+	"\tcan_order\x18\n" +
+	" \x01(\bR\bcanOrder\"\x81\x02\n" +
+	"\vOrderFilter\x12\x1a\n" +
+	"\bstatuses\x18\x01 \x03(\tR\bstatuses\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\x12&\n" +
+	"\x0forder_no_prefix\x18\x04 \x01(\tR\rorderNoPrefix\x12\x1d\n" +
+	"\n" +
+	"user_phone\x18\x05 \x01(\tR\tuserPhone\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x06 \x01(\x02R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\a \x01(\x02R\tmaxAmount\x12\x17\n" +
+	"\asort_by\x18\b \x01(\tR\x06sortBy\"\xf7\x01\n" +
+	"\x13SearchOrdersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12*\n" +
+	"\x06filter\x18\x03 \x01(\v2\x12.order.OrderFilterR\x06filter\x12\x1b\n" +
+	"\x04page\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12&\n" +
+	"\tpage_size\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\n" +
+	"R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"with_total\x18\a \x01(\bR\twithTotal\"\xca\x01\n" +
+	"\x14SearchOrdersResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12$\n" +
+	"\x06orders\x18\x03 \x03(\v2\f.order.OrderR\x06orders\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vnext_cursor\x18\a \x01(\tR\n" +
+	"nextCursor\"\x92\x01\n" +
+	"\x17GetMerchantStatsRequest\x12(\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x13\n" +
+	"\x05top_n\x18\x04 \x01(\x05R\x04topN\";\n" +
+	"\vStatusCount\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"~\n" +
+	"\tDailyStat\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1f\n" +
+	"\vorder_count\x18\x02 \x01(\x03R\n" +
+	"orderCount\x12*\n" +
+	"\x11valid_order_count\x18\x03 \x01(\x03R\x0fvalidOrderCount\x12\x10\n" +
+	"\x03gmv\x18\x04 \x01(\x02R\x03gmv\"\x84\x01\n" +
+	"\fProductSales\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x02R\x06amount\"\xfa\x03\n" +
+	"\rMerchantStats\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x1f\n" +
+	"\vorder_count\x18\x04 \x01(\x03R\n" +
+	"orderCount\x12*\n" +
+	"\x11valid_order_count\x18\x05 \x01(\x03R\x0fvalidOrderCount\x127\n" +
+	"\rstatus_counts\x18\x06 \x03(\v2\x12.order.StatusCountR\fstatusCounts\x12\x10\n" +
+	"\x03gmv\x18\a \x01(\x02R\x03gmv\x12&\n" +
+	"\x0favg_order_value\x18\b \x01(\x02R\ravgOrderValue\x12\x1f\n" +
+	"\vcancel_rate\x18\t \x01(\x02R\n" +
+	"cancelRate\x12\x1f\n" +
+	"\vreject_rate\x18\n" +
+	" \x01(\x02R\n" +
+	"rejectRate\x12,\n" +
+	"\x12avg_accept_seconds\x18\v \x01(\x03R\x10avgAcceptSeconds\x12&\n" +
+	"\x05daily\x18\f \x03(\v2\x10.order.DailyStatR\x05daily\x126\n" +
+	"\ftop_products\x18\r \x03(\v2\x13.order.ProductSalesR\vtopProducts\"l\n" +
+	"\x18GetMerchantStatsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12*\n" +
+	"\x05stats\x18\x03 \x01(\v2\x14.order.MerchantStatsR\x05stats\"\xa5\x01\n" +
+	"\x1bExportMerchantOrdersRequest\x12(\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x18\n" +
+	"\acolumns\x18\x03 \x03(\tR\acolumns\x12*\n" +
+	"\x06filter\x18\x04 \x01(\v2\x12.order.OrderFilterR\x06filter\"\xc2\x01\n" +
+	"\x1cExportMerchantOrdersResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05chunk\x18\x05 \x01(\fR\x05chunk\x12\x12\n" +
+	"\x04done\x18\x06 \x01(\bR\x04done\x12\x12\n" +
+	"\x04rows\x18\a \x01(\x03R\x04rows\"\x89\x01\n" +
+	"\n" +
+	"ReviewItem\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\"\xca\x03\n" +
+	"\x06Review\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x03R\breviewId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x04 \x01(\tR\buserName\x12\x1f\n" +
+	"\vmerchant_id\x18\x05 \x01(\x03R\n" +
+	"merchantId\x12'\n" +
+	"\x0fmerchant_rating\x18\x06 \x01(\x05R\x0emerchantRating\x12\x18\n" +
+	"\acontent\x18\a \x01(\tR\acontent\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x19\n" +
+	"\brider_id\x18\t \x01(\x03R\ariderId\x12!\n" +
+	"\frider_rating\x18\n" +
+	" \x01(\x05R\vriderRating\x12\x1d\n" +
+	"\n" +
+	"rider_tags\x18\v \x03(\tR\triderTags\x12'\n" +
+	"\x05items\x18\f \x03(\v2\x11.order.ReviewItemR\x05items\x12\x14\n" +
+	"\x05reply\x18\r \x01(\tR\x05reply\x12\x1d\n" +
+	"\n" +
+	"reply_time\x18\x0e \x01(\tR\treplyTime\x12\x1f\n" +
+	"\vcreate_time\x18\x0f \x01(\tR\n" +
+	"createTime\"\x9d\x02\n" +
+	"\x13CreateReviewRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12'\n" +
+	"\x0fmerchant_rating\x18\x03 \x01(\x05R\x0emerchantRating\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12!\n" +
+	"\frider_rating\x18\x06 \x01(\x05R\vriderRating\x12\x1d\n" +
+	"\n" +
+	"rider_tags\x18\a \x03(\tR\triderTags\x12'\n" +
+	"\x05items\x18\b \x03(\v2\x11.order.ReviewItemR\x05items\"Y\n" +
+	"\x14CreateReviewResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x1b\n" +
+	"\treview_id\x18\x03 \x01(\x03R\breviewId\"\x84\x02\n" +
+	"\x12ListReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03R\tproductId\x12\x19\n" +
+	"\brider_id\x18\x04 \x01(\x03R\ariderId\x12\x1b\n" +
+	"\x04page\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12&\n" +
+	"\tpage_size\x18\x06 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\n" +
+	"R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"with_total\x18\b \x01(\bR\twithTotal\"\xcc\x01\n" +
+	"\x13ListReviewsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12'\n" +
+	"\areviews\x18\x03 \x03(\v2\r.order.ReviewR\areviews\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vnext_cursor\x18\a \x01(\tR\n" +
+	"nextCursor\"z\n" +
+	"\x12ReplyReviewRequest\x12$\n" +
+	"\treview_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\breviewId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12\x14\n" +
+	"\x05reply\x18\x03 \x01(\tR\x05reply2\x9d\n" +
+	"\n" +
 	"\fOrderService\x12D\n" +
 	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\x12K\n" +
 	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a\x15.order.CommonResponse\x12M\n" +
 	"\x0eListUserOrders\x12\x1c.order.ListUserOrdersRequest\x1a\x1d.order.ListUserOrdersResponse\x12Y\n" +
 	"\x12ListMerchantOrders\x12 .order.ListMerchantOrdersRequest\x1a!.order.ListMerchantOrdersResponse\x12?\n" +
 	"\fGetOrderByID\x12\x16.order.GetOrderRequest\x1a\x17.order.GetOrderResponse\x12?\n" +
-	"\vCancelOrder\x12\x19.order.CancelOrderRequest\x1a\x15.order.CommonResponseB#Z!./internal/order/proto;orderProtob\x06proto3"
+	"\vCancelOrder\x12\x19.order.CancelOrderRequest\x1a\x15.order.CommonResponse\x12S\n" +
+	"\x10RefundOrderItems\x12\x1e.order.RefundOrderItemsRequest\x1a\x1f.order.RefundOrderItemsResponse\x12G\n" +
+	"\fCreateCoupon\x12\x1a.order.CreateCouponRequest\x1a\x1b.order.CreateCouponResponse\x12D\n" +
+	"\vClaimCoupon\x12\x19.order.ClaimCouponRequest\x1a\x1a.order.ClaimCouponResponse\x12P\n" +
+	"\x0fListUserCoupons\x12\x1d.order.ListUserCouponsRequest\x1a\x1e.order.ListUserCouponsResponse\x12G\n" +
+	"\fPreviewOrder\x12\x1a.order.PreviewOrderRequest\x1a\x1b.order.PreviewOrderResponse\x12G\n" +
+	"\fSearchOrders\x12\x1a.order.SearchOrdersRequest\x1a\x1b.order.SearchOrdersResponse\x12S\n" +
+	"\x10GetMerchantStats\x12\x1e.order.GetMerchantStatsRequest\x1a\x1f.order.GetMerchantStatsResponse\x12a\n" +
+	"\x14ExportMerchantOrders\x12\".order.ExportMerchantOrdersRequest\x1a#.order.ExportMerchantOrdersResponse0\x01\x12G\n" +
+	"\fCreateReview\x12\x1a.order.CreateReviewRequest\x1a\x1b.order.CreateReviewResponse\x12D\n" +
+	"\vListReviews\x12\x19.order.ListReviewsRequest\x1a\x1a.order.ListReviewsResponse\x12?\n" +
+	"\vReplyReview\x12\x19.order.ReplyReviewRequest\x1a\x15.order.CommonResponseB#Z!./internal/order/proto;orderProtob\x06proto3"
 
 var (
 	file_order_proto_rawDescOnce sync.Once
@@ -1158,45 +4788,127 @@ func file_order_proto_rawDescGZIP() []byte {
 	return file_order_proto_rawDescData
 }
 
-var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_order_proto_goTypes = []any{
-	(*OrderItem)(nil),                  // 0: order.OrderItem
-	(*Order)(nil),                      // 1: order.Order
-	(*CommonResponse)(nil),             // 2: order.CommonResponse
-	(*CreateOrderRequest)(nil),         // 3: order.CreateOrderRequest
-	(*CreateOrderResponse)(nil),        // 4: order.CreateOrderResponse
-	(*UpdateOrderStatusRequest)(nil),   // 5: order.UpdateOrderStatusRequest
-	(*ListUserOrdersRequest)(nil),      // 6: order.ListUserOrdersRequest
-	(*ListMerchantOrdersRequest)(nil),  // 7: order.ListMerchantOrdersRequest
-	(*ListUserOrdersResponse)(nil),     // 8: order.ListUserOrdersResponse
-	(*ListMerchantOrdersResponse)(nil), // 9: order.ListMerchantOrdersResponse
-	(*GetOrderRequest)(nil),            // 10: order.GetOrderRequest
-	(*GetOrderResponse)(nil),           // 11: order.GetOrderResponse
-	(*CancelOrderRequest)(nil),         // 12: order.CancelOrderRequest
+	(*OrderItem)(nil),                    // 0: order.OrderItem
+	(*OrderItemOption)(nil),              // 1: order.OrderItemOption
+	(*Order)(nil),                        // 2: order.Order
+	(*FeeDetail)(nil),                    // 3: order.FeeDetail
+	(*OrderDiscount)(nil),                // 4: order.OrderDiscount
+	(*CommonResponse)(nil),               // 5: order.CommonResponse
+	(*CreateOrderRequest)(nil),           // 6: order.CreateOrderRequest
+	(*CreateOrderResponse)(nil),          // 7: order.CreateOrderResponse
+	(*UpdateOrderStatusRequest)(nil),     // 8: order.UpdateOrderStatusRequest
+	(*ListUserOrdersRequest)(nil),        // 9: order.ListUserOrdersRequest
+	(*ListMerchantOrdersRequest)(nil),    // 10: order.ListMerchantOrdersRequest
+	(*ListUserOrdersResponse)(nil),       // 11: order.ListUserOrdersResponse
+	(*ListMerchantOrdersResponse)(nil),   // 12: order.ListMerchantOrdersResponse
+	(*GetOrderRequest)(nil),              // 13: order.GetOrderRequest
+	(*GetOrderResponse)(nil),             // 14: order.GetOrderResponse
+	(*CancelOrderRequest)(nil),           // 15: order.CancelOrderRequest
+	(*RefundItem)(nil),                   // 16: order.RefundItem
+	(*RefundOrderItemsRequest)(nil),      // 17: order.RefundOrderItemsRequest
+	(*RefundOrderItemsResponse)(nil),     // 18: order.RefundOrderItemsResponse
+	(*Coupon)(nil),                       // 19: order.Coupon
+	(*UserCoupon)(nil),                   // 20: order.UserCoupon
+	(*CreateCouponRequest)(nil),          // 21: order.CreateCouponRequest
+	(*CreateCouponResponse)(nil),         // 22: order.CreateCouponResponse
+	(*ClaimCouponRequest)(nil),           // 23: order.ClaimCouponRequest
+	(*ClaimCouponResponse)(nil),          // 24: order.ClaimCouponResponse
+	(*ListUserCouponsRequest)(nil),       // 25: order.ListUserCouponsRequest
+	(*ListUserCouponsResponse)(nil),      // 26: order.ListUserCouponsResponse
+	(*PreviewItem)(nil),                  // 27: order.PreviewItem
+	(*PreviewOrderRequest)(nil),          // 28: order.PreviewOrderRequest
+	(*PreviewItemResult)(nil),            // 29: order.PreviewItemResult
+	(*PreviewOrderResponse)(nil),         // 30: order.PreviewOrderResponse
+	(*OrderFilter)(nil),                  // 31: order.OrderFilter
+	(*SearchOrdersRequest)(nil),          // 32: order.SearchOrdersRequest
+	(*SearchOrdersResponse)(nil),         // 33: order.SearchOrdersResponse
+	(*GetMerchantStatsRequest)(nil),      // 34: order.GetMerchantStatsRequest
+	(*StatusCount)(nil),                  // 35: order.StatusCount
+	(*DailyStat)(nil),                    // 36: order.DailyStat
+	(*ProductSales)(nil),                 // 37: order.ProductSales
+	(*MerchantStats)(nil),                // 38: order.MerchantStats
+	(*GetMerchantStatsResponse)(nil),     // 39: order.GetMerchantStatsResponse
+	(*ExportMerchantOrdersRequest)(nil),  // 40: order.ExportMerchantOrdersRequest
+	(*ExportMerchantOrdersResponse)(nil), // 41: order.ExportMerchantOrdersResponse
+	(*ReviewItem)(nil),                   // 42: order.ReviewItem
+	(*Review)(nil),                       // 43: order.Review
+	(*CreateReviewRequest)(nil),          // 44: order.CreateReviewRequest
+	(*CreateReviewResponse)(nil),         // 45: order.CreateReviewResponse
+	(*ListReviewsRequest)(nil),           // 46: order.ListReviewsRequest
+	(*ListReviewsResponse)(nil),          // 47: order.ListReviewsResponse
+	(*ReplyReviewRequest)(nil),           // 48: order.ReplyReviewRequest
 }
 var file_order_proto_depIdxs = []int32{
-	0,  // 0: order.Order.items:type_name -> order.OrderItem
-	0,  // 1: order.CreateOrderRequest.items:type_name -> order.OrderItem
-	1,  // 2: order.ListUserOrdersResponse.orders:type_name -> order.Order
-	1,  // 3: order.ListMerchantOrdersResponse.orders:type_name -> order.Order
-	1,  // 4: order.GetOrderResponse.order:type_name -> order.Order
-	3,  // 5: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	5,  // 6: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
-	6,  // 7: order.OrderService.ListUserOrders:input_type -> order.ListUserOrdersRequest
-	7,  // 8: order.OrderService.ListMerchantOrders:input_type -> order.ListMerchantOrdersRequest
-	10, // 9: order.OrderService.GetOrderByID:input_type -> order.GetOrderRequest
-	12, // 10: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
-	4,  // 11: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
-	2,  // 12: order.OrderService.UpdateOrderStatus:output_type -> order.CommonResponse
-	8,  // 13: order.OrderService.ListUserOrders:output_type -> order.ListUserOrdersResponse
-	9,  // 14: order.OrderService.ListMerchantOrders:output_type -> order.ListMerchantOrdersResponse
-	11, // 15: order.OrderService.GetOrderByID:output_type -> order.GetOrderResponse
-	2,  // 16: order.OrderService.CancelOrder:output_type -> order.CommonResponse
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	1,  // 0: order.OrderItem.options:type_name -> order.OrderItemOption
+	0,  // 1: order.Order.items:type_name -> order.OrderItem
+	4,  // 2: order.Order.discounts:type_name -> order.OrderDiscount
+	0,  // 3: order.CreateOrderRequest.items:type_name -> order.OrderItem
+	3,  // 4: order.CreateOrderResponse.fee:type_name -> order.FeeDetail
+	31, // 5: order.ListMerchantOrdersRequest.filter:type_name -> order.OrderFilter
+	2,  // 6: order.ListUserOrdersResponse.orders:type_name -> order.Order
+	2,  // 7: order.ListMerchantOrdersResponse.orders:type_name -> order.Order
+	2,  // 8: order.GetOrderResponse.order:type_name -> order.Order
+	16, // 9: order.RefundOrderItemsRequest.items:type_name -> order.RefundItem
+	19, // 10: order.UserCoupon.coupon:type_name -> order.Coupon
+	19, // 11: order.CreateCouponResponse.coupon:type_name -> order.Coupon
+	20, // 12: order.ClaimCouponResponse.user_coupon:type_name -> order.UserCoupon
+	20, // 13: order.ListUserCouponsResponse.user_coupons:type_name -> order.UserCoupon
+	27, // 14: order.PreviewOrderRequest.items:type_name -> order.PreviewItem
+	1,  // 15: order.PreviewItemResult.options:type_name -> order.OrderItemOption
+	29, // 16: order.PreviewOrderResponse.items:type_name -> order.PreviewItemResult
+	3,  // 17: order.PreviewOrderResponse.fee:type_name -> order.FeeDetail
+	4,  // 18: order.PreviewOrderResponse.discounts:type_name -> order.OrderDiscount
+	31, // 19: order.SearchOrdersRequest.filter:type_name -> order.OrderFilter
+	2,  // 20: order.SearchOrdersResponse.orders:type_name -> order.Order
+	35, // 21: order.MerchantStats.status_counts:type_name -> order.StatusCount
+	36, // 22: order.MerchantStats.daily:type_name -> order.DailyStat
+	37, // 23: order.MerchantStats.top_products:type_name -> order.ProductSales
+	38, // 24: order.GetMerchantStatsResponse.stats:type_name -> order.MerchantStats
+	31, // 25: order.ExportMerchantOrdersRequest.filter:type_name -> order.OrderFilter
+	42, // 26: order.Review.items:type_name -> order.ReviewItem
+	42, // 27: order.CreateReviewRequest.items:type_name -> order.ReviewItem
+	43, // 28: order.ListReviewsResponse.reviews:type_name -> order.Review
+	6,  // 29: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	8,  // 30: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
+	9,  // 31: order.OrderService.ListUserOrders:input_type -> order.ListUserOrdersRequest
+	10, // 32: order.OrderService.ListMerchantOrders:input_type -> order.ListMerchantOrdersRequest
+	13, // 33: order.OrderService.GetOrderByID:input_type -> order.GetOrderRequest
+	15, // 34: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
+	17, // 35: order.OrderService.RefundOrderItems:input_type -> order.RefundOrderItemsRequest
+	21, // 36: order.OrderService.CreateCoupon:input_type -> order.CreateCouponRequest
+	23, // 37: order.OrderService.ClaimCoupon:input_type -> order.ClaimCouponRequest
+	25, // 38: order.OrderService.ListUserCoupons:input_type -> order.ListUserCouponsRequest
+	28, // 39: order.OrderService.PreviewOrder:input_type -> order.PreviewOrderRequest
+	32, // 40: order.OrderService.SearchOrders:input_type -> order.SearchOrdersRequest
+	34, // 41: order.OrderService.GetMerchantStats:input_type -> order.GetMerchantStatsRequest
+	40, // 42: order.OrderService.ExportMerchantOrders:input_type -> order.ExportMerchantOrdersRequest
+	44, // 43: order.OrderService.CreateReview:input_type -> order.CreateReviewRequest
+	46, // 44: order.OrderService.ListReviews:input_type -> order.ListReviewsRequest
+	48, // 45: order.OrderService.ReplyReview:input_type -> order.ReplyReviewRequest
+	7,  // 46: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
+	5,  // 47: order.OrderService.UpdateOrderStatus:output_type -> order.CommonResponse
+	11, // 48: order.OrderService.ListUserOrders:output_type -> order.ListUserOrdersResponse
+	12, // 49: order.OrderService.ListMerchantOrders:output_type -> order.ListMerchantOrdersResponse
+	14, // 50: order.OrderService.GetOrderByID:output_type -> order.GetOrderResponse
+	5,  // 51: order.OrderService.CancelOrder:output_type -> order.CommonResponse
+	18, // 52: order.OrderService.RefundOrderItems:output_type -> order.RefundOrderItemsResponse
+	22, // 53: order.OrderService.CreateCoupon:output_type -> order.CreateCouponResponse
+	24, // 54: order.OrderService.ClaimCoupon:output_type -> order.ClaimCouponResponse
+	26, // 55: order.OrderService.ListUserCoupons:output_type -> order.ListUserCouponsResponse
+	30, // 56: order.OrderService.PreviewOrder:output_type -> order.PreviewOrderResponse
+	33, // 57: order.OrderService.SearchOrders:output_type -> order.SearchOrdersResponse
+	39, // 58: order.OrderService.GetMerchantStats:output_type -> order.GetMerchantStatsResponse
+	41, // 59: order.OrderService.ExportMerchantOrders:output_type -> order.ExportMerchantOrdersResponse
+	45, // 60: order.OrderService.CreateReview:output_type -> order.CreateReviewResponse
+	47, // 61: order.OrderService.ListReviews:output_type -> order.ListReviewsResponse
+	5,  // 62: order.OrderService.ReplyReview:output_type -> order.CommonResponse
+	46, // [46:63] is the sub-list for method output_type
+	29, // [29:46] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_order_proto_init() }
@@ -1210,7 +4922,7 @@ func file_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_proto_rawDesc), len(file_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
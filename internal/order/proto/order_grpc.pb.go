@@ -19,12 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_CreateOrder_FullMethodName        = "/order.OrderService/CreateOrder"
-	OrderService_UpdateOrderStatus_FullMethodName  = "/order.OrderService/UpdateOrderStatus"
-	OrderService_ListUserOrders_FullMethodName     = "/order.OrderService/ListUserOrders"
-	OrderService_ListMerchantOrders_FullMethodName = "/order.OrderService/ListMerchantOrders"
-	OrderService_GetOrderByID_FullMethodName       = "/order.OrderService/GetOrderByID"
-	OrderService_CancelOrder_FullMethodName        = "/order.OrderService/CancelOrder"
+	OrderService_CreateOrder_FullMethodName          = "/order.OrderService/CreateOrder"
+	OrderService_UpdateOrderStatus_FullMethodName    = "/order.OrderService/UpdateOrderStatus"
+	OrderService_ListUserOrders_FullMethodName       = "/order.OrderService/ListUserOrders"
+	OrderService_ListMerchantOrders_FullMethodName   = "/order.OrderService/ListMerchantOrders"
+	OrderService_GetOrderByID_FullMethodName         = "/order.OrderService/GetOrderByID"
+	OrderService_CancelOrder_FullMethodName          = "/order.OrderService/CancelOrder"
+	OrderService_RefundOrderItems_FullMethodName     = "/order.OrderService/RefundOrderItems"
+	OrderService_CreateCoupon_FullMethodName         = "/order.OrderService/CreateCoupon"
+	OrderService_ClaimCoupon_FullMethodName          = "/order.OrderService/ClaimCoupon"
+	OrderService_ListUserCoupons_FullMethodName      = "/order.OrderService/ListUserCoupons"
+	OrderService_PreviewOrder_FullMethodName         = "/order.OrderService/PreviewOrder"
+	OrderService_SearchOrders_FullMethodName         = "/order.OrderService/SearchOrders"
+	OrderService_GetMerchantStats_FullMethodName     = "/order.OrderService/GetMerchantStats"
+	OrderService_ExportMerchantOrders_FullMethodName = "/order.OrderService/ExportMerchantOrders"
+	OrderService_CreateReview_FullMethodName         = "/order.OrderService/CreateReview"
+	OrderService_ListReviews_FullMethodName          = "/order.OrderService/ListReviews"
+	OrderService_ReplyReview_FullMethodName          = "/order.OrderService/ReplyReview"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -43,6 +54,28 @@ type OrderServiceClient interface {
 	GetOrderByID(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error)
 	// 取消订单（用户/系统）
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// 缺货部分退款（商家，下单7天内）
+	RefundOrderItems(ctx context.Context, in *RefundOrderItemsRequest, opts ...grpc.CallOption) (*RefundOrderItemsResponse, error)
+	// 创建优惠券（商家/平台运营）
+	CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*CreateCouponResponse, error)
+	// 领取优惠券（用户）
+	ClaimCoupon(ctx context.Context, in *ClaimCouponRequest, opts ...grpc.CallOption) (*ClaimCouponResponse, error)
+	// 查询用户优惠券
+	ListUserCoupons(ctx context.Context, in *ListUserCouponsRequest, opts ...grpc.CallOption) (*ListUserCouponsResponse, error)
+	// 订单预览（试算价格，不扣库存、不落库）
+	PreviewOrder(ctx context.Context, in *PreviewOrderRequest, opts ...grpc.CallOption) (*PreviewOrderResponse, error)
+	// 订单检索（平台客服）
+	SearchOrders(ctx context.Context, in *SearchOrdersRequest, opts ...grpc.CallOption) (*SearchOrdersResponse, error)
+	// 商家经营统计（商家/平台运营）
+	GetMerchantStats(ctx context.Context, in *GetMerchantStatsRequest, opts ...grpc.CallOption) (*GetMerchantStatsResponse, error)
+	// 导出商家订单（服务端流式，按块返回CSV/XLSX文件内容）
+	ExportMerchantOrders(ctx context.Context, in *ExportMerchantOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportMerchantOrdersResponse], error)
+	// 评价订单（已完成订单，每单一次，可评价商家、骑手及商品）
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewResponse, error)
+	// 查询评价（按用户/商家/商品/骑手）
+	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	// 商家回复评价
+	ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...grpc.CallOption) (*CommonResponse, error)
 }
 
 type orderServiceClient struct {
@@ -113,6 +146,125 @@ func (c *orderServiceClient) CancelOrder(ctx context.Context, in *CancelOrderReq
 	return out, nil
 }
 
+func (c *orderServiceClient) RefundOrderItems(ctx context.Context, in *RefundOrderItemsRequest, opts ...grpc.CallOption) (*RefundOrderItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundOrderItemsResponse)
+	err := c.cc.Invoke(ctx, OrderService_RefundOrderItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*CreateCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCouponResponse)
+	err := c.cc.Invoke(ctx, OrderService_CreateCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ClaimCoupon(ctx context.Context, in *ClaimCouponRequest, opts ...grpc.CallOption) (*ClaimCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimCouponResponse)
+	err := c.cc.Invoke(ctx, OrderService_ClaimCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListUserCoupons(ctx context.Context, in *ListUserCouponsRequest, opts ...grpc.CallOption) (*ListUserCouponsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserCouponsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListUserCoupons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) PreviewOrder(ctx context.Context, in *PreviewOrderRequest, opts ...grpc.CallOption) (*PreviewOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_PreviewOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SearchOrders(ctx context.Context, in *SearchOrdersRequest, opts ...grpc.CallOption) (*SearchOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_SearchOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetMerchantStats(ctx context.Context, in *GetMerchantStatsRequest, opts ...grpc.CallOption) (*GetMerchantStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMerchantStatsResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetMerchantStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ExportMerchantOrders(ctx context.Context, in *ExportMerchantOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportMerchantOrdersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrderService_ServiceDesc.Streams[0], OrderService_ExportMerchantOrders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportMerchantOrdersRequest, ExportMerchantOrdersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_ExportMerchantOrdersClient = grpc.ServerStreamingClient[ExportMerchantOrdersResponse]
+
+func (c *orderServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReviewResponse)
+	err := c.cc.Invoke(ctx, OrderService_CreateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, OrderService_ReplyReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -129,6 +281,28 @@ type OrderServiceServer interface {
 	GetOrderByID(context.Context, *GetOrderRequest) (*GetOrderResponse, error)
 	// 取消订单（用户/系统）
 	CancelOrder(context.Context, *CancelOrderRequest) (*CommonResponse, error)
+	// 缺货部分退款（商家，下单7天内）
+	RefundOrderItems(context.Context, *RefundOrderItemsRequest) (*RefundOrderItemsResponse, error)
+	// 创建优惠券（商家/平台运营）
+	CreateCoupon(context.Context, *CreateCouponRequest) (*CreateCouponResponse, error)
+	// 领取优惠券（用户）
+	ClaimCoupon(context.Context, *ClaimCouponRequest) (*ClaimCouponResponse, error)
+	// 查询用户优惠券
+	ListUserCoupons(context.Context, *ListUserCouponsRequest) (*ListUserCouponsResponse, error)
+	// 订单预览（试算价格，不扣库存、不落库）
+	PreviewOrder(context.Context, *PreviewOrderRequest) (*PreviewOrderResponse, error)
+	// 订单检索（平台客服）
+	SearchOrders(context.Context, *SearchOrdersRequest) (*SearchOrdersResponse, error)
+	// 商家经营统计（商家/平台运营）
+	GetMerchantStats(context.Context, *GetMerchantStatsRequest) (*GetMerchantStatsResponse, error)
+	// 导出商家订单（服务端流式，按块返回CSV/XLSX文件内容）
+	ExportMerchantOrders(*ExportMerchantOrdersRequest, grpc.ServerStreamingServer[ExportMerchantOrdersResponse]) error
+	// 评价订单（已完成订单，每单一次，可评价商家、骑手及商品）
+	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewResponse, error)
+	// 查询评价（按用户/商家/商品/骑手）
+	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
+	// 商家回复评价
+	ReplyReview(context.Context, *ReplyReviewRequest) (*CommonResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -157,6 +331,39 @@ func (UnimplementedOrderServiceServer) GetOrderByID(context.Context, *GetOrderRe
 func (UnimplementedOrderServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*CommonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) RefundOrderItems(context.Context, *RefundOrderItemsRequest) (*RefundOrderItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundOrderItems not implemented")
+}
+func (UnimplementedOrderServiceServer) CreateCoupon(context.Context, *CreateCouponRequest) (*CreateCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCoupon not implemented")
+}
+func (UnimplementedOrderServiceServer) ClaimCoupon(context.Context, *ClaimCouponRequest) (*ClaimCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimCoupon not implemented")
+}
+func (UnimplementedOrderServiceServer) ListUserCoupons(context.Context, *ListUserCouponsRequest) (*ListUserCouponsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserCoupons not implemented")
+}
+func (UnimplementedOrderServiceServer) PreviewOrder(context.Context, *PreviewOrderRequest) (*PreviewOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) SearchOrders(context.Context, *SearchOrdersRequest) (*SearchOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) GetMerchantStats(context.Context, *GetMerchantStatsRequest) (*GetMerchantStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantStats not implemented")
+}
+func (UnimplementedOrderServiceServer) ExportMerchantOrders(*ExportMerchantOrdersRequest, grpc.ServerStreamingServer[ExportMerchantOrdersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMerchantOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedOrderServiceServer) ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviews not implemented")
+}
+func (UnimplementedOrderServiceServer) ReplyReview(context.Context, *ReplyReviewRequest) (*CommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyReview not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -286,6 +493,197 @@ func _OrderService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_RefundOrderItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundOrderItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RefundOrderItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RefundOrderItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RefundOrderItems(ctx, req.(*RefundOrderItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateCoupon(ctx, req.(*CreateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ClaimCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ClaimCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ClaimCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ClaimCoupon(ctx, req.(*ClaimCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListUserCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListUserCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListUserCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListUserCoupons(ctx, req.(*ListUserCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_PreviewOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PreviewOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_PreviewOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PreviewOrder(ctx, req.(*PreviewOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SearchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SearchOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SearchOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SearchOrders(ctx, req.(*SearchOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetMerchantStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMerchantStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetMerchantStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetMerchantStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetMerchantStats(ctx, req.(*GetMerchantStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ExportMerchantOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMerchantOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServiceServer).ExportMerchantOrders(m, &grpc.GenericServerStream[ExportMerchantOrdersRequest, ExportMerchantOrdersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_ExportMerchantOrdersServer = grpc.ServerStreamingServer[ExportMerchantOrdersResponse]
+
+func _OrderService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateReview(ctx, req.(*CreateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListReviews(ctx, req.(*ListReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ReplyReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ReplyReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ReplyReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ReplyReview(ctx, req.(*ReplyReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -317,7 +715,53 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _OrderService_CancelOrder_Handler,
 		},
+		{
+			MethodName: "RefundOrderItems",
+			Handler:    _OrderService_RefundOrderItems_Handler,
+		},
+		{
+			MethodName: "CreateCoupon",
+			Handler:    _OrderService_CreateCoupon_Handler,
+		},
+		{
+			MethodName: "ClaimCoupon",
+			Handler:    _OrderService_ClaimCoupon_Handler,
+		},
+		{
+			MethodName: "ListUserCoupons",
+			Handler:    _OrderService_ListUserCoupons_Handler,
+		},
+		{
+			MethodName: "PreviewOrder",
+			Handler:    _OrderService_PreviewOrder_Handler,
+		},
+		{
+			MethodName: "SearchOrders",
+			Handler:    _OrderService_SearchOrders_Handler,
+		},
+		{
+			MethodName: "GetMerchantStats",
+			Handler:    _OrderService_GetMerchantStats_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _OrderService_CreateReview_Handler,
+		},
+		{
+			MethodName: "ListReviews",
+			Handler:    _OrderService_ListReviews_Handler,
+		},
+		{
+			MethodName: "ReplyReview",
+			Handler:    _OrderService_ReplyReview_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportMerchantOrders",
+			Handler:       _OrderService_ExportMerchantOrders_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "order.proto",
 }
@@ -0,0 +1,164 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// CouponRepo 优惠券数据访问接口
+type CouponRepo interface {
+	CreateCoupon(ctx context.Context, coupon *model.Coupon) error
+	GetCouponByID(ctx context.Context, couponID int64) (*model.Coupon, error)
+	GetCouponsByIDs(ctx context.Context, couponIDs []int64) ([]*model.Coupon, error)
+	ClaimCoupon(ctx context.Context, couponID, userID int64) (*model.UserCoupon, error) // 事务扣减发放量+创建用户券
+	ListUserCoupons(ctx context.Context, userID int64, status string) ([]*model.UserCoupon, error)
+	GetUserCoupons(ctx context.Context, userID int64, userCouponIDs []int64) ([]*model.UserCoupon, error)
+	ReturnCoupons(ctx context.Context, orderID int64) (int64, error) // 退还订单使用的优惠券，返回退还数量
+	GetOrderDiscounts(ctx context.Context, orderID int64) ([]*model.OrderDiscount, error)
+}
+
+// couponRepo 实现
+type couponRepo struct{}
+
+// NewCouponRepo 创建实例
+func NewCouponRepo() CouponRepo {
+	return &couponRepo{}
+}
+
+// CreateCoupon 创建优惠券模板
+func (r *couponRepo) CreateCoupon(ctx context.Context, coupon *model.Coupon) error {
+	if err := db.Mysql.WithContext(ctx).Create(coupon).Error; err != nil {
+		zap.L().Error("创建优惠券失败", zap.Any("coupon", coupon), zap.Error(err))
+		return utils.NewDBError("创建优惠券失败：" + err.Error())
+	}
+	return nil
+}
+
+// GetCouponByID 查询优惠券模板
+func (r *couponRepo) GetCouponByID(ctx context.Context, couponID int64) (*model.Coupon, error) {
+	var coupon model.Coupon
+	tx := db.Mysql.WithContext(ctx).Where("coupon_id = ?", couponID).First(&coupon)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return nil, utils.NewBizError("优惠券不存在")
+		}
+		zap.L().Error("查询优惠券失败", zap.Int64("coupon_id", couponID), zap.Error(tx.Error))
+		return nil, utils.NewDBError("查询优惠券失败：" + tx.Error.Error())
+	}
+	return &coupon, nil
+}
+
+// GetCouponsByIDs 批量查询优惠券模板
+func (r *couponRepo) GetCouponsByIDs(ctx context.Context, couponIDs []int64) ([]*model.Coupon, error) {
+	var coupons []*model.Coupon
+	if len(couponIDs) == 0 {
+		return coupons, nil
+	}
+	if err := db.Mysql.WithContext(ctx).Where("coupon_id IN ?", couponIDs).Find(&coupons).Error; err != nil {
+		zap.L().Error("批量查询优惠券失败", zap.Int64s("coupon_ids", couponIDs), zap.Error(err))
+		return nil, utils.NewDBError("查询优惠券失败：" + err.Error())
+	}
+	return coupons, nil
+}
+
+// ClaimCoupon 领取优惠券（事务：校验未领取→扣减剩余发放量→创建用户券）
+func (r *couponRepo) ClaimCoupon(ctx context.Context, couponID, userID int64) (*model.UserCoupon, error) {
+	userCoupon := &model.UserCoupon{
+		CouponID: couponID,
+		UserID:   userID,
+		Status:   "未使用",
+	}
+	err := db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. 每个用户限领一次
+		var count int64
+		if err := tx.Model(&model.UserCoupon{}).Where("coupon_id = ? AND user_id = ?", couponID, userID).Count(&count).Error; err != nil {
+			zap.L().Error("查询用户领券记录失败", zap.Int64("coupon_id", couponID), zap.Int64("user_id", userID), zap.Error(err))
+			return utils.NewDBError("领取优惠券失败：" + err.Error())
+		}
+		if count > 0 {
+			return utils.NewBizError("已领取过该优惠券")
+		}
+
+		// 2. 扣减发放量（条件更新：未领完且未过期）
+		res := tx.Model(&model.Coupon{}).
+			Where("coupon_id = ? AND claimed < total AND end_time > ?", couponID, time.Now()).
+			Update("claimed", gorm.Expr("claimed + 1"))
+		if res.Error != nil {
+			zap.L().Error("扣减优惠券发放量失败", zap.Int64("coupon_id", couponID), zap.Error(res.Error))
+			return utils.NewDBError("领取优惠券失败：" + res.Error.Error())
+		}
+		if res.RowsAffected == 0 {
+			return utils.NewBizError("优惠券已领完或已过期")
+		}
+
+		// 3. 创建用户券（唯一索引兜底并发重复领取）
+		if err := tx.Create(userCoupon).Error; err != nil {
+			zap.L().Error("创建用户优惠券失败", zap.Int64("coupon_id", couponID), zap.Int64("user_id", userID), zap.Error(err))
+			return utils.NewDBError("领取优惠券失败：" + err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return userCoupon, nil
+}
+
+// ListUserCoupons 查询用户优惠券
+func (r *couponRepo) ListUserCoupons(ctx context.Context, userID int64, status string) ([]*model.UserCoupon, error) {
+	var userCoupons []*model.UserCoupon
+	query := db.Mysql.WithContext(ctx).Where("user_id = ?", userID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Order("create_time DESC").Find(&userCoupons).Error; err != nil {
+		zap.L().Error("查询用户优惠券失败", zap.Int64("user_id", userID), zap.Error(err))
+		return nil, utils.NewDBError("查询优惠券失败：" + err.Error())
+	}
+	return userCoupons, nil
+}
+
+// GetUserCoupons 按ID批量查询用户优惠券（仅返回属于该用户的）
+func (r *couponRepo) GetUserCoupons(ctx context.Context, userID int64, userCouponIDs []int64) ([]*model.UserCoupon, error) {
+	var userCoupons []*model.UserCoupon
+	if err := db.Mysql.WithContext(ctx).
+		Where("user_id = ? AND user_coupon_id IN ?", userID, userCouponIDs).
+		Find(&userCoupons).Error; err != nil {
+		zap.L().Error("批量查询用户优惠券失败", zap.Int64("user_id", userID), zap.Int64s("user_coupon_ids", userCouponIDs), zap.Error(err))
+		return nil, utils.NewDBError("查询优惠券失败：" + err.Error())
+	}
+	return userCoupons, nil
+}
+
+// ReturnCoupons 退还订单使用的优惠券（条件更新保证幂等）
+func (r *couponRepo) ReturnCoupons(ctx context.Context, orderID int64) (int64, error) {
+	tx := db.Mysql.WithContext(ctx).Model(&model.UserCoupon{}).
+		Where("order_id = ? AND status = ?", orderID, "已使用").
+		Updates(map[string]interface{}{
+			"status":    "未使用",
+			"order_id":  0,
+			"used_time": nil,
+		})
+	if tx.Error != nil {
+		zap.L().Error("退还订单优惠券失败", zap.Int64("order_id", orderID), zap.Error(tx.Error))
+		return 0, utils.NewDBError("退还优惠券失败：" + tx.Error.Error())
+	}
+	return tx.RowsAffected, nil
+}
+
+// GetOrderDiscounts 查询订单优惠明细
+func (r *couponRepo) GetOrderDiscounts(ctx context.Context, orderID int64) ([]*model.OrderDiscount, error) {
+	var discounts []*model.OrderDiscount
+	if err := db.Mysql.WithContext(ctx).Where("order_id = ?", orderID).Find(&discounts).Error; err != nil {
+		zap.L().Error("查询订单优惠明细失败", zap.Int64("order_id", orderID), zap.Error(err))
+		return nil, utils.NewDBError("查询订单优惠明细失败：" + err.Error())
+	}
+	return discounts, nil
+}
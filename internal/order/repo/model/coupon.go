@@ -0,0 +1,65 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Coupon 优惠券模板表（满减/折扣/商家代金券）
+type Coupon struct {
+	CouponID    int64          `gorm:"column:coupon_id;primaryKey;autoIncrement" json:"coupon_id"`
+	Name        string         `gorm:"column:name;not null;size:64;comment:'优惠券名称'" json:"name"`
+	Type        string         `gorm:"column:type;not null;size:16;comment:'类型：满减/折扣/代金券'" json:"type"`
+	MerchantID  int64          `gorm:"column:merchant_id;not null;default:0;index;comment:'适用商家ID（0为平台券）'" json:"merchant_id"`
+	Threshold   float64        `gorm:"column:threshold;not null;default:0;type:decimal(10,2);comment:'使用门槛（商品金额）'" json:"threshold"`
+	Amount      float64        `gorm:"column:amount;not null;default:0;type:decimal(10,2);comment:'减免金额（满减/代金券）'" json:"amount"`
+	Rate        float64        `gorm:"column:rate;not null;default:0;type:decimal(4,2);comment:'折扣率（折扣券，如0.85）'" json:"rate"`
+	MaxDiscount float64        `gorm:"column:max_discount;not null;default:0;type:decimal(10,2);comment:'最高优惠金额（折扣券，0不封顶）'" json:"max_discount"`
+	Total       int32          `gorm:"column:total;not null;comment:'发放总量'" json:"total"`
+	Claimed     int32          `gorm:"column:claimed;not null;default:0;comment:'已领取数量'" json:"claimed"`
+	StartTime   time.Time      `gorm:"column:start_time;not null;comment:'生效时间'" json:"start_time"`
+	EndTime     time.Time      `gorm:"column:end_time;not null;comment:'失效时间'" json:"end_time"`
+	CreateTime  time.Time      `gorm:"column:create_time;autoCreateTime;comment:'创建时间'" json:"create_time"`
+	UpdateTime  time.Time      `gorm:"column:update_time;autoUpdateTime;comment:'更新时间'" json:"update_time"`
+	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at;index;comment:'软删除时间'" json:"-"`
+}
+
+// TableName 表名
+func (c *Coupon) TableName() string {
+	return "t_coupon"
+}
+
+// UserCoupon 用户优惠券表（每个用户每张券仅可领取一次、使用一次）
+type UserCoupon struct {
+	UserCouponID int64      `gorm:"column:user_coupon_id;primaryKey;autoIncrement" json:"user_coupon_id"`
+	CouponID     int64      `gorm:"column:coupon_id;not null;uniqueIndex:uk_coupon_user;comment:'优惠券ID'" json:"coupon_id"`
+	UserID       int64      `gorm:"column:user_id;not null;uniqueIndex:uk_coupon_user;index;comment:'用户ID'" json:"user_id"`
+	Status       string     `gorm:"column:status;not null;size:16;default:'未使用';comment:'状态：未使用/已使用'" json:"status"`
+	OrderID      int64      `gorm:"column:order_id;not null;default:0;index;comment:'使用的订单ID'" json:"order_id"`
+	UsedTime     *time.Time `gorm:"column:used_time;comment:'使用时间'" json:"used_time"`
+	CreateTime   time.Time  `gorm:"column:create_time;autoCreateTime;comment:'领取时间'" json:"create_time"`
+	UpdateTime   time.Time  `gorm:"column:update_time;autoUpdateTime;comment:'更新时间'" json:"update_time"`
+}
+
+// TableName 表名
+func (uc *UserCoupon) TableName() string {
+	return "t_user_coupon"
+}
+
+// OrderDiscount 订单优惠明细表
+type OrderDiscount struct {
+	DiscountID   int64     `gorm:"column:discount_id;primaryKey;autoIncrement" json:"discount_id"`
+	OrderID      int64     `gorm:"column:order_id;not null;index;comment:'订单ID'" json:"order_id"`
+	UserCouponID int64     `gorm:"column:user_coupon_id;not null;comment:'用户优惠券ID'" json:"user_coupon_id"`
+	CouponID     int64     `gorm:"column:coupon_id;not null;comment:'优惠券ID'" json:"coupon_id"`
+	CouponName   string    `gorm:"column:coupon_name;not null;size:64;comment:'优惠券名称'" json:"coupon_name"`
+	Type         string    `gorm:"column:type;not null;size:16;comment:'优惠类型'" json:"type"`
+	Amount       float64   `gorm:"column:amount;not null;type:decimal(10,2);comment:'优惠金额'" json:"amount"`
+	CreateTime   time.Time `gorm:"column:create_time;autoCreateTime;comment:'创建时间'" json:"create_time"`
+}
+
+// TableName 表名
+func (od *OrderDiscount) TableName() string {
+	return "t_order_discount"
+}
@@ -11,17 +11,37 @@ import (
 type Order struct {
 	OrderID            int64          `gorm:"column:order_id;primaryKey;autoIncrement" json:"order_id"`
 	OrderNo            string         `gorm:"column:order_no;not null;uniqueIndex;size:64;comment:'订单编号'" json:"order_no"`
-	UserID             int64          `gorm:"column:user_id;not null;index;comment:'用户ID'" json:"user_id"`
-	UserName           string         `gorm:"column:user_name;not null;size:64;comment:'用户名'" json:"user_name"`
-	UserPhone          string         `gorm:"column:user_phone;not null;size:11;comment:'用户电话'" json:"user_phone"`
-	MerchantID         int64          `gorm:"column:merchant_id;not null;index;comment:'商家ID'" json:"merchant_id"`
+	UserID             int64          `gorm:"column:user_id;not null;index:idx_order_user_time,priority:1;comment:'用户ID'" json:"user_id"`
+	UserName           string         `gorm:"column:user_name;not null;size:64;comment:'收货人'" json:"user_name"`
+	UserPhone          string         `gorm:"column:user_phone;not null;size:11;index:idx_order_user_phone;comment:'收货人电话'" json:"user_phone"`
+	MerchantID         int64          `gorm:"column:merchant_id;not null;index:idx_order_merchant_time,priority:1;comment:'商家ID'" json:"merchant_id"`
 	MerchantName       string         `gorm:"column:merchant_name;not null;size:64;comment:'商家名称'" json:"merchant_name"`
-	TotalAmount        float64        `gorm:"column:total_amount;not null;type:decimal(10,2);comment:'订单总金额'" json:"total_amount"`
-	Status             string         `gorm:"column:status;not null;size:16;default:'待接单';comment:'订单状态'" json:"status"`
-	Address            string         `gorm:"column:address;not null;size:255;comment:'收货地址'" json:"address"`
+	GoodsAmount        float64        `gorm:"column:goods_amount;not null;default:0;type:decimal(10,2);comment:'商品金额（优惠前）'" json:"goods_amount"`
+	DiscountAmount     float64        `gorm:"column:discount_amount;not null;default:0;type:decimal(10,2);comment:'优惠金额'" json:"discount_amount"`
+	PackingFee         float64        `gorm:"column:packing_fee;not null;default:0;type:decimal(10,2);comment:'打包费'" json:"packing_fee"`
+	DeliveryFee        float64        `gorm:"column:delivery_fee;not null;default:0;type:decimal(10,2);comment:'配送费'" json:"delivery_fee"`
+	DeliveryDistance   int32          `gorm:"column:delivery_distance;not null;default:0;comment:'配送距离（米）'" json:"delivery_distance"`
+	TipAmount          float64        `gorm:"column:tip_amount;not null;default:0;type:decimal(10,2);comment:'骑手小费（全额归骑手）'" json:"tip_amount"`
+	TotalAmount        float64        `gorm:"column:total_amount;not null;type:decimal(10,2);comment:'订单总金额（实付）'" json:"total_amount"`
+	Status             string         `gorm:"column:status;not null;size:16;default:'待支付';comment:'订单状态'" json:"status"`
+	Address            string         `gorm:"column:address;not null;size:255;comment:'收货地址（完整地址）'" json:"address"`
+	AddressID          int64          `gorm:"column:address_id;not null;default:0;comment:'收货地址ID'" json:"address_id"`
+	Province           string         `gorm:"column:province;size:16;comment:'省（下单快照）'" json:"province"`
+	City               string         `gorm:"column:city;size:16;comment:'市（下单快照）'" json:"city"`
+	District           string         `gorm:"column:district;size:16;comment:'区（下单快照）'" json:"district"`
+	AddressDetail      string         `gorm:"column:address_detail;size:255;comment:'详细地址（下单快照）'" json:"address_detail"`
+	Longitude          float64        `gorm:"column:longitude;not null;default:0;type:decimal(10,6);comment:'收货地址经度'" json:"longitude"`
+	Latitude           float64        `gorm:"column:latitude;not null;default:0;type:decimal(10,6);comment:'收货地址纬度'" json:"latitude"`
 	ExpectDeliveryTime string         `gorm:"column:expect_delivery_time;size:32;comment:'预计送达时间'" json:"expect_delivery_time"`
 	Remark             string         `gorm:"column:remark;size:255;comment:'备注'" json:"remark"`
-	CreateTime         time.Time      `gorm:"column:create_time;autoCreateTime;comment:'创建时间'" json:"create_time"`
+	PaidTime           *time.Time     `gorm:"column:paid_time;comment:'支付时间'" json:"paid_time"`
+	AcceptTime         *time.Time     `gorm:"column:accept_time;comment:'商家接单时间'" json:"accept_time"`
+	CompleteTime       *time.Time     `gorm:"column:complete_time;comment:'订单完成时间'" json:"complete_time"`
+	RiderID            int64          `gorm:"column:rider_id;not null;default:0;comment:'配送骑手ID（骑手接单后写入）'" json:"rider_id"`
+	RefundAmount       float64        `gorm:"column:refund_amount;not null;default:0;type:decimal(10,2);comment:'累计退款金额'" json:"refund_amount"`
+	StockRestored      bool           `gorm:"column:stock_restored;not null;default:false;comment:'库存是否已恢复'" json:"stock_restored"`
+	SettlePublished    bool           `gorm:"column:settle_published;not null;default:false;comment:'订单完成结算消息是否已投递'" json:"settle_published"`
+	CreateTime         time.Time      `gorm:"column:create_time;autoCreateTime;index:idx_order_user_time,priority:2;index:idx_order_merchant_time,priority:2;index:idx_order_create_time;comment:'创建时间'" json:"create_time"`
 	UpdateTime         time.Time      `gorm:"column:update_time;autoUpdateTime;comment:'更新时间'" json:"update_time"`
 	DeletedAt          gorm.DeletedAt `gorm:"column:deleted_at;index;comment:'软删除时间'" json:"-"`
 }
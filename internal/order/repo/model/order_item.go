@@ -15,6 +15,10 @@ type OrderItem struct {
 	Price       float64        `gorm:"column:price;not null;type:decimal(10,2);comment:'商品单价'" json:"price"`
 	Quantity    int32          `gorm:"column:quantity;not null;default:1;comment:'购买数量'" json:"quantity"`
 	TotalPrice  float64        `gorm:"column:total_price;not null;type:decimal(10,2);comment:'商品总价'" json:"total_price"`
+	RefundedQty int32          `gorm:"column:refunded_qty;not null;default:0;comment:'已退款数量'" json:"refunded_qty"`
+	SkuID       int64          `gorm:"column:sku_id;not null;default:0;comment:'规格ID（0表示单规格商品）'" json:"sku_id"`
+	SkuName     string         `gorm:"column:sku_name;size:32;comment:'规格名称'" json:"sku_name"`
+	Options     []ItemOption   `gorm:"column:options;type:varchar(1024);serializer:json;comment:'所选属性快照'" json:"options"`
 	CreatedAt   time.Time      `gorm:"column:created_at;autoCreateTime;comment:'创建时间'" json:"created_at"`
 	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at;index;comment:'软删除时间'" json:"-"`
 }
@@ -23,3 +27,11 @@ type OrderItem struct {
 func (oi *OrderItem) TableName() string {
 	return "t_order_item"
 }
+
+// ItemOption 订单项属性快照（下单时的属性组、选项名称及加价）
+type ItemOption struct {
+	OptionID  int64   `json:"option_id"`
+	GroupName string  `json:"group_name"`
+	Name      string  `json:"name"`
+	Price     float64 `json:"price"`
+}
@@ -0,0 +1,57 @@
+package model
+
+import "time"
+
+// Review 订单评价表（每个订单仅可评价一次）
+type Review struct {
+	ReviewID       int64      `gorm:"column:review_id;primaryKey;autoIncrement" json:"review_id"`
+	OrderID        int64      `gorm:"column:order_id;not null;uniqueIndex;comment:'订单ID'" json:"order_id"`
+	UserID         int64      `gorm:"column:user_id;not null;index:idx_review_user_time,priority:1;comment:'用户ID'" json:"user_id"`
+	UserName       string     `gorm:"column:user_name;not null;size:64;comment:'用户昵称（展示时脱敏）'" json:"user_name"`
+	MerchantID     int64      `gorm:"column:merchant_id;not null;index:idx_review_merchant_time,priority:1;comment:'商家ID'" json:"merchant_id"`
+	MerchantRating int32      `gorm:"column:merchant_rating;not null;comment:'商家评分（1-5）'" json:"merchant_rating"`
+	Content        string     `gorm:"column:content;size:512;comment:'评价内容'" json:"content"`
+	Tags           string     `gorm:"column:tags;size:255;comment:'商家评价标签（逗号分隔）'" json:"tags"`
+	RiderID        int64      `gorm:"column:rider_id;not null;default:0;index:idx_review_rider_time,priority:1;comment:'骑手ID（0表示未评价骑手）'" json:"rider_id"`
+	RiderRating    int32      `gorm:"column:rider_rating;not null;default:0;comment:'骑手评分（1-5，0表示未评价）'" json:"rider_rating"`
+	RiderTags      string     `gorm:"column:rider_tags;size:255;comment:'骑手评价标签（逗号分隔）'" json:"rider_tags"`
+	Reply          string     `gorm:"column:reply;size:512;comment:'商家回复'" json:"reply"`
+	ReplyTime      *time.Time `gorm:"column:reply_time;comment:'商家回复时间'" json:"reply_time"`
+	CreateTime     time.Time  `gorm:"column:create_time;autoCreateTime;index:idx_review_user_time,priority:2;index:idx_review_merchant_time,priority:2;index:idx_review_rider_time,priority:2;comment:'创建时间'" json:"create_time"`
+	UpdateTime     time.Time  `gorm:"column:update_time;autoUpdateTime;comment:'更新时间'" json:"update_time"`
+}
+
+// TableName 表名
+func (r *Review) TableName() string {
+	return "t_review"
+}
+
+// ReviewItem 商品评价表（订单内每个商品一条）
+type ReviewItem struct {
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	ReviewID    int64     `gorm:"column:review_id;not null;index;comment:'评价ID'" json:"review_id"`
+	ProductID   int64     `gorm:"column:product_id;not null;index:idx_review_item_product_time,priority:1;comment:'商品ID'" json:"product_id"`
+	ProductName string    `gorm:"column:product_name;not null;size:64;comment:'商品名称（快照）'" json:"product_name"`
+	Rating      int32     `gorm:"column:rating;not null;comment:'商品评分（1-5）'" json:"rating"`
+	Content     string    `gorm:"column:content;size:255;comment:'商品评价内容'" json:"content"`
+	CreateTime  time.Time `gorm:"column:create_time;autoCreateTime;index:idx_review_item_product_time,priority:2;comment:'创建时间'" json:"create_time"`
+}
+
+// TableName 表名
+func (i *ReviewItem) TableName() string {
+	return "t_review_item"
+}
+
+// RatingSummary 评分汇总表（按对象累计评分次数及总分，用于增量计算贝叶斯平均分）
+type RatingSummary struct {
+	TargetType  string    `gorm:"column:target_type;primaryKey;size:16;comment:'对象类型：merchant/product/rider'" json:"target_type"`
+	TargetID    int64     `gorm:"column:target_id;primaryKey;autoIncrement:false;comment:'对象ID'" json:"target_id"`
+	RatingCount int64     `gorm:"column:rating_count;not null;default:0;comment:'评分次数'" json:"rating_count"`
+	RatingSum   int64     `gorm:"column:rating_sum;not null;default:0;comment:'评分总和'" json:"rating_sum"`
+	UpdateTime  time.Time `gorm:"column:update_time;autoUpdateTime;comment:'更新时间'" json:"update_time"`
+}
+
+// TableName 表名
+func (s *RatingSummary) TableName() string {
+	return "t_rating_summary"
+}
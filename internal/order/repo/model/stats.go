@@ -0,0 +1,49 @@
+package model
+
+import "time"
+
+// MerchantDailyStat 商家日统计表（按下单日期+订单状态汇总已支付订单）
+type MerchantDailyStat struct {
+	ID            int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	MerchantID    int64     `gorm:"column:merchant_id;not null;uniqueIndex:uk_merchant_date_status,priority:1;comment:'商家ID'" json:"merchant_id"`
+	StatDate      time.Time `gorm:"column:stat_date;not null;type:date;uniqueIndex:uk_merchant_date_status,priority:2;index;comment:'统计日期（下单日期）'" json:"stat_date"`
+	Status        string    `gorm:"column:status;not null;size:16;uniqueIndex:uk_merchant_date_status,priority:3;comment:'订单状态'" json:"status"`
+	OrderCount    int64     `gorm:"column:order_count;not null;default:0;comment:'订单数'" json:"order_count"`
+	Amount        float64   `gorm:"column:amount;not null;default:0;type:decimal(12,2);comment:'实付金额（扣除退款）'" json:"amount"`
+	AcceptCount   int64     `gorm:"column:accept_count;not null;default:0;comment:'已接单订单数'" json:"accept_count"`
+	AcceptSeconds int64     `gorm:"column:accept_seconds;not null;default:0;comment:'接单时长合计（秒，支付到接单）'" json:"accept_seconds"`
+	CreateTime    time.Time `gorm:"column:create_time;autoCreateTime;comment:'创建时间'" json:"create_time"`
+}
+
+// TableName 表名
+func (s *MerchantDailyStat) TableName() string {
+	return "t_merchant_daily_stat"
+}
+
+// MerchantDailyProductStat 商家商品日销量表（仅统计有效订单：已支付且未取消/拒单）
+type MerchantDailyProductStat struct {
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	MerchantID  int64     `gorm:"column:merchant_id;not null;uniqueIndex:uk_merchant_date_product,priority:1;comment:'商家ID'" json:"merchant_id"`
+	StatDate    time.Time `gorm:"column:stat_date;not null;type:date;uniqueIndex:uk_merchant_date_product,priority:2;index;comment:'统计日期（下单日期）'" json:"stat_date"`
+	ProductID   int64     `gorm:"column:product_id;not null;uniqueIndex:uk_merchant_date_product,priority:3;comment:'商品ID'" json:"product_id"`
+	ProductName string    `gorm:"column:product_name;not null;size:64;comment:'商品名称'" json:"product_name"`
+	Quantity    int64     `gorm:"column:quantity;not null;default:0;comment:'销量（扣除退款）'" json:"quantity"`
+	Amount      float64   `gorm:"column:amount;not null;default:0;type:decimal(12,2);comment:'销售额（扣除退款）'" json:"amount"`
+	CreateTime  time.Time `gorm:"column:create_time;autoCreateTime;comment:'创建时间'" json:"create_time"`
+}
+
+// TableName 表名
+func (s *MerchantDailyProductStat) TableName() string {
+	return "t_merchant_daily_product_stat"
+}
+
+// StatRollup 日统计汇总进度表（每汇总完一天写入一行，最大日期即汇总水位）
+type StatRollup struct {
+	StatDate   time.Time `gorm:"column:stat_date;primaryKey;type:date;comment:'已汇总日期'" json:"stat_date"`
+	CreateTime time.Time `gorm:"column:create_time;autoCreateTime;comment:'汇总时间'" json:"create_time"`
+}
+
+// TableName 表名
+func (s *StatRollup) TableName() string {
+	return "t_stat_rollup"
+}
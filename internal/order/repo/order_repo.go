@@ -3,9 +3,11 @@ package repo
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo/model"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/pagination"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
@@ -13,13 +15,23 @@ import (
 
 // OrderRepo 订单数据访问接口
 type OrderRepo interface {
-	CreateOrder(ctx context.Context, order *model.Order, items []*model.OrderItem) error // 事务创建订单+订单项
-	UpdateOrderStatus(ctx context.Context, orderID int64, status, remark string) error
-	ListUserOrders(ctx context.Context, userID int64, status string, page, pageSize int32) ([]*model.Order, int64, error)
-	ListMerchantOrders(ctx context.Context, merchantID int64, status string, page, pageSize int32) ([]*model.Order, int64, error)
+	CreateOrder(ctx context.Context, order *model.Order, items []*model.OrderItem, discounts []*model.OrderDiscount) error // 事务创建订单+订单项+优惠明细（核销优惠券）
+	UpdateOrderStatus(ctx context.Context, orderID int64, fromStatus, status, remark string) error                         // 仅当前状态为fromStatus时更新
+	AssignRider(ctx context.Context, orderID, riderID int64) error                                                         // 骑手接单：已接单→待配送并记录骑手
+	ListUserOrders(ctx context.Context, userID int64, status string, page pagination.Param) ([]*model.Order, pagination.Result, error)
+	SearchOrders(ctx context.Context, filter OrderFilter, page pagination.Param) ([]*model.Order, pagination.Result, error) // 按条件检索订单（商家列表/客服检索）
 	GetOrderByID(ctx context.Context, orderID int64) (*model.Order, error)
-	CancelOrder(ctx context.Context, orderID, userID int64, reason string) error
-	GetOrderItems(ctx context.Context, orderID int64) ([]*model.OrderItem, error) // 查询订单项
+	CancelOrder(ctx context.Context, orderID, userID int64, fromStatus, reason string) error             // 仅当前状态为fromStatus时取消
+	GetOrderItems(ctx context.Context, orderID int64) ([]*model.OrderItem, error)                        // 查询订单项
+	GetOrderItemsByOrderIDs(ctx context.Context, orderIDs []int64) (map[int64][]*model.OrderItem, error) // 批量查询订单项（按订单ID分组）
+	MarkStockRestored(ctx context.Context, orderID int64) (bool, error)                                  // 标记库存已恢复（返回是否本次标记成功）
+	MarkOrderPaid(ctx context.Context, orderID int64, paidTime time.Time) (bool, error)                  // 待支付→待接单（返回是否本次更新成功）
+	ListExpiredUnpaidOrders(ctx context.Context, before time.Time, limit int) ([]*model.Order, error)
+	ListUnrestoredOrders(ctx context.Context, before time.Time, limit int) ([]*model.Order, error)       // 查询已取消/已拒单但库存未恢复的订单
+	MarkSettlePublished(ctx context.Context, orderID int64) error                                        // 标记订单完成结算消息已投递
+	ListSettleDueOrders(ctx context.Context, createdBefore time.Time, limit int) ([]*model.Order, error) // 查询下单早于createdBefore、结算消息未投递的已完成订单
+	MarkFullRefunded(ctx context.Context, orderID int64) (bool, error)                                   // 累计退款金额置为订单总额（返回是否本次更新成功）
+	RefundOrderItems(ctx context.Context, orderID int64, itemQty map[int64]int32, amount float64) error  // 事务累加订单项退款数量+订单退款金额
 }
 
 // orderRepo 实现
@@ -30,8 +42,8 @@ func NewOrderRepo() OrderRepo {
 	return &orderRepo{}
 }
 
-// CreateOrder 事务创建订单+订单项
-func (r *orderRepo) CreateOrder(ctx context.Context, order *model.Order, items []*model.OrderItem) error {
+// CreateOrder 事务创建订单+订单项+优惠明细，同时核销使用的优惠券
+func (r *orderRepo) CreateOrder(ctx context.Context, order *model.Order, items []*model.OrderItem, discounts []*model.OrderDiscount) error {
 	tx := db.Mysql.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -56,7 +68,36 @@ func (r *orderRepo) CreateOrder(ctx context.Context, order *model.Order, items [
 		return utils.NewDBError("创建订单失败：" + err.Error())
 	}
 
-	// 3. 提交事务
+	// 3. 核销优惠券（条件更新：仅本人未使用的券）+创建优惠明细
+	if len(discounts) > 0 {
+		now := time.Now()
+		for _, d := range discounts {
+			d.OrderID = order.OrderID
+			res := tx.Model(&model.UserCoupon{}).
+				Where("user_coupon_id = ? AND user_id = ? AND status = ?", d.UserCouponID, order.UserID, "未使用").
+				Updates(map[string]interface{}{
+					"status":    "已使用",
+					"order_id":  order.OrderID,
+					"used_time": now,
+				})
+			if res.Error != nil {
+				tx.Rollback()
+				zap.L().Error("核销优惠券失败", zap.Int64("user_coupon_id", d.UserCouponID), zap.Error(res.Error))
+				return utils.NewDBError("创建订单失败：" + res.Error.Error())
+			}
+			if res.RowsAffected == 0 {
+				tx.Rollback()
+				return utils.NewBizError("优惠券已被使用：" + d.CouponName)
+			}
+		}
+		if err := tx.Create(discounts).Error; err != nil {
+			tx.Rollback()
+			zap.L().Error("创建订单优惠明细失败", zap.Any("discounts", discounts), zap.Error(err))
+			return utils.NewDBError("创建订单失败：" + err.Error())
+		}
+	}
+
+	// 4. 提交事务
 	if err := tx.Commit().Error; err != nil {
 		tx.Rollback()
 		return utils.NewDBError("创建订单失败：" + err.Error())
@@ -65,86 +106,183 @@ func (r *orderRepo) CreateOrder(ctx context.Context, order *model.Order, items [
 	return nil
 }
 
-// UpdateOrderStatus 更新订单状态
-func (r *orderRepo) UpdateOrderStatus(ctx context.Context, orderID int64, status, remark string) error {
+// UpdateOrderStatus 更新订单状态（条件更新：当前状态必须为fromStatus）
+func (r *orderRepo) UpdateOrderStatus(ctx context.Context, orderID int64, fromStatus, status, remark string) error {
 	updateData := map[string]interface{}{
 		"status": status,
 	}
 	if remark != "" {
 		updateData["remark"] = remark
 	}
+	if status == "已接单" {
+		updateData["accept_time"] = time.Now() // 用于统计接单时长
+	}
+	if status == "已完成" {
+		updateData["complete_time"] = time.Now() // 结算按完成日期出账
+	}
 
 	tx := db.Mysql.WithContext(ctx).Model(&model.Order{}).
-		Where("order_id = ?", orderID).
+		Where("order_id = ? AND status = ?", orderID, fromStatus).
 		Updates(updateData)
 	if tx.Error != nil {
 		zap.L().Error("更新订单状态失败", zap.Int64("order_id", orderID), zap.String("status", status), zap.Error(tx.Error))
 		return utils.NewDBError("更新订单状态失败：" + tx.Error.Error())
 	}
 	if tx.RowsAffected == 0 {
-		return utils.NewBizError("订单不存在")
+		return utils.NewBizError("订单不存在或状态已变更")
 	}
 	return nil
 }
 
-// ListUserOrders 查询用户订单列表
-func (r *orderRepo) ListUserOrders(ctx context.Context, userID int64, status string, page, pageSize int32) ([]*model.Order, int64, error) {
-	var (
-		orders []*model.Order
-		total  int64
-	)
+// AssignRider 骑手接单（条件更新：当前状态必须为已接单）
+func (r *orderRepo) AssignRider(ctx context.Context, orderID, riderID int64) error {
+	tx := db.Mysql.WithContext(ctx).Model(&model.Order{}).
+		Where("order_id = ? AND status = ?", orderID, "已接单").
+		Updates(map[string]interface{}{
+			"status":   "待配送",
+			"rider_id": riderID,
+		})
+	if tx.Error != nil {
+		zap.L().Error("骑手接单更新订单失败", zap.Int64("order_id", orderID), zap.Int64("rider_id", riderID), zap.Error(tx.Error))
+		return utils.NewDBError("更新订单状态失败：" + tx.Error.Error())
+	}
+	if tx.RowsAffected == 0 {
+		return utils.NewBizError("订单不存在或状态已变更")
+	}
+	return nil
+}
+
+// 订单排序方式
+const (
+	OrderSortCreateTimeDesc = "create_time_desc" // 下单时间倒序（默认）
+	OrderSortCreateTimeAsc  = "create_time_asc"  // 下单时间正序
+	OrderSortAmountDesc     = "amount_desc"      // 实付金额倒序
+	OrderSortAmountAsc      = "amount_asc"       // 实付金额正序
+)
+
+// OrderFilter 订单检索条件（零值表示不限）
+type OrderFilter struct {
+	UserID        int64
+	MerchantID    int64
+	PaidOnly      bool     // 仅已支付订单（商家侧）
+	Statuses      []string // 订单状态（多选）
+	OrderNoPrefix string   // 订单号前缀
+	UserPhone     string   // 收货人完整手机号
+	UserPhoneTail string   // 收货人手机尾号（需同时限定商家或用户）
+	StartTime     time.Time
+	EndTime       time.Time
+	MinAmount     float64
+	MaxAmount     float64
+	SortBy        string
+}
+
+// orderKeyset 订单列表按创建时间、订单ID倒序分页
+var orderKeyset = pagination.Keyset{TimeColumn: "create_time", IDColumn: "order_id"}
 
+// ListUserOrders 查询用户订单列表
+func (r *orderRepo) ListUserOrders(ctx context.Context, userID int64, status string, page pagination.Param) ([]*model.Order, pagination.Result, error) {
 	// 构建查询条件
 	query := db.Mysql.WithContext(ctx).Model(&model.Order{}).Where("user_id = ?", userID)
 	if status != "" {
 		query = query.Where("status = ?", status)
 	}
 
-	// 统计总数
-	if err := query.Count(&total).Error; err != nil {
-		zap.L().Error("统计用户订单总数失败", zap.Int64("user_id", userID), zap.Error(err))
-		return nil, 0, utils.NewDBError("查询订单失败：" + err.Error())
-	}
-
-	// 分页查询
-	offset := (page - 1) * pageSize
-	if err := query.Offset(int(offset)).Limit(int(pageSize)).
-		Order("create_time DESC").Find(&orders).Error; err != nil {
+	orders, result, err := listOrders(query, page, OrderSortCreateTimeDesc)
+	if err != nil {
 		zap.L().Error("查询用户订单列表失败", zap.Int64("user_id", userID), zap.Error(err))
-		return nil, 0, utils.NewDBError("查询订单失败：" + err.Error())
+		return nil, pagination.Result{}, err
 	}
-
-	return orders, total, nil
+	return orders, result, nil
 }
 
-// ListMerchantOrders 查询商家订单列表
-func (r *orderRepo) ListMerchantOrders(ctx context.Context, merchantID int64, status string, page, pageSize int32) ([]*model.Order, int64, error) {
-	var (
-		orders []*model.Order
-		total  int64
-	)
-
+// SearchOrders 按条件检索订单
+// 查询条件均落在索引上：商家/用户+创建时间走联合索引，订单号前缀走唯一索引，完整手机号走手机号索引；
+// 手机尾号仅在限定商家或用户时使用（后缀匹配无法走索引，需由前导列缩小范围）
+func (r *orderRepo) SearchOrders(ctx context.Context, filter OrderFilter, page pagination.Param) ([]*model.Order, pagination.Result, error) {
 	// 构建查询条件
-	query := db.Mysql.WithContext(ctx).Model(&model.Order{}).Where("merchant_id = ?", merchantID)
-	if status != "" {
-		query = query.Where("status = ?", status)
+	query := db.Mysql.WithContext(ctx).Model(&model.Order{})
+	if filter.MerchantID > 0 {
+		query = query.Where("merchant_id = ?", filter.MerchantID)
+	}
+	if filter.UserID > 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.PaidOnly {
+		query = query.Where("paid_time IS NOT NULL")
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+	if filter.OrderNoPrefix != "" {
+		query = query.Where("order_no LIKE ?", filter.OrderNoPrefix+"%")
+	}
+	if filter.UserPhone != "" {
+		query = query.Where("user_phone = ?", filter.UserPhone)
+	}
+	if filter.UserPhoneTail != "" {
+		query = query.Where("user_phone LIKE ?", "%"+filter.UserPhoneTail)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("create_time >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("create_time <= ?", filter.EndTime)
+	}
+	if filter.MinAmount > 0 {
+		query = query.Where("total_amount >= ?", filter.MinAmount)
+	}
+	if filter.MaxAmount > 0 {
+		query = query.Where("total_amount <= ?", filter.MaxAmount)
 	}
 
-	// 统计总数
-	if err := query.Count(&total).Error; err != nil {
-		zap.L().Error("统计商家订单总数失败", zap.Int64("merchant_id", merchantID), zap.Error(err))
-		return nil, 0, utils.NewDBError("查询订单失败：" + err.Error())
+	orders, result, err := listOrders(query, page, filter.SortBy)
+	if err != nil {
+		zap.L().Error("检索订单失败", zap.Int64("merchant_id", filter.MerchantID), zap.Int64("user_id", filter.UserID), zap.Error(err))
+		return nil, pagination.Result{}, err
 	}
+	return orders, result, nil
+}
 
-	// 分页查询
-	offset := (page - 1) * pageSize
-	if err := query.Offset(int(offset)).Limit(int(pageSize)).
-		Order("create_time DESC").Find(&orders).Error; err != nil {
-		zap.L().Error("查询商家订单列表失败", zap.Int64("merchant_id", merchantID), zap.Error(err))
-		return nil, 0, utils.NewDBError("查询订单失败：" + err.Error())
+// listOrders 按需统计总数并按指定排序分页查询订单
+func listOrders(query *gorm.DB, page pagination.Param, sortBy string) ([]*model.Order, pagination.Result, error) {
+	total, err := pagination.Count(query, page)
+	if err != nil {
+		return nil, pagination.Result{}, utils.NewDBError("查询订单失败：" + err.Error())
+	}
+
+	var orders []*model.Order
+	switch sortBy {
+	case OrderSortAmountDesc, OrderSortAmountAsc:
+		// 金额排序无法做keyset分页，仅支持页码模式
+		if page.CursorMode() {
+			return nil, pagination.Result{}, utils.NewParamError("按金额排序仅支持页码分页")
+		}
+		dir := "DESC"
+		if sortBy == OrderSortAmountAsc {
+			dir = "ASC"
+		}
+		err = query.Order("total_amount " + dir).Order("order_id " + dir).
+			Offset(int((page.Page - 1) * page.PageSize)).Limit(int(page.PageSize)).
+			Find(&orders).Error
+		if err != nil {
+			return nil, pagination.Result{}, utils.NewDBError("查询订单失败：" + err.Error())
+		}
+		return orders, pagination.Result{Total: total}, nil
 	}
 
-	return orders, total, nil
+	keyset := orderKeyset
+	keyset.Asc = sortBy == OrderSortCreateTimeAsc
+	query, err = keyset.Apply(query, page)
+	if err != nil {
+		return nil, pagination.Result{}, err
+	}
+	if err = query.Find(&orders).Error; err != nil {
+		return nil, pagination.Result{}, utils.NewDBError("查询订单失败：" + err.Error())
+	}
+	orders, next := pagination.Trim(orders, page.PageSize, func(o *model.Order) (time.Time, int64) {
+		return o.CreateTime, o.OrderID
+	})
+	return orders, pagination.Result{Total: total, NextCursor: next}, nil
 }
 
 // GetOrderByID 查询订单详情
@@ -161,10 +299,10 @@ func (r *orderRepo) GetOrderByID(ctx context.Context, orderID int64) (*model.Ord
 	return &order, nil
 }
 
-// CancelOrder 取消订单（更新状态+备注）
-func (r *orderRepo) CancelOrder(ctx context.Context, orderID, userID int64, reason string) error {
+// CancelOrder 取消订单（更新状态+备注，条件：当前状态仍为fromStatus，避免覆盖并发的支付/接单）
+func (r *orderRepo) CancelOrder(ctx context.Context, orderID, userID int64, fromStatus, reason string) error {
 	tx := db.Mysql.WithContext(ctx).Model(&model.Order{}).
-		Where("order_id = ? AND user_id = ?", orderID, userID).
+		Where("order_id = ? AND user_id = ? AND status = ?", orderID, userID, fromStatus).
 		Updates(map[string]interface{}{
 			"status": "已取消",
 			"remark": reason,
@@ -174,7 +312,7 @@ func (r *orderRepo) CancelOrder(ctx context.Context, orderID, userID int64, reas
 		return utils.NewDBError("取消订单失败：" + tx.Error.Error())
 	}
 	if tx.RowsAffected == 0 {
-		return utils.NewBizError("订单不存在或无权限取消")
+		return utils.NewBizError("订单状态已变更")
 	}
 	return nil
 }
@@ -188,3 +326,137 @@ func (r *orderRepo) GetOrderItems(ctx context.Context, orderID int64) ([]*model.
 	}
 	return items, nil
 }
+
+// GetOrderItemsByOrderIDs 一次查询多个订单的订单项，按订单ID分组返回
+func (r *orderRepo) GetOrderItemsByOrderIDs(ctx context.Context, orderIDs []int64) (map[int64][]*model.OrderItem, error) {
+	result := make(map[int64][]*model.OrderItem, len(orderIDs))
+	if len(orderIDs) == 0 {
+		return result, nil
+	}
+	var items []*model.OrderItem
+	if err := db.Mysql.WithContext(ctx).Where("order_id IN ?", orderIDs).Order("item_id").Find(&items).Error; err != nil {
+		zap.L().Error("批量查询订单项失败", zap.Int64s("order_ids", orderIDs), zap.Error(err))
+		return nil, utils.NewDBError("查询订单项失败：" + err.Error())
+	}
+	for _, item := range items {
+		result[item.OrderID] = append(result[item.OrderID], item)
+	}
+	return result, nil
+}
+
+// MarkStockRestored 标记订单库存已恢复（条件更新保证只有一次调用成功）
+func (r *orderRepo) MarkStockRestored(ctx context.Context, orderID int64) (bool, error) {
+	tx := db.Mysql.WithContext(ctx).Model(&model.Order{}).
+		Where("order_id = ? AND stock_restored = ?", orderID, false).
+		Update("stock_restored", true)
+	if tx.Error != nil {
+		zap.L().Error("标记订单库存已恢复失败", zap.Int64("order_id", orderID), zap.Error(tx.Error))
+		return false, utils.NewDBError("更新订单失败：" + tx.Error.Error())
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+// MarkOrderPaid 标记订单已支付（条件更新：仅待支付订单流转为待接单）
+func (r *orderRepo) MarkOrderPaid(ctx context.Context, orderID int64, paidTime time.Time) (bool, error) {
+	tx := db.Mysql.WithContext(ctx).Model(&model.Order{}).
+		Where("order_id = ? AND status = ?", orderID, "待支付").
+		Updates(map[string]interface{}{
+			"status":    "待接单",
+			"paid_time": paidTime,
+		})
+	if tx.Error != nil {
+		zap.L().Error("标记订单已支付失败", zap.Int64("order_id", orderID), zap.Error(tx.Error))
+		return false, utils.NewDBError("更新订单失败：" + tx.Error.Error())
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+// ListExpiredUnpaidOrders 查询创建时间早于before的待支付订单
+func (r *orderRepo) ListExpiredUnpaidOrders(ctx context.Context, before time.Time, limit int) ([]*model.Order, error) {
+	var orders []*model.Order
+	if err := db.Mysql.WithContext(ctx).
+		Where("status = ? AND create_time < ?", "待支付", before).
+		Order("create_time ASC").Limit(limit).Find(&orders).Error; err != nil {
+		zap.L().Error("查询超时未支付订单失败", zap.Time("before", before), zap.Error(err))
+		return nil, utils.NewDBError("查询订单失败：" + err.Error())
+	}
+	return orders, nil
+}
+
+// ListUnrestoredOrders 查询更新时间早于before、库存未恢复的已取消/已拒单订单（恢复失败后重试用）
+func (r *orderRepo) ListUnrestoredOrders(ctx context.Context, before time.Time, limit int) ([]*model.Order, error) {
+	var orders []*model.Order
+	if err := db.Mysql.WithContext(ctx).
+		Where("status IN ? AND stock_restored = ? AND update_time < ?", []string{"已取消", "已拒单"}, false, before).
+		Order("update_time ASC").Limit(limit).Find(&orders).Error; err != nil {
+		zap.L().Error("查询库存未恢复订单失败", zap.Time("before", before), zap.Error(err))
+		return nil, utils.NewDBError("查询订单失败：" + err.Error())
+	}
+	return orders, nil
+}
+
+// MarkSettlePublished 标记订单完成结算消息已投递
+func (r *orderRepo) MarkSettlePublished(ctx context.Context, orderID int64) error {
+	if err := db.Mysql.WithContext(ctx).Model(&model.Order{}).
+		Where("order_id = ?", orderID).Update("settle_published", true).Error; err != nil {
+		zap.L().Error("标记订单结算消息已投递失败", zap.Int64("order_id", orderID), zap.Error(err))
+		return utils.NewDBError("更新订单失败：" + err.Error())
+	}
+	return nil
+}
+
+// ListSettleDueOrders 查询下单时间早于createdBefore（已过退款期限）、结算消息未投递的已完成订单
+func (r *orderRepo) ListSettleDueOrders(ctx context.Context, createdBefore time.Time, limit int) ([]*model.Order, error) {
+	var orders []*model.Order
+	if err := db.Mysql.WithContext(ctx).
+		Where("status = ? AND settle_published = ? AND create_time < ?", "已完成", false, createdBefore).
+		Order("create_time ASC").Limit(limit).Find(&orders).Error; err != nil {
+		zap.L().Error("查询待结算订单失败", zap.Time("created_before", createdBefore), zap.Error(err))
+		return nil, utils.NewDBError("查询订单失败：" + err.Error())
+	}
+	return orders, nil
+}
+
+// MarkFullRefunded 标记订单全额退款（条件更新：仅未全额退款的订单可更新）
+func (r *orderRepo) MarkFullRefunded(ctx context.Context, orderID int64) (bool, error) {
+	tx := db.Mysql.WithContext(ctx).Model(&model.Order{}).
+		Where("order_id = ? AND refund_amount < total_amount", orderID).
+		Update("refund_amount", gorm.Expr("total_amount"))
+	if tx.Error != nil {
+		zap.L().Error("标记订单全额退款失败", zap.Int64("order_id", orderID), zap.Error(tx.Error))
+		return false, utils.NewDBError("更新订单失败：" + tx.Error.Error())
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+// RefundOrderItems 事务累加订单项已退款数量及订单累计退款金额（数量/金额超限则回滚）
+func (r *orderRepo) RefundOrderItems(ctx context.Context, orderID int64, itemQty map[int64]int32, amount float64) error {
+	return db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. 累加订单项已退款数量（条件：剩余可退数量充足）
+		for itemID, qty := range itemQty {
+			res := tx.Model(&model.OrderItem{}).
+				Where("item_id = ? AND order_id = ? AND quantity - refunded_qty >= ?", itemID, orderID, qty).
+				Update("refunded_qty", gorm.Expr("refunded_qty + ?", qty))
+			if res.Error != nil {
+				zap.L().Error("更新订单项退款数量失败", zap.Int64("order_id", orderID), zap.Int64("item_id", itemID), zap.Error(res.Error))
+				return utils.NewDBError("更新订单项失败：" + res.Error.Error())
+			}
+			if res.RowsAffected == 0 {
+				return utils.NewBizError("订单项可退数量不足")
+			}
+		}
+
+		// 2. 累加订单退款金额（条件：不超过订单总额且尚未投递结算）
+		res := tx.Model(&model.Order{}).
+			Where("order_id = ? AND refund_amount + ? <= total_amount AND settle_published = ?", orderID, amount, false).
+			Update("refund_amount", gorm.Expr("refund_amount + ?", amount))
+		if res.Error != nil {
+			zap.L().Error("更新订单退款金额失败", zap.Int64("order_id", orderID), zap.Error(res.Error))
+			return utils.NewDBError("更新订单失败：" + res.Error.Error())
+		}
+		if res.RowsAffected == 0 {
+			return utils.NewBizError("退款金额超过订单可退金额或订单已结算")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,201 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/pagination"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReviewFilter 评价查询条件（零值表示不过滤）
+type ReviewFilter struct {
+	UserID     int64
+	MerchantID int64
+	ProductID  int64
+	RiderID    int64
+}
+
+// RatingDelta 一次评分（用于增量更新评分汇总）
+type RatingDelta struct {
+	TargetType string
+	TargetID   int64
+	Rating     int32
+}
+
+// ReviewRepo 评价数据访问接口
+type ReviewRepo interface {
+	CreateReview(ctx context.Context, review *model.Review, items []*model.ReviewItem, ratings []RatingDelta) ([]*model.RatingSummary, error) // 事务写入评价+商品评价并累加评分汇总，返回更新后的汇总
+	GetReviewByID(ctx context.Context, reviewID int64) (*model.Review, error)                                                                 // 不存在返回nil
+	GetReviewByOrderID(ctx context.Context, orderID int64) (*model.Review, error)                                                             // 不存在返回nil
+	ListReviews(ctx context.Context, filter ReviewFilter, page pagination.Param) ([]*model.Review, pagination.Result, error)
+	GetReviewItemsByReviewIDs(ctx context.Context, reviewIDs []int64) (map[int64][]*model.ReviewItem, error) // 批量查询商品评价（按评价ID分组）
+	ReplyReview(ctx context.Context, reviewID, merchantID int64, reply string) error                         // 仅未回复的评价可回复
+}
+
+// reviewRepo 实现
+type reviewRepo struct{}
+
+// NewReviewRepo 创建实例
+func NewReviewRepo() ReviewRepo {
+	return &reviewRepo{}
+}
+
+// CreateReview 事务写入评价、商品评价，并累加各对象评分次数及总分
+func (r *reviewRepo) CreateReview(ctx context.Context, review *model.Review, items []*model.ReviewItem, ratings []RatingDelta) ([]*model.RatingSummary, error) {
+	var summaries []*model.RatingSummary
+	err := db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. 写入评价（订单ID唯一，重复评价直接返回）
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(review)
+		if res.Error != nil {
+			return utils.NewDBError("创建评价失败：" + res.Error.Error())
+		}
+		if res.RowsAffected == 0 {
+			return utils.NewBizError("订单已评价")
+		}
+
+		// 2. 写入商品评价
+		for _, item := range items {
+			item.ReviewID = review.ReviewID
+		}
+		if len(items) > 0 {
+			if err := tx.Create(&items).Error; err != nil {
+				return utils.NewDBError("创建商品评价失败：" + err.Error())
+			}
+		}
+
+		// 3. 累加评分汇总并读取最新值
+		for _, d := range ratings {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "target_type"}, {Name: "target_id"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"rating_count": gorm.Expr("rating_count + 1"),
+					"rating_sum":   gorm.Expr("rating_sum + ?", d.Rating),
+				}),
+			}).Create(&model.RatingSummary{TargetType: d.TargetType, TargetID: d.TargetID, RatingCount: 1, RatingSum: int64(d.Rating)}).Error; err != nil {
+				return utils.NewDBError("更新评分汇总失败：" + err.Error())
+			}
+			var summary model.RatingSummary
+			if err := tx.Where("target_type = ? AND target_id = ?", d.TargetType, d.TargetID).First(&summary).Error; err != nil {
+				return utils.NewDBError("查询评分汇总失败：" + err.Error())
+			}
+			summaries = append(summaries, &summary)
+		}
+		return nil
+	})
+	if err != nil {
+		zap.L().Error("创建评价失败", zap.Int64("order_id", review.OrderID), zap.Error(err))
+		return nil, err
+	}
+	return summaries, nil
+}
+
+// GetReviewByID 根据ID查询评价
+func (r *reviewRepo) GetReviewByID(ctx context.Context, reviewID int64) (*model.Review, error) {
+	var review model.Review
+	tx := db.Mysql.WithContext(ctx).Where("review_id = ?", reviewID).First(&review)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		zap.L().Error("查询评价失败", zap.Int64("review_id", reviewID), zap.Error(tx.Error))
+		return nil, utils.NewDBError("查询评价失败：" + tx.Error.Error())
+	}
+	return &review, nil
+}
+
+// GetReviewByOrderID 根据订单ID查询评价
+func (r *reviewRepo) GetReviewByOrderID(ctx context.Context, orderID int64) (*model.Review, error) {
+	var review model.Review
+	tx := db.Mysql.WithContext(ctx).Where("order_id = ?", orderID).First(&review)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		zap.L().Error("查询订单评价失败", zap.Int64("order_id", orderID), zap.Error(tx.Error))
+		return nil, utils.NewDBError("查询评价失败：" + tx.Error.Error())
+	}
+	return &review, nil
+}
+
+// reviewKeyset 评价列表按创建时间、评价ID倒序分页
+var reviewKeyset = pagination.Keyset{TimeColumn: "create_time", IDColumn: "review_id"}
+
+// ListReviews 分页查询评价
+func (r *reviewRepo) ListReviews(ctx context.Context, filter ReviewFilter, page pagination.Param) ([]*model.Review, pagination.Result, error) {
+	// 构建查询条件
+	query := db.Mysql.WithContext(ctx).Model(&model.Review{})
+	if filter.UserID > 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.MerchantID > 0 {
+		query = query.Where("merchant_id = ?", filter.MerchantID)
+	}
+	if filter.RiderID > 0 {
+		query = query.Where("rider_id = ?", filter.RiderID)
+	}
+	if filter.ProductID > 0 {
+		query = query.Where("review_id IN (?)", db.Mysql.Model(&model.ReviewItem{}).Select("review_id").Where("product_id = ?", filter.ProductID))
+	}
+
+	// 统计总数并分页查询
+	total, err := pagination.Count(query, page)
+	if err != nil {
+		zap.L().Error("统计评价总数失败", zap.Any("filter", filter), zap.Error(err))
+		return nil, pagination.Result{}, utils.NewDBError("查询评价失败：" + err.Error())
+	}
+	query, err = reviewKeyset.Apply(query, page)
+	if err != nil {
+		return nil, pagination.Result{}, err
+	}
+	var reviews []*model.Review
+	if err = query.Find(&reviews).Error; err != nil {
+		zap.L().Error("查询评价列表失败", zap.Any("filter", filter), zap.Error(err))
+		return nil, pagination.Result{}, utils.NewDBError("查询评价失败：" + err.Error())
+	}
+	reviews, next := pagination.Trim(reviews, page.PageSize, func(rv *model.Review) (time.Time, int64) {
+		return rv.CreateTime, rv.ReviewID
+	})
+	return reviews, pagination.Result{Total: total, NextCursor: next}, nil
+}
+
+// GetReviewItemsByReviewIDs 批量查询商品评价
+func (r *reviewRepo) GetReviewItemsByReviewIDs(ctx context.Context, reviewIDs []int64) (map[int64][]*model.ReviewItem, error) {
+	result := make(map[int64][]*model.ReviewItem, len(reviewIDs))
+	if len(reviewIDs) == 0 {
+		return result, nil
+	}
+	var items []*model.ReviewItem
+	if err := db.Mysql.WithContext(ctx).Where("review_id IN ?", reviewIDs).Order("id").Find(&items).Error; err != nil {
+		zap.L().Error("批量查询商品评价失败", zap.Int64s("review_ids", reviewIDs), zap.Error(err))
+		return nil, utils.NewDBError("查询商品评价失败：" + err.Error())
+	}
+	for _, item := range items {
+		result[item.ReviewID] = append(result[item.ReviewID], item)
+	}
+	return result, nil
+}
+
+// ReplyReview 商家回复评价（条件更新：评价属于该商家且未回复）
+func (r *reviewRepo) ReplyReview(ctx context.Context, reviewID, merchantID int64, reply string) error {
+	tx := db.Mysql.WithContext(ctx).Model(&model.Review{}).
+		Where("review_id = ? AND merchant_id = ? AND reply = ''", reviewID, merchantID).
+		Updates(map[string]interface{}{
+			"reply":      reply,
+			"reply_time": time.Now(),
+		})
+	if tx.Error != nil {
+		zap.L().Error("回复评价失败", zap.Int64("review_id", reviewID), zap.Error(tx.Error))
+		return utils.NewDBError("回复评价失败：" + tx.Error.Error())
+	}
+	if tx.RowsAffected == 0 {
+		return utils.NewBizError("评价不存在或已回复")
+	}
+	return nil
+}
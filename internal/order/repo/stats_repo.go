@@ -0,0 +1,138 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// StatsRepo 商家经营统计数据访问接口
+type StatsRepo interface {
+	AggregateDailyStats(ctx context.Context, merchantID int64, start, end time.Time) ([]*model.MerchantDailyStat, error)               // 从订单表实时汇总[start,end)，merchantID为0时汇总全部商家
+	AggregateDailyProductStats(ctx context.Context, merchantID int64, start, end time.Time) ([]*model.MerchantDailyProductStat, error) // 从订单项实时汇总商品销量
+	SaveDailyRollup(ctx context.Context, day time.Time, stats []*model.MerchantDailyStat, products []*model.MerchantDailyProductStat) error
+	GetRollupRange(ctx context.Context) (time.Time, time.Time, error)                                                               // 已汇总的最小、最大日期（未汇总过返回零值）
+	ListDailyStats(ctx context.Context, merchantID int64, startDate, endDate time.Time) ([]*model.MerchantDailyStat, error)         // 查询日统计（含首尾日期）
+	SumProductStats(ctx context.Context, merchantID int64, startDate, endDate time.Time) ([]*model.MerchantDailyProductStat, error) // 按商品合计日销量（含首尾日期）
+}
+
+// statsRepo 实现
+type statsRepo struct{}
+
+// NewStatsRepo 创建实例
+func NewStatsRepo() StatsRepo {
+	return &statsRepo{}
+}
+
+// AggregateDailyStats 按商家、下单日期、订单状态汇总已支付订单
+func (r *statsRepo) AggregateDailyStats(ctx context.Context, merchantID int64, start, end time.Time) ([]*model.MerchantDailyStat, error) {
+	query := db.Mysql.WithContext(ctx).Model(&model.Order{}).
+		Select("merchant_id, DATE(create_time) AS stat_date, status, COUNT(*) AS order_count, "+
+			"SUM(total_amount - refund_amount) AS amount, COUNT(accept_time) AS accept_count, "+
+			"COALESCE(SUM(TIMESTAMPDIFF(SECOND, paid_time, accept_time)), 0) AS accept_seconds").
+		Where("paid_time IS NOT NULL AND create_time >= ? AND create_time < ?", start, end)
+	if merchantID > 0 {
+		query = query.Where("merchant_id = ?", merchantID)
+	}
+
+	var stats []*model.MerchantDailyStat
+	if err := query.Group("merchant_id, DATE(create_time), status").Scan(&stats).Error; err != nil {
+		zap.L().Error("汇总订单统计失败", zap.Int64("merchant_id", merchantID), zap.Time("start", start), zap.Error(err))
+		return nil, utils.NewDBError("汇总订单统计失败：" + err.Error())
+	}
+	return stats, nil
+}
+
+// AggregateDailyProductStats 按商家、下单日期、商品汇总有效订单的销量（扣除已退款数量）
+func (r *statsRepo) AggregateDailyProductStats(ctx context.Context, merchantID int64, start, end time.Time) ([]*model.MerchantDailyProductStat, error) {
+	query := db.Mysql.WithContext(ctx).Table("t_order_item AS i").
+		Select("o.merchant_id, DATE(o.create_time) AS stat_date, i.product_id, MAX(i.product_name) AS product_name, "+
+			"SUM(i.quantity - i.refunded_qty) AS quantity, SUM(i.price * (i.quantity - i.refunded_qty)) AS amount").
+		Joins("JOIN t_order AS o ON o.order_id = i.order_id").
+		Where("o.paid_time IS NOT NULL AND o.status NOT IN ? AND o.create_time >= ? AND o.create_time < ?", []string{"已取消", "已拒单"}, start, end).
+		Where("i.deleted_at IS NULL AND o.deleted_at IS NULL")
+	if merchantID > 0 {
+		query = query.Where("o.merchant_id = ?", merchantID)
+	}
+
+	var products []*model.MerchantDailyProductStat
+	if err := query.Group("o.merchant_id, DATE(o.create_time), i.product_id").Scan(&products).Error; err != nil {
+		zap.L().Error("汇总商品销量失败", zap.Int64("merchant_id", merchantID), zap.Time("start", start), zap.Error(err))
+		return nil, utils.NewDBError("汇总商品销量失败：" + err.Error())
+	}
+	return products, nil
+}
+
+// SaveDailyRollup 事务写入某日汇总结果（覆盖已有数据）并推进汇总水位
+func (r *statsRepo) SaveDailyRollup(ctx context.Context, day time.Time, stats []*model.MerchantDailyStat, products []*model.MerchantDailyProductStat) error {
+	err := db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("stat_date = ?", day).Delete(&model.MerchantDailyStat{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("stat_date = ?", day).Delete(&model.MerchantDailyProductStat{}).Error; err != nil {
+			return err
+		}
+		if len(stats) > 0 {
+			if err := tx.CreateInBatches(stats, 500).Error; err != nil {
+				return err
+			}
+		}
+		if len(products) > 0 {
+			if err := tx.CreateInBatches(products, 500).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Save(&model.StatRollup{StatDate: day}).Error
+	})
+	if err != nil {
+		zap.L().Error("写入日统计失败", zap.Time("day", day), zap.Error(err))
+		return utils.NewDBError("写入日统计失败：" + err.Error())
+	}
+	return nil
+}
+
+// GetRollupRange 查询已汇总的日期范围（逐日连续汇总，范围内每天均已汇总）
+func (r *statsRepo) GetRollupRange(ctx context.Context) (time.Time, time.Time, error) {
+	var first, last model.StatRollup
+	if err := db.Mysql.WithContext(ctx).Order("stat_date ASC").Limit(1).Find(&first).Error; err != nil {
+		zap.L().Error("查询统计汇总范围失败", zap.Error(err))
+		return time.Time{}, time.Time{}, utils.NewDBError("查询统计汇总范围失败：" + err.Error())
+	}
+	if err := db.Mysql.WithContext(ctx).Order("stat_date DESC").Limit(1).Find(&last).Error; err != nil {
+		zap.L().Error("查询统计汇总范围失败", zap.Error(err))
+		return time.Time{}, time.Time{}, utils.NewDBError("查询统计汇总范围失败：" + err.Error())
+	}
+	return first.StatDate, last.StatDate, nil
+}
+
+// ListDailyStats 查询商家日统计
+func (r *statsRepo) ListDailyStats(ctx context.Context, merchantID int64, startDate, endDate time.Time) ([]*model.MerchantDailyStat, error) {
+	var stats []*model.MerchantDailyStat
+	err := db.Mysql.WithContext(ctx).
+		Where("merchant_id = ? AND stat_date >= ? AND stat_date <= ?", merchantID, startDate, endDate).
+		Order("stat_date").Find(&stats).Error
+	if err != nil {
+		zap.L().Error("查询商家日统计失败", zap.Int64("merchant_id", merchantID), zap.Error(err))
+		return nil, utils.NewDBError("查询商家日统计失败：" + err.Error())
+	}
+	return stats, nil
+}
+
+// SumProductStats 按商品合计商家日销量
+func (r *statsRepo) SumProductStats(ctx context.Context, merchantID int64, startDate, endDate time.Time) ([]*model.MerchantDailyProductStat, error) {
+	var products []*model.MerchantDailyProductStat
+	err := db.Mysql.WithContext(ctx).Model(&model.MerchantDailyProductStat{}).
+		Select("merchant_id, product_id, MAX(product_name) AS product_name, SUM(quantity) AS quantity, SUM(amount) AS amount").
+		Where("merchant_id = ? AND stat_date >= ? AND stat_date <= ?", merchantID, startDate, endDate).
+		Group("merchant_id, product_id").Scan(&products).Error
+	if err != nil {
+		zap.L().Error("查询商品销量统计失败", zap.Int64("merchant_id", merchantID), zap.Error(err))
+		return nil, utils.NewDBError("查询商品销量统计失败：" + err.Error())
+	}
+	return products, nil
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/promotion"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// 入参结构体
+type CreateCouponParam struct {
+	Operator    string  `validate:"required,min=2"`
+	Name        string  `validate:"required,min=2,max=64"`
+	Type        string  `validate:"required,oneof=满减 折扣 代金券"`
+	MerchantID  int64   `validate:"gte=0"`
+	Threshold   float64 `validate:"gte=0"`
+	Amount      float64 `validate:"gte=0"`
+	Rate        float64 `validate:"gte=0,lt=1"`
+	MaxDiscount float64 `validate:"gte=0"`
+	Total       int32   `validate:"required,gt=0"`
+	StartTime   string  `validate:"required"`
+	EndTime     string  `validate:"required"`
+}
+
+type ClaimCouponParam struct {
+	CouponID int64 `validate:"required,gt=0"`
+	UserID   int64 `validate:"required,gt=0"`
+}
+
+type ListUserCouponsParam struct {
+	UserID int64  `validate:"required,gt=0"`
+	Status string `validate:"omitempty,oneof=未使用 已使用"`
+}
+
+// 响应结构体
+type CouponResult struct {
+	CouponID    int64   `json:"coupon_id"`
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	MerchantID  int64   `json:"merchant_id"`
+	Threshold   float64 `json:"threshold"`
+	Amount      float64 `json:"amount"`
+	Rate        float64 `json:"rate"`
+	MaxDiscount float64 `json:"max_discount"`
+	Total       int32   `json:"total"`
+	Claimed     int32   `json:"claimed"`
+	StartTime   string  `json:"start_time"`
+	EndTime     string  `json:"end_time"`
+}
+
+type UserCouponResult struct {
+	UserCouponID int64        `json:"user_coupon_id"`
+	UserID       int64        `json:"user_id"`
+	Status       string       `json:"status"`
+	OrderID      int64        `json:"order_id"`
+	UsedTime     string       `json:"used_time"`
+	ClaimTime    string       `json:"claim_time"`
+	Coupon       CouponResult `json:"coupon"`
+}
+
+// CouponService 优惠券业务逻辑接口
+type CouponService interface {
+	CreateCoupon(ctx context.Context, param CreateCouponParam) (CouponResult, error)
+	ClaimCoupon(ctx context.Context, param ClaimCouponParam) (UserCouponResult, error)
+	ListUserCoupons(ctx context.Context, param ListUserCouponsParam) ([]UserCouponResult, error)
+}
+
+// couponService 实现
+type couponService struct {
+	couponRepo repo.CouponRepo
+	validate   *validator.Validate
+}
+
+// NewCouponService 创建实例
+func NewCouponService(couponRepo repo.CouponRepo) CouponService {
+	return &couponService{
+		couponRepo: couponRepo,
+		validate:   validator.New(),
+	}
+}
+
+// CreateCoupon 创建优惠券（商家创建本店券，平台运营创建平台券或指定商家券）
+func (s *couponService) CreateCoupon(ctx context.Context, param CreateCouponParam) (CouponResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("创建优惠券参数校验失败", zap.Any("param", param), zap.Error(err))
+		return CouponResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+	startTime, err := time.ParseInLocation("2006-01-02 15:04:05", param.StartTime, time.Local)
+	if err != nil {
+		return CouponResult{}, utils.NewParamError("生效时间格式错误")
+	}
+	endTime, err := time.ParseInLocation("2006-01-02 15:04:05", param.EndTime, time.Local)
+	if err != nil {
+		return CouponResult{}, utils.NewParamError("失效时间格式错误")
+	}
+	if !endTime.After(startTime) {
+		return CouponResult{}, utils.NewParamError("失效时间必须晚于生效时间")
+	}
+
+	// 2. 按类型校验优惠规则
+	switch param.Type {
+	case promotion.TypeFullReduction:
+		if param.Amount <= 0 || param.Threshold < param.Amount {
+			return CouponResult{}, utils.NewParamError("满减券减免金额必须大于0且不超过使用门槛")
+		}
+	case promotion.TypeDiscount:
+		if param.Rate <= 0 {
+			return CouponResult{}, utils.NewParamError("折扣券折扣率必须在0-1之间")
+		}
+	case promotion.TypeVoucher:
+		if param.Amount <= 0 || param.MerchantID == 0 {
+			return CouponResult{}, utils.NewParamError("代金券必须指定商家且减免金额大于0")
+		}
+	}
+
+	// 3. 校验操作人：商家仅可创建本店券
+	role, operatorID, err := checkOperator(ctx, param.Operator)
+	if err != nil {
+		return CouponResult{}, err
+	}
+	switch role {
+	case "merchant":
+		if param.MerchantID != operatorID {
+			return CouponResult{}, utils.NewAuthError("商家仅可创建本店优惠券")
+		}
+	case "admin", "system":
+	default:
+		return CouponResult{}, utils.NewAuthError("无权限创建优惠券")
+	}
+
+	// 4. 创建优惠券
+	coupon := &model.Coupon{
+		Name:        param.Name,
+		Type:        param.Type,
+		MerchantID:  param.MerchantID,
+		Threshold:   param.Threshold,
+		Amount:      param.Amount,
+		Rate:        param.Rate,
+		MaxDiscount: param.MaxDiscount,
+		Total:       param.Total,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+	if err = s.couponRepo.CreateCoupon(ctx, coupon); err != nil {
+		return CouponResult{}, err
+	}
+
+	zap.L().Info("创建优惠券成功", zap.Int64("coupon_id", coupon.CouponID), zap.String("operator", param.Operator))
+	return toCouponResult(coupon), nil
+}
+
+// ClaimCoupon 用户领取优惠券（每人限领一张）
+func (s *couponService) ClaimCoupon(ctx context.Context, param ClaimCouponParam) (UserCouponResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("领取优惠券参数校验失败", zap.Any("param", param), zap.Error(err))
+		return UserCouponResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "user", param.UserID); err != nil {
+		return UserCouponResult{}, err
+	}
+
+	// 2. 校验优惠券有效期
+	coupon, err := s.couponRepo.GetCouponByID(ctx, param.CouponID)
+	if err != nil {
+		return UserCouponResult{}, err
+	}
+	if time.Now().After(coupon.EndTime) {
+		return UserCouponResult{}, utils.NewBizError("优惠券已过期")
+	}
+
+	// 3. 领取
+	userCoupon, err := s.couponRepo.ClaimCoupon(ctx, param.CouponID, param.UserID)
+	if err != nil {
+		return UserCouponResult{}, err
+	}
+
+	zap.L().Info("领取优惠券成功", zap.Int64("coupon_id", param.CouponID), zap.Int64("user_id", param.UserID))
+	return toUserCouponResult(userCoupon, coupon), nil
+}
+
+// ListUserCoupons 查询用户优惠券
+func (s *couponService) ListUserCoupons(ctx context.Context, param ListUserCouponsParam) ([]UserCouponResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("查询用户优惠券参数校验失败", zap.Any("param", param), zap.Error(err))
+		return nil, utils.NewParamError("参数错误：" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "user", param.UserID); err != nil {
+		return nil, err
+	}
+
+	// 2. 查询用户券及券模板
+	userCoupons, err := s.couponRepo.ListUserCoupons(ctx, param.UserID, param.Status)
+	if err != nil {
+		return nil, err
+	}
+	couponIDs := make([]int64, 0, len(userCoupons))
+	for _, uc := range userCoupons {
+		couponIDs = append(couponIDs, uc.CouponID)
+	}
+	coupons, err := s.couponRepo.GetCouponsByIDs(ctx, couponIDs)
+	if err != nil {
+		return nil, err
+	}
+	couponMap := make(map[int64]*model.Coupon, len(coupons))
+	for _, c := range coupons {
+		couponMap[c.CouponID] = c
+	}
+
+	// 3. 组装结果
+	var results []UserCouponResult
+	for _, uc := range userCoupons {
+		coupon, ok := couponMap[uc.CouponID]
+		if !ok {
+			continue
+		}
+		results = append(results, toUserCouponResult(uc, coupon))
+	}
+	return results, nil
+}
+
+// toCouponResult 模型 → 领域层结果
+func toCouponResult(coupon *model.Coupon) CouponResult {
+	return CouponResult{
+		CouponID:    coupon.CouponID,
+		Name:        coupon.Name,
+		Type:        coupon.Type,
+		MerchantID:  coupon.MerchantID,
+		Threshold:   coupon.Threshold,
+		Amount:      coupon.Amount,
+		Rate:        coupon.Rate,
+		MaxDiscount: coupon.MaxDiscount,
+		Total:       coupon.Total,
+		Claimed:     coupon.Claimed,
+		StartTime:   coupon.StartTime.Format("2006-01-02 15:04:05"),
+		EndTime:     coupon.EndTime.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// toUserCouponResult 模型 → 领域层结果
+func toUserCouponResult(userCoupon *model.UserCoupon, coupon *model.Coupon) UserCouponResult {
+	return UserCouponResult{
+		UserCouponID: userCoupon.UserCouponID,
+		UserID:       userCoupon.UserID,
+		Status:       userCoupon.Status,
+		OrderID:      userCoupon.OrderID,
+		UsedTime:     formatTime(userCoupon.UsedTime),
+		ClaimTime:    userCoupon.CreateTime.Format("2006-01-02 15:04:05"),
+		Coupon:       toCouponResult(coupon),
+	}
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo/model"
+)
+
+// toOrderResults 批量加载一页订单的订单项并转换为结果（订单项查询失败时整体返回错误，不再静默丢单）
+func (s *orderService) toOrderResults(ctx context.Context, orders []*model.Order) ([]OrderInfoResult, error) {
+	orderIDs := make([]int64, 0, len(orders))
+	for _, o := range orders {
+		orderIDs = append(orderIDs, o.OrderID)
+	}
+	itemsByOrder, err := s.orderRepo.GetOrderItemsByOrderIDs(ctx, orderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]OrderInfoResult, 0, len(orders))
+	for _, o := range orders {
+		results = append(results, toOrderInfoResult(o, itemsByOrder[o.OrderID]))
+	}
+	return results, nil
+}
+
+// toOrderInfoResult 订单主表+订单项转换为订单结果（不含优惠明细）
+func toOrderInfoResult(o *model.Order, items []*model.OrderItem) OrderInfoResult {
+	return OrderInfoResult{
+		OrderID:            o.OrderID,
+		OrderNo:            o.OrderNo,
+		UserID:             o.UserID,
+		UserName:           o.UserName,
+		UserPhone:          o.UserPhone,
+		MerchantID:         o.MerchantID,
+		MerchantName:       o.MerchantName,
+		Items:              toOrderItemResults(items),
+		TotalAmount:        o.TotalAmount,
+		Status:             o.Status,
+		Address:            o.Address,
+		AddressID:          o.AddressID,
+		Province:           o.Province,
+		City:               o.City,
+		District:           o.District,
+		AddressDetail:      o.AddressDetail,
+		Longitude:          o.Longitude,
+		Latitude:           o.Latitude,
+		CreateTime:         o.CreateTime.Format("2006-01-02 15:04:05"),
+		UpdateTime:         o.UpdateTime.Format("2006-01-02 15:04:05"),
+		ExpectDeliveryTime: o.ExpectDeliveryTime,
+		Remark:             o.Remark,
+		PaidTime:           formatTime(o.PaidTime),
+		RefundAmount:       o.RefundAmount,
+		GoodsAmount:        o.GoodsAmount,
+		DiscountAmount:     o.DiscountAmount,
+		PackingFee:         o.PackingFee,
+		DeliveryFee:        o.DeliveryFee,
+		DeliveryDistance:   o.DeliveryDistance,
+		TipAmount:          o.TipAmount,
+		RiderID:            o.RiderID,
+	}
+}
+
+// toOrderItemResults 订单项转换
+func toOrderItemResults(items []*model.OrderItem) []OrderItemResult {
+	var results []OrderItemResult
+	for _, item := range items {
+		results = append(results, OrderItemResult{
+			ItemID:      item.ItemID,
+			OrderID:     item.OrderID,
+			ProductID:   item.ProductID,
+			ProductName: item.ProductName,
+			Price:       item.Price,
+			Quantity:    item.Quantity,
+			TotalPrice:  item.TotalPrice,
+			RefundedQty: item.RefundedQty,
+			SkuID:       item.SkuID,
+			SkuName:     item.SkuName,
+			Options:     toItemOptionResults(item.Options),
+		})
+	}
+	return results
+}
+
+// toOrderDiscountResults 优惠明细转换
+func toOrderDiscountResults(discounts []*model.OrderDiscount) []OrderDiscountResult {
+	var results []OrderDiscountResult
+	for _, d := range discounts {
+		results = append(results, OrderDiscountResult{
+			UserCouponID: d.UserCouponID,
+			CouponID:     d.CouponID,
+			CouponName:   d.CouponName,
+			Type:         d.Type,
+			Amount:       d.Amount,
+		})
+	}
+	return results
+}
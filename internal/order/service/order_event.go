@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/client"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo/model"
+	productProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/product/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// restoreItemStock 调用商品服务恢复单个商品（多规格商品为规格）库存（带重试，按恢复流水号幂等）
+func restoreItemStock(ctx context.Context, restoreNo string, userID, productID, skuID int64, num int32) error {
+	return utils.Retry(3, 200*time.Millisecond, func() error {
+		resp, err := client.ProductClient.RestoreStock(ctx, &productProto.RestoreStockRequest{
+			ProductId: productID,
+			Num:       num,
+			SkuId:     skuID,
+			UserId:    userID,
+			RestoreNo: restoreNo,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.Code != utils.ErrCodeSuccess {
+			return utils.NewAppError(int(resp.Code), resp.Msg)
+		}
+		return nil
+	})
+}
+
+// restoreStock 恢复订单项库存：同步重试仍失败的订单项投递Kafka补偿，由消费者继续恢复
+// 已创建的订单按订单项生成恢复流水号（重复恢复由商品服务跳过）；补偿消息投递失败时返回错误
+func restoreStock(ctx context.Context, userID, orderID int64, items []*model.OrderItem) error {
+	batchNo := "RC" + time.Now().Format("20060102150405") + utils.RandomString(8) // 未创建订单时的流水号前缀
+	var failed error
+	for i, item := range items {
+		restoreNo := fmt.Sprintf("%s-%d", batchNo, i)
+		if orderID > 0 {
+			restoreNo = fmt.Sprintf("RS%d-%d", orderID, item.ItemID)
+		}
+		err := restoreItemStock(ctx, restoreNo, userID, item.ProductID, item.SkuID, item.Quantity)
+		if err == nil {
+			continue
+		}
+		zap.L().Error("恢复库存失败，投递补偿消息", zap.Int64("order_id", orderID), zap.Int64("product_id", item.ProductID), zap.Error(err))
+		event := kafka.StockRestoreEvent{
+			RestoreNo: restoreNo,
+			OrderID:   orderID,
+			ProductID: item.ProductID,
+			SkuID:     item.SkuID,
+			UserID:    userID,
+			Num:       item.Quantity,
+		}
+		if err = kafka.SendJSON(kafka.TopicStockRestore, strconv.FormatInt(orderID, 10), event); err != nil {
+			zap.L().Error("投递库存恢复补偿消息失败", zap.Any("event", event), zap.Error(err))
+			failed = err
+		}
+	}
+	return failed
+}
+
+// restoreOrderStock 恢复订单库存：全部订单项恢复（或已投递补偿）后再标记已恢复，失败时由RetryStockRestore重试
+func (s *orderService) restoreOrderStock(ctx context.Context, order *model.Order) error {
+	if order.StockRestored {
+		zap.L().Info("订单库存已恢复，跳过", zap.Int64("order_id", order.OrderID))
+		return nil
+	}
+	items, err := s.orderRepo.GetOrderItems(ctx, order.OrderID)
+	if err != nil {
+		return err
+	}
+	if err = restoreStock(ctx, order.UserID, order.OrderID, items); err != nil {
+		return err
+	}
+	if _, err = s.orderRepo.MarkStockRestored(ctx, order.OrderID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RetryStockRestore 重试库存未恢复的已取消/已拒单订单（定时任务调用），返回恢复订单数
+func (s *orderService) RetryStockRestore(ctx context.Context) (int, error) {
+	before := time.Now().Add(-time.Minute) // 跳过刚取消、恢复可能仍在进行中的订单
+	orders, err := s.orderRepo.ListUnrestoredOrders(ctx, before, 100)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, order := range orders {
+		if err = s.restoreOrderStock(ctx, order); err != nil {
+			zap.L().Error("重试恢复订单库存失败", zap.Int64("order_id", order.OrderID), zap.Error(err))
+			continue
+		}
+		count++
+	}
+	if count > 0 {
+		zap.L().Info("重试恢复订单库存完成", zap.Int("count", count))
+	}
+	return count, nil
+}
+
+// returnCoupons 退还订单使用的优惠券（取消/拒单/超时，幂等）
+func (s *orderService) returnCoupons(ctx context.Context, orderID int64) {
+	count, err := s.couponRepo.ReturnCoupons(ctx, orderID)
+	if err != nil {
+		zap.L().Error("退还订单优惠券失败，需人工处理", zap.Int64("order_id", orderID), zap.Error(err))
+		return
+	}
+	if count > 0 {
+		zap.L().Info("已退还订单优惠券", zap.Int64("order_id", orderID), zap.Int64("count", count))
+	}
+}
+
+// publishRefund 投递订单退款消息（由支付服务消费执行退款并记录退款流水）
+func publishRefund(event kafka.OrderRefundEvent) {
+	err := utils.Retry(3, 200*time.Millisecond, func() error {
+		return kafka.SendJSON(kafka.TopicOrderRefund, strconv.FormatInt(event.OrderID, 10), event)
+	})
+	if err != nil {
+		zap.L().Error("投递订单退款消息失败，需人工处理", zap.Any("event", event), zap.Error(err))
+	}
+}
+
+// refundOrder 订单全额退款（取消/拒单）：退还扣除已部分退款后的剩余金额，同一订单仅触发一次
+func (s *orderService) refundOrder(ctx context.Context, order *model.Order, scene, reason string) {
+	marked, err := s.orderRepo.MarkFullRefunded(ctx, order.OrderID)
+	if err != nil {
+		zap.L().Error("标记订单全额退款失败，需人工处理", zap.Int64("order_id", order.OrderID), zap.String("scene", scene), zap.Error(err))
+		return
+	}
+	if !marked {
+		zap.L().Info("订单已全额退款，跳过", zap.Int64("order_id", order.OrderID))
+		return
+	}
+	publishRefund(kafka.OrderRefundEvent{
+		RefundNo:   "RF" + order.OrderNo,
+		OrderID:    order.OrderID,
+		OrderNo:    order.OrderNo,
+		UserID:     order.UserID,
+		Amount:     utils.RoundMoney(order.TotalAmount - order.RefundAmount),
+		RefundType: kafka.RefundTypeFull,
+		Scene:      scene,
+		Reason:     reason,
+	})
+}
+
+// publishOrderCompleted 投递订单完成消息（供支付服务结算）：商品金额扣除已退款数量，优惠按出资方拆分
+// 投递成功后标记已投递（此后不再允许部分退款）；失败时下次定时任务重投（支付服务按凭证号幂等）
+func (s *orderService) publishOrderCompleted(ctx context.Context, order *model.Order) error {
+	event := kafka.OrderCompletedEvent{
+		OrderID:       order.OrderID,
+		OrderNo:       order.OrderNo,
+		MerchantID:    order.MerchantID,
+		PackingFee:    order.PackingFee,
+		DeliveryFee:   order.DeliveryFee,
+		TipAmount:     order.TipAmount,
+		PaidAmount:    utils.RoundMoney(order.TotalAmount - order.RefundAmount),
+		CompletedTime: order.UpdateTime,
+	}
+	if order.CompleteTime != nil {
+		event.CompletedTime = *order.CompleteTime
+	}
+
+	// 1. 商品金额（扣除已退款数量）
+	items, err := s.orderRepo.GetOrderItems(ctx, order.OrderID)
+	if err != nil {
+		zap.L().Error("查询订单项失败，结算消息未投递", zap.Int64("order_id", order.OrderID), zap.Error(err))
+		return err
+	}
+	for _, item := range items {
+		event.ItemAmount += item.Price * float64(item.Quantity-item.RefundedQty)
+	}
+	event.ItemAmount = utils.RoundMoney(event.ItemAmount)
+
+	// 2. 优惠拆分：商家券由商家承担，平台券由平台承担
+	discounts, err := s.couponRepo.GetOrderDiscounts(ctx, order.OrderID)
+	if err != nil {
+		zap.L().Error("查询订单优惠明细失败，结算消息未投递", zap.Int64("order_id", order.OrderID), zap.Error(err))
+		return err
+	}
+	if len(discounts) > 0 {
+		couponIDs := make([]int64, 0, len(discounts))
+		for _, d := range discounts {
+			couponIDs = append(couponIDs, d.CouponID)
+		}
+		coupons, err := s.couponRepo.GetCouponsByIDs(ctx, couponIDs)
+		if err != nil {
+			zap.L().Error("查询优惠券失败，结算消息未投递", zap.Int64("order_id", order.OrderID), zap.Error(err))
+			return err
+		}
+		merchantFunded := make(map[int64]bool, len(coupons))
+		for _, c := range coupons {
+			merchantFunded[c.CouponID] = c.MerchantID > 0
+		}
+		for _, d := range discounts {
+			if merchantFunded[d.CouponID] {
+				event.MerchantDiscount += d.Amount
+			} else {
+				event.PlatformDiscount += d.Amount
+			}
+		}
+		event.MerchantDiscount = utils.RoundMoney(event.MerchantDiscount)
+		event.PlatformDiscount = utils.RoundMoney(event.PlatformDiscount)
+	}
+
+	// 3. 投递消息
+	err = utils.Retry(3, 200*time.Millisecond, func() error {
+		return kafka.SendJSON(kafka.TopicOrderCompleted, strconv.FormatInt(event.OrderID, 10), event)
+	})
+	if err != nil {
+		zap.L().Error("投递订单完成消息失败，等待重投", zap.Any("event", event), zap.Error(err))
+		return utils.NewSystemError("投递订单完成消息失败：" + err.Error())
+	}
+	return s.orderRepo.MarkSettlePublished(ctx, order.OrderID)
+}
+
+// PublishDueSettlements 投递已过退款期限的已完成订单结算消息（定时任务调用），返回投递订单数
+// 结算推迟到部分退款期限结束后，商家应收按最终退款后的金额计算，无需冲销已结算金额
+func (s *orderService) PublishDueSettlements(ctx context.Context) (int, error) {
+	createdBefore := time.Now().Add(-refundWindowDays*24*time.Hour - settleGrace)
+	orders, err := s.orderRepo.ListSettleDueOrders(ctx, createdBefore, 100)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, order := range orders {
+		if err = s.publishOrderCompleted(ctx, order); err != nil {
+			zap.L().Error("投递订单完成消息失败", zap.Int64("order_id", order.OrderID), zap.Error(err))
+			continue
+		}
+		count++
+	}
+	if count > 0 {
+		zap.L().Info("投递订单完成消息完成", zap.Int("count", count))
+	}
+	return count, nil
+}
+
+// HandleStockRestore 库存恢复补偿消息处理（按恢复流水号幂等，重复消息由商品服务跳过）
+func HandleStockRestore(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var event kafka.StockRestoreEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		zap.L().Error("库存恢复补偿消息格式错误", zap.ByteString("value", msg.Value), zap.Error(err))
+		return nil // 格式错误无法重试，直接跳过
+	}
+	if err := restoreItemStock(ctx, event.RestoreNo, event.UserID, event.ProductID, event.SkuID, event.Num); err != nil {
+		return err
+	}
+	zap.L().Info("库存恢复补偿成功", zap.Any("event", event))
+	return nil
+}
+
+// HandlePaymentPaid 支付成功消息处理：待支付订单流转为待接单；订单已取消则触发退款
+func (s *orderService) HandlePaymentPaid(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var event kafka.PaymentPaidEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		zap.L().Error("支付成功消息格式错误", zap.ByteString("value", msg.Value), zap.Error(err))
+		return nil // 格式错误无法重试，直接跳过
+	}
+
+	marked, err := s.orderRepo.MarkOrderPaid(ctx, event.OrderID, event.PaidTime)
+	if err != nil {
+		return err
+	}
+	if marked {
+		zap.L().Info("订单支付成功，待商家接单", zap.Int64("order_id", event.OrderID), zap.String("payment_no", event.PaymentNo))
+		return nil
+	}
+
+	// 未更新：重复消息直接跳过；订单已取消（如超时）则原路退款
+	order, err := s.orderRepo.GetOrderByID(ctx, event.OrderID)
+	if err != nil {
+		return err
+	}
+	if order.PaidTime == nil && order.Status == "已取消" {
+		zap.L().Warn("订单已取消后收到支付成功，触发退款", zap.Int64("order_id", event.OrderID), zap.String("payment_no", event.PaymentNo))
+		s.refundOrder(ctx, order, kafka.RefundSceneExpire, "订单已取消，支付自动退款")
+	}
+	return nil
+}
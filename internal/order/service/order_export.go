@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/export"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/pagination"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// exportBatchSize 导出时每批查询的订单数（控制内存占用）
+const exportBatchSize = 200
+
+// exportColumn 导出列定义（每个订单项一行，订单字段在各行重复）
+type exportColumn struct {
+	header  string
+	numeric bool
+	value   func(o *OrderInfoResult, item *OrderItemResult) string
+}
+
+// exportColumns 可选导出列
+var exportColumns = map[string]exportColumn{
+	"order_no":        {header: "订单号", value: func(o *OrderInfoResult, _ *OrderItemResult) string { return o.OrderNo }},
+	"create_time":     {header: "下单时间", value: func(o *OrderInfoResult, _ *OrderItemResult) string { return o.CreateTime }},
+	"paid_time":       {header: "支付时间", value: func(o *OrderInfoResult, _ *OrderItemResult) string { return o.PaidTime }},
+	"status":          {header: "订单状态", value: func(o *OrderInfoResult, _ *OrderItemResult) string { return o.Status }},
+	"user_name":       {header: "收货人", value: func(o *OrderInfoResult, _ *OrderItemResult) string { return o.UserName }},
+	"user_phone":      {header: "收货人电话", value: func(o *OrderInfoResult, _ *OrderItemResult) string { return o.UserPhone }},
+	"address":         {header: "收货地址", value: func(o *OrderInfoResult, _ *OrderItemResult) string { return o.Address }},
+	"remark":          {header: "备注", value: func(o *OrderInfoResult, _ *OrderItemResult) string { return o.Remark }},
+	"goods_amount":    {header: "商品金额", numeric: true, value: func(o *OrderInfoResult, _ *OrderItemResult) string { return formatAmount(o.GoodsAmount) }},
+	"packing_fee":     {header: "打包费", numeric: true, value: func(o *OrderInfoResult, _ *OrderItemResult) string { return formatAmount(o.PackingFee) }},
+	"delivery_fee":    {header: "配送费", numeric: true, value: func(o *OrderInfoResult, _ *OrderItemResult) string { return formatAmount(o.DeliveryFee) }},
+	"discount_amount": {header: "优惠金额", numeric: true, value: func(o *OrderInfoResult, _ *OrderItemResult) string { return formatAmount(o.DiscountAmount) }},
+	"total_amount":    {header: "实付金额", numeric: true, value: func(o *OrderInfoResult, _ *OrderItemResult) string { return formatAmount(o.TotalAmount) }},
+	"refund_amount":   {header: "退款金额", numeric: true, value: func(o *OrderInfoResult, _ *OrderItemResult) string { return formatAmount(o.RefundAmount) }},
+	"product_id": {header: "商品ID", numeric: true, value: func(_ *OrderInfoResult, item *OrderItemResult) string {
+		if item == nil {
+			return ""
+		}
+		return strconv.FormatInt(item.ProductID, 10)
+	}},
+	"product_name": {header: "商品名称", value: func(_ *OrderInfoResult, item *OrderItemResult) string {
+		if item == nil {
+			return ""
+		}
+		return item.ProductName
+	}},
+	"spec": {header: "规格/属性", value: func(_ *OrderInfoResult, item *OrderItemResult) string {
+		if item == nil {
+			return ""
+		}
+		return specText(item)
+	}},
+	"price": {header: "单价", numeric: true, value: func(_ *OrderInfoResult, item *OrderItemResult) string {
+		if item == nil {
+			return ""
+		}
+		return formatAmount(item.Price)
+	}},
+	"quantity": {header: "数量", numeric: true, value: func(_ *OrderInfoResult, item *OrderItemResult) string {
+		if item == nil {
+			return ""
+		}
+		return strconv.Itoa(int(item.Quantity))
+	}},
+	"refunded_qty": {header: "退款数量", numeric: true, value: func(_ *OrderInfoResult, item *OrderItemResult) string {
+		if item == nil {
+			return ""
+		}
+		return strconv.Itoa(int(item.RefundedQty))
+	}},
+	"item_total": {header: "商品小计", numeric: true, value: func(_ *OrderInfoResult, item *OrderItemResult) string {
+		if item == nil {
+			return ""
+		}
+		return formatAmount(item.TotalPrice)
+	}},
+}
+
+// defaultExportColumns 默认导出列
+var defaultExportColumns = []string{
+	"order_no", "create_time", "paid_time", "status", "user_name", "user_phone",
+	"product_name", "spec", "price", "quantity", "refunded_qty", "item_total",
+	"goods_amount", "packing_fee", "delivery_fee", "discount_amount", "total_amount", "refund_amount",
+}
+
+// 入参结构体
+type ExportMerchantOrdersParam struct {
+	MerchantID int64            `validate:"required,gt=0"`
+	Format     string           `validate:"required,oneof=csv xlsx"`
+	Columns    []string         `validate:"omitempty,max=32,unique"`
+	Filter     OrderFilterParam // 必须指定下单时间段
+}
+
+// 响应结构体
+type ExportOrdersResult struct {
+	Orders int64 `json:"orders"` // 导出订单数
+	Rows   int64 `json:"rows"`   // 导出数据行数（每个订单项一行）
+}
+
+// ExportMerchantOrders 导出商家订单到w（按下单时间正序分批查询，边查边写）
+// 参数或鉴权失败时不会向w写入任何内容
+func (s *orderService) ExportMerchantOrders(ctx context.Context, param ExportMerchantOrdersParam, w io.Writer) (ExportOrdersResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("导出订单参数校验失败", zap.Int64("merchant_id", param.MerchantID), zap.String("format", param.Format), zap.Error(err))
+		return ExportOrdersResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+	if param.Filter.StartTime == "" || param.Filter.EndTime == "" {
+		return ExportOrdersResult{}, utils.NewParamError("导出需指定下单时间段")
+	}
+	columnKeys := param.Columns
+	if len(columnKeys) == 0 {
+		columnKeys = defaultExportColumns
+	}
+	columns := make([]exportColumn, 0, len(columnKeys))
+	for _, key := range columnKeys {
+		col, ok := exportColumns[key]
+		if !ok {
+			return ExportOrdersResult{}, utils.NewParamError("不支持的导出列：" + key)
+		}
+		columns = append(columns, col)
+	}
+	filter, err := buildOrderFilter(param.Filter)
+	if err != nil {
+		return ExportOrdersResult{}, err
+	}
+	filter.MerchantID = param.MerchantID
+	filter.PaidOnly = true
+	filter.SortBy = repo.OrderSortCreateTimeAsc
+
+	// 2. 鉴权：商家仅可导出本店订单（手机号脱敏），平台运营可导出任意商家
+	claims, err := middleware.CheckRole(ctx, "merchant", "admin")
+	if err != nil {
+		return ExportOrdersResult{}, err
+	}
+	maskPhone := claims.Role == "merchant"
+	if maskPhone {
+		if err = middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+			return ExportOrdersResult{}, err
+		}
+	}
+
+	// 3. 写表头
+	writer, err := export.NewWriter(param.Format, w)
+	if err != nil {
+		return ExportOrdersResult{}, err
+	}
+	header := make([]export.Cell, 0, len(columns))
+	for _, col := range columns {
+		header = append(header, export.Cell{Value: col.header})
+	}
+	if err = writer.WriteRow(header); err != nil {
+		return ExportOrdersResult{}, utils.NewSystemError("写入导出文件失败：" + err.Error())
+	}
+
+	// 4. 分批查询并写入
+	var result ExportOrdersResult
+	row := make([]export.Cell, len(columns))
+	writeRow := func(o *OrderInfoResult, item *OrderItemResult) error {
+		for i, col := range columns {
+			row[i] = export.Cell{Value: col.value(o, item), Numeric: col.numeric}
+		}
+		result.Rows++
+		return writer.WriteRow(row)
+	}
+	page := pagination.Param{PageSize: exportBatchSize}
+	for {
+		if err = ctx.Err(); err != nil {
+			return result, utils.NewSystemError("导出已取消")
+		}
+		orders, pageResult, err := s.orderRepo.SearchOrders(ctx, filter, page)
+		if err != nil {
+			return result, err
+		}
+		orderResults, err := s.toOrderResults(ctx, orders)
+		if err != nil {
+			return result, err
+		}
+		for i := range orderResults {
+			o := &orderResults[i]
+			if maskPhone {
+				o.UserPhone = utils.MaskPhone(o.UserPhone)
+			}
+			result.Orders++
+			if len(o.Items) == 0 {
+				err = writeRow(o, nil)
+			}
+			for j := range o.Items {
+				if err = writeRow(o, &o.Items[j]); err != nil {
+					break
+				}
+			}
+			if err != nil {
+				return result, utils.NewSystemError("写入导出文件失败：" + err.Error())
+			}
+		}
+		if pageResult.NextCursor == "" {
+			break
+		}
+		page.Cursor = pageResult.NextCursor
+	}
+
+	// 5. 写入文件尾
+	if err = writer.Close(); err != nil {
+		return result, utils.NewSystemError("写入导出文件失败：" + err.Error())
+	}
+	zap.L().Info("导出商家订单完成", zap.Int64("merchant_id", param.MerchantID), zap.String("format", param.Format),
+		zap.Int64("orders", result.Orders), zap.Int64("rows", result.Rows))
+	return result, nil
+}
+
+// formatAmount 金额格式化为两位小数
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
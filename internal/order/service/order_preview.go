@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/client"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/pricing"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/promotion"
+	productProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/product/proto"
+	userProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/user/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+type PreviewOrderParam struct {
+	UserID     int64              `validate:"required,gt=0"`
+	MerchantID int64              `validate:"required,gt=0"`
+	Items      []PreviewItemParam `validate:"required,min=1,dive"`
+	AddressID  int64              `validate:"gte=0"` // 可选
+	CouponIDs  []int64            `validate:"omitempty,max=2,unique,dive,gt=0"`
+	TipAmount  float64            `validate:"gte=0,lte=200"` // 骑手小费
+}
+
+type PreviewItemParam struct {
+	ProductID int64   `validate:"required,gt=0"`
+	Quantity  int32   `validate:"required,gt=0"`
+	SkuID     int64   `validate:"gte=0"`
+	OptionIDs []int64 `validate:"omitempty,max=20,unique,dive,gt=0"`
+}
+
+type PreviewOrderResult struct {
+	Items              []PreviewItemResult   `json:"items"`
+	Fee                FeeDetailResult       `json:"fee"`
+	Discounts          []OrderDiscountResult `json:"discounts"`
+	EtaMinutes         int32                 `json:"eta_minutes"`
+	ExpectDeliveryTime string                `json:"expect_delivery_time"`
+	Address            string                `json:"address"`
+	Errors             []string              `json:"errors"` // 阻断下单的问题
+	CanOrder           bool                  `json:"can_order"`
+}
+
+type PreviewItemResult struct {
+	ProductID         int64              `json:"product_id"`
+	ProductName       string             `json:"product_name"`
+	Price             float64            `json:"price"`
+	PackingFee        float64            `json:"packing_fee"`
+	Quantity          int32              `json:"quantity"`
+	TotalPrice        float64            `json:"total_price"`
+	Stock             int32              `json:"stock"`
+	Available         bool               `json:"available"`
+	UnavailableReason string             `json:"unavailable_reason"`
+	SkuID             int64              `json:"sku_id"`
+	SkuName           string             `json:"sku_name"`
+	Options           []ItemOptionResult `json:"options"` // 单价已含属性加价
+}
+
+// PreviewOrder 订单预览：按当前价格、库存、地址和优惠券试算，不扣库存、不写库
+// 业务问题（打烊、缺货、起送价、优惠券不可用等）收集到Errors中返回，不中断试算
+func (s *orderService) PreviewOrder(ctx context.Context, param PreviewOrderParam) (PreviewOrderResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("订单预览参数校验失败", zap.Any("param", param), zap.Error(err))
+		return PreviewOrderResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "user", param.UserID); err != nil {
+		return PreviewOrderResult{}, err
+	}
+
+	var result PreviewOrderResult
+	blocking := func(err error) error {
+		msg, ok := bizMessage(err)
+		if !ok {
+			return err
+		}
+		result.Errors = append(result.Errors, msg)
+		return nil
+	}
+
+	// 2. 收货地址（坐标用于计算配送距离）
+	var userLng, userLat float64
+	if param.AddressID > 0 {
+		addr, err := fetchAddress(ctx, param.UserID, param.AddressID)
+		if err != nil {
+			if err = blocking(err); err != nil {
+				return PreviewOrderResult{}, err
+			}
+		} else {
+			result.Address = formatAddress(addr)
+			userLng, userLat = addr.Longitude, addr.Latitude
+		}
+	} else {
+		result.Errors = append(result.Errors, "请选择收货地址")
+	}
+
+	// 3. 商家（不存在时无法试算，直接返回）
+	merchant, err := fetchMerchant(ctx, param.MerchantID)
+	if err != nil {
+		return PreviewOrderResult{}, err
+	}
+	if !merchant.IsOpen {
+		result.Errors = append(result.Errors, "商家已打烊")
+	}
+
+	// 4. 商品：逐项校验当前价格、归属及库存，只有可购买商品参与计价
+	var pricingItems []pricing.Item
+	for _, item := range param.Items {
+		line, err := previewItem(ctx, param.MerchantID, item)
+		if err != nil {
+			return PreviewOrderResult{}, err
+		}
+		if line.Available {
+			pricingItems = append(pricingItems, pricing.Item{
+				Price:      line.Price,
+				PackingFee: line.PackingFee,
+				Quantity:   line.Quantity,
+			})
+		} else {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s：%s", line.ProductName, line.UnavailableReason))
+		}
+		result.Items = append(result.Items, line)
+	}
+
+	// 5. 商品金额、打包费、配送费（起送价、配送范围不满足时仍返回已算出的部分）
+	now := time.Now()
+	fee, err := pricing.Calculate(config.Cfg.Pricing, pricing.Param{
+		Items:          pricingItems,
+		MinOrderAmount: float64(merchant.MinOrderAmount),
+		MerchantLng:    merchant.Longitude,
+		MerchantLat:    merchant.Latitude,
+		DeliveryRadius: float64(merchant.DeliveryRadius),
+		UserLng:        userLng,
+		UserLat:        userLat,
+		OrderTime:      now,
+	})
+	if err != nil {
+		if err = blocking(err); err != nil {
+			return PreviewOrderResult{}, err
+		}
+	}
+
+	// 6. 优惠（优惠券不可用时按无券试算）
+	promo, err := s.calcDiscount(ctx, param.UserID, param.MerchantID, fee.GoodsAmount, param.CouponIDs)
+	if err != nil {
+		if err = blocking(err); err != nil {
+			return PreviewOrderResult{}, err
+		}
+		promo = promotion.Result{GoodsAmount: fee.GoodsAmount, PayAmount: fee.GoodsAmount}
+	}
+	for _, line := range promo.Lines {
+		result.Discounts = append(result.Discounts, OrderDiscountResult{
+			UserCouponID: line.UserCouponID,
+			CouponID:     line.CouponID,
+			CouponName:   line.Name,
+			Type:         line.Type,
+			Amount:       line.Amount,
+		})
+	}
+
+	// 7. 汇总费用及预计送达时间
+	tip := utils.RoundMoney(param.TipAmount)
+	quote := orderQuote{
+		merchantName: merchant.Name,
+		fee:          fee,
+		promo:        promo,
+		tipAmount:    tip,
+		totalAmount:  utils.RoundMoney(promo.PayAmount + fee.PackingFee + fee.DeliveryFee + tip),
+	}
+	result.Fee = quote.feeDetail()
+	result.EtaMinutes = pricing.EstimateMinutes(fee.DeliveryDistance)
+	result.ExpectDeliveryTime = now.Add(time.Duration(result.EtaMinutes) * time.Minute).Format("2006-01-02 15:04:05")
+	result.CanOrder = len(result.Errors) == 0
+	return result, nil
+}
+
+// previewItem 查询商品当前价格与库存（含所选规格及属性），判断是否可购买
+func previewItem(ctx context.Context, merchantID int64, item PreviewItemParam) (PreviewItemResult, error) {
+	line := PreviewItemResult{ProductID: item.ProductID, Quantity: item.Quantity, SkuID: item.SkuID}
+	productResp, err := client.ProductClient.GetProductByID(ctx, &productProto.GetProductRequest{ProductId: item.ProductID})
+	if err != nil {
+		zap.L().Error("调用商品服务查询商品失败", zap.Int64("product_id", item.ProductID), zap.Error(err))
+		return line, utils.NewSystemError("商品服务异常")
+	}
+	if productResp.Code != utils.ErrCodeSuccess || productResp.Product == nil {
+		line.ProductName = fmt.Sprintf("商品%d", item.ProductID)
+		line.UnavailableReason = "商品不存在或已下架"
+		return line, nil
+	}
+
+	product := productResp.Product
+	line.ProductName = product.Name
+	line.Price = float64(product.Price)
+	line.PackingFee = float64(product.PackingFee)
+	line.Stock = product.Stock
+	spec, specErr := resolveItemSpec(product, item.SkuID, item.OptionIDs)
+	if specErr == nil {
+		line.Price = spec.price
+		line.Stock = spec.stock
+		line.SkuName = spec.skuName
+		line.Options = toItemOptionResults(spec.options)
+	}
+	line.TotalPrice = utils.RoundMoney(line.Price * float64(item.Quantity))
+	switch {
+	case product.MerchantId != merchantID:
+		line.UnavailableReason = "商品不属于该商家"
+	case specErr != nil:
+		line.UnavailableReason, _ = bizMessage(specErr)
+	case product.UnavailableReason != "":
+		line.UnavailableReason = product.UnavailableReason
+	case line.Stock <= 0:
+		line.UnavailableReason = "商品已售罄"
+	case line.Stock < item.Quantity:
+		line.UnavailableReason = fmt.Sprintf("库存不足，剩余%d件", line.Stock)
+	}
+	line.Available = line.UnavailableReason == ""
+	return line, nil
+}
+
+// fetchAddress 调用用户服务查询收货地址（用户服务校验地址归属）
+func fetchAddress(ctx context.Context, userID, addressID int64) (*userProto.Address, error) {
+	resp, err := client.UserClient.GetAddress(ctx, &userProto.GetAddressRequest{UserId: userID, AddressId: addressID})
+	if err != nil {
+		zap.L().Error("调用用户服务查询地址失败", zap.Int64("address_id", addressID), zap.Error(err))
+		return nil, utils.NewSystemError("用户服务异常")
+	}
+	if resp.Code != utils.ErrCodeSuccess {
+		return nil, utils.NewAppError(int(resp.Code), resp.Msg)
+	}
+	return resp.Address, nil
+}
+
+// formatAddress 拼接完整收货地址
+func formatAddress(addr *userProto.Address) string {
+	return addr.Province + addr.City + addr.District + addr.Detail
+}
+
+// bizMessage 参数/业务错误返回提示信息，其他错误（系统、数据库、鉴权）返回false
+func bizMessage(err error) (string, bool) {
+	var appErr *utils.AppError
+	if !errors.As(err, &appErr) {
+		return "", false
+	}
+	if appErr.Code != utils.ErrCodeBiz && appErr.Code != utils.ErrCodeParam {
+		return "", false
+	}
+	return appErr.Message, true
+}
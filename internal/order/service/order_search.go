@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/pagination"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// maxOrderSearchSpan 下单时间筛选的最大跨度
+const maxOrderSearchSpan = 92 * 24 * time.Hour
+
+// OrderFilterParam 订单筛选条件（零值表示不限）
+type OrderFilterParam struct {
+	Statuses      []string `validate:"omitempty,max=8,dive,oneof=待支付 待接单 已接单 已拒单 待配送 配送中 已完成 已取消"`
+	StartTime     string   `validate:"omitempty,datetime=2006-01-02 15:04:05"`
+	EndTime       string   `validate:"omitempty,datetime=2006-01-02 15:04:05"`
+	OrderNoPrefix string   `validate:"omitempty,number,max=64"`
+	UserPhone     string   `validate:"omitempty,number,len=4|len=11"` // 完整手机号或尾号4位
+	MinAmount     float64  `validate:"gte=0"`
+	MaxAmount     float64  `validate:"omitempty,gtefield=MinAmount"`
+	SortBy        string   `validate:"omitempty,oneof=create_time_desc create_time_asc amount_desc amount_asc"`
+}
+
+type SearchOrdersParam struct {
+	UserID     int64 `validate:"gte=0"`
+	MerchantID int64 `validate:"gte=0"`
+	Filter     OrderFilterParam
+	Page       int32  `validate:"gte=0"` // 0表示游标分页
+	PageSize   int32  `validate:"required,gte=10,lte=100"`
+	Cursor     string `validate:"omitempty,max=256"`
+	WithTotal  bool
+}
+
+// buildOrderFilter 筛选参数转换为Repo检索条件（参数需已通过校验）
+func buildOrderFilter(param OrderFilterParam) (repo.OrderFilter, error) {
+	filter := repo.OrderFilter{
+		Statuses:      param.Statuses,
+		OrderNoPrefix: param.OrderNoPrefix,
+		MinAmount:     utils.RoundMoney(param.MinAmount), // proto为float，消除精度误差
+		MaxAmount:     utils.RoundMoney(param.MaxAmount),
+		SortBy:        param.SortBy,
+	}
+	if len(param.UserPhone) == 4 {
+		filter.UserPhoneTail = param.UserPhone
+	} else {
+		filter.UserPhone = param.UserPhone
+	}
+
+	var err error
+	if param.StartTime != "" {
+		if filter.StartTime, err = time.ParseInLocation("2006-01-02 15:04:05", param.StartTime, time.Local); err != nil {
+			return repo.OrderFilter{}, utils.NewParamError("开始时间格式错误")
+		}
+	}
+	if param.EndTime != "" {
+		if filter.EndTime, err = time.ParseInLocation("2006-01-02 15:04:05", param.EndTime, time.Local); err != nil {
+			return repo.OrderFilter{}, utils.NewParamError("结束时间格式错误")
+		}
+	}
+	if !filter.StartTime.IsZero() && !filter.EndTime.IsZero() {
+		if filter.EndTime.Before(filter.StartTime) {
+			return repo.OrderFilter{}, utils.NewParamError("结束时间不能早于开始时间")
+		}
+		if filter.EndTime.Sub(filter.StartTime) > maxOrderSearchSpan {
+			return repo.OrderFilter{}, utils.NewParamError("下单时间跨度不能超过92天")
+		}
+	}
+	return filter, nil
+}
+
+// SearchOrders 订单检索（仅平台客服可用，结果中的手机号脱敏）
+// 必须指定至少一个高选择性条件（用户、商家、8位以上订单号前缀、完整手机号或完整时间段），避免全表扫描
+func (s *orderService) SearchOrders(ctx context.Context, param SearchOrdersParam) (ListOrdersResult, error) {
+	// 1. 鉴权
+	if _, err := middleware.CheckRole(ctx, "admin"); err != nil {
+		return ListOrdersResult{}, err
+	}
+
+	// 2. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("检索订单参数校验失败", zap.Int64("user_id", param.UserID), zap.Int64("merchant_id", param.MerchantID),
+			zap.String("user_phone", utils.MaskPhone(param.Filter.UserPhone)), zap.Error(err))
+		return ListOrdersResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+	filter, err := buildOrderFilter(param.Filter)
+	if err != nil {
+		return ListOrdersResult{}, err
+	}
+	filter.UserID = param.UserID
+	filter.MerchantID = param.MerchantID
+
+	scoped := filter.UserID > 0 || filter.MerchantID > 0
+	if filter.UserPhoneTail != "" && !scoped {
+		return ListOrdersResult{}, utils.NewParamError("按手机尾号检索需同时指定用户或商家")
+	}
+	if !scoped && len(filter.OrderNoPrefix) < 8 && filter.UserPhone == "" &&
+		(filter.StartTime.IsZero() || filter.EndTime.IsZero()) {
+		return ListOrdersResult{}, utils.NewParamError("请至少指定用户、商家、订单号前缀（8位以上）、完整手机号或下单时间段")
+	}
+
+	// 3. 调用Repo检索订单
+	orders, page, err := s.orderRepo.SearchOrders(ctx, filter, pagination.Param{
+		Page:      param.Page,
+		PageSize:  param.PageSize,
+		Cursor:    param.Cursor,
+		WithTotal: param.WithTotal,
+	})
+	if err != nil {
+		return ListOrdersResult{}, err
+	}
+
+	// 4. 批量查询订单项并转换
+	resultOrders, err := s.toOrderResults(ctx, orders)
+	if err != nil {
+		return ListOrdersResult{}, err
+	}
+	for i := range resultOrders {
+		resultOrders[i].UserPhone = utils.MaskPhone(resultOrders[i].UserPhone)
+	}
+
+	// 5. 组装结果
+	return ListOrdersResult{
+		Orders:     resultOrders,
+		Total:      int32(page.Total),
+		Page:       param.Page,
+		PageSize:   param.PageSize,
+		NextCursor: page.NextCursor,
+	}, nil
+}
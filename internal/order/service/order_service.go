@@ -2,11 +2,24 @@ package service
 
 import (
 	"context"
+	"io"
+	"math"
+	"strconv"
+	"time"
 
+	"github.com/IBM/sarama"
+	merchantProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/merchant/proto"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/client"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/pricing"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/promotion"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo/model"
 	productProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/product/proto"
+	userProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/user/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/pagination"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
 	"github.com/go-playground/validator/v10"
 	"go.uber.org/zap"
@@ -15,13 +28,13 @@ import (
 // 入参结构体
 type CreateOrderParam struct {
 	UserID             int64            `validate:"required,gt=0"`
-	UserName           string           `validate:"required,min=2"`
-	UserPhone          string           `validate:"required,regexp=^1[3-9]\\d{9}$"`
 	MerchantID         int64            `validate:"required,gt=0"`
 	Items              []OrderItemParam `validate:"required,min=1"`
 	TotalAmount        float64          `validate:"required,gt=0"`
-	Address            string           `validate:"required,min=5"`
+	AddressID          int64            `validate:"required,gt=0"` // 用户收货地址ID（收货人、电话、地址、坐标均以此为准）
 	ExpectDeliveryTime string           `validate:"omitempty"`
+	CouponIDs          []int64          `validate:"omitempty,max=2,unique,dive,gt=0"` // 使用的用户优惠券ID
+	TipAmount          float64          `validate:"gte=0,lte=200"`                    // 骑手小费（计入实付，全额归骑手）
 }
 
 type OrderItemParam struct {
@@ -30,6 +43,8 @@ type OrderItemParam struct {
 	Price       float64 `validate:"required,gt=0"`
 	Quantity    int32   `validate:"required,gt=0"`
 	TotalPrice  float64 `validate:"required,gt=0"`
+	SkuID       int64   `validate:"gte=0"`                             // 规格ID（多规格商品必填）
+	OptionIDs   []int64 `validate:"omitempty,max=20,unique,dive,gt=0"` // 所选属性选项ID
 }
 
 type UpdateOrderStatusParam struct {
@@ -40,17 +55,22 @@ type UpdateOrderStatusParam struct {
 }
 
 type ListUserOrdersParam struct {
-	UserID   int64  `validate:"required,gt=0"`
-	Status   string `validate:"omitempty"`
-	Page     int32  `validate:"required,gte=1"`
-	PageSize int32  `validate:"required,gte=10,lte=100"`
+	UserID    int64  `validate:"required,gt=0"`
+	Status    string `validate:"omitempty"`
+	Page      int32  `validate:"gte=0"` // 0表示游标分页
+	PageSize  int32  `validate:"required,gte=10,lte=100"`
+	Cursor    string `validate:"omitempty,max=256"`
+	WithTotal bool
 }
 
 type ListMerchantOrdersParam struct {
 	MerchantID int64  `validate:"required,gt=0"`
 	Status     string `validate:"omitempty"`
-	Page       int32  `validate:"required,gte=1"`
+	Page       int32  `validate:"gte=0"` // 0表示游标分页
 	PageSize   int32  `validate:"required,gte=10,lte=100"`
+	Cursor     string `validate:"omitempty,max=256"`
+	WithTotal  bool
+	Filter     OrderFilterParam // 高级筛选条件（Status非空时并入Statuses）
 }
 
 type CancelOrderParam struct {
@@ -59,45 +79,135 @@ type CancelOrderParam struct {
 	Reason  string `validate:"required,min=2"`
 }
 
+type RefundOrderItemsParam struct {
+	OrderID  int64                  `validate:"required,gt=0"`
+	Operator string                 `validate:"required,min=2"`
+	Items    []RefundOrderItemParam `validate:"required,min=1,dive"`
+	Reason   string                 `validate:"required,min=2"`
+}
+
+type RefundOrderItemParam struct {
+	ItemID   int64 `validate:"required,gt=0"`
+	Quantity int32 `validate:"required,gt=0"`
+}
+
 // 响应结构体
 type CreateOrderResult struct {
-	OrderID int64  `json:"order_id"`
-	OrderNo string `json:"order_no"`
+	OrderID int64           `json:"order_id"`
+	OrderNo string          `json:"order_no"`
+	Fee     FeeDetailResult `json:"fee"`
+}
+
+type FeeDetailResult struct {
+	GoodsAmount      float64 `json:"goods_amount"`
+	PackingFee       float64 `json:"packing_fee"`
+	DeliveryFee      float64 `json:"delivery_fee"`
+	DeliveryDistance int32   `json:"delivery_distance"`
+	DiscountAmount   float64 `json:"discount_amount"`
+	TotalAmount      float64 `json:"total_amount"`
+	TipAmount        float64 `json:"tip_amount"`
+}
+
+type RefundOrderItemsResult struct {
+	RefundNo string  `json:"refund_no"`
+	Amount   float64 `json:"amount"`
 }
 
 type OrderInfoResult struct {
-	OrderID            int64             `json:"order_id"`
-	OrderNo            string            `json:"order_no"`
-	UserID             int64             `json:"user_id"`
-	UserName           string            `json:"user_name"`
-	UserPhone          string            `json:"user_phone"`
-	MerchantID         int64             `json:"merchant_id"`
-	MerchantName       string            `json:"merchant_name"`
-	Items              []OrderItemResult `json:"items"`
-	TotalAmount        float64           `json:"total_amount"`
-	Status             string            `json:"status"`
-	Address            string            `json:"address"`
-	CreateTime         string            `json:"create_time"`
-	UpdateTime         string            `json:"update_time"`
-	ExpectDeliveryTime string            `json:"expect_delivery_time"`
-	Remark             string            `json:"remark"`
+	OrderID            int64                 `json:"order_id"`
+	OrderNo            string                `json:"order_no"`
+	UserID             int64                 `json:"user_id"`
+	UserName           string                `json:"user_name"`
+	UserPhone          string                `json:"user_phone"`
+	MerchantID         int64                 `json:"merchant_id"`
+	MerchantName       string                `json:"merchant_name"`
+	Items              []OrderItemResult     `json:"items"`
+	TotalAmount        float64               `json:"total_amount"`
+	Status             string                `json:"status"`
+	Address            string                `json:"address"`
+	AddressID          int64                 `json:"address_id"`
+	Province           string                `json:"province"`
+	City               string                `json:"city"`
+	District           string                `json:"district"`
+	AddressDetail      string                `json:"address_detail"`
+	Longitude          float64               `json:"longitude"`
+	Latitude           float64               `json:"latitude"`
+	CreateTime         string                `json:"create_time"`
+	UpdateTime         string                `json:"update_time"`
+	ExpectDeliveryTime string                `json:"expect_delivery_time"`
+	Remark             string                `json:"remark"`
+	PaidTime           string                `json:"paid_time"`
+	RefundAmount       float64               `json:"refund_amount"`
+	GoodsAmount        float64               `json:"goods_amount"`
+	DiscountAmount     float64               `json:"discount_amount"`
+	PackingFee         float64               `json:"packing_fee"`
+	DeliveryFee        float64               `json:"delivery_fee"`
+	DeliveryDistance   int32                 `json:"delivery_distance"`
+	TipAmount          float64               `json:"tip_amount"`
+	RiderID            int64                 `json:"rider_id"`
+	Discounts          []OrderDiscountResult `json:"discounts"`
+}
+
+type OrderDiscountResult struct {
+	UserCouponID int64   `json:"user_coupon_id"`
+	CouponID     int64   `json:"coupon_id"`
+	CouponName   string  `json:"coupon_name"`
+	Type         string  `json:"type"`
+	Amount       float64 `json:"amount"`
 }
 
 type OrderItemResult struct {
-	ItemID      int64   `json:"item_id"`
-	OrderID     int64   `json:"order_id"`
-	ProductID   int64   `json:"product_id"`
-	ProductName string  `json:"product_name"`
-	Price       float64 `json:"price"`
-	Quantity    int32   `json:"quantity"`
-	TotalPrice  float64 `json:"total_price"`
+	ItemID      int64              `json:"item_id"`
+	OrderID     int64              `json:"order_id"`
+	ProductID   int64              `json:"product_id"`
+	ProductName string             `json:"product_name"`
+	Price       float64            `json:"price"`
+	Quantity    int32              `json:"quantity"`
+	TotalPrice  float64            `json:"total_price"`
+	RefundedQty int32              `json:"refunded_qty"`
+	SkuID       int64              `json:"sku_id"`
+	SkuName     string             `json:"sku_name"`
+	Options     []ItemOptionResult `json:"options"`
 }
 
 type ListOrdersResult struct {
-	Orders   []OrderInfoResult `json:"orders"`
-	Total    int32             `json:"total"`
-	Page     int32             `json:"page"`
-	PageSize int32             `json:"page_size"`
+	Orders     []OrderInfoResult `json:"orders"`
+	Total      int32             `json:"total"` // 未统计时为-1
+	Page       int32             `json:"page"`
+	PageSize   int32             `json:"page_size"`
+	NextCursor string            `json:"next_cursor"`
+}
+
+// statusRule 订单状态流转规则
+type statusRule struct {
+	role string   // 允许操作的角色
+	from []string // 允许流转的当前状态
+}
+
+// orderStatusFlow 目标状态 → 流转规则（取消订单走CancelOrder，不在此列）
+var orderStatusFlow = map[string]statusRule{
+	"已接单": {role: "merchant", from: []string{"待接单"}},
+	"已拒单": {role: "merchant", from: []string{"待接单"}},
+	"待配送": {role: "rider", from: []string{"已接单"}},
+	"配送中": {role: "rider", from: []string{"待配送"}},
+	"已完成": {role: "rider", from: []string{"配送中"}},
+}
+
+// checkOperator 校验操作人（格式：角色_ID）与JWT Claims一致，返回角色和ID
+func checkOperator(ctx context.Context, operator string) (string, int64, error) {
+	claims, ok := middleware.GetClaims(ctx)
+	if !ok {
+		return "", 0, utils.NewAuthError("未获取到鉴权信息")
+	}
+	if operator != claims.Role+"_"+claims.UserID {
+		zap.L().Warn("操作人与Token身份不一致", zap.String("operator", operator), zap.String("role", claims.Role), zap.String("user_id", claims.UserID))
+		return "", 0, utils.NewAuthError("操作人与登录身份不一致")
+	}
+	operatorID, err := strconv.ParseInt(claims.UserID, 10, 64)
+	if err != nil {
+		return "", 0, utils.NewAuthError("Token身份信息不合法")
+	}
+	return claims.Role, operatorID, nil
 }
 
 // OrderService 订单业务逻辑接口
@@ -108,19 +218,29 @@ type OrderService interface {
 	ListMerchantOrders(ctx context.Context, param ListMerchantOrdersParam) (ListOrdersResult, error)
 	GetOrderByID(ctx context.Context, orderID int64) (OrderInfoResult, error)
 	CancelOrder(ctx context.Context, param CancelOrderParam) error
+	RefundOrderItems(ctx context.Context, param RefundOrderItemsParam) (RefundOrderItemsResult, error)                  // 商家缺货部分退款
+	ExpireUnpaidOrders(ctx context.Context) (int, error)                                                                // 超时未支付订单自动取消
+	RetryStockRestore(ctx context.Context) (int, error)                                                                 // 重试库存未恢复的已取消/已拒单订单
+	PublishDueSettlements(ctx context.Context) (int, error)                                                             // 投递已过退款期限的已完成订单结算消息
+	HandlePaymentPaid(ctx context.Context, msg *sarama.ConsumerMessage) error                                           // 消费支付成功消息
+	PreviewOrder(ctx context.Context, param PreviewOrderParam) (PreviewOrderResult, error)                              // 订单预览（只读试算）
+	SearchOrders(ctx context.Context, param SearchOrdersParam) (ListOrdersResult, error)                                // 订单检索（平台客服）
+	ExportMerchantOrders(ctx context.Context, param ExportMerchantOrdersParam, w io.Writer) (ExportOrdersResult, error) // 导出商家订单（流式写入w）
 }
 
 // orderService 实现
 type orderService struct {
-	orderRepo repo.OrderRepo
-	validate  *validator.Validate
+	orderRepo  repo.OrderRepo
+	couponRepo repo.CouponRepo
+	validate   *validator.Validate
 }
 
 // NewOrderService 创建实例
-func NewOrderService(orderRepo repo.OrderRepo) OrderService {
+func NewOrderService(orderRepo repo.OrderRepo, couponRepo repo.CouponRepo) OrderService {
 	return &orderService{
-		orderRepo: orderRepo,
-		validate:  validator.New(),
+		orderRepo:  orderRepo,
+		couponRepo: couponRepo,
+		validate:   validator.New(),
 	}
 }
 
@@ -132,69 +252,292 @@ func (s *orderService) CreateOrder(ctx context.Context, param CreateOrderParam)
 		return CreateOrderResult{}, utils.NewParamError("参数错误：" + err.Error())
 	}
 
-	// 2. 批量扣减商品库存（调用商品服务）
-	for _, item := range param.Items {
-		deductReq := &productProto.DeductStockRequest{
+	// 仅允许用户本人下单
+	if err := middleware.CheckIdentity(ctx, "user", param.UserID); err != nil {
+		return CreateOrderResult{}, err
+	}
+
+	// 2. 查询收货地址（用户服务校验归属），下单时快照到订单
+	addr, err := fetchAddress(ctx, param.UserID, param.AddressID)
+	if err != nil {
+		return CreateOrderResult{}, err
+	}
+
+	// 3. 服务端计价（商品金额、打包费、配送费、优惠）
+	quote, err := s.quoteOrder(ctx, param, addr)
+	if err != nil {
+		return CreateOrderResult{}, err
+	}
+	promo := quote.promo
+
+	// 4. 转换为模型（订单项，记录所选规格及属性快照）
+	var items []*model.OrderItem
+	for i, item := range param.Items {
+		spec := quote.specs[i]
+		items = append(items, &model.OrderItem{
+			ProductID:   item.ProductID,
+			ProductName: item.ProductName,
+			Price:       item.Price,
+			Quantity:    item.Quantity,
+			TotalPrice:  utils.RoundMoney(item.Price * float64(item.Quantity)),
+			SkuID:       item.SkuID,
+			SkuName:     spec.skuName,
+			Options:     spec.options,
+		})
+	}
+
+	// 5. 批量扣减商品库存（调用商品服务，多规格商品扣减规格库存），失败时恢复已扣减的订单项
+	for i, item := range items {
+		resp, err := client.ProductClient.DeductStock(ctx, &productProto.DeductStockRequest{
 			ProductId: item.ProductID,
 			Num:       item.Quantity,
+			SkuId:     item.SkuID,
+			UserId:    param.UserID,
+		})
+		if err == nil && resp.Code == utils.ErrCodeSuccess {
+			continue
+		}
+		if restoreErr := restoreStock(ctx, param.UserID, 0, items[:i]); restoreErr != nil {
+			zap.L().Error("下单失败恢复库存失败，需人工处理", zap.Int64("user_id", param.UserID), zap.Error(restoreErr))
 		}
-		_, err := client.ProductClient.DeductStock(ctx, deductReq)
 		if err != nil {
-			zap.L().Error("扣减商品库存失败", zap.Int64("product_id", item.ProductID), zap.Error(err))
-			// 库存不足/商品不存在，直接返回
-			return CreateOrderResult{}, utils.NewBizError("商品库存不足或不存在：" + item.ProductName)
+			zap.L().Error("扣减商品库存失败", zap.Int64("product_id", item.ProductID), zap.Int64("sku_id", item.SkuID), zap.Error(err))
+			return CreateOrderResult{}, utils.NewSystemError("下单失败，商品服务异常")
 		}
+		// 库存不足/商品不存在
+		return CreateOrderResult{}, utils.NewAppError(int(resp.Code), resp.Msg+"："+item.ProductName)
 	}
 
-	// 3. 转换为模型（订单主表）
+	// 6. 转换为模型（订单主表）
 	order := &model.Order{
 		UserID:             param.UserID,
-		UserName:           param.UserName,
-		UserPhone:          param.UserPhone,
+		UserName:           addr.Receiver,
+		UserPhone:          addr.Phone,
 		MerchantID:         param.MerchantID,
-		MerchantName:       "测试商家", // TODO：后续对接商家服务获取真实名称
-		TotalAmount:        param.TotalAmount,
-		Status:             "待接单",
-		Address:            param.Address,
+		MerchantName:       quote.merchantName,
+		GoodsAmount:        quote.fee.GoodsAmount,
+		DiscountAmount:     promo.DiscountAmount,
+		PackingFee:         quote.fee.PackingFee,
+		DeliveryFee:        quote.fee.DeliveryFee,
+		DeliveryDistance:   quote.fee.DeliveryDistance,
+		TipAmount:          quote.tipAmount,
+		TotalAmount:        quote.totalAmount,
+		Status:             "待支付",
+		Address:            formatAddress(addr),
+		AddressID:          addr.AddressId,
+		Province:           addr.Province,
+		City:               addr.City,
+		District:           addr.District,
+		AddressDetail:      addr.Detail,
+		Longitude:          addr.Longitude,
+		Latitude:           addr.Latitude,
 		ExpectDeliveryTime: param.ExpectDeliveryTime,
 	}
 
-	// 4. 转换为模型（订单项）
-	var items []*model.OrderItem
-	for _, item := range param.Items {
-		items = append(items, &model.OrderItem{
-			ProductID:   item.ProductID,
-			ProductName: item.ProductName,
-			Price:       item.Price,
-			Quantity:    item.Quantity,
-			TotalPrice:  item.TotalPrice,
+	// 7. 转换为模型（优惠明细）
+	var discounts []*model.OrderDiscount
+	for _, line := range promo.Lines {
+		discounts = append(discounts, &model.OrderDiscount{
+			UserCouponID: line.UserCouponID,
+			CouponID:     line.CouponID,
+			CouponName:   line.Name,
+			Type:         line.Type,
+			Amount:       line.Amount,
 		})
 	}
 
-	// 5. 事务创建订单+订单项
-	if err := s.orderRepo.CreateOrder(ctx, order, items); err != nil {
+	// 8. 事务创建订单+订单项+优惠明细（同时核销优惠券）
+	if err := s.orderRepo.CreateOrder(ctx, order, items, discounts); err != nil {
 		// 订单创建失败，恢复库存
-		for _, item := range param.Items {
-			restoreReq := &productProto.RestoreStockRequest{
-				ProductId: item.ProductID,
-				Num:       item.Quantity,
-			}
-			_, _ = client.ProductClient.RestoreStock(ctx, restoreReq) // 忽略错误，仅日志
+		if restoreErr := restoreStock(ctx, param.UserID, 0, items); restoreErr != nil {
+			zap.L().Error("创建订单失败恢复库存失败，需人工处理", zap.Int64("user_id", param.UserID), zap.Error(restoreErr))
 		}
 		zap.L().Error("创建订单失败，已恢复库存", zap.Int64("user_id", param.UserID), zap.Error(err))
 		return CreateOrderResult{}, err
 	}
 
-	// 6. 组装结果
+	// 9. 组装结果
 	result := CreateOrderResult{
 		OrderID: order.OrderID,
 		OrderNo: order.OrderNo,
+		Fee:     quote.feeDetail(),
 	}
 
 	zap.L().Info("创建订单成功", zap.Int64("order_id", order.OrderID), zap.String("order_no", order.OrderNo), zap.Int64("user_id", param.UserID))
 	return result, nil
 }
 
+// orderQuote 订单报价
+type orderQuote struct {
+	merchantName string
+	fee          pricing.Breakdown
+	promo        promotion.Result
+	tipAmount    float64    // 骑手小费
+	totalAmount  float64    // 实付 = 商品金额 - 优惠 + 打包费 + 配送费 + 小费
+	specs        []itemSpec // 各订单项所选规格及属性（与入参订单项一一对应）
+}
+
+// feeDetail 费用明细
+func (q orderQuote) feeDetail() FeeDetailResult {
+	return FeeDetailResult{
+		GoodsAmount:      q.fee.GoodsAmount,
+		PackingFee:       q.fee.PackingFee,
+		DeliveryFee:      q.fee.DeliveryFee,
+		DeliveryDistance: q.fee.DeliveryDistance,
+		DiscountAmount:   q.promo.DiscountAmount,
+		TotalAmount:      q.totalAmount,
+		TipAmount:        q.tipAmount,
+	}
+}
+
+// quoteOrder 服务端计价：校验商家及商品，按收货地址计算配送费，校验起送价并计算优惠
+func (s *orderService) quoteOrder(ctx context.Context, param CreateOrderParam, addr *userProto.Address) (orderQuote, error) {
+	// 1. 查询商家（营业状态、坐标、起送价）
+	merchant, err := fetchMerchant(ctx, param.MerchantID)
+	if err != nil {
+		return orderQuote{}, err
+	}
+	if !merchant.IsOpen {
+		return orderQuote{}, utils.NewBizError("商家已打烊")
+	}
+
+	// 2. 查询商品（校验归属、规格属性及价格，获取打包费）
+	var pricingItems []pricing.Item
+	specs := make([]itemSpec, 0, len(param.Items))
+	for _, item := range param.Items {
+		productResp, err := client.ProductClient.GetProductByID(ctx, &productProto.GetProductRequest{ProductId: item.ProductID})
+		if err != nil {
+			zap.L().Error("调用商品服务查询商品失败", zap.Int64("product_id", item.ProductID), zap.Error(err))
+			return orderQuote{}, utils.NewSystemError("下单失败，商品服务异常")
+		}
+		if productResp.Code != utils.ErrCodeSuccess {
+			return orderQuote{}, utils.NewBizError("商品不存在：" + item.ProductName)
+		}
+		product := productResp.Product
+		if product.MerchantId != param.MerchantID {
+			return orderQuote{}, utils.NewParamError("商品不属于该商家：" + item.ProductName)
+		}
+		if product.UnavailableReason != "" {
+			return orderQuote{}, utils.NewBizError(product.UnavailableReason + "：" + item.ProductName)
+		}
+		spec, err := resolveItemSpec(product, item.SkuID, item.OptionIDs)
+		if err != nil {
+			msg, _ := bizMessage(err)
+			return orderQuote{}, utils.NewBizError(msg + "：" + item.ProductName)
+		}
+		if math.Abs(spec.price-item.Price) > 0.001 {
+			return orderQuote{}, utils.NewBizError("商品价格已变化，请刷新后重试：" + item.ProductName)
+		}
+		specs = append(specs, spec)
+		pricingItems = append(pricingItems, pricing.Item{
+			Price:      item.Price,
+			PackingFee: float64(product.PackingFee),
+			Quantity:   item.Quantity,
+		})
+	}
+
+	// 3. 计算商品金额、打包费、配送费（校验起送价、配送范围）
+	fee, err := pricing.Calculate(config.Cfg.Pricing, pricing.Param{
+		Items:          pricingItems,
+		MinOrderAmount: float64(merchant.MinOrderAmount),
+		MerchantLng:    merchant.Longitude,
+		MerchantLat:    merchant.Latitude,
+		DeliveryRadius: float64(merchant.DeliveryRadius),
+		UserLng:        addr.Longitude,
+		UserLat:        addr.Latitude,
+		OrderTime:      time.Now(),
+	})
+	if err != nil {
+		return orderQuote{}, err
+	}
+	if math.Abs(fee.GoodsAmount-param.TotalAmount) > 0.01 {
+		return orderQuote{}, utils.NewParamError("订单金额与商品明细不一致")
+	}
+
+	// 4. 计算优惠（仅作用于商品金额）
+	promo, err := s.calcDiscount(ctx, param.UserID, param.MerchantID, fee.GoodsAmount, param.CouponIDs)
+	if err != nil {
+		return orderQuote{}, err
+	}
+
+	tip := utils.RoundMoney(param.TipAmount)
+	return orderQuote{
+		merchantName: merchant.Name,
+		fee:          fee,
+		promo:        promo,
+		tipAmount:    tip,
+		totalAmount:  utils.RoundMoney(promo.PayAmount + fee.PackingFee + fee.DeliveryFee + tip),
+		specs:        specs,
+	}, nil
+}
+
+// fetchMerchant 调用商家服务查询商家信息
+func fetchMerchant(ctx context.Context, merchantID int64) (*merchantProto.Merchant, error) {
+	merchantResp, err := client.MerchantClient.GetMerchantInfo(ctx, &merchantProto.GetMerchantInfoRequest{MerchantId: merchantID})
+	if err != nil {
+		zap.L().Error("调用商家服务查询商家失败", zap.Int64("merchant_id", merchantID), zap.Error(err))
+		return nil, utils.NewSystemError("商家服务异常")
+	}
+	if merchantResp.Code != utils.ErrCodeSuccess {
+		return nil, utils.NewAppError(int(merchantResp.Code), merchantResp.Msg)
+	}
+	return merchantResp.Merchant, nil
+}
+
+// calcDiscount 校验用户优惠券并计算订单优惠
+func (s *orderService) calcDiscount(ctx context.Context, userID, merchantID int64, goodsAmount float64, userCouponIDs []int64) (promotion.Result, error) {
+	if len(userCouponIDs) == 0 {
+		return promotion.Calculate(goodsAmount, merchantID, nil, time.Now())
+	}
+
+	// 1. 校验用户券归属及状态
+	userCoupons, err := s.couponRepo.GetUserCoupons(ctx, userID, userCouponIDs)
+	if err != nil {
+		return promotion.Result{}, err
+	}
+	if len(userCoupons) != len(userCouponIDs) {
+		return promotion.Result{}, utils.NewBizError("优惠券不存在")
+	}
+	couponIDs := make([]int64, 0, len(userCoupons))
+	for _, uc := range userCoupons {
+		if uc.Status != "未使用" {
+			return promotion.Result{}, utils.NewBizError("优惠券已使用")
+		}
+		couponIDs = append(couponIDs, uc.CouponID)
+	}
+
+	// 2. 查询券模板，计算优惠
+	coupons, err := s.couponRepo.GetCouponsByIDs(ctx, couponIDs)
+	if err != nil {
+		return promotion.Result{}, err
+	}
+	couponMap := make(map[int64]*model.Coupon, len(coupons))
+	for _, c := range coupons {
+		couponMap[c.CouponID] = c
+	}
+	var promoCoupons []promotion.Coupon
+	for _, uc := range userCoupons {
+		c, ok := couponMap[uc.CouponID]
+		if !ok {
+			return promotion.Result{}, utils.NewBizError("优惠券不存在")
+		}
+		promoCoupons = append(promoCoupons, promotion.Coupon{
+			UserCouponID: uc.UserCouponID,
+			CouponID:     c.CouponID,
+			Name:         c.Name,
+			Type:         c.Type,
+			MerchantID:   c.MerchantID,
+			Threshold:    c.Threshold,
+			Amount:       c.Amount,
+			Rate:         c.Rate,
+			MaxDiscount:  c.MaxDiscount,
+			StartTime:    c.StartTime,
+			EndTime:      c.EndTime,
+		})
+	}
+	return promotion.Calculate(goodsAmount, merchantID, promoCoupons, time.Now())
+}
+
 // UpdateOrderStatus 更新订单状态
 func (s *orderService) UpdateOrderStatus(ctx context.Context, param UpdateOrderStatusParam) error {
 	// 1. 参数校验
@@ -204,13 +547,57 @@ func (s *orderService) UpdateOrderStatus(ctx context.Context, param UpdateOrderS
 	}
 
 	// 2. 校验状态合法性
-	validStatus := []string{"待接单", "已接单", "待配送", "已完成", "已取消", "已拒单"}
-	if !utils.ContainsString(validStatus, param.Status) {
+	rule, ok := orderStatusFlow[param.Status]
+	if !ok {
 		return utils.NewParamError("订单状态不合法")
 	}
 
-	// 3. 调用Repo更新状态
-	return s.orderRepo.UpdateOrderStatus(ctx, param.OrderID, param.Status, param.Remark)
+	// 3. 校验操作人身份（必须与JWT一致且角色匹配）
+	role, operatorID, err := checkOperator(ctx, param.Operator)
+	if err != nil {
+		return err
+	}
+	if role != rule.role {
+		return utils.NewAuthError("无权限将订单更新为" + param.Status)
+	}
+
+	// 4. 校验订单归属及当前状态
+	order, err := s.orderRepo.GetOrderByID(ctx, param.OrderID)
+	if err != nil {
+		return err
+	}
+	if role == "merchant" && order.MerchantID != operatorID {
+		zap.L().Warn("商家操作非本店订单", zap.Int64("order_id", order.OrderID), zap.String("operator", param.Operator))
+		return utils.NewAuthError("订单不属于该商家")
+	}
+	// 骑手接单（→待配送）时订单尚未分配骑手，其余骑手流转须为已分配的本人
+	if role == "rider" && param.Status != "待配送" && order.RiderID != operatorID {
+		zap.L().Warn("骑手操作非本人配送订单", zap.Int64("order_id", order.OrderID), zap.String("operator", param.Operator))
+		return utils.NewAuthError("订单不属于该骑手")
+	}
+	if !utils.ContainsString(rule.from, order.Status) {
+		return utils.NewBizError("当前订单状态为" + order.Status + "，无法变更为" + param.Status)
+	}
+
+	// 5. 调用Repo更新状态（带当前状态条件，防止并发覆盖；骑手接单时记录骑手）
+	if param.Status == "待配送" {
+		err = s.orderRepo.AssignRider(ctx, param.OrderID, operatorID)
+	} else {
+		err = s.orderRepo.UpdateOrderStatus(ctx, param.OrderID, order.Status, param.Status, param.Remark)
+	}
+	if err != nil {
+		return err
+	}
+
+	// 6. 拒单：恢复库存、退还优惠券并触发退款
+	if param.Status == "已拒单" {
+		if err = s.restoreOrderStock(ctx, order); err != nil {
+			zap.L().Error("拒单恢复库存失败", zap.Int64("order_id", param.OrderID), zap.Error(err))
+		}
+		s.returnCoupons(ctx, param.OrderID)
+		s.refundOrder(ctx, order, kafka.RefundSceneReject, param.Remark)
+	}
+	return nil
 }
 
 // ListUserOrders 查询用户订单列表
@@ -222,61 +609,29 @@ func (s *orderService) ListUserOrders(ctx context.Context, param ListUserOrdersP
 	}
 
 	// 2. 调用Repo查询订单
-	orders, total, err := s.orderRepo.ListUserOrders(ctx, param.UserID, param.Status, param.Page, param.PageSize)
+	orders, page, err := s.orderRepo.ListUserOrders(ctx, param.UserID, param.Status, pagination.Param{
+		Page:      param.Page,
+		PageSize:  param.PageSize,
+		Cursor:    param.Cursor,
+		WithTotal: param.WithTotal,
+	})
 	if err != nil {
 		return ListOrdersResult{}, err
 	}
 
-	// 3. 批量查询订单项
-	var resultOrders []OrderInfoResult
-	for _, o := range orders {
-		// 查询订单项
-		items, err := s.orderRepo.GetOrderItems(ctx, o.OrderID)
-		if err != nil {
-			zap.L().Warn("查询订单项失败，跳过该订单", zap.Int64("order_id", o.OrderID), zap.Error(err))
-			continue
-		}
-
-		// 转换订单项
-		var itemResults []OrderItemResult
-		for _, item := range items {
-			itemResults = append(itemResults, OrderItemResult{
-				ItemID:      item.ItemID,
-				OrderID:     item.OrderID,
-				ProductID:   item.ProductID,
-				ProductName: item.ProductName,
-				Price:       item.Price,
-				Quantity:    item.Quantity,
-				TotalPrice:  item.TotalPrice,
-			})
-		}
-
-		// 转换订单
-		resultOrders = append(resultOrders, OrderInfoResult{
-			OrderID:            o.OrderID,
-			OrderNo:            o.OrderNo,
-			UserID:             o.UserID,
-			UserName:           o.UserName,
-			UserPhone:          o.UserPhone,
-			MerchantID:         o.MerchantID,
-			MerchantName:       o.MerchantName,
-			Items:              itemResults,
-			TotalAmount:        o.TotalAmount,
-			Status:             o.Status,
-			Address:            o.Address,
-			CreateTime:         o.CreateTime.Format("2006-01-02 15:04:05"),
-			UpdateTime:         o.UpdateTime.Format("2006-01-02 15:04:05"),
-			ExpectDeliveryTime: o.ExpectDeliveryTime,
-			Remark:             o.Remark,
-		})
+	// 3. 批量查询订单项并转换
+	resultOrders, err := s.toOrderResults(ctx, orders)
+	if err != nil {
+		return ListOrdersResult{}, err
 	}
 
 	// 4. 组装结果
 	result := ListOrdersResult{
-		Orders:   resultOrders,
-		Total:    int32(total),
-		Page:     param.Page,
-		PageSize: param.PageSize,
+		Orders:     resultOrders,
+		Total:      int32(page.Total),
+		Page:       param.Page,
+		PageSize:   param.PageSize,
+		NextCursor: page.NextCursor,
 	}
 
 	return result, nil
@@ -290,62 +645,41 @@ func (s *orderService) ListMerchantOrders(ctx context.Context, param ListMerchan
 		return ListOrdersResult{}, utils.NewParamError("参数错误：" + err.Error())
 	}
 
-	// 2. 调用Repo查询订单
-	orders, total, err := s.orderRepo.ListMerchantOrders(ctx, param.MerchantID, param.Status, param.Page, param.PageSize)
+	// 2. 构建筛选条件（商家仅可见已支付订单）
+	filter, err := buildOrderFilter(param.Filter)
 	if err != nil {
 		return ListOrdersResult{}, err
 	}
+	filter.MerchantID = param.MerchantID
+	filter.PaidOnly = true
+	if param.Status != "" {
+		filter.Statuses = append(filter.Statuses, param.Status)
+	}
 
-	// 3. 批量查询订单项
-	var resultOrders []OrderInfoResult
-	for _, o := range orders {
-		// 查询订单项
-		items, err := s.orderRepo.GetOrderItems(ctx, o.OrderID)
-		if err != nil {
-			zap.L().Warn("查询订单项失败，跳过该订单", zap.Int64("order_id", o.OrderID), zap.Error(err))
-			continue
-		}
-
-		// 转换订单项
-		var itemResults []OrderItemResult
-		for _, item := range items {
-			itemResults = append(itemResults, OrderItemResult{
-				ItemID:      item.ItemID,
-				OrderID:     item.OrderID,
-				ProductID:   item.ProductID,
-				ProductName: item.ProductName,
-				Price:       item.Price,
-				Quantity:    item.Quantity,
-				TotalPrice:  item.TotalPrice,
-			})
-		}
+	// 3. 调用Repo查询订单
+	orders, page, err := s.orderRepo.SearchOrders(ctx, filter, pagination.Param{
+		Page:      param.Page,
+		PageSize:  param.PageSize,
+		Cursor:    param.Cursor,
+		WithTotal: param.WithTotal,
+	})
+	if err != nil {
+		return ListOrdersResult{}, err
+	}
 
-		// 转换订单
-		resultOrders = append(resultOrders, OrderInfoResult{
-			OrderID:            o.OrderID,
-			OrderNo:            o.OrderNo,
-			UserID:             o.UserID,
-			UserName:           o.UserName,
-			UserPhone:          o.UserPhone,
-			MerchantID:         o.MerchantID,
-			MerchantName:       o.MerchantName,
-			Items:              itemResults,
-			TotalAmount:        o.TotalAmount,
-			Status:             o.Status,
-			Address:            o.Address,
-			CreateTime:         o.CreateTime.Format("2006-01-02 15:04:05"),
-			UpdateTime:         o.UpdateTime.Format("2006-01-02 15:04:05"),
-			ExpectDeliveryTime: o.ExpectDeliveryTime,
-			Remark:             o.Remark,
-		})
+	// 4. 批量查询订单项并转换
+	resultOrders, err := s.toOrderResults(ctx, orders)
+	if err != nil {
+		return ListOrdersResult{}, err
 	}
 
-	// 4. 组装结果
+	// 5. 组装结果
 	result := ListOrdersResult{
-		Orders:   resultOrders,
-		Total:    int32(total),
-		Page:     param.Page,
-		PageSize: param.PageSize,
+		Orders:     resultOrders,
+		Total:      int32(page.Total),
+		Page:       param.Page,
+		PageSize:   param.PageSize,
+		NextCursor: page.NextCursor,
 	}
 
 	return result, nil
@@ -371,38 +705,15 @@ func (s *orderService) GetOrderByID(ctx context.Context, orderID int64) (OrderIn
 		items = []*model.OrderItem{} // 空列表，不影响主信息
 	}
 
-	// 4. 转换订单项
-	var itemResults []OrderItemResult
-	for _, item := range items {
-		itemResults = append(itemResults, OrderItemResult{
-			ItemID:      item.ItemID,
-			OrderID:     item.OrderID,
-			ProductID:   item.ProductID,
-			ProductName: item.ProductName,
-			Price:       item.Price,
-			Quantity:    item.Quantity,
-			TotalPrice:  item.TotalPrice,
-		})
+	// 4. 查询优惠明细
+	discounts, err := s.couponRepo.GetOrderDiscounts(ctx, orderID)
+	if err != nil {
+		zap.L().Warn("查询订单优惠明细失败", zap.Int64("order_id", orderID), zap.Error(err))
 	}
 
 	// 5. 组装结果
-	result := OrderInfoResult{
-		OrderID:            order.OrderID,
-		OrderNo:            order.OrderNo,
-		UserID:             order.UserID,
-		UserName:           order.UserName,
-		UserPhone:          order.UserPhone,
-		MerchantID:         order.MerchantID,
-		MerchantName:       order.MerchantName,
-		Items:              itemResults,
-		TotalAmount:        order.TotalAmount,
-		Status:             order.Status,
-		Address:            order.Address,
-		CreateTime:         order.CreateTime.Format("2006-01-02 15:04:05"),
-		UpdateTime:         order.UpdateTime.Format("2006-01-02 15:04:05"),
-		ExpectDeliveryTime: order.ExpectDeliveryTime,
-		Remark:             order.Remark,
-	}
+	result := toOrderInfoResult(order, items)
+	result.Discounts = toOrderDiscountResults(discounts)
 
 	return result, nil
 }
@@ -420,24 +731,171 @@ func (s *orderService) CancelOrder(ctx context.Context, param CancelOrderParam)
 	if err != nil {
 		return err
 	}
-	if order.Status != "待接单" && order.Status != "已拒单" {
-		return utils.NewBizError("仅待接单/已拒单的订单可取消")
+	if order.Status != "待支付" && order.Status != "待接单" && order.Status != "已拒单" {
+		return utils.NewBizError("仅待支付/待接单/已拒单的订单可取消")
+	}
+	if role, operatorID, err := checkOperator(ctx, "user_"+strconv.FormatInt(param.UserID, 10)); err != nil {
+		return err
+	} else if role != "user" || operatorID != order.UserID {
+		return utils.NewAuthError("无权限取消该订单")
 	}
 
-	// 3. 查询订单项，恢复库存
+	// 3. 调用Repo取消订单（条件更新：状态仍为读取时的状态，下文按该状态决定是否退款）
+	if err = s.orderRepo.CancelOrder(ctx, param.OrderID, param.UserID, order.Status, param.Reason); err != nil {
+		return err
+	}
+
+	// 4. 恢复库存（已拒单的订单在拒单时已恢复，幂等跳过）
+	if err = s.restoreOrderStock(ctx, order); err != nil {
+		zap.L().Error("取消订单恢复库存失败", zap.Int64("order_id", param.OrderID), zap.Error(err))
+	}
+
+	// 5. 退还优惠券（幂等）
+	s.returnCoupons(ctx, param.OrderID)
+
+	// 6. 触发退款（已拒单的订单在拒单时已触发）
+	if order.Status == "待接单" {
+		s.refundOrder(ctx, order, kafka.RefundSceneCancel, param.Reason)
+	}
+	return nil
+}
+
+// refundableStatus 允许缺货部分退款的订单状态
+var refundableStatus = []string{"已接单", "待配送", "配送中", "已完成"}
+
+// refundWindowDays 下单后允许部分退款的天数（日统计按此回刷，超期订单的统计不再变化；结算在期限结束后投递）
+const refundWindowDays = 7
+
+// settleGrace 退款期限结束后延迟投递结算的时间（等待期限前发起、仍在处理中的退款落库）
+const settleGrace = time.Hour
+
+// RefundOrderItems 商家缺货部分退款（按订单项单价×退款数量计算退款金额）
+func (s *orderService) RefundOrderItems(ctx context.Context, param RefundOrderItemsParam) (RefundOrderItemsResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("部分退款参数校验失败", zap.Any("param", param), zap.Error(err))
+		return RefundOrderItemsResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+
+	// 2. 校验操作人（仅订单所属商家）
+	role, operatorID, err := checkOperator(ctx, param.Operator)
+	if err != nil {
+		return RefundOrderItemsResult{}, err
+	}
+	if role != "merchant" {
+		return RefundOrderItemsResult{}, utils.NewAuthError("仅商家可发起缺货退款")
+	}
+	order, err := s.orderRepo.GetOrderByID(ctx, param.OrderID)
+	if err != nil {
+		return RefundOrderItemsResult{}, err
+	}
+	if order.MerchantID != operatorID {
+		return RefundOrderItemsResult{}, utils.NewAuthError("订单不属于该商家")
+	}
+	if order.PaidTime == nil || !utils.ContainsString(refundableStatus, order.Status) {
+		return RefundOrderItemsResult{}, utils.NewBizError("当前订单状态为" + order.Status + "，无法部分退款")
+	}
+	if time.Since(order.CreateTime) > refundWindowDays*24*time.Hour {
+		return RefundOrderItemsResult{}, utils.NewBizError("订单已超过" + strconv.Itoa(refundWindowDays) + "天退款期限，无法部分退款")
+	}
+
+	// 3. 校验订单项及可退数量，计算退款金额
 	items, err := s.orderRepo.GetOrderItems(ctx, param.OrderID)
 	if err != nil {
-		zap.L().Warn("查询订单项失败，跳过库存恢复", zap.Int64("order_id", param.OrderID), zap.Error(err))
-	} else {
-		for _, item := range items {
-			restoreReq := &productProto.RestoreStockRequest{
-				ProductId: item.ProductID,
-				Num:       item.Quantity,
-			}
-			_, _ = client.ProductClient.RestoreStock(ctx, restoreReq) // 忽略错误，仅日志
+		return RefundOrderItemsResult{}, err
+	}
+	itemMap := make(map[int64]*model.OrderItem, len(items))
+	for _, item := range items {
+		itemMap[item.ItemID] = item
+	}
+	itemQty := make(map[int64]int32, len(param.Items))
+	for _, p := range param.Items {
+		itemQty[p.ItemID] += p.Quantity
+	}
+	var (
+		amount     float64
+		eventItems []kafka.RefundItemEvent
+	)
+	for itemID, qty := range itemQty {
+		item, ok := itemMap[itemID]
+		if !ok {
+			return RefundOrderItemsResult{}, utils.NewParamError("订单项不存在：" + strconv.FormatInt(itemID, 10))
+		}
+		if qty > item.Quantity-item.RefundedQty {
+			return RefundOrderItemsResult{}, utils.NewBizError("退款数量超过可退数量：" + item.ProductName)
+		}
+		itemAmount := utils.RoundMoney(item.Price * float64(qty))
+		amount += itemAmount
+		eventItems = append(eventItems, kafka.RefundItemEvent{
+			ItemID:      item.ItemID,
+			ProductID:   item.ProductID,
+			ProductName: item.ProductName,
+			Quantity:    qty,
+			Amount:      itemAmount,
+		})
+	}
+	amount = utils.RoundMoney(amount)
+	if remain := utils.RoundMoney(order.TotalAmount - order.RefundAmount); amount > remain {
+		amount = remain
+	}
+	if amount <= 0 {
+		return RefundOrderItemsResult{}, utils.NewBizError("订单已无可退金额")
+	}
+
+	// 4. 事务更新已退数量及退款金额（条件更新防止并发超退）
+	if err = s.orderRepo.RefundOrderItems(ctx, param.OrderID, itemQty, amount); err != nil {
+		return RefundOrderItemsResult{}, err
+	}
+
+	// 5. 投递退款消息
+	refundNo := "RP" + time.Now().Format("20060102150405") + utils.RandomString(6)
+	publishRefund(kafka.OrderRefundEvent{
+		RefundNo:   refundNo,
+		OrderID:    order.OrderID,
+		OrderNo:    order.OrderNo,
+		UserID:     order.UserID,
+		Amount:     amount,
+		RefundType: kafka.RefundTypePartial,
+		Scene:      kafka.RefundSceneMissing,
+		Reason:     param.Reason,
+		Items:      eventItems,
+	})
+
+	zap.L().Info("缺货部分退款成功", zap.Int64("order_id", order.OrderID), zap.String("refund_no", refundNo), zap.Float64("amount", amount))
+	return RefundOrderItemsResult{RefundNo: refundNo, Amount: amount}, nil
+}
+
+// ExpireUnpaidOrders 超时未支付订单自动取消（恢复库存），返回取消数量
+func (s *orderService) ExpireUnpaidOrders(ctx context.Context) (int, error) {
+	before := time.Now().Add(-config.Cfg.Payment.ExpireDuration())
+	orders, err := s.orderRepo.ListExpiredUnpaidOrders(ctx, before, 100)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, order := range orders {
+		// 条件更新：并发支付成功的订单不会被取消
+		if err = s.orderRepo.UpdateOrderStatus(ctx, order.OrderID, "待支付", "已取消", "超时未支付，系统自动取消"); err != nil {
+			zap.L().Warn("超时订单取消失败，跳过", zap.Int64("order_id", order.OrderID), zap.Error(err))
+			continue
+		}
+		if err = s.restoreOrderStock(ctx, order); err != nil {
+			zap.L().Error("超时订单恢复库存失败", zap.Int64("order_id", order.OrderID), zap.Error(err))
 		}
+		s.returnCoupons(ctx, order.OrderID)
+		count++
 	}
+	if count > 0 {
+		zap.L().Info("超时未支付订单已取消", zap.Int("count", count))
+	}
+	return count, nil
+}
 
-	// 4. 调用Repo取消订单
-	return s.orderRepo.CancelOrder(ctx, param.OrderID, param.UserID, param.Reason)
+// formatTime 格式化可空时间
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02 15:04:05")
 }
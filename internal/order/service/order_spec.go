@@ -0,0 +1,114 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo/model"
+	productProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/product/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+)
+
+// ItemOptionResult 订单项所选属性
+type ItemOptionResult struct {
+	OptionID  int64   `json:"option_id"`
+	GroupName string  `json:"group_name"`
+	Name      string  `json:"name"`
+	Price     float64 `json:"price"`
+}
+
+// itemSpec 订单项所选规格及属性的计价结果
+type itemSpec struct {
+	price   float64            // 单价（规格价+属性加价）
+	stock   int32              // 可售库存（多规格商品为规格库存）
+	skuName string             // 规格名称
+	options []model.ItemOption // 属性快照
+}
+
+// resolveItemSpec 校验所选规格及属性，计算单价
+// 多规格商品必须选择规格；属性须属于该商品且满足各属性组的最少/最多选择数
+func resolveItemSpec(product *productProto.Product, skuID int64, optionIDs []int64) (itemSpec, error) {
+	spec := itemSpec{price: float64(product.Price), stock: product.Stock}
+
+	// 1. 规格
+	switch {
+	case product.HasSku && skuID == 0:
+		return itemSpec{}, utils.NewBizError("请选择商品规格")
+	case !product.HasSku && skuID > 0:
+		return itemSpec{}, utils.NewBizError("商品规格已失效，请重新选择")
+	case product.HasSku:
+		var sku *productProto.Sku
+		for _, s := range product.Skus {
+			if s.SkuId == skuID {
+				sku = s
+				break
+			}
+		}
+		if sku == nil {
+			return itemSpec{}, utils.NewBizError("商品规格已失效，请重新选择")
+		}
+		spec.price = float64(sku.Price)
+		spec.stock = sku.Stock
+		spec.skuName = sku.Name
+	}
+
+	// 2. 属性（按属性组统计选择数）
+	selected := make(map[int64]bool, len(optionIDs))
+	for _, id := range optionIDs {
+		selected[id] = true
+	}
+	for _, group := range product.OptionGroups {
+		var count int32
+		for _, opt := range group.Options {
+			if !selected[opt.OptionId] {
+				continue
+			}
+			delete(selected, opt.OptionId)
+			count++
+			spec.price += float64(opt.Price)
+			spec.options = append(spec.options, model.ItemOption{
+				OptionID:  opt.OptionId,
+				GroupName: group.Name,
+				Name:      opt.Name,
+				Price:     float64(opt.Price),
+			})
+		}
+		if count < group.MinSelect {
+			return itemSpec{}, utils.NewBizError(fmt.Sprintf("%s至少选择%d项", group.Name, group.MinSelect))
+		}
+		if count > group.MaxSelect {
+			return itemSpec{}, utils.NewBizError(fmt.Sprintf("%s最多选择%d项", group.Name, group.MaxSelect))
+		}
+	}
+	if len(selected) > 0 {
+		return itemSpec{}, utils.NewBizError("商品属性已失效，请重新选择")
+	}
+	spec.price = utils.RoundMoney(spec.price)
+	return spec, nil
+}
+
+// toItemOptionResults 属性快照转换
+func toItemOptionResults(options []model.ItemOption) []ItemOptionResult {
+	var results []ItemOptionResult
+	for _, opt := range options {
+		results = append(results, ItemOptionResult{
+			OptionID:  opt.OptionID,
+			GroupName: opt.GroupName,
+			Name:      opt.Name,
+			Price:     opt.Price,
+		})
+	}
+	return results
+}
+
+// specText 规格及属性描述（如"大杯/少冰/加珍珠"）
+func specText(item *OrderItemResult) string {
+	parts := make([]string, 0, len(item.Options)+1)
+	if item.SkuName != "" {
+		parts = append(parts, item.SkuName)
+	}
+	for _, opt := range item.Options {
+		parts = append(parts, opt.Name)
+	}
+	return strings.Join(parts, "/")
+}
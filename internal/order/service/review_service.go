@@ -0,0 +1,363 @@
+package service
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/pagination"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// 贝叶斯平均分参数：score = (priorWeight*priorMean + 评分总和) / (priorWeight + 评分次数)
+// 先验均值与新对象默认评分一致，评分较少时向默认分收敛，避免个别评价导致分数大幅波动
+const (
+	bayesPriorMean   = 5.0
+	bayesPriorWeight = 10
+)
+
+// 入参结构体
+type ReviewItemParam struct {
+	ProductID int64  `validate:"required,gt=0"`
+	Rating    int32  `validate:"required,gte=1,lte=5"`
+	Content   string `validate:"omitempty,max=255"`
+}
+
+type CreateReviewParam struct {
+	OrderID        int64             `validate:"required,gt=0"`
+	UserID         int64             `validate:"required,gt=0"`
+	MerchantRating int32             `validate:"required,gte=1,lte=5"`
+	Content        string            `validate:"omitempty,max=512"`
+	Tags           []string          `validate:"omitempty,max=5,unique,dive,required,max=16,excludes=0x2C"`
+	RiderRating    int32             `validate:"gte=0,lte=5"` // 0表示不评价骑手
+	RiderTags      []string          `validate:"omitempty,max=5,unique,dive,required,max=16,excludes=0x2C"`
+	Items          []ReviewItemParam `validate:"omitempty,max=50,dive"` // 商品评价（可只评价部分商品）
+}
+
+type ListReviewsParam struct {
+	UserID     int64  `validate:"gte=0"`
+	MerchantID int64  `validate:"gte=0"`
+	ProductID  int64  `validate:"gte=0"`
+	RiderID    int64  `validate:"gte=0"`
+	Page       int32  `validate:"gte=0"` // 0表示游标分页
+	PageSize   int32  `validate:"required,gte=10,lte=100"`
+	Cursor     string `validate:"omitempty,max=256"`
+	WithTotal  bool
+}
+
+type ReplyReviewParam struct {
+	ReviewID   int64  `validate:"required,gt=0"`
+	MerchantID int64  `validate:"required,gt=0"`
+	Reply      string `validate:"required,max=512"`
+}
+
+// 响应结构体
+type ReviewResult struct {
+	ReviewID       int64              `json:"review_id"`
+	OrderID        int64              `json:"order_id"`
+	UserID         int64              `json:"user_id"`
+	UserName       string             `json:"user_name"` // 脱敏
+	MerchantID     int64              `json:"merchant_id"`
+	MerchantRating int32              `json:"merchant_rating"`
+	Content        string             `json:"content"`
+	Tags           []string           `json:"tags"`
+	RiderID        int64              `json:"rider_id"`
+	RiderRating    int32              `json:"rider_rating"`
+	RiderTags      []string           `json:"rider_tags"`
+	Items          []ReviewItemResult `json:"items"`
+	Reply          string             `json:"reply"`
+	ReplyTime      string             `json:"reply_time"`
+	CreateTime     string             `json:"create_time"`
+}
+
+type ReviewItemResult struct {
+	ProductID   int64  `json:"product_id"`
+	ProductName string `json:"product_name"`
+	Rating      int32  `json:"rating"`
+	Content     string `json:"content"`
+}
+
+type ListReviewsResult struct {
+	Reviews    []ReviewResult `json:"reviews"`
+	Total      int32          `json:"total"` // 未统计时为-1
+	Page       int32          `json:"page"`
+	PageSize   int32          `json:"page_size"`
+	NextCursor string         `json:"next_cursor"`
+}
+
+// ReviewService 评价业务逻辑接口
+type ReviewService interface {
+	CreateReview(ctx context.Context, param CreateReviewParam) (int64, error) // 用户评价已完成订单，返回评价ID
+	ListReviews(ctx context.Context, param ListReviewsParam) (ListReviewsResult, error)
+	ReplyReview(ctx context.Context, param ReplyReviewParam) error // 商家回复评价
+}
+
+// reviewService 实现
+type reviewService struct {
+	orderRepo  repo.OrderRepo
+	reviewRepo repo.ReviewRepo
+	validate   *validator.Validate
+}
+
+// NewReviewService 创建实例
+func NewReviewService(orderRepo repo.OrderRepo, reviewRepo repo.ReviewRepo) ReviewService {
+	return &reviewService{
+		orderRepo:  orderRepo,
+		reviewRepo: reviewRepo,
+		validate:   validator.New(),
+	}
+}
+
+// CreateReview 评价订单：商家评分必填，骑手和商品评分可选；写入后增量更新评分并通知各服务
+func (s *reviewService) CreateReview(ctx context.Context, param CreateReviewParam) (int64, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("创建评价参数校验失败", zap.Int64("order_id", param.OrderID), zap.Error(err))
+		return 0, utils.NewParamError("参数错误：" + err.Error())
+	}
+
+	// 2. 鉴权：仅下单用户本人可评价
+	if err := middleware.CheckIdentity(ctx, "user", param.UserID); err != nil {
+		return 0, err
+	}
+
+	// 3. 校验订单归属、状态及是否已评价
+	order, err := s.orderRepo.GetOrderByID(ctx, param.OrderID)
+	if err != nil {
+		return 0, err
+	}
+	if order.UserID != param.UserID {
+		return 0, utils.NewAuthError("订单不属于该用户")
+	}
+	if order.Status != "已完成" {
+		return 0, utils.NewBizError("订单未完成，暂不能评价")
+	}
+	existing, err := s.reviewRepo.GetReviewByOrderID(ctx, param.OrderID)
+	if err != nil {
+		return 0, err
+	}
+	if existing != nil {
+		return 0, utils.NewBizError("订单已评价")
+	}
+	if param.RiderRating > 0 && order.RiderID == 0 {
+		return 0, utils.NewParamError("订单无配送骑手，不能评价骑手")
+	}
+
+	// 4. 组装评价及评分
+	review := &model.Review{
+		OrderID:        order.OrderID,
+		UserID:         order.UserID,
+		UserName:       order.UserName,
+		MerchantID:     order.MerchantID,
+		MerchantRating: param.MerchantRating,
+		Content:        param.Content,
+		Tags:           strings.Join(param.Tags, ","),
+	}
+	ratings := []repo.RatingDelta{{TargetType: kafka.RatingTargetMerchant, TargetID: order.MerchantID, Rating: param.MerchantRating}}
+	if param.RiderRating > 0 {
+		review.RiderID = order.RiderID
+		review.RiderRating = param.RiderRating
+		review.RiderTags = strings.Join(param.RiderTags, ",")
+		ratings = append(ratings, repo.RatingDelta{TargetType: kafka.RatingTargetRider, TargetID: order.RiderID, Rating: param.RiderRating})
+	}
+
+	// 5. 商品评价：商品必须属于该订单，且不能重复评价
+	var items []*model.ReviewItem
+	if len(param.Items) > 0 {
+		orderItems, err := s.orderRepo.GetOrderItems(ctx, order.OrderID)
+		if err != nil {
+			return 0, err
+		}
+		productNames := make(map[int64]string, len(orderItems))
+		for _, item := range orderItems {
+			productNames[item.ProductID] = item.ProductName
+		}
+		rated := make(map[int64]bool, len(param.Items))
+		for _, p := range param.Items {
+			name, ok := productNames[p.ProductID]
+			if !ok {
+				return 0, utils.NewParamError("商品" + strconv.FormatInt(p.ProductID, 10) + "不属于该订单")
+			}
+			if rated[p.ProductID] {
+				return 0, utils.NewParamError("商品" + strconv.FormatInt(p.ProductID, 10) + "重复评价")
+			}
+			rated[p.ProductID] = true
+			items = append(items, &model.ReviewItem{
+				ProductID:   p.ProductID,
+				ProductName: name,
+				Rating:      p.Rating,
+				Content:     p.Content,
+			})
+			ratings = append(ratings, repo.RatingDelta{TargetType: kafka.RatingTargetProduct, TargetID: p.ProductID, Rating: p.Rating})
+		}
+	}
+
+	// 6. 写入评价并更新评分汇总
+	summaries, err := s.reviewRepo.CreateReview(ctx, review, items, ratings)
+	if err != nil {
+		return 0, err
+	}
+
+	// 7. 通知商家/商品/骑手服务更新评分（失败仅记录日志，下次评价会携带更新的累计值）
+	for _, summary := range summaries {
+		event := kafka.RatingUpdatedEvent{
+			TargetType:  summary.TargetType,
+			TargetID:    summary.TargetID,
+			Score:       bayesScore(summary.RatingCount, summary.RatingSum),
+			RatingCount: summary.RatingCount,
+		}
+		err = utils.Retry(3, 200*time.Millisecond, func() error {
+			return kafka.SendJSON(kafka.TopicRatingUpdated, event.TargetType+"_"+strconv.FormatInt(event.TargetID, 10), event)
+		})
+		if err != nil {
+			zap.L().Error("投递评分更新消息失败", zap.Any("event", event), zap.Error(err))
+		}
+	}
+
+	zap.L().Info("订单评价成功", zap.Int64("order_id", order.OrderID), zap.Int64("review_id", review.ReviewID),
+		zap.Int32("merchant_rating", param.MerchantRating), zap.Int32("rider_rating", param.RiderRating))
+	return review.ReviewID, nil
+}
+
+// ListReviews 查询评价（按商家/商品/骑手查询对所有登录角色开放，按用户查询仅限本人或平台运营）
+func (s *reviewService) ListReviews(ctx context.Context, param ListReviewsParam) (ListReviewsResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("查询评价参数校验失败", zap.Any("param", param), zap.Error(err))
+		return ListReviewsResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+	if param.UserID == 0 && param.MerchantID == 0 && param.ProductID == 0 && param.RiderID == 0 {
+		return ListReviewsResult{}, utils.NewParamError("请指定用户、商家、商品或骑手")
+	}
+
+	// 2. 鉴权
+	if param.UserID > 0 {
+		claims, err := middleware.CheckRole(ctx, "user", "admin")
+		if err != nil {
+			return ListReviewsResult{}, err
+		}
+		if claims.Role == "user" {
+			if err = middleware.CheckIdentity(ctx, "user", param.UserID); err != nil {
+				return ListReviewsResult{}, err
+			}
+		}
+	}
+
+	// 3. 查询评价及商品评价
+	reviews, page, err := s.reviewRepo.ListReviews(ctx, repo.ReviewFilter{
+		UserID:     param.UserID,
+		MerchantID: param.MerchantID,
+		ProductID:  param.ProductID,
+		RiderID:    param.RiderID,
+	}, pagination.Param{
+		Page:      param.Page,
+		PageSize:  param.PageSize,
+		Cursor:    param.Cursor,
+		WithTotal: param.WithTotal,
+	})
+	if err != nil {
+		return ListReviewsResult{}, err
+	}
+	reviewIDs := make([]int64, 0, len(reviews))
+	for _, r := range reviews {
+		reviewIDs = append(reviewIDs, r.ReviewID)
+	}
+	itemMap, err := s.reviewRepo.GetReviewItemsByReviewIDs(ctx, reviewIDs)
+	if err != nil {
+		return ListReviewsResult{}, err
+	}
+
+	// 4. 组装结果
+	results := make([]ReviewResult, 0, len(reviews))
+	for _, r := range reviews {
+		results = append(results, toReviewResult(r, itemMap[r.ReviewID]))
+	}
+	return ListReviewsResult{
+		Reviews:    results,
+		Total:      int32(page.Total),
+		Page:       param.Page,
+		PageSize:   param.PageSize,
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+// ReplyReview 商家回复评价（每条评价仅可回复一次）
+func (s *reviewService) ReplyReview(ctx context.Context, param ReplyReviewParam) error {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("回复评价参数校验失败", zap.Any("param", param), zap.Error(err))
+		return utils.NewParamError("参数错误：" + err.Error())
+	}
+
+	// 2. 鉴权
+	if err := middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+		return err
+	}
+
+	// 3. 条件更新（评价需属于该商家且未回复）
+	if err := s.reviewRepo.ReplyReview(ctx, param.ReviewID, param.MerchantID, param.Reply); err != nil {
+		return err
+	}
+	zap.L().Info("商家回复评价成功", zap.Int64("review_id", param.ReviewID), zap.Int64("merchant_id", param.MerchantID))
+	return nil
+}
+
+// toReviewResult 模型 → 领域层结果
+func toReviewResult(r *model.Review, items []*model.ReviewItem) ReviewResult {
+	result := ReviewResult{
+		ReviewID:       r.ReviewID,
+		OrderID:        r.OrderID,
+		UserID:         r.UserID,
+		UserName:       maskName(r.UserName),
+		MerchantID:     r.MerchantID,
+		MerchantRating: r.MerchantRating,
+		Content:        r.Content,
+		Tags:           splitTags(r.Tags),
+		RiderID:        r.RiderID,
+		RiderRating:    r.RiderRating,
+		RiderTags:      splitTags(r.RiderTags),
+		Reply:          r.Reply,
+		ReplyTime:      formatTime(r.ReplyTime),
+		CreateTime:     r.CreateTime.Format("2006-01-02 15:04:05"),
+	}
+	for _, item := range items {
+		result.Items = append(result.Items, ReviewItemResult{
+			ProductID:   item.ProductID,
+			ProductName: item.ProductName,
+			Rating:      item.Rating,
+			Content:     item.Content,
+		})
+	}
+	return result
+}
+
+// bayesScore 贝叶斯平均分（保留一位小数）
+func bayesScore(count, sum int64) float64 {
+	score := (bayesPriorWeight*bayesPriorMean + float64(sum)) / float64(bayesPriorWeight+count)
+	return math.Round(score*10) / 10
+}
+
+// splitTags 逗号分隔的标签转换为列表
+func splitTags(tags string) []string {
+	if tags == "" {
+		return nil
+	}
+	return strings.Split(tags, ",")
+}
+
+// maskName 姓名脱敏（保留首字）
+func maskName(name string) string {
+	runes := []rune(name)
+	if len(runes) == 0 {
+		return ""
+	}
+	return string(runes[0]) + "**"
+}
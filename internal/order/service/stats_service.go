@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/order/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+const (
+	statsRollupLagDays = 1   // 订单下单后次日内状态仍可能变化，仅汇总前天及更早的数据
+	statsBackfillDays  = 90  // 首次汇总时回溯天数（更早的日期查询时实时汇总）
+	maxStatsRangeDays  = 366 // 单次查询最大天数
+	defaultStatsTopN   = 10  // 默认热销商品数
+)
+
+// 入参结构体
+type GetMerchantStatsParam struct {
+	MerchantID int64  `validate:"required,gt=0"`
+	StartDate  string `validate:"required,datetime=2006-01-02"`
+	EndDate    string `validate:"required,datetime=2006-01-02"`
+	TopN       int32  `validate:"gte=0,lte=50"` // 热销商品数（0取默认值）
+}
+
+// 响应结构体
+type MerchantStatsResult struct {
+	MerchantID       int64                `json:"merchant_id"`
+	StartDate        string               `json:"start_date"`
+	EndDate          string               `json:"end_date"`
+	OrderCount       int64                `json:"order_count"`        // 已支付订单数
+	ValidOrderCount  int64                `json:"valid_order_count"`  // 有效订单数（未取消/拒单）
+	StatusCounts     []StatusCountResult  `json:"status_counts"`      // 各状态订单数
+	GMV              float64              `json:"gmv"`                // 有效订单实付金额（扣除退款）
+	AvgOrderValue    float64              `json:"avg_order_value"`    // 客单价
+	CancelRate       float64              `json:"cancel_rate"`        // 取消率
+	RejectRate       float64              `json:"reject_rate"`        // 拒单率
+	AvgAcceptSeconds int64                `json:"avg_accept_seconds"` // 平均接单时长（秒，支付到接单）
+	Daily            []DailyStatResult    `json:"daily"`              // 每日趋势
+	TopProducts      []ProductSalesResult `json:"top_products"`       // 热销商品
+}
+
+type StatusCountResult struct {
+	Status string `json:"status"`
+	Count  int64  `json:"count"`
+}
+
+type DailyStatResult struct {
+	Date            string  `json:"date"`
+	OrderCount      int64   `json:"order_count"`
+	ValidOrderCount int64   `json:"valid_order_count"`
+	GMV             float64 `json:"gmv"`
+}
+
+type ProductSalesResult struct {
+	ProductID   int64   `json:"product_id"`
+	ProductName string  `json:"product_name"`
+	Quantity    int64   `json:"quantity"`
+	Amount      float64 `json:"amount"`
+}
+
+// StatsService 商家经营统计业务逻辑接口
+type StatsService interface {
+	GetMerchantStats(ctx context.Context, param GetMerchantStatsParam) (MerchantStatsResult, error)
+	RollupDailyStats(ctx context.Context) (int, error) // 汇总日统计（定时任务），返回本次汇总天数
+}
+
+// statsService 实现
+type statsService struct {
+	statsRepo repo.StatsRepo
+	validate  *validator.Validate
+}
+
+// NewStatsService 创建实例
+func NewStatsService(statsRepo repo.StatsRepo) StatsService {
+	return &statsService{
+		statsRepo: statsRepo,
+		validate:  validator.New(),
+	}
+}
+
+// GetMerchantStats 查询商家经营统计（已汇总日期读日统计表，汇总范围之前及之后的日期实时汇总订单表）
+func (s *statsService) GetMerchantStats(ctx context.Context, param GetMerchantStatsParam) (MerchantStatsResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("查询商家统计参数校验失败", zap.Any("param", param), zap.Error(err))
+		return MerchantStatsResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+	startDate, _ := time.ParseInLocation("2006-01-02", param.StartDate, time.Local)
+	endDate, _ := time.ParseInLocation("2006-01-02", param.EndDate, time.Local)
+	if endDate.Before(startDate) {
+		return MerchantStatsResult{}, utils.NewParamError("结束日期不能早于开始日期")
+	}
+	if endDate.Sub(startDate) >= maxStatsRangeDays*24*time.Hour {
+		return MerchantStatsResult{}, utils.NewParamError("统计日期跨度不能超过366天")
+	}
+	topN := int(param.TopN)
+	if topN == 0 {
+		topN = defaultStatsTopN
+	}
+
+	// 2. 鉴权：商家仅可查询本店，平台运营可查询任意商家
+	claims, err := middleware.CheckRole(ctx, "merchant", "admin")
+	if err != nil {
+		return MerchantStatsResult{}, err
+	}
+	if claims.Role == "merchant" {
+		if err = middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+			return MerchantStatsResult{}, err
+		}
+	}
+
+	// 3. 读取已汇总部分（与汇总范围[first,last]的交集）
+	first, last, err := s.statsRepo.GetRollupRange(ctx)
+	if err != nil {
+		return MerchantStatsResult{}, err
+	}
+	var stats []*model.MerchantDailyStat
+	var products []*model.MerchantDailyProductStat
+	liveRanges := [][2]time.Time{{startDate, endDate}} // 需实时汇总的日期区间（含首尾）
+	if !first.IsZero() && !last.Before(startDate) && !first.After(endDate) {
+		rollupStart, rollupEnd := startDate, endDate
+		if first.After(rollupStart) {
+			rollupStart = first
+		}
+		if last.Before(rollupEnd) {
+			rollupEnd = last
+		}
+		if stats, err = s.statsRepo.ListDailyStats(ctx, param.MerchantID, rollupStart, rollupEnd); err != nil {
+			return MerchantStatsResult{}, err
+		}
+		if products, err = s.statsRepo.SumProductStats(ctx, param.MerchantID, rollupStart, rollupEnd); err != nil {
+			return MerchantStatsResult{}, err
+		}
+		liveRanges = [][2]time.Time{
+			{startDate, rollupStart.AddDate(0, 0, -1)},
+			{rollupEnd.AddDate(0, 0, 1), endDate},
+		}
+	}
+
+	// 4. 实时汇总未汇总部分
+	for _, r := range liveRanges {
+		if r[0].After(r[1]) {
+			continue
+		}
+		liveStats, err := s.statsRepo.AggregateDailyStats(ctx, param.MerchantID, r[0], r[1].AddDate(0, 0, 1))
+		if err != nil {
+			return MerchantStatsResult{}, err
+		}
+		liveProducts, err := s.statsRepo.AggregateDailyProductStats(ctx, param.MerchantID, r[0], r[1].AddDate(0, 0, 1))
+		if err != nil {
+			return MerchantStatsResult{}, err
+		}
+		stats = append(stats, liveStats...)
+		products = append(products, liveProducts...)
+	}
+
+	// 5. 计算指标
+	result := buildMerchantStats(stats, startDate, endDate)
+	result.MerchantID = param.MerchantID
+	result.StartDate = param.StartDate
+	result.EndDate = param.EndDate
+	result.TopProducts = topProductSales(products, topN)
+	return result, nil
+}
+
+// RollupDailyStats 从汇总水位的次日起逐日汇总，直到前天（幂等：同一天重复汇总会覆盖）
+// 退款期限内的日期每次回刷，使部分退款、状态变化反映到已汇总的日统计
+func (s *statsService) RollupDailyStats(ctx context.Context) (int, error) {
+	today := truncateDay(time.Now())
+	lastDay := today.AddDate(0, 0, -1-statsRollupLagDays)
+
+	_, watermark, err := s.statsRepo.GetRollupRange(ctx)
+	if err != nil {
+		return 0, err
+	}
+	day := today.AddDate(0, 0, -statsBackfillDays)
+	if !watermark.IsZero() {
+		day = truncateDay(watermark).AddDate(0, 0, 1)
+		if reroll := today.AddDate(0, 0, -refundWindowDays-1); reroll.Before(day) {
+			day = reroll
+		}
+	}
+
+	count := 0
+	for ; !day.After(lastDay); day = day.AddDate(0, 0, 1) {
+		if err = ctx.Err(); err != nil {
+			return count, err
+		}
+		next := day.AddDate(0, 0, 1)
+		stats, err := s.statsRepo.AggregateDailyStats(ctx, 0, day, next)
+		if err != nil {
+			return count, err
+		}
+		products, err := s.statsRepo.AggregateDailyProductStats(ctx, 0, day, next)
+		if err != nil {
+			return count, err
+		}
+		for _, st := range stats {
+			st.StatDate = day
+		}
+		for _, p := range products {
+			p.StatDate = day
+		}
+		if err = s.statsRepo.SaveDailyRollup(ctx, day, stats, products); err != nil {
+			return count, err
+		}
+		count++
+	}
+	if count > 0 {
+		zap.L().Info("商家日统计汇总完成", zap.Int("days", count), zap.Time("last_day", lastDay))
+	}
+	return count, nil
+}
+
+// buildMerchantStats 由日统计计算汇总指标及每日趋势
+func buildMerchantStats(stats []*model.MerchantDailyStat, startDate, endDate time.Time) MerchantStatsResult {
+	var result MerchantStatsResult
+	statusCount := make(map[string]int64)
+	daily := make(map[string]*DailyStatResult)
+	var acceptCount, acceptSeconds int64
+	for _, st := range stats {
+		valid := st.Status != "已取消" && st.Status != "已拒单"
+		statusCount[st.Status] += st.OrderCount
+		result.OrderCount += st.OrderCount
+		acceptCount += st.AcceptCount
+		acceptSeconds += st.AcceptSeconds
+
+		date := st.StatDate.Format("2006-01-02")
+		d, ok := daily[date]
+		if !ok {
+			d = &DailyStatResult{Date: date}
+			daily[date] = d
+		}
+		d.OrderCount += st.OrderCount
+		if valid {
+			result.ValidOrderCount += st.OrderCount
+			result.GMV += st.Amount
+			d.ValidOrderCount += st.OrderCount
+			d.GMV += st.Amount
+		}
+	}
+
+	// 各状态订单数（按状态名排序，保证输出稳定）
+	for status, count := range statusCount {
+		result.StatusCounts = append(result.StatusCounts, StatusCountResult{Status: status, Count: count})
+	}
+	sort.Slice(result.StatusCounts, func(i, j int) bool {
+		return result.StatusCounts[i].Status < result.StatusCounts[j].Status
+	})
+
+	// 每日趋势（无订单的日期补零）
+	for day := startDate; !day.After(endDate); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		d, ok := daily[date]
+		if !ok {
+			d = &DailyStatResult{Date: date}
+		}
+		d.GMV = utils.RoundMoney(d.GMV)
+		result.Daily = append(result.Daily, *d)
+	}
+
+	result.GMV = utils.RoundMoney(result.GMV)
+	if result.ValidOrderCount > 0 {
+		result.AvgOrderValue = utils.RoundMoney(result.GMV / float64(result.ValidOrderCount))
+	}
+	if result.OrderCount > 0 {
+		result.CancelRate = roundRate(float64(statusCount["已取消"]) / float64(result.OrderCount))
+		result.RejectRate = roundRate(float64(statusCount["已拒单"]) / float64(result.OrderCount))
+	}
+	if acceptCount > 0 {
+		result.AvgAcceptSeconds = acceptSeconds / acceptCount
+	}
+	return result
+}
+
+// topProductSales 合并商品销量并按销量、销售额倒序取前topN
+func topProductSales(products []*model.MerchantDailyProductStat, topN int) []ProductSalesResult {
+	merged := make(map[int64]*ProductSalesResult)
+	for _, p := range products {
+		item, ok := merged[p.ProductID]
+		if !ok {
+			item = &ProductSalesResult{ProductID: p.ProductID, ProductName: p.ProductName}
+			merged[p.ProductID] = item
+		}
+		item.Quantity += p.Quantity
+		item.Amount += p.Amount
+	}
+
+	results := make([]ProductSalesResult, 0, len(merged))
+	for _, item := range merged {
+		if item.Quantity <= 0 {
+			continue // 已全部退款
+		}
+		item.Amount = utils.RoundMoney(item.Amount)
+		results = append(results, *item)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Quantity != results[j].Quantity {
+			return results[i].Quantity > results[j].Quantity
+		}
+		if results[i].Amount != results[j].Amount {
+			return results[i].Amount > results[j].Amount
+		}
+		return results[i].ProductID < results[j].ProductID
+	})
+	if len(results) > topN {
+		results = results[:topN]
+	}
+	return results
+}
+
+// truncateDay 取当天零点（本地时区）
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// roundRate 比率保留四位小数
+func roundRate(rate float64) float64 {
+	return math.Round(rate*10000) / 10000
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	orderProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/order/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var OrderClient orderProto.OrderServiceClient // 全局订单服务客户端
+
+// InitOrderClient 初始化订单服务gRPC客户端
+func InitOrderClient() {
+	addr := "localhost:50054"
+
+	conn, err := grpc.Dial(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(middleware.GRPCAuthForwardInterceptor()),
+	)
+	if err != nil {
+		zap.L().Fatal("连接订单服务失败", zap.String("addr", addr), zap.Error(err))
+	}
+	OrderClient = orderProto.NewOrderServiceClient(conn)
+	zap.L().Info("订单服务客户端初始化成功", zap.String("addr", addr))
+}
@@ -0,0 +1,47 @@
+package gateway
+
+import "context"
+
+// 网关交易状态
+const (
+	TradeSuccess = "SUCCESS"
+	TradeFailed  = "FAILED"
+)
+
+// PayParam 发起支付参数
+type PayParam struct {
+	PaymentNo string
+	Amount    float64
+	Subject   string
+}
+
+// PayResult 发起支付结果
+type PayResult struct {
+	PayURL string // 支付链接（客户端跳转/拉起）
+}
+
+// CallbackParam 网关回调参数
+type CallbackParam struct {
+	PaymentNo   string
+	TradeNo     string
+	TradeStatus string
+	Amount      float64
+	Sign        string
+}
+
+// RefundParam 退款参数
+type RefundParam struct {
+	PaymentNo string
+	TradeNo   string
+	RefundNo  string
+	Amount    float64
+	Reason    string
+}
+
+// Gateway 支付网关接口（对接第三方支付渠道）
+type Gateway interface {
+	Name() string
+	Pay(ctx context.Context, param PayParam) (PayResult, error)
+	VerifyCallback(param CallbackParam) error // 回调验签
+	Refund(ctx context.Context, param RefundParam) error
+}
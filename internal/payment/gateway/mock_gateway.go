@@ -0,0 +1,81 @@
+package gateway
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// MockGateway 本地测试用支付网关：HMAC签名，可选自动回调支付成功
+type MockGateway struct {
+	secret   string
+	autoPay  bool
+	notifyFn func(ctx context.Context, param CallbackParam) error
+}
+
+// NewMockGateway 创建实例
+func NewMockGateway(secret string, autoPay bool) *MockGateway {
+	return &MockGateway{
+		secret:  secret,
+		autoPay: autoPay,
+	}
+}
+
+// SetNotify 设置自动回调处理函数（autoPay开启时，发起支付后模拟网关异步通知）
+func (g *MockGateway) SetNotify(fn func(ctx context.Context, param CallbackParam) error) {
+	g.notifyFn = fn
+}
+
+func (g *MockGateway) Name() string {
+	return "mock"
+}
+
+// Pay 发起支付
+func (g *MockGateway) Pay(ctx context.Context, param PayParam) (PayResult, error) {
+	if g.autoPay && g.notifyFn != nil {
+		go func() {
+			time.Sleep(time.Second)
+			callback := CallbackParam{
+				PaymentNo:   param.PaymentNo,
+				TradeNo:     "MOCK" + time.Now().Format("20060102150405") + utils.RandomString(6),
+				TradeStatus: TradeSuccess,
+				Amount:      param.Amount,
+			}
+			callback.Sign = g.Sign(callback)
+			if err := g.notifyFn(context.Background(), callback); err != nil {
+				zap.L().Error("mock网关自动回调失败", zap.String("payment_no", param.PaymentNo), zap.Error(err))
+			}
+		}()
+	}
+	return PayResult{
+		PayURL: fmt.Sprintf("mock://pay?payment_no=%s&amount=%.2f", param.PaymentNo, param.Amount),
+	}, nil
+}
+
+// VerifyCallback 回调验签
+func (g *MockGateway) VerifyCallback(param CallbackParam) error {
+	if !hmac.Equal([]byte(g.Sign(param)), []byte(param.Sign)) {
+		return errors.New("签名校验失败")
+	}
+	return nil
+}
+
+// Refund 退款（mock直接成功）
+func (g *MockGateway) Refund(ctx context.Context, param RefundParam) error {
+	zap.L().Info("mock网关退款成功", zap.Any("param", param))
+	return nil
+}
+
+// Sign 计算回调签名：HMAC-SHA256(payment_no|trade_no|trade_status|amount)
+func (g *MockGateway) Sign(param CallbackParam) string {
+	mac := hmac.New(sha256.New, []byte(g.secret))
+	mac.Write([]byte(fmt.Sprintf("%s|%s|%s|%.2f", param.PaymentNo, param.TradeNo, param.TradeStatus, param.Amount)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	paymentProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// PaymentHandler 支付gRPC接口实现
+type PaymentHandler struct {
+	paymentProto.UnimplementedPaymentServiceServer
+	paymentService    service.PaymentService
+	settlementService service.SettlementService
+}
+
+// NewPaymentHandler 创建实例
+func NewPaymentHandler(paymentService service.PaymentService, settlementService service.SettlementService) *PaymentHandler {
+	return &PaymentHandler{
+		paymentService:    paymentService,
+		settlementService: settlementService,
+	}
+}
+
+// CreatePayment 创建支付单
+func (h *PaymentHandler) CreatePayment(ctx context.Context, req *paymentProto.CreatePaymentRequest) (*paymentProto.CreatePaymentResponse, error) {
+	// proto → service参数
+	param := service.CreatePaymentParam{
+		OrderID: req.OrderId,
+		UserID:  req.UserId,
+		Channel: req.Channel,
+	}
+
+	// 调用service
+	result, err := h.paymentService.CreatePayment(ctx, param)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("创建支付单未知错误", zap.Error(err), zap.Int64("order_id", req.OrderId))
+			return &paymentProto.CreatePaymentResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &paymentProto.CreatePaymentResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	return &paymentProto.CreatePaymentResponse{
+		Code:    utils.ErrCodeSuccess,
+		Msg:     "创建支付单成功",
+		Payment: toProtoPayment(result),
+	}, nil
+}
+
+// PaymentCallback 支付回调
+func (h *PaymentHandler) PaymentCallback(ctx context.Context, req *paymentProto.PaymentCallbackRequest) (*paymentProto.CommonResponse, error) {
+	// proto → service参数
+	param := service.PaymentCallbackParam{
+		PaymentNo:   req.PaymentNo,
+		TradeNo:     req.TradeNo,
+		TradeStatus: req.TradeStatus,
+		Amount:      float64(req.Amount),
+		Sign:        req.Sign,
+	}
+
+	// 调用service
+	err := h.paymentService.PaymentCallback(ctx, param)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("支付回调未知错误", zap.Error(err), zap.String("payment_no", req.PaymentNo))
+			return &paymentProto.CommonResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &paymentProto.CommonResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	return &paymentProto.CommonResponse{
+		Code: utils.ErrCodeSuccess,
+		Msg:  "回调处理成功",
+	}, nil
+}
+
+// GetPayment 查询订单支付信息
+func (h *PaymentHandler) GetPayment(ctx context.Context, req *paymentProto.GetPaymentRequest) (*paymentProto.GetPaymentResponse, error) {
+	// 调用service
+	result, err := h.paymentService.GetPayment(ctx, req.OrderId)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("查询支付信息未知错误", zap.Error(err), zap.Int64("order_id", req.OrderId))
+			return &paymentProto.GetPaymentResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &paymentProto.GetPaymentResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	return &paymentProto.GetPaymentResponse{
+		Code:    utils.ErrCodeSuccess,
+		Msg:     "查询成功",
+		Payment: toProtoPayment(result),
+	}, nil
+}
+
+// ListRefunds 查询退款流水
+func (h *PaymentHandler) ListRefunds(ctx context.Context, req *paymentProto.ListRefundsRequest) (*paymentProto.ListRefundsResponse, error) {
+	// proto → service参数
+	param := service.ListRefundsParam{
+		OrderID:  req.OrderId,
+		UserID:   req.UserId,
+		Status:   req.Status,
+		Scene:    req.Scene,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	// 调用service
+	result, err := h.paymentService.ListRefunds(ctx, param)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("查询退款流水未知错误", zap.Error(err), zap.Any("param", param))
+			return &paymentProto.ListRefundsResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &paymentProto.ListRefundsResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	// 领域层结果 → proto
+	var refunds []*paymentProto.Refund
+	for _, r := range result.Refunds {
+		refunds = append(refunds, &paymentProto.Refund{
+			RefundId:   r.RefundID,
+			RefundNo:   r.RefundNo,
+			PaymentNo:  r.PaymentNo,
+			OrderId:    r.OrderID,
+			OrderNo:    r.OrderNo,
+			UserId:     r.UserID,
+			Amount:     float32(r.Amount),
+			RefundType: r.RefundType,
+			Scene:      r.Scene,
+			Reason:     r.Reason,
+			Items:      r.Items,
+			Status:     r.Status,
+			FailReason: r.FailReason,
+			RefundTime: r.RefundTime,
+			CreateTime: r.CreateTime,
+		})
+	}
+
+	return &paymentProto.ListRefundsResponse{
+		Code:     utils.ErrCodeSuccess,
+		Msg:      "查询成功",
+		Refunds:  refunds,
+		Total:    result.Total,
+		Page:     result.Page,
+		PageSize: result.PageSize,
+	}, nil
+}
+
+// toProtoPayment 领域层结果 → proto
+func toProtoPayment(result service.PaymentResult) *paymentProto.Payment {
+	return &paymentProto.Payment{
+		PaymentId:      result.PaymentID,
+		PaymentNo:      result.PaymentNo,
+		OrderId:        result.OrderID,
+		OrderNo:        result.OrderNo,
+		UserId:         result.UserID,
+		Amount:         float32(result.Amount),
+		Channel:        result.Channel,
+		Status:         result.Status,
+		TradeNo:        result.TradeNo,
+		PayUrl:         result.PayURL,
+		ExpireTime:     result.ExpireTime,
+		PaidTime:       result.PaidTime,
+		RefundTime:     result.RefundTime,
+		CreateTime:     result.CreateTime,
+		RefundedAmount: float32(result.RefundedAmount),
+	}
+}
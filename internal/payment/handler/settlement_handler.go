@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	paymentProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// SetMerchantCommission 设置商家佣金比例
+func (h *PaymentHandler) SetMerchantCommission(ctx context.Context, req *paymentProto.SetMerchantCommissionRequest) (*paymentProto.CommonResponse, error) {
+	// proto → service参数
+	param := service.SetMerchantCommissionParam{
+		MerchantID: req.MerchantId,
+		Rate:       float64(req.Rate),
+	}
+
+	// 调用service
+	err := h.settlementService.SetMerchantCommission(ctx, param)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("设置佣金比例未知错误", zap.Error(err), zap.Int64("merchant_id", req.MerchantId))
+			return &paymentProto.CommonResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &paymentProto.CommonResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	return &paymentProto.CommonResponse{
+		Code: utils.ErrCodeSuccess,
+		Msg:  "设置成功",
+	}, nil
+}
+
+// ListSettlementStatements 查询商家日结算单
+func (h *PaymentHandler) ListSettlementStatements(ctx context.Context, req *paymentProto.ListSettlementStatementsRequest) (*paymentProto.ListSettlementStatementsResponse, error) {
+	// proto → service参数
+	param := service.ListStatementsParam{
+		MerchantID: req.MerchantId,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	}
+
+	// 调用service
+	result, err := h.settlementService.ListSettlementStatements(ctx, param)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("查询结算单未知错误", zap.Error(err), zap.Any("param", param))
+			return &paymentProto.ListSettlementStatementsResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &paymentProto.ListSettlementStatementsResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	// 领域层结果 → proto
+	var statements []*paymentProto.SettlementStatement
+	for _, st := range result.Statements {
+		statements = append(statements, toProtoStatement(st))
+	}
+
+	return &paymentProto.ListSettlementStatementsResponse{
+		Code:       utils.ErrCodeSuccess,
+		Msg:        "查询成功",
+		Statements: statements,
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+	}, nil
+}
+
+// GetSettlementStatement 查询结算单详情
+func (h *PaymentHandler) GetSettlementStatement(ctx context.Context, req *paymentProto.GetSettlementStatementRequest) (*paymentProto.GetSettlementStatementResponse, error) {
+	// 调用service
+	result, err := h.settlementService.GetSettlementStatement(ctx, req.StatementId)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("查询结算单详情未知错误", zap.Error(err), zap.Int64("statement_id", req.StatementId))
+			return &paymentProto.GetSettlementStatementResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &paymentProto.GetSettlementStatementResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	// 领域层结果 → proto
+	items := make([]*paymentProto.SettlementItem, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &paymentProto.SettlementItem{
+			ItemId:           item.ItemID,
+			OrderId:          item.OrderID,
+			OrderNo:          item.OrderNo,
+			ItemAmount:       float32(item.ItemAmount),
+			CommissionRate:   float32(item.CommissionRate),
+			Commission:       float32(item.Commission),
+			MerchantDiscount: float32(item.MerchantDiscount),
+			SettleAmount:     float32(item.SettleAmount),
+			JournalNo:        item.JournalNo,
+			CompletedTime:    item.CompletedTime,
+		})
+	}
+
+	return &paymentProto.GetSettlementStatementResponse{
+		Code:      utils.ErrCodeSuccess,
+		Msg:       "查询成功",
+		Statement: toProtoStatement(result.Statement),
+		Items:     items,
+	}, nil
+}
+
+// toProtoStatement 领域层结果 → proto
+func toProtoStatement(result service.StatementResult) *paymentProto.SettlementStatement {
+	return &paymentProto.SettlementStatement{
+		StatementId:      result.StatementID,
+		StatementNo:      result.StatementNo,
+		MerchantId:       result.MerchantID,
+		SettleDate:       result.SettleDate,
+		Seq:              result.Seq,
+		OrderCount:       result.OrderCount,
+		ItemAmount:       float32(result.ItemAmount),
+		Commission:       float32(result.Commission),
+		MerchantDiscount: float32(result.MerchantDiscount),
+		SettleAmount:     float32(result.SettleAmount),
+		Status:           result.Status,
+		CreateTime:       result.CreateTime,
+	}
+}
@@ -0,0 +1,81 @@
+package ledger
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+)
+
+// 记账方向
+const (
+	Debit  = "借"
+	Credit = "贷"
+)
+
+// 平台科目（商家科目使用 MerchantPayable 生成）
+const (
+	AccountClearing   = "platform_clearing"   // 用户支付待清算款（资产）
+	AccountSubsidy    = "platform_subsidy"    // 平台承担的优惠补贴（费用）
+	AccountCommission = "platform_commission" // 佣金收入
+	AccountServiceFee = "platform_fee_income" // 配送费、打包费等服务费收入
+	AccountRiderTip   = "rider_tip_payable"   // 应付骑手小费（代收，骑手服务按配送收入发放）
+)
+
+// MerchantPayable 应付商家科目
+func MerchantPayable(merchantID int64) string {
+	return "merchant_payable:" + strconv.FormatInt(merchantID, 10)
+}
+
+// Entry 分录
+type Entry struct {
+	Account   string
+	Direction string
+	Amount    float64
+}
+
+// Journal 凭证（借贷必相等）
+type Journal struct {
+	entries []Entry
+	balance int64 // 借方合计-贷方合计（分）
+}
+
+// Debit 记借方；金额为负时记入贷方
+func (j *Journal) Debit(account string, amount float64) {
+	j.post(account, toCents(amount))
+}
+
+// Credit 记贷方；金额为负时记入借方
+func (j *Journal) Credit(account string, amount float64) {
+	j.post(account, -toCents(amount))
+}
+
+// post 按带符号金额记账（正数借方，负数贷方，0忽略）
+func (j *Journal) post(account string, cents int64) {
+	if cents == 0 {
+		return
+	}
+	entry := Entry{Account: account, Direction: Debit, Amount: float64(cents) / 100}
+	if cents < 0 {
+		entry.Direction = Credit
+		entry.Amount = float64(-cents) / 100
+	}
+	j.entries = append(j.entries, entry)
+	j.balance += cents
+}
+
+// Entries 返回分录，借贷不平时报错
+func (j *Journal) Entries() ([]Entry, error) {
+	if j.balance != 0 {
+		return nil, utils.NewBizError("凭证借贷不平衡")
+	}
+	if len(j.entries) == 0 {
+		return nil, utils.NewBizError("凭证没有分录")
+	}
+	return j.entries, nil
+}
+
+// toCents 金额转换为分
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
@@ -0,0 +1,89 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournalEntries(t *testing.T) {
+	tests := []struct {
+		name    string
+		post    func(j *Journal)
+		want    []Entry
+		wantErr bool
+	}{
+		{"借贷相等",
+			func(j *Journal) {
+				j.Debit(AccountClearing, 100)
+				j.Credit(MerchantPayable(1), 80)
+				j.Credit(AccountCommission, 20)
+			},
+			[]Entry{{AccountClearing, Debit, 100}, {"merchant_payable:1", Credit, 80}, {AccountCommission, Credit, 20}},
+			false},
+		{"负数借方记入贷方",
+			func(j *Journal) {
+				j.Debit(AccountClearing, 10)
+				j.Debit(AccountSubsidy, -2.5)
+				j.Credit(AccountServiceFee, 7.5)
+			},
+			[]Entry{{AccountClearing, Debit, 10}, {AccountSubsidy, Credit, 2.5}, {AccountServiceFee, Credit, 7.5}},
+			false},
+		{"负数贷方记入借方",
+			func(j *Journal) {
+				j.Credit(MerchantPayable(2), -3)
+				j.Credit(AccountClearing, 3)
+			},
+			[]Entry{{"merchant_payable:2", Debit, 3}, {AccountClearing, Credit, 3}},
+			false},
+		{"0金额不生成分录",
+			func(j *Journal) {
+				j.Debit(AccountClearing, 5)
+				j.Debit(AccountSubsidy, 0)
+				j.Credit(AccountRiderTip, 0)
+				j.Credit(AccountServiceFee, 5)
+			},
+			[]Entry{{AccountClearing, Debit, 5}, {AccountServiceFee, Credit, 5}},
+			false},
+		{"按分比较浮点金额",
+			func(j *Journal) {
+				j.Debit(AccountClearing, 0.1+0.2)
+				j.Credit(AccountServiceFee, 0.3)
+			},
+			[]Entry{{AccountClearing, Debit, 0.3}, {AccountServiceFee, Credit, 0.3}},
+			false},
+		{"借贷不平衡",
+			func(j *Journal) {
+				j.Debit(AccountClearing, 10)
+				j.Credit(AccountServiceFee, 9.99)
+			},
+			nil, true},
+		{"负数记账后借贷不平衡",
+			func(j *Journal) {
+				j.Debit(AccountClearing, -10)
+				j.Credit(AccountServiceFee, 10)
+			},
+			nil, true},
+		{"全部为0时没有分录",
+			func(j *Journal) {
+				j.Debit(AccountClearing, 0)
+				j.Credit(AccountServiceFee, 0.001)
+			},
+			nil, true},
+		{"未记账", func(j *Journal) {}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var j Journal
+			tt.post(&j)
+			entries, err := j.Entries()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, entries)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// Payment 支付单表
+type Payment struct {
+	PaymentID      int64          `gorm:"column:payment_id;primaryKey;autoIncrement" json:"payment_id"`
+	PaymentNo      string         `gorm:"column:payment_no;not null;uniqueIndex;size:64;comment:'支付单号'" json:"payment_no"`
+	OrderID        int64          `gorm:"column:order_id;not null;uniqueIndex;comment:'订单ID（一个订单仅一张支付单）'" json:"order_id"`
+	OrderNo        string         `gorm:"column:order_no;not null;size:64;comment:'订单编号'" json:"order_no"`
+	UserID         int64          `gorm:"column:user_id;not null;index;comment:'用户ID'" json:"user_id"`
+	Amount         float64        `gorm:"column:amount;not null;type:decimal(10,2);comment:'支付金额'" json:"amount"`
+	Channel        string         `gorm:"column:channel;not null;size:16;comment:'支付渠道'" json:"channel"`
+	Status         string         `gorm:"column:status;not null;size:16;default:'待支付';comment:'支付状态：待支付/已支付/部分退款/已退款'" json:"status"`
+	TradeNo        string         `gorm:"column:trade_no;size:64;comment:'网关交易号'" json:"trade_no"`
+	PayURL         string         `gorm:"column:pay_url;size:255;comment:'支付链接'" json:"pay_url"`
+	ExpireTime     time.Time      `gorm:"column:expire_time;not null;comment:'支付过期时间'" json:"expire_time"`
+	PaidTime       *time.Time     `gorm:"column:paid_time;comment:'支付时间'" json:"paid_time"`
+	RefundedAmount float64        `gorm:"column:refunded_amount;not null;default:0;type:decimal(10,2);comment:'累计退款金额'" json:"refunded_amount"`
+	RefundTime     *time.Time     `gorm:"column:refund_time;comment:'最近退款时间'" json:"refund_time"`
+	CreateTime     time.Time      `gorm:"column:create_time;autoCreateTime;comment:'创建时间'" json:"create_time"`
+	UpdateTime     time.Time      `gorm:"column:update_time;autoUpdateTime;comment:'更新时间'" json:"update_time"`
+	DeletedAt      gorm.DeletedAt `gorm:"column:deleted_at;index;comment:'软删除时间'" json:"-"`
+}
+
+// TableName 表名
+func (p *Payment) TableName() string {
+	return "t_payment"
+}
+
+// BeforeCreate 钩子：生成唯一支付单号
+func (p *Payment) BeforeCreate(tx *gorm.DB) error {
+	// 生成规则：P + YYYYMMDDHHMMSS + 随机数
+	p.PaymentNo = "P" + time.Now().Format("20060102150405") + utils.RandomString(6)
+	return nil
+}
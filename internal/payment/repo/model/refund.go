@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Refund 退款流水表（关联订单及支付单）
+type Refund struct {
+	RefundID   int64          `gorm:"column:refund_id;primaryKey;autoIncrement" json:"refund_id"`
+	RefundNo   string         `gorm:"column:refund_no;not null;uniqueIndex;size:64;comment:'退款单号'" json:"refund_no"`
+	PaymentID  int64          `gorm:"column:payment_id;not null;index;comment:'支付单ID'" json:"payment_id"`
+	PaymentNo  string         `gorm:"column:payment_no;not null;size:64;comment:'支付单号'" json:"payment_no"`
+	OrderID    int64          `gorm:"column:order_id;not null;index;comment:'订单ID'" json:"order_id"`
+	OrderNo    string         `gorm:"column:order_no;not null;size:64;comment:'订单编号'" json:"order_no"`
+	UserID     int64          `gorm:"column:user_id;not null;index;comment:'用户ID'" json:"user_id"`
+	Amount     float64        `gorm:"column:amount;not null;type:decimal(10,2);comment:'退款金额'" json:"amount"`
+	RefundType string         `gorm:"column:refund_type;not null;size:16;comment:'退款类型：全额退款/部分退款'" json:"refund_type"`
+	Scene      string         `gorm:"column:scene;not null;size:16;index;comment:'退款场景：用户取消/商家拒单/超时取消/商品缺货'" json:"scene"`
+	Reason     string         `gorm:"column:reason;size:255;comment:'退款原因'" json:"reason"`
+	Items      string         `gorm:"column:items;type:text;comment:'退款订单项（JSON，部分退款）'" json:"items"`
+	Status     string         `gorm:"column:status;not null;size:16;default:'退款中';comment:'退款状态：退款中/退款成功/退款失败'" json:"status"`
+	FailReason string         `gorm:"column:fail_reason;size:255;comment:'失败原因'" json:"fail_reason"`
+	RefundTime *time.Time     `gorm:"column:refund_time;comment:'退款成功时间'" json:"refund_time"`
+	CreateTime time.Time      `gorm:"column:create_time;autoCreateTime;comment:'创建时间'" json:"create_time"`
+	UpdateTime time.Time      `gorm:"column:update_time;autoUpdateTime;comment:'更新时间'" json:"update_time"`
+	DeletedAt  gorm.DeletedAt `gorm:"column:deleted_at;index;comment:'软删除时间'" json:"-"`
+}
+
+// TableName 表名
+func (r *Refund) TableName() string {
+	return "t_refund"
+}
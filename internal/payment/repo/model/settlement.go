@@ -0,0 +1,106 @@
+package model
+
+import (
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// MerchantCommission 商家佣金比例表（未配置的商家使用平台默认比例）
+type MerchantCommission struct {
+	MerchantID int64     `gorm:"column:merchant_id;primaryKey;autoIncrement:false;comment:'商家ID'" json:"merchant_id"`
+	Rate       float64   `gorm:"column:rate;not null;type:decimal(5,4);comment:'佣金比例（如0.18）'" json:"rate"`
+	CreateTime time.Time `gorm:"column:create_time;autoCreateTime;comment:'创建时间'" json:"create_time"`
+	UpdateTime time.Time `gorm:"column:update_time;autoUpdateTime;comment:'更新时间'" json:"update_time"`
+}
+
+// TableName 表名
+func (c *MerchantCommission) TableName() string {
+	return "t_merchant_commission"
+}
+
+// SettlementItem 结算明细表（每个完成订单一条）
+type SettlementItem struct {
+	ItemID           int64     `gorm:"column:item_id;primaryKey;autoIncrement" json:"item_id"`
+	StatementID      int64     `gorm:"column:statement_id;not null;default:0;index;comment:'结算单ID（0表示未出账）'" json:"statement_id"`
+	MerchantID       int64     `gorm:"column:merchant_id;not null;index:idx_settle_item_merchant_time,priority:1;comment:'商家ID'" json:"merchant_id"`
+	OrderID          int64     `gorm:"column:order_id;not null;uniqueIndex;comment:'订单ID'" json:"order_id"`
+	OrderNo          string    `gorm:"column:order_no;not null;size:64;comment:'订单编号'" json:"order_no"`
+	ItemAmount       float64   `gorm:"column:item_amount;not null;type:decimal(10,2);comment:'商品金额（扣除退款）'" json:"item_amount"`
+	CommissionRate   float64   `gorm:"column:commission_rate;not null;type:decimal(5,4);comment:'佣金比例（结算时快照）'" json:"commission_rate"`
+	Commission       float64   `gorm:"column:commission;not null;type:decimal(10,2);comment:'平台佣金'" json:"commission"`
+	MerchantDiscount float64   `gorm:"column:merchant_discount;not null;default:0;type:decimal(10,2);comment:'商家承担优惠'" json:"merchant_discount"`
+	SettleAmount     float64   `gorm:"column:settle_amount;not null;type:decimal(10,2);comment:'商家应收金额'" json:"settle_amount"`
+	JournalNo        string    `gorm:"column:journal_no;not null;size:64;comment:'记账凭证号'" json:"journal_no"`
+	CompletedTime    time.Time `gorm:"column:completed_time;not null;index:idx_settle_item_merchant_time,priority:2;comment:'订单完成时间'" json:"completed_time"`
+	CreateTime       time.Time `gorm:"column:create_time;autoCreateTime;comment:'创建时间'" json:"create_time"`
+}
+
+// TableName 表名
+func (i *SettlementItem) TableName() string {
+	return "t_settlement_item"
+}
+
+// SettlementStatement 商家日结算单（按订单完成日期汇总结算明细；当日结算单已打款后迟到的明细另出补充结算单）
+type SettlementStatement struct {
+	StatementID      int64     `gorm:"column:statement_id;primaryKey;autoIncrement" json:"statement_id"`
+	StatementNo      string    `gorm:"column:statement_no;not null;uniqueIndex;size:64;comment:'结算单号'" json:"statement_no"`
+	MerchantID       int64     `gorm:"column:merchant_id;not null;uniqueIndex:uk_merchant_settle_date,priority:1;comment:'商家ID'" json:"merchant_id"`
+	SettleDate       time.Time `gorm:"column:settle_date;not null;type:date;uniqueIndex:uk_merchant_settle_date,priority:2;comment:'结算日期'" json:"settle_date"`
+	Seq              int32     `gorm:"column:seq;not null;default:0;uniqueIndex:uk_merchant_settle_date,priority:3;comment:'同日序号（0为日结算单，大于0为补充结算单）'" json:"seq"`
+	OrderCount       int64     `gorm:"column:order_count;not null;default:0;comment:'订单数'" json:"order_count"`
+	ItemAmount       float64   `gorm:"column:item_amount;not null;default:0;type:decimal(12,2);comment:'商品金额合计'" json:"item_amount"`
+	Commission       float64   `gorm:"column:commission;not null;default:0;type:decimal(12,2);comment:'平台佣金合计'" json:"commission"`
+	MerchantDiscount float64   `gorm:"column:merchant_discount;not null;default:0;type:decimal(12,2);comment:'商家承担优惠合计'" json:"merchant_discount"`
+	SettleAmount     float64   `gorm:"column:settle_amount;not null;default:0;type:decimal(12,2);comment:'商家应收合计'" json:"settle_amount"`
+	Status           string    `gorm:"column:status;not null;size:16;default:'待打款';comment:'状态：待打款/已打款'" json:"status"`
+	CreateTime       time.Time `gorm:"column:create_time;autoCreateTime;comment:'创建时间'" json:"create_time"`
+	UpdateTime       time.Time `gorm:"column:update_time;autoUpdateTime;comment:'更新时间'" json:"update_time"`
+}
+
+// TableName 表名
+func (s *SettlementStatement) TableName() string {
+	return "t_settlement_statement"
+}
+
+// BeforeCreate 钩子：生成唯一结算单号
+func (s *SettlementStatement) BeforeCreate(tx *gorm.DB) error {
+	// 生成规则：S + 结算日期 + 随机数（补充结算单以SA开头）
+	prefix := "S"
+	if s.Seq > 0 {
+		prefix = "SA"
+	}
+	s.StatementNo = prefix + s.SettleDate.Format("20060102") + utils.RandomString(8)
+	return nil
+}
+
+// LedgerJournal 记账凭证表
+type LedgerJournal struct {
+	JournalID  int64     `gorm:"column:journal_id;primaryKey;autoIncrement" json:"journal_id"`
+	JournalNo  string    `gorm:"column:journal_no;not null;uniqueIndex;size:64;comment:'凭证号（业务幂等键）'" json:"journal_no"`
+	BizType    string    `gorm:"column:biz_type;not null;size:16;comment:'业务类型'" json:"biz_type"`
+	BizID      int64     `gorm:"column:biz_id;not null;index;comment:'业务ID'" json:"biz_id"`
+	Memo       string    `gorm:"column:memo;size:255;comment:'摘要'" json:"memo"`
+	CreateTime time.Time `gorm:"column:create_time;autoCreateTime;comment:'创建时间'" json:"create_time"`
+}
+
+// TableName 表名
+func (j *LedgerJournal) TableName() string {
+	return "t_ledger_journal"
+}
+
+// LedgerEntry 记账分录表（同一凭证借贷合计相等）
+type LedgerEntry struct {
+	EntryID    int64     `gorm:"column:entry_id;primaryKey;autoIncrement" json:"entry_id"`
+	JournalID  int64     `gorm:"column:journal_id;not null;index;comment:'凭证ID'" json:"journal_id"`
+	Account    string    `gorm:"column:account;not null;size:64;index;comment:'科目'" json:"account"`
+	Direction  string    `gorm:"column:direction;not null;size:4;comment:'方向：借/贷'" json:"direction"`
+	Amount     float64   `gorm:"column:amount;not null;type:decimal(12,2);comment:'金额'" json:"amount"`
+	CreateTime time.Time `gorm:"column:create_time;autoCreateTime;comment:'创建时间'" json:"create_time"`
+}
+
+// TableName 表名
+func (e *LedgerEntry) TableName() string {
+	return "t_ledger_entry"
+}
@@ -0,0 +1,97 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PaymentRepo 支付数据访问接口
+type PaymentRepo interface {
+	CreatePayment(ctx context.Context, payment *model.Payment) (bool, error) // 订单已有支付单时不写入并返回false
+	UpdatePayURL(ctx context.Context, paymentNo, payURL string) error
+	GetPaymentByNo(ctx context.Context, paymentNo string) (*model.Payment, error)
+	GetLatestPaymentByOrderID(ctx context.Context, orderID int64) (*model.Payment, error) // 不存在返回nil
+	MarkPaid(ctx context.Context, paymentNo, tradeNo string, paidTime time.Time) (bool, error)
+}
+
+// paymentRepo 实现
+type paymentRepo struct{}
+
+// NewPaymentRepo 创建实例
+func NewPaymentRepo() PaymentRepo {
+	return &paymentRepo{}
+}
+
+// CreatePayment 创建支付单（以订单ID唯一索引幂等，并发创建时仅一笔写入）
+func (r *paymentRepo) CreatePayment(ctx context.Context, payment *model.Payment) (bool, error) {
+	tx := db.Mysql.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(payment)
+	if tx.Error != nil {
+		zap.L().Error("创建支付单失败", zap.Any("payment", payment), zap.Error(tx.Error))
+		return false, utils.NewDBError("创建支付单失败：" + tx.Error.Error())
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+// UpdatePayURL 更新支付链接
+func (r *paymentRepo) UpdatePayURL(ctx context.Context, paymentNo, payURL string) error {
+	tx := db.Mysql.WithContext(ctx).Model(&model.Payment{}).
+		Where("payment_no = ?", paymentNo).
+		Update("pay_url", payURL)
+	if tx.Error != nil {
+		zap.L().Error("更新支付链接失败", zap.String("payment_no", paymentNo), zap.Error(tx.Error))
+		return utils.NewDBError("更新支付单失败：" + tx.Error.Error())
+	}
+	return nil
+}
+
+// GetPaymentByNo 根据支付单号查询
+func (r *paymentRepo) GetPaymentByNo(ctx context.Context, paymentNo string) (*model.Payment, error) {
+	var payment model.Payment
+	tx := db.Mysql.WithContext(ctx).Where("payment_no = ?", paymentNo).First(&payment)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return nil, utils.NewBizError("支付单不存在")
+		}
+		zap.L().Error("查询支付单失败", zap.String("payment_no", paymentNo), zap.Error(tx.Error))
+		return nil, utils.NewDBError("查询支付单失败：" + tx.Error.Error())
+	}
+	return &payment, nil
+}
+
+// GetLatestPaymentByOrderID 查询订单最近一笔支付单
+func (r *paymentRepo) GetLatestPaymentByOrderID(ctx context.Context, orderID int64) (*model.Payment, error) {
+	var payment model.Payment
+	tx := db.Mysql.WithContext(ctx).Where("order_id = ?", orderID).Order("payment_id DESC").First(&payment)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		zap.L().Error("查询订单支付单失败", zap.Int64("order_id", orderID), zap.Error(tx.Error))
+		return nil, utils.NewDBError("查询支付单失败：" + tx.Error.Error())
+	}
+	return &payment, nil
+}
+
+// MarkPaid 标记支付成功（条件更新：仅待支付可更新，返回是否本次更新成功）
+func (r *paymentRepo) MarkPaid(ctx context.Context, paymentNo, tradeNo string, paidTime time.Time) (bool, error) {
+	tx := db.Mysql.WithContext(ctx).Model(&model.Payment{}).
+		Where("payment_no = ? AND status = ?", paymentNo, "待支付").
+		Updates(map[string]interface{}{
+			"status":    "已支付",
+			"trade_no":  tradeNo,
+			"paid_time": paidTime,
+		})
+	if tx.Error != nil {
+		zap.L().Error("更新支付单为已支付失败", zap.String("payment_no", paymentNo), zap.Error(tx.Error))
+		return false, utils.NewDBError("更新支付单失败：" + tx.Error.Error())
+	}
+	return tx.RowsAffected > 0, nil
+}
@@ -0,0 +1,174 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RefundFilter 退款流水查询条件（零值表示不过滤）
+type RefundFilter struct {
+	OrderID int64
+	UserID  int64
+	Status  string
+	Scene   string
+}
+
+// RefundRepo 退款流水数据访问接口
+type RefundRepo interface {
+	CreateRefund(ctx context.Context, refund *model.Refund) error
+	GetRefundByNo(ctx context.Context, refundNo string) (*model.Refund, error) // 不存在返回nil
+	MarkRefundFailed(ctx context.Context, refundNo, failReason string) error
+	CompleteRefund(ctx context.Context, refundNo string, refundTime time.Time) (bool, error) // 事务更新退款成功+支付单累计退款（返回是否本次更新成功）
+	ListRefunds(ctx context.Context, filter RefundFilter, page, pageSize int32) ([]*model.Refund, int64, error)
+}
+
+// refundRepo 实现
+type refundRepo struct{}
+
+// NewRefundRepo 创建实例
+func NewRefundRepo() RefundRepo {
+	return &refundRepo{}
+}
+
+// CreateRefund 创建退款流水
+func (r *refundRepo) CreateRefund(ctx context.Context, refund *model.Refund) error {
+	if err := db.Mysql.WithContext(ctx).Create(refund).Error; err != nil {
+		zap.L().Error("创建退款流水失败", zap.Any("refund", refund), zap.Error(err))
+		return utils.NewDBError("创建退款流水失败：" + err.Error())
+	}
+	return nil
+}
+
+// GetRefundByNo 根据退款单号查询
+func (r *refundRepo) GetRefundByNo(ctx context.Context, refundNo string) (*model.Refund, error) {
+	var refund model.Refund
+	tx := db.Mysql.WithContext(ctx).Where("refund_no = ?", refundNo).First(&refund)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		zap.L().Error("查询退款流水失败", zap.String("refund_no", refundNo), zap.Error(tx.Error))
+		return nil, utils.NewDBError("查询退款流水失败：" + tx.Error.Error())
+	}
+	return &refund, nil
+}
+
+// MarkRefundFailed 标记退款失败（已成功的流水不覆盖）
+func (r *refundRepo) MarkRefundFailed(ctx context.Context, refundNo, failReason string) error {
+	tx := db.Mysql.WithContext(ctx).Model(&model.Refund{}).
+		Where("refund_no = ? AND status <> ?", refundNo, "退款成功").
+		Updates(map[string]interface{}{
+			"status":      "退款失败",
+			"fail_reason": failReason,
+		})
+	if tx.Error != nil {
+		zap.L().Error("标记退款失败出错", zap.String("refund_no", refundNo), zap.Error(tx.Error))
+		return utils.NewDBError("更新退款流水失败：" + tx.Error.Error())
+	}
+	return nil
+}
+
+// CompleteRefund 事务更新退款流水为成功，并累加支付单退款金额（退满为已退款，否则为部分退款）
+func (r *refundRepo) CompleteRefund(ctx context.Context, refundNo string, refundTime time.Time) (bool, error) {
+	completed := false
+	err := db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. 更新退款流水（条件更新：已成功的不重复处理）
+		var refund model.Refund
+		if err := tx.Where("refund_no = ?", refundNo).First(&refund).Error; err != nil {
+			zap.L().Error("查询退款流水失败", zap.String("refund_no", refundNo), zap.Error(err))
+			return utils.NewDBError("查询退款流水失败：" + err.Error())
+		}
+		res := tx.Model(&model.Refund{}).
+			Where("refund_no = ? AND status <> ?", refundNo, "退款成功").
+			Updates(map[string]interface{}{
+				"status":      "退款成功",
+				"fail_reason": "",
+				"refund_time": refundTime,
+			})
+		if res.Error != nil {
+			zap.L().Error("更新退款流水为成功失败", zap.String("refund_no", refundNo), zap.Error(res.Error))
+			return utils.NewDBError("更新退款流水失败：" + res.Error.Error())
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+
+		// 2. 锁定支付单，累加退款金额
+		var payment model.Payment
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("payment_id = ?", refund.PaymentID).First(&payment).Error; err != nil {
+			zap.L().Error("查询支付单失败", zap.Int64("payment_id", refund.PaymentID), zap.Error(err))
+			return utils.NewDBError("查询支付单失败：" + err.Error())
+		}
+		refundedAmount := payment.RefundedAmount + refund.Amount
+		if refundedAmount > payment.Amount+0.001 {
+			return utils.NewBizError("累计退款金额超过支付金额")
+		}
+		status := "部分退款"
+		if refundedAmount >= payment.Amount-0.001 {
+			status = "已退款"
+		}
+		if err := tx.Model(&model.Payment{}).Where("payment_id = ?", payment.PaymentID).
+			Updates(map[string]interface{}{
+				"refunded_amount": refundedAmount,
+				"status":          status,
+				"refund_time":     refundTime,
+			}).Error; err != nil {
+			zap.L().Error("更新支付单退款金额失败", zap.Int64("payment_id", payment.PaymentID), zap.Error(err))
+			return utils.NewDBError("更新支付单失败：" + err.Error())
+		}
+		completed = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return completed, nil
+}
+
+// ListRefunds 分页查询退款流水
+func (r *refundRepo) ListRefunds(ctx context.Context, filter RefundFilter, page, pageSize int32) ([]*model.Refund, int64, error) {
+	var (
+		refunds []*model.Refund
+		total   int64
+	)
+
+	// 构建查询条件
+	query := db.Mysql.WithContext(ctx).Model(&model.Refund{})
+	if filter.OrderID > 0 {
+		query = query.Where("order_id = ?", filter.OrderID)
+	}
+	if filter.UserID > 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Scene != "" {
+		query = query.Where("scene = ?", filter.Scene)
+	}
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("统计退款流水总数失败", zap.Any("filter", filter), zap.Error(err))
+		return nil, 0, utils.NewDBError("查询退款流水失败：" + err.Error())
+	}
+
+	// 分页查询
+	offset := (page - 1) * pageSize
+	if err := query.Offset(int(offset)).Limit(int(pageSize)).
+		Order("create_time DESC").Find(&refunds).Error; err != nil {
+		zap.L().Error("查询退款流水列表失败", zap.Any("filter", filter), zap.Error(err))
+		return nil, 0, utils.NewDBError("查询退款流水失败：" + err.Error())
+	}
+
+	return refunds, total, nil
+}
@@ -0,0 +1,241 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StatementFilter 结算单查询条件（零值表示不过滤）
+type StatementFilter struct {
+	MerchantID int64
+	StartDate  time.Time // 结算日期起（含）
+	EndDate    time.Time // 结算日期止（含）
+}
+
+// SettlementRepo 商家结算数据访问接口
+type SettlementRepo interface {
+	GetCommissionRate(ctx context.Context, merchantID int64) (float64, bool, error) // 未单独配置返回false
+	SetCommissionRate(ctx context.Context, merchantID int64, rate float64) error
+	CreateSettlement(ctx context.Context, item *model.SettlementItem, journal *model.LedgerJournal, entries []*model.LedgerEntry) (bool, error) // 事务写入凭证+分录+结算明细（返回是否本次写入，已结算的订单返回false）
+	GenerateStatements(ctx context.Context, before time.Time) (int, error)                                                                      // 将before之前完成的未出账明细按商家+日期汇总为结算单（已打款的不再变更），返回涉及结算单数
+	ListStatements(ctx context.Context, filter StatementFilter, page, pageSize int32) ([]*model.SettlementStatement, int64, error)
+	GetStatement(ctx context.Context, statementID int64) (*model.SettlementStatement, error) // 不存在返回nil
+	ListStatementItems(ctx context.Context, statementID int64) ([]*model.SettlementItem, error)
+}
+
+// settlementRepo 实现
+type settlementRepo struct{}
+
+// NewSettlementRepo 创建实例
+func NewSettlementRepo() SettlementRepo {
+	return &settlementRepo{}
+}
+
+// GetCommissionRate 查询商家佣金比例
+func (r *settlementRepo) GetCommissionRate(ctx context.Context, merchantID int64) (float64, bool, error) {
+	var commission model.MerchantCommission
+	tx := db.Mysql.WithContext(ctx).Where("merchant_id = ?", merchantID).First(&commission)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return 0, false, nil
+		}
+		zap.L().Error("查询商家佣金比例失败", zap.Int64("merchant_id", merchantID), zap.Error(tx.Error))
+		return 0, false, utils.NewDBError("查询商家佣金比例失败：" + tx.Error.Error())
+	}
+	return commission.Rate, true, nil
+}
+
+// SetCommissionRate 设置商家佣金比例（已存在则覆盖）
+func (r *settlementRepo) SetCommissionRate(ctx context.Context, merchantID int64, rate float64) error {
+	err := db.Mysql.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "merchant_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "update_time"}),
+	}).Create(&model.MerchantCommission{MerchantID: merchantID, Rate: rate}).Error
+	if err != nil {
+		zap.L().Error("设置商家佣金比例失败", zap.Int64("merchant_id", merchantID), zap.Float64("rate", rate), zap.Error(err))
+		return utils.NewDBError("设置商家佣金比例失败：" + err.Error())
+	}
+	return nil
+}
+
+// CreateSettlement 事务写入记账凭证、分录及结算明细（以凭证号幂等）
+func (r *settlementRepo) CreateSettlement(ctx context.Context, item *model.SettlementItem, journal *model.LedgerJournal, entries []*model.LedgerEntry) (bool, error) {
+	created := false
+	err := db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. 写入凭证（凭证号已存在说明已结算过）
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(journal)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+
+		// 2. 写入分录及结算明细
+		for _, entry := range entries {
+			entry.JournalID = journal.JournalID
+		}
+		if err := tx.Create(&entries).Error; err != nil {
+			return err
+		}
+		item.JournalNo = journal.JournalNo
+		if err := tx.Create(item).Error; err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
+	if err != nil {
+		zap.L().Error("写入结算明细失败", zap.Int64("order_id", item.OrderID), zap.String("journal_no", journal.JournalNo), zap.Error(err))
+		return false, utils.NewDBError("写入结算明细失败：" + err.Error())
+	}
+	return created, nil
+}
+
+// GenerateStatements 汇总未出账的结算明细（幂等：迟到的明细并入当日待打款的结算单并重算合计，
+// 当日结算单已打款时另建补充结算单，已打款结算单的明细和合计保持不变）
+func (r *settlementRepo) GenerateStatements(ctx context.Context, before time.Time) (int, error) {
+	// 1. 查询待出账的商家+日期
+	var groups []struct {
+		MerchantID int64
+		SettleDate time.Time
+	}
+	err := db.Mysql.WithContext(ctx).Model(&model.SettlementItem{}).
+		Select("merchant_id, DATE(completed_time) AS settle_date").
+		Where("statement_id = 0 AND completed_time < ?", before).
+		Group("merchant_id, DATE(completed_time)").Scan(&groups).Error
+	if err != nil {
+		zap.L().Error("查询待出账结算明细失败", zap.Time("before", before), zap.Error(err))
+		return 0, utils.NewDBError("查询待出账结算明细失败：" + err.Error())
+	}
+
+	// 2. 逐个商家+日期生成结算单
+	for i, g := range groups {
+		err = db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// 2.1 锁定当日最新的结算单，不存在或已打款时新建（同日序号递增）
+			var statement model.SettlementStatement
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("merchant_id = ? AND settle_date = ?", g.MerchantID, g.SettleDate).
+				Order("seq DESC").First(&statement).Error
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			if err != nil || statement.Status != "待打款" {
+				seq := int32(0)
+				if err == nil {
+					seq = statement.Seq + 1
+				}
+				statement = model.SettlementStatement{MerchantID: g.MerchantID, SettleDate: g.SettleDate, Seq: seq, Status: "待打款"}
+				if err = tx.Create(&statement).Error; err != nil {
+					return err
+				}
+			}
+
+			// 2.2 归集明细
+			dayEnd := g.SettleDate.AddDate(0, 0, 1)
+			if err := tx.Model(&model.SettlementItem{}).
+				Where("merchant_id = ? AND statement_id = 0 AND completed_time >= ? AND completed_time < ?", g.MerchantID, g.SettleDate, dayEnd).
+				Update("statement_id", statement.StatementID).Error; err != nil {
+				return err
+			}
+
+			// 2.3 按明细重算合计
+			var sum struct {
+				OrderCount       int64
+				ItemAmount       float64
+				Commission       float64
+				MerchantDiscount float64
+				SettleAmount     float64
+			}
+			if err := tx.Model(&model.SettlementItem{}).
+				Select("COUNT(*) AS order_count, COALESCE(SUM(item_amount), 0) AS item_amount, COALESCE(SUM(commission), 0) AS commission, "+
+					"COALESCE(SUM(merchant_discount), 0) AS merchant_discount, COALESCE(SUM(settle_amount), 0) AS settle_amount").
+				Where("statement_id = ?", statement.StatementID).Scan(&sum).Error; err != nil {
+				return err
+			}
+			return tx.Model(&model.SettlementStatement{}).Where("statement_id = ?", statement.StatementID).
+				Updates(map[string]interface{}{
+					"order_count":       sum.OrderCount,
+					"item_amount":       sum.ItemAmount,
+					"commission":        sum.Commission,
+					"merchant_discount": sum.MerchantDiscount,
+					"settle_amount":     sum.SettleAmount,
+				}).Error
+		})
+		if err != nil {
+			zap.L().Error("生成结算单失败", zap.Int64("merchant_id", g.MerchantID), zap.Time("settle_date", g.SettleDate), zap.Error(err))
+			return i, utils.NewDBError("生成结算单失败：" + err.Error())
+		}
+	}
+	return len(groups), nil
+}
+
+// ListStatements 分页查询结算单
+func (r *settlementRepo) ListStatements(ctx context.Context, filter StatementFilter, page, pageSize int32) ([]*model.SettlementStatement, int64, error) {
+	var (
+		statements []*model.SettlementStatement
+		total      int64
+	)
+
+	// 构建查询条件
+	query := db.Mysql.WithContext(ctx).Model(&model.SettlementStatement{})
+	if filter.MerchantID > 0 {
+		query = query.Where("merchant_id = ?", filter.MerchantID)
+	}
+	if !filter.StartDate.IsZero() {
+		query = query.Where("settle_date >= ?", filter.StartDate)
+	}
+	if !filter.EndDate.IsZero() {
+		query = query.Where("settle_date <= ?", filter.EndDate)
+	}
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("统计结算单总数失败", zap.Any("filter", filter), zap.Error(err))
+		return nil, 0, utils.NewDBError("查询结算单失败：" + err.Error())
+	}
+
+	// 分页查询
+	offset := (page - 1) * pageSize
+	if err := query.Offset(int(offset)).Limit(int(pageSize)).
+		Order("settle_date DESC, statement_id DESC").Find(&statements).Error; err != nil {
+		zap.L().Error("查询结算单列表失败", zap.Any("filter", filter), zap.Error(err))
+		return nil, 0, utils.NewDBError("查询结算单失败：" + err.Error())
+	}
+
+	return statements, total, nil
+}
+
+// GetStatement 根据ID查询结算单
+func (r *settlementRepo) GetStatement(ctx context.Context, statementID int64) (*model.SettlementStatement, error) {
+	var statement model.SettlementStatement
+	tx := db.Mysql.WithContext(ctx).Where("statement_id = ?", statementID).First(&statement)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		zap.L().Error("查询结算单失败", zap.Int64("statement_id", statementID), zap.Error(tx.Error))
+		return nil, utils.NewDBError("查询结算单失败：" + tx.Error.Error())
+	}
+	return &statement, nil
+}
+
+// ListStatementItems 查询结算单明细（按订单完成时间正序）
+func (r *settlementRepo) ListStatementItems(ctx context.Context, statementID int64) ([]*model.SettlementItem, error) {
+	var items []*model.SettlementItem
+	err := db.Mysql.WithContext(ctx).Where("statement_id = ?", statementID).
+		Order("completed_time, item_id").Find(&items).Error
+	if err != nil {
+		zap.L().Error("查询结算明细失败", zap.Int64("statement_id", statementID), zap.Error(err))
+		return nil, utils.NewDBError("查询结算明细失败：" + err.Error())
+	}
+	return items, nil
+}
@@ -0,0 +1,480 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	orderProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/order/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/client"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/gateway"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// 入参结构体
+type CreatePaymentParam struct {
+	OrderID int64  `validate:"required,gt=0"`
+	UserID  int64  `validate:"required,gt=0"`
+	Channel string `validate:"omitempty"`
+}
+
+type PaymentCallbackParam struct {
+	PaymentNo   string  `validate:"required"`
+	TradeNo     string  `validate:"required"`
+	TradeStatus string  `validate:"required,oneof=SUCCESS FAILED"`
+	Amount      float64 `validate:"required,gt=0"`
+	Sign        string  `validate:"required"`
+}
+
+type ListRefundsParam struct {
+	OrderID  int64  `validate:"omitempty,gt=0"`
+	UserID   int64  `validate:"omitempty,gt=0"`
+	Status   string `validate:"omitempty,oneof=退款中 退款成功 退款失败"`
+	Scene    string `validate:"omitempty"`
+	Page     int32  `validate:"required,gte=1"`
+	PageSize int32  `validate:"required,gte=10,lte=100"`
+}
+
+// 响应结构体
+type PaymentResult struct {
+	PaymentID      int64   `json:"payment_id"`
+	PaymentNo      string  `json:"payment_no"`
+	OrderID        int64   `json:"order_id"`
+	OrderNo        string  `json:"order_no"`
+	UserID         int64   `json:"user_id"`
+	Amount         float64 `json:"amount"`
+	Channel        string  `json:"channel"`
+	Status         string  `json:"status"`
+	TradeNo        string  `json:"trade_no"`
+	PayURL         string  `json:"pay_url"`
+	ExpireTime     string  `json:"expire_time"`
+	PaidTime       string  `json:"paid_time"`
+	RefundTime     string  `json:"refund_time"`
+	CreateTime     string  `json:"create_time"`
+	RefundedAmount float64 `json:"refunded_amount"`
+}
+
+type RefundResult struct {
+	RefundID   int64   `json:"refund_id"`
+	RefundNo   string  `json:"refund_no"`
+	PaymentNo  string  `json:"payment_no"`
+	OrderID    int64   `json:"order_id"`
+	OrderNo    string  `json:"order_no"`
+	UserID     int64   `json:"user_id"`
+	Amount     float64 `json:"amount"`
+	RefundType string  `json:"refund_type"`
+	Scene      string  `json:"scene"`
+	Reason     string  `json:"reason"`
+	Items      string  `json:"items"`
+	Status     string  `json:"status"`
+	FailReason string  `json:"fail_reason"`
+	RefundTime string  `json:"refund_time"`
+	CreateTime string  `json:"create_time"`
+}
+
+type ListRefundsResult struct {
+	Refunds  []RefundResult `json:"refunds"`
+	Total    int32          `json:"total"`
+	Page     int32          `json:"page"`
+	PageSize int32          `json:"page_size"`
+}
+
+// PaymentService 支付业务逻辑接口
+type PaymentService interface {
+	CreatePayment(ctx context.Context, param CreatePaymentParam) (PaymentResult, error)
+	PaymentCallback(ctx context.Context, param PaymentCallbackParam) error
+	GetPayment(ctx context.Context, orderID int64) (PaymentResult, error)
+	ListRefunds(ctx context.Context, param ListRefundsParam) (ListRefundsResult, error) // 客服查询退款流水
+	HandleOrderRefund(ctx context.Context, msg *sarama.ConsumerMessage) error           // 消费订单退款消息
+}
+
+// paymentService 实现
+type paymentService struct {
+	paymentRepo repo.PaymentRepo
+	refundRepo  repo.RefundRepo
+	gateway     gateway.Gateway
+	validate    *validator.Validate
+}
+
+// NewPaymentService 创建实例
+func NewPaymentService(paymentRepo repo.PaymentRepo, refundRepo repo.RefundRepo, gw gateway.Gateway) PaymentService {
+	return &paymentService{
+		paymentRepo: paymentRepo,
+		refundRepo:  refundRepo,
+		gateway:     gw,
+		validate:    validator.New(),
+	}
+}
+
+// CreatePayment 创建支付单（同一订单未过期的待支付单直接复用）
+func (s *paymentService) CreatePayment(ctx context.Context, param CreatePaymentParam) (PaymentResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("创建支付单参数校验失败", zap.Any("param", param), zap.Error(err))
+		return PaymentResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+	if param.Channel == "" {
+		param.Channel = s.gateway.Name()
+	}
+	if param.Channel != s.gateway.Name() {
+		return PaymentResult{}, utils.NewParamError("不支持的支付渠道：" + param.Channel)
+	}
+	if err := middleware.CheckIdentity(ctx, "user", param.UserID); err != nil {
+		return PaymentResult{}, err
+	}
+
+	// 2. 查询订单，校验归属及状态
+	orderResp, err := client.OrderClient.GetOrderByID(ctx, &orderProto.GetOrderRequest{OrderId: param.OrderID})
+	if err != nil {
+		zap.L().Error("调用订单服务查询订单失败", zap.Int64("order_id", param.OrderID), zap.Error(err))
+		return PaymentResult{}, utils.NewSystemError("创建支付单失败，订单服务异常")
+	}
+	if orderResp.Code != utils.ErrCodeSuccess {
+		return PaymentResult{}, utils.NewAppError(int(orderResp.Code), orderResp.Msg)
+	}
+	order := orderResp.Order
+	if order.UserId != param.UserID {
+		return PaymentResult{}, utils.NewAuthError("订单不属于该用户")
+	}
+	if order.Status != "待支付" {
+		return PaymentResult{}, utils.NewBizError("当前订单状态为" + order.Status + "，无需支付")
+	}
+	createTime, err := time.ParseInLocation("2006-01-02 15:04:05", order.CreateTime, time.Local)
+	if err != nil {
+		return PaymentResult{}, utils.NewSystemError("订单创建时间格式错误")
+	}
+	expireTime := createTime.Add(config.Cfg.Payment.ExpireDuration())
+	if time.Now().After(expireTime) {
+		return PaymentResult{}, utils.NewBizError("订单已超时，请重新下单")
+	}
+
+	// 3. 复用已有支付单
+	existPayment, err := s.paymentRepo.GetLatestPaymentByOrderID(ctx, param.OrderID)
+	if err != nil {
+		return PaymentResult{}, err
+	}
+	if existPayment != nil {
+		return reusePayment(existPayment)
+	}
+
+	// 4. 创建支付单（并发创建时复用先写入的支付单）
+	payment := &model.Payment{
+		OrderID:    order.OrderId,
+		OrderNo:    order.OrderNo,
+		UserID:     order.UserId,
+		Amount:     utils.RoundMoney(float64(order.TotalAmount)),
+		Channel:    param.Channel,
+		Status:     "待支付",
+		ExpireTime: expireTime,
+	}
+	created, err := s.paymentRepo.CreatePayment(ctx, payment)
+	if err != nil {
+		return PaymentResult{}, err
+	}
+	if !created {
+		if existPayment, err = s.paymentRepo.GetLatestPaymentByOrderID(ctx, param.OrderID); err != nil {
+			return PaymentResult{}, err
+		}
+		if existPayment == nil {
+			return PaymentResult{}, utils.NewSystemError("创建支付单失败，请稍后重试")
+		}
+		return reusePayment(existPayment)
+	}
+
+	// 5. 调用网关发起支付
+	payResult, err := s.gateway.Pay(ctx, gateway.PayParam{
+		PaymentNo: payment.PaymentNo,
+		Amount:    payment.Amount,
+		Subject:   "订单" + payment.OrderNo,
+	})
+	if err != nil {
+		zap.L().Error("调用支付网关失败", zap.String("payment_no", payment.PaymentNo), zap.Error(err))
+		return PaymentResult{}, utils.NewSystemError("发起支付失败，支付网关异常")
+	}
+	if err = s.paymentRepo.UpdatePayURL(ctx, payment.PaymentNo, payResult.PayURL); err != nil {
+		return PaymentResult{}, err
+	}
+	payment.PayURL = payResult.PayURL
+
+	zap.L().Info("创建支付单成功", zap.String("payment_no", payment.PaymentNo), zap.Int64("order_id", payment.OrderID))
+	return toPaymentResult(payment), nil
+}
+
+// PaymentCallback 支付回调（验签→金额校验→更新支付单→投递支付成功消息）
+func (s *paymentService) PaymentCallback(ctx context.Context, param PaymentCallbackParam) error {
+	// 1. 参数校验+验签
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("支付回调参数校验失败", zap.Any("param", param), zap.Error(err))
+		return utils.NewParamError("参数错误：" + err.Error())
+	}
+	if err := s.gateway.VerifyCallback(gateway.CallbackParam{
+		PaymentNo:   param.PaymentNo,
+		TradeNo:     param.TradeNo,
+		TradeStatus: param.TradeStatus,
+		Amount:      param.Amount,
+		Sign:        param.Sign,
+	}); err != nil {
+		zap.L().Warn("支付回调验签失败", zap.Any("param", param), zap.Error(err))
+		return utils.NewAuthError("签名校验失败")
+	}
+
+	// 2. 查询支付单，校验金额
+	payment, err := s.paymentRepo.GetPaymentByNo(ctx, param.PaymentNo)
+	if err != nil {
+		return err
+	}
+	if math.Abs(payment.Amount-param.Amount) > 0.001 {
+		zap.L().Error("支付回调金额不一致", zap.String("payment_no", param.PaymentNo), zap.Float64("amount", payment.Amount), zap.Float64("callback_amount", param.Amount))
+		return utils.NewBizError("支付金额不一致")
+	}
+	if param.TradeStatus == gateway.TradeFailed {
+		zap.L().Warn("支付失败回调", zap.String("payment_no", param.PaymentNo), zap.String("trade_no", param.TradeNo))
+		return nil
+	}
+
+	// 3. 更新为已支付（重复回调幂等，重新投递消息以防上次投递失败）
+	paidTime := time.Now()
+	marked, err := s.paymentRepo.MarkPaid(ctx, param.PaymentNo, param.TradeNo, paidTime)
+	if err != nil {
+		return err
+	}
+	if !marked {
+		if payment.PaidTime == nil {
+			zap.L().Warn("支付单状态不允许更新为已支付", zap.String("payment_no", param.PaymentNo), zap.String("status", payment.Status))
+			return nil
+		}
+		paidTime = *payment.PaidTime
+	}
+
+	// 4. 投递支付成功消息（订单服务消费后流转为待接单）
+	event := kafka.PaymentPaidEvent{
+		PaymentNo: payment.PaymentNo,
+		OrderID:   payment.OrderID,
+		Amount:    payment.Amount,
+		PaidTime:  paidTime,
+	}
+	if err = kafka.SendJSON(kafka.TopicPaymentPaid, strconv.FormatInt(payment.OrderID, 10), event); err != nil {
+		zap.L().Error("投递支付成功消息失败", zap.Any("event", event), zap.Error(err))
+		return utils.NewSystemError("支付结果处理失败，请重试")
+	}
+
+	zap.L().Info("支付成功", zap.String("payment_no", param.PaymentNo), zap.Int64("order_id", payment.OrderID))
+	return nil
+}
+
+// GetPayment 查询订单支付信息（仅下单用户本人可查）
+func (s *paymentService) GetPayment(ctx context.Context, orderID int64) (PaymentResult, error) {
+	if orderID <= 0 {
+		return PaymentResult{}, utils.NewParamError("订单ID不能为空且大于0")
+	}
+	payment, err := s.paymentRepo.GetLatestPaymentByOrderID(ctx, orderID)
+	if err != nil {
+		return PaymentResult{}, err
+	}
+	if payment == nil {
+		return PaymentResult{}, utils.NewBizError("支付单不存在")
+	}
+	if err = middleware.CheckIdentity(ctx, "user", payment.UserID); err != nil {
+		return PaymentResult{}, err
+	}
+	return toPaymentResult(payment), nil
+}
+
+// ListRefunds 查询退款流水（客服/系统可查全部，用户仅可查自己的）
+func (s *paymentService) ListRefunds(ctx context.Context, param ListRefundsParam) (ListRefundsResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("查询退款流水参数校验失败", zap.Any("param", param), zap.Error(err))
+		return ListRefundsResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+
+	// 2. 校验身份
+	claims, err := middleware.CheckRole(ctx, "admin", "system", "user")
+	if err != nil {
+		return ListRefundsResult{}, err
+	}
+	if claims.Role == "user" {
+		userID, _ := strconv.ParseInt(claims.UserID, 10, 64)
+		if param.UserID != 0 && param.UserID != userID {
+			return ListRefundsResult{}, utils.NewAuthError("无权限查询其他用户的退款")
+		}
+		param.UserID = userID
+	}
+
+	// 3. 查询退款流水
+	refunds, total, err := s.refundRepo.ListRefunds(ctx, repo.RefundFilter{
+		OrderID: param.OrderID,
+		UserID:  param.UserID,
+		Status:  param.Status,
+		Scene:   param.Scene,
+	}, param.Page, param.PageSize)
+	if err != nil {
+		return ListRefundsResult{}, err
+	}
+
+	// 4. 组装结果
+	var results []RefundResult
+	for _, refund := range refunds {
+		results = append(results, toRefundResult(refund))
+	}
+	return ListRefundsResult{
+		Refunds:  results,
+		Total:    int32(total),
+		Page:     param.Page,
+		PageSize: param.PageSize,
+	}, nil
+}
+
+// HandleOrderRefund 订单退款消息处理（记录退款流水→调用网关原路退款→累加支付单退款金额）
+func (s *paymentService) HandleOrderRefund(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var event kafka.OrderRefundEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil || event.RefundNo == "" {
+		zap.L().Error("订单退款消息格式错误", zap.ByteString("value", msg.Value), zap.Error(err))
+		return nil // 格式错误无法重试，直接跳过
+	}
+
+	// 1. 幂等：按退款单号查询已有流水
+	refund, err := s.refundRepo.GetRefundByNo(ctx, event.RefundNo)
+	if err != nil {
+		return err
+	}
+	if refund != nil && refund.Status == "退款成功" {
+		zap.L().Info("退款已完成，跳过", zap.String("refund_no", event.RefundNo))
+		return nil
+	}
+
+	// 2. 查询支付单，首次处理时记录退款流水
+	var payment *model.Payment
+	if refund != nil {
+		if payment, err = s.paymentRepo.GetPaymentByNo(ctx, refund.PaymentNo); err != nil {
+			return err
+		}
+	} else {
+		if payment, err = s.paymentRepo.GetLatestPaymentByOrderID(ctx, event.OrderID); err != nil {
+			return err
+		}
+		if payment == nil || payment.PaidTime == nil {
+			zap.L().Info("订单无已支付的支付单，跳过退款", zap.Int64("order_id", event.OrderID))
+			return nil
+		}
+		amount := math.Min(event.Amount, utils.RoundMoney(payment.Amount-payment.RefundedAmount))
+		if amount <= 0 {
+			zap.L().Warn("支付单已无可退金额，跳过退款", zap.String("payment_no", payment.PaymentNo), zap.String("refund_no", event.RefundNo))
+			return nil
+		}
+		var items string
+		if len(event.Items) > 0 {
+			itemsJSON, _ := json.Marshal(event.Items)
+			items = string(itemsJSON)
+		}
+		refund = &model.Refund{
+			RefundNo:   event.RefundNo,
+			PaymentID:  payment.PaymentID,
+			PaymentNo:  payment.PaymentNo,
+			OrderID:    event.OrderID,
+			OrderNo:    event.OrderNo,
+			UserID:     event.UserID,
+			Amount:     amount,
+			RefundType: event.RefundType,
+			Scene:      event.Scene,
+			Reason:     event.Reason,
+			Items:      items,
+			Status:     "退款中",
+		}
+		if err = s.refundRepo.CreateRefund(ctx, refund); err != nil {
+			return err
+		}
+	}
+
+	// 3. 调用网关退款（失败记录原因后返回错误，由消费者重试）
+	if err = s.gateway.Refund(ctx, gateway.RefundParam{
+		PaymentNo: payment.PaymentNo,
+		TradeNo:   payment.TradeNo,
+		RefundNo:  refund.RefundNo,
+		Amount:    refund.Amount,
+		Reason:    refund.Reason,
+	}); err != nil {
+		zap.L().Error("调用支付网关退款失败", zap.String("refund_no", refund.RefundNo), zap.Error(err))
+		if markErr := s.refundRepo.MarkRefundFailed(ctx, refund.RefundNo, err.Error()); markErr != nil {
+			zap.L().Error("记录退款失败原因失败", zap.String("refund_no", refund.RefundNo), zap.Error(markErr))
+		}
+		return err
+	}
+
+	// 4. 更新退款流水及支付单
+	if _, err = s.refundRepo.CompleteRefund(ctx, refund.RefundNo, time.Now()); err != nil {
+		return err
+	}
+
+	zap.L().Info("订单退款成功", zap.String("refund_no", refund.RefundNo), zap.Int64("order_id", refund.OrderID),
+		zap.Float64("amount", refund.Amount), zap.String("scene", refund.Scene))
+	return nil
+}
+
+// reusePayment 复用订单已有的支付单（仅待支付可继续支付）
+func reusePayment(payment *model.Payment) (PaymentResult, error) {
+	if payment.Status != "待支付" {
+		return PaymentResult{}, utils.NewBizError("订单已支付")
+	}
+	return toPaymentResult(payment), nil
+}
+
+// toPaymentResult 模型 → 领域层结果
+func toPaymentResult(payment *model.Payment) PaymentResult {
+	result := PaymentResult{
+		PaymentID:      payment.PaymentID,
+		PaymentNo:      payment.PaymentNo,
+		OrderID:        payment.OrderID,
+		OrderNo:        payment.OrderNo,
+		UserID:         payment.UserID,
+		Amount:         payment.Amount,
+		Channel:        payment.Channel,
+		Status:         payment.Status,
+		TradeNo:        payment.TradeNo,
+		PayURL:         payment.PayURL,
+		ExpireTime:     payment.ExpireTime.Format("2006-01-02 15:04:05"),
+		CreateTime:     payment.CreateTime.Format("2006-01-02 15:04:05"),
+		RefundedAmount: payment.RefundedAmount,
+	}
+	if payment.PaidTime != nil {
+		result.PaidTime = payment.PaidTime.Format("2006-01-02 15:04:05")
+	}
+	if payment.RefundTime != nil {
+		result.RefundTime = payment.RefundTime.Format("2006-01-02 15:04:05")
+	}
+	return result
+}
+
+// toRefundResult 模型 → 领域层结果
+func toRefundResult(refund *model.Refund) RefundResult {
+	result := RefundResult{
+		RefundID:   refund.RefundID,
+		RefundNo:   refund.RefundNo,
+		PaymentNo:  refund.PaymentNo,
+		OrderID:    refund.OrderID,
+		OrderNo:    refund.OrderNo,
+		UserID:     refund.UserID,
+		Amount:     refund.Amount,
+		RefundType: refund.RefundType,
+		Scene:      refund.Scene,
+		Reason:     refund.Reason,
+		Items:      refund.Items,
+		Status:     refund.Status,
+		FailReason: refund.FailReason,
+		CreateTime: refund.CreateTime.Format("2006-01-02 15:04:05"),
+	}
+	if refund.RefundTime != nil {
+		result.RefundTime = refund.RefundTime.Format("2006-01-02 15:04:05")
+	}
+	return result
+}
@@ -0,0 +1,326 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/ledger"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/payment/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// 入参结构体
+type SetMerchantCommissionParam struct {
+	MerchantID int64   `validate:"required,gt=0"`
+	Rate       float64 `validate:"gte=0,lt=1"`
+}
+
+type ListStatementsParam struct {
+	MerchantID int64  `validate:"omitempty,gt=0"` // 商家查询时必填且为本店
+	StartDate  string `validate:"omitempty,datetime=2006-01-02"`
+	EndDate    string `validate:"omitempty,datetime=2006-01-02"`
+	Page       int32  `validate:"required,gte=1"`
+	PageSize   int32  `validate:"required,gte=10,lte=100"`
+}
+
+// 响应结构体
+type StatementResult struct {
+	StatementID      int64   `json:"statement_id"`
+	StatementNo      string  `json:"statement_no"`
+	MerchantID       int64   `json:"merchant_id"`
+	SettleDate       string  `json:"settle_date"`
+	Seq              int32   `json:"seq"`
+	OrderCount       int64   `json:"order_count"`
+	ItemAmount       float64 `json:"item_amount"`
+	Commission       float64 `json:"commission"`
+	MerchantDiscount float64 `json:"merchant_discount"`
+	SettleAmount     float64 `json:"settle_amount"`
+	Status           string  `json:"status"`
+	CreateTime       string  `json:"create_time"`
+}
+
+type StatementItemResult struct {
+	ItemID           int64   `json:"item_id"`
+	OrderID          int64   `json:"order_id"`
+	OrderNo          string  `json:"order_no"`
+	ItemAmount       float64 `json:"item_amount"`
+	CommissionRate   float64 `json:"commission_rate"`
+	Commission       float64 `json:"commission"`
+	MerchantDiscount float64 `json:"merchant_discount"`
+	SettleAmount     float64 `json:"settle_amount"`
+	JournalNo        string  `json:"journal_no"`
+	CompletedTime    string  `json:"completed_time"`
+}
+
+type ListStatementsResult struct {
+	Statements []StatementResult `json:"statements"`
+	Total      int32             `json:"total"`
+	Page       int32             `json:"page"`
+	PageSize   int32             `json:"page_size"`
+}
+
+type StatementDetailResult struct {
+	Statement StatementResult       `json:"statement"`
+	Items     []StatementItemResult `json:"items"`
+}
+
+// SettlementService 商家结算业务逻辑接口
+type SettlementService interface {
+	HandleOrderCompleted(ctx context.Context, msg *sarama.ConsumerMessage) error // 消费订单完成消息，记账并生成结算明细
+	GenerateDailyStatements(ctx context.Context) (int, error)                    // 生成日结算单（定时任务），返回涉及结算单数
+	SetMerchantCommission(ctx context.Context, param SetMerchantCommissionParam) error
+	ListSettlementStatements(ctx context.Context, param ListStatementsParam) (ListStatementsResult, error)
+	GetSettlementStatement(ctx context.Context, statementID int64) (StatementDetailResult, error)
+}
+
+// settlementService 实现
+type settlementService struct {
+	settlementRepo repo.SettlementRepo
+	validate       *validator.Validate
+}
+
+// NewSettlementService 创建实例
+func NewSettlementService(settlementRepo repo.SettlementRepo) SettlementService {
+	return &settlementService{
+		settlementRepo: settlementRepo,
+		validate:       validator.New(),
+	}
+}
+
+// HandleOrderCompleted 订单完成消息处理：按商家佣金比例计算应收，并以一张凭证记账
+//
+//	借 平台待清算款   用户实付
+//	借 平台优惠补贴   平台承担优惠
+//	  贷 应付商家     商品金额-佣金-商家承担优惠
+//	  贷 佣金收入     商品金额×佣金比例
+//	  贷 应付骑手小费 用户小费
+//	  贷 服务费收入   差额（打包费+配送费）
+func (s *settlementService) HandleOrderCompleted(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var event kafka.OrderCompletedEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil || event.OrderID == 0 || event.MerchantID == 0 {
+		zap.L().Error("订单完成消息格式错误", zap.ByteString("value", msg.Value), zap.Error(err))
+		return nil // 格式错误无法重试，直接跳过
+	}
+
+	// 1. 计算佣金及商家应收（佣金比例在结算时快照）
+	rate, ok, err := s.settlementRepo.GetCommissionRate(ctx, event.MerchantID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		rate = config.Cfg.Payment.DefaultCommissionRate()
+	}
+	commission := utils.RoundMoney(event.ItemAmount * rate)
+	settleAmount := utils.RoundMoney(event.ItemAmount - commission - event.MerchantDiscount)
+
+	// 2. 生成凭证分录
+	var journal ledger.Journal
+	journal.Debit(ledger.AccountClearing, event.PaidAmount)
+	journal.Debit(ledger.AccountSubsidy, event.PlatformDiscount)
+	journal.Credit(ledger.MerchantPayable(event.MerchantID), settleAmount)
+	journal.Credit(ledger.AccountCommission, commission)
+	journal.Credit(ledger.AccountRiderTip, event.TipAmount)
+	journal.Credit(ledger.AccountServiceFee, utils.RoundMoney(event.PaidAmount+event.PlatformDiscount-settleAmount-commission-event.TipAmount))
+	entries, err := journal.Entries()
+	if err != nil {
+		zap.L().Error("结算凭证生成失败，需人工处理", zap.Any("event", event), zap.Error(err))
+		return nil // 数据问题重试无意义
+	}
+	entryModels := make([]*model.LedgerEntry, 0, len(entries))
+	for _, e := range entries {
+		entryModels = append(entryModels, &model.LedgerEntry{Account: e.Account, Direction: e.Direction, Amount: e.Amount})
+	}
+
+	// 3. 写入凭证及结算明细（同一订单只结算一次）
+	created, err := s.settlementRepo.CreateSettlement(ctx, &model.SettlementItem{
+		MerchantID:       event.MerchantID,
+		OrderID:          event.OrderID,
+		OrderNo:          event.OrderNo,
+		ItemAmount:       event.ItemAmount,
+		CommissionRate:   rate,
+		Commission:       commission,
+		MerchantDiscount: event.MerchantDiscount,
+		SettleAmount:     settleAmount,
+		CompletedTime:    event.CompletedTime,
+	}, &model.LedgerJournal{
+		JournalNo: "ORDER-" + strconv.FormatInt(event.OrderID, 10),
+		BizType:   "订单结算",
+		BizID:     event.OrderID,
+		Memo:      "订单" + event.OrderNo + "完成结算",
+	}, entryModels)
+	if err != nil {
+		return err
+	}
+	if !created {
+		zap.L().Info("订单已结算，跳过", zap.Int64("order_id", event.OrderID))
+		return nil
+	}
+
+	zap.L().Info("订单结算记账成功", zap.Int64("order_id", event.OrderID), zap.Int64("merchant_id", event.MerchantID),
+		zap.Float64("commission", commission), zap.Float64("settle_amount", settleAmount))
+	return nil
+}
+
+// GenerateDailyStatements 将今天之前完成的订单明细汇总为日结算单
+func (s *settlementService) GenerateDailyStatements(ctx context.Context) (int, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	count, err := s.settlementRepo.GenerateStatements(ctx, today)
+	if err != nil {
+		return count, err
+	}
+	if count > 0 {
+		zap.L().Info("生成商家日结算单完成", zap.Int("statements", count))
+	}
+	return count, nil
+}
+
+// SetMerchantCommission 设置商家佣金比例（仅平台运营可操作，对之后完成的订单生效）
+func (s *settlementService) SetMerchantCommission(ctx context.Context, param SetMerchantCommissionParam) error {
+	// 1. 鉴权
+	claims, err := middleware.CheckRole(ctx, "admin")
+	if err != nil {
+		return err
+	}
+
+	// 2. 参数校验
+	if err = s.validate.Struct(param); err != nil {
+		zap.L().Warn("设置佣金比例参数校验失败", zap.Any("param", param), zap.Error(err))
+		return utils.NewParamError("参数错误：" + err.Error())
+	}
+
+	// 3. 保存（proto为float，保留四位小数消除精度误差）
+	rate := math.Round(param.Rate*10000) / 10000
+	if err = s.settlementRepo.SetCommissionRate(ctx, param.MerchantID, rate); err != nil {
+		return err
+	}
+	zap.L().Info("设置商家佣金比例成功", zap.Int64("merchant_id", param.MerchantID), zap.Float64("rate", rate), zap.String("operator", claims.UserID))
+	return nil
+}
+
+// ListSettlementStatements 查询结算单（商家仅可查本店，平台运营可查全部）
+func (s *settlementService) ListSettlementStatements(ctx context.Context, param ListStatementsParam) (ListStatementsResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("查询结算单参数校验失败", zap.Any("param", param), zap.Error(err))
+		return ListStatementsResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+	filter := repo.StatementFilter{MerchantID: param.MerchantID}
+	if param.StartDate != "" {
+		filter.StartDate, _ = time.ParseInLocation("2006-01-02", param.StartDate, time.Local)
+	}
+	if param.EndDate != "" {
+		filter.EndDate, _ = time.ParseInLocation("2006-01-02", param.EndDate, time.Local)
+	}
+	if !filter.StartDate.IsZero() && !filter.EndDate.IsZero() && filter.EndDate.Before(filter.StartDate) {
+		return ListStatementsResult{}, utils.NewParamError("结束日期不能早于开始日期")
+	}
+
+	// 2. 鉴权
+	claims, err := middleware.CheckRole(ctx, "merchant", "admin")
+	if err != nil {
+		return ListStatementsResult{}, err
+	}
+	if claims.Role == "merchant" {
+		if err = middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+			return ListStatementsResult{}, err
+		}
+	}
+
+	// 3. 查询结算单
+	statements, total, err := s.settlementRepo.ListStatements(ctx, filter, param.Page, param.PageSize)
+	if err != nil {
+		return ListStatementsResult{}, err
+	}
+
+	// 4. 组装结果
+	var results []StatementResult
+	for _, st := range statements {
+		results = append(results, toStatementResult(st))
+	}
+	return ListStatementsResult{
+		Statements: results,
+		Total:      int32(total),
+		Page:       param.Page,
+		PageSize:   param.PageSize,
+	}, nil
+}
+
+// GetSettlementStatement 查询结算单及明细（商家仅可查本店，平台运营可查全部）
+func (s *settlementService) GetSettlementStatement(ctx context.Context, statementID int64) (StatementDetailResult, error) {
+	// 1. 参数校验
+	if statementID <= 0 {
+		return StatementDetailResult{}, utils.NewParamError("结算单ID不合法")
+	}
+	claims, err := middleware.CheckRole(ctx, "merchant", "admin")
+	if err != nil {
+		return StatementDetailResult{}, err
+	}
+
+	// 2. 查询结算单并校验归属
+	statement, err := s.settlementRepo.GetStatement(ctx, statementID)
+	if err != nil {
+		return StatementDetailResult{}, err
+	}
+	if statement == nil {
+		return StatementDetailResult{}, utils.NewBizError("结算单不存在")
+	}
+	if claims.Role == "merchant" {
+		if err = middleware.CheckIdentity(ctx, "merchant", statement.MerchantID); err != nil {
+			return StatementDetailResult{}, err
+		}
+	}
+
+	// 3. 查询明细
+	items, err := s.settlementRepo.ListStatementItems(ctx, statementID)
+	if err != nil {
+		return StatementDetailResult{}, err
+	}
+	result := StatementDetailResult{
+		Statement: toStatementResult(statement),
+		Items:     make([]StatementItemResult, 0, len(items)),
+	}
+	for _, item := range items {
+		result.Items = append(result.Items, StatementItemResult{
+			ItemID:           item.ItemID,
+			OrderID:          item.OrderID,
+			OrderNo:          item.OrderNo,
+			ItemAmount:       item.ItemAmount,
+			CommissionRate:   item.CommissionRate,
+			Commission:       item.Commission,
+			MerchantDiscount: item.MerchantDiscount,
+			SettleAmount:     item.SettleAmount,
+			JournalNo:        item.JournalNo,
+			CompletedTime:    item.CompletedTime.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return result, nil
+}
+
+// toStatementResult 模型 → 领域层结果
+func toStatementResult(statement *model.SettlementStatement) StatementResult {
+	return StatementResult{
+		StatementID:      statement.StatementID,
+		StatementNo:      statement.StatementNo,
+		MerchantID:       statement.MerchantID,
+		SettleDate:       statement.SettleDate.Format("2006-01-02"),
+		Seq:              statement.Seq,
+		OrderCount:       statement.OrderCount,
+		ItemAmount:       statement.ItemAmount,
+		Commission:       statement.Commission,
+		MerchantDiscount: statement.MerchantDiscount,
+		SettleAmount:     statement.SettleAmount,
+		Status:           statement.Status,
+		CreateTime:       statement.CreateTime.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	productProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/product/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// CartHandler 购物车gRPC接口实现
+type CartHandler struct {
+	productProto.UnimplementedCartServiceServer
+	cartService service.CartService
+}
+
+// NewCartHandler 创建实例
+func NewCartHandler(cartService service.CartService) *CartHandler {
+	return &CartHandler{
+		cartService: cartService,
+	}
+}
+
+// commonResponse 将service错误转换为通用响应
+func commonResponse(err error, successMsg, errLog string) *productProto.CommonResponse {
+	if err == nil {
+		return &productProto.CommonResponse{
+			Code: utils.ErrCodeSuccess,
+			Msg:  successMsg,
+		}
+	}
+	var appError *utils.AppError
+	if !errors.As(err, &appError) {
+		zap.L().Error(errLog, zap.Error(err))
+		return &productProto.CommonResponse{
+			Code: utils.ErrCodeSystem,
+			Msg:  "系统错误",
+		}
+	}
+	return &productProto.CommonResponse{
+		Code: int32(appError.Code),
+		Msg:  appError.Message,
+	}
+}
+
+func (h *CartHandler) AddCartItem(ctx context.Context, req *productProto.AddCartItemRequest) (*productProto.CommonResponse, error) {
+	err := h.cartService.AddCartItem(ctx, service.AddCartItemParam{
+		UserID:     req.UserId,
+		MerchantID: req.MerchantId,
+		ProductID:  req.ProductId,
+		Quantity:   req.Quantity,
+	})
+	return commonResponse(err, "加入购物车成功", "加入购物车未知错误"), nil
+}
+
+func (h *CartHandler) UpdateCartItem(ctx context.Context, req *productProto.UpdateCartItemRequest) (*productProto.CommonResponse, error) {
+	err := h.cartService.UpdateCartItem(ctx, service.UpdateCartItemParam{
+		UserID:     req.UserId,
+		MerchantID: req.MerchantId,
+		ProductID:  req.ProductId,
+		Quantity:   req.Quantity,
+	})
+	return commonResponse(err, "修改购物车成功", "修改购物车未知错误"), nil
+}
+
+func (h *CartHandler) RemoveCartItem(ctx context.Context, req *productProto.RemoveCartItemRequest) (*productProto.CommonResponse, error) {
+	err := h.cartService.RemoveCartItem(ctx, service.RemoveCartItemParam{
+		UserID:     req.UserId,
+		MerchantID: req.MerchantId,
+		ProductID:  req.ProductId,
+	})
+	return commonResponse(err, "移除购物车商品成功", "移除购物车商品未知错误"), nil
+}
+
+func (h *CartHandler) ClearCart(ctx context.Context, req *productProto.ClearCartRequest) (*productProto.CommonResponse, error) {
+	err := h.cartService.ClearCart(ctx, service.CartParam{
+		UserID:     req.UserId,
+		MerchantID: req.MerchantId,
+	})
+	return commonResponse(err, "清空购物车成功", "清空购物车未知错误"), nil
+}
+
+func (h *CartHandler) ListCart(ctx context.Context, req *productProto.ListCartRequest) (*productProto.ListCartResponse, error) {
+	result, err := h.cartService.ListCart(ctx, service.CartParam{
+		UserID:     req.UserId,
+		MerchantID: req.MerchantId,
+	})
+	if err != nil {
+		var appError *utils.AppError
+		ok := errors.As(err, &appError)
+		if !ok {
+			zap.L().Error("查询购物车未知错误", zap.Error(err))
+			return &productProto.ListCartResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &productProto.ListCartResponse{
+			Code: int32(appError.Code),
+			Msg:  appError.Message,
+		}, nil
+	}
+
+	items := make([]*productProto.CartItem, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &productProto.CartItem{
+			ProductId:         item.ProductID,
+			Name:              item.Name,
+			ImageUrl:          item.ImageURL,
+			Price:             float32(item.Price),
+			PackingFee:        float32(item.PackingFee),
+			Quantity:          item.Quantity,
+			TotalPrice:        float32(item.TotalPrice),
+			Available:         item.Available,
+			UnavailableReason: item.UnavailableReason,
+		})
+	}
+	return &productProto.ListCartResponse{
+		Code:          utils.ErrCodeSuccess,
+		Msg:           "查询购物车成功",
+		Items:         items,
+		GoodsAmount:   float32(result.GoodsAmount),
+		PackingFee:    float32(result.PackingFee),
+		CheckoutReady: result.CheckoutReady,
+	}, nil
+}
+
+func (h *CartHandler) CheckoutCart(ctx context.Context, req *productProto.CheckoutCartRequest) (*productProto.CheckoutCartResponse, error) {
+	result, err := h.cartService.CheckoutCart(ctx, service.CheckoutCartParam{
+		UserID:             req.UserId,
+		MerchantID:         req.MerchantId,
+		AddressID:          req.AddressId,
+		ExpectDeliveryTime: req.ExpectDeliveryTime,
+		CouponIDs:          req.CouponIds,
+	})
+	if err != nil {
+		var appError *utils.AppError
+		ok := errors.As(err, &appError)
+		if !ok {
+			zap.L().Error("购物车结算未知错误", zap.Error(err), zap.Int64("user_id", req.UserId))
+			return &productProto.CheckoutCartResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &productProto.CheckoutCartResponse{
+			Code: int32(appError.Code),
+			Msg:  appError.Message,
+		}, nil
+	}
+	return &productProto.CheckoutCartResponse{
+		Code:           utils.ErrCodeSuccess,
+		Msg:            "下单成功",
+		OrderId:        result.OrderID,
+		OrderNo:        result.OrderNo,
+		GoodsAmount:    float32(result.GoodsAmount),
+		PackingFee:     float32(result.PackingFee),
+		DeliveryFee:    float32(result.DeliveryFee),
+		DiscountAmount: float32(result.DiscountAmount),
+		TotalAmount:    float32(result.TotalAmount),
+	}, nil
+}
@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	productProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/product/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// CreateCategory 商家创建菜单分类
+func (p *ProductHandler) CreateCategory(ctx context.Context, req *productProto.CreateCategoryRequest) (*productProto.CreateCategoryResponse, error) {
+	categoryID, err := p.categoryService.CreateCategory(ctx, service.CreateCategoryParam{
+		MerchantID: req.MerchantId,
+		Name:       req.Name,
+		SortOrder:  req.SortOrder,
+	})
+	if err != nil {
+		var appError *utils.AppError
+		ok := errors.As(err, &appError)
+		if !ok {
+			zap.L().Error("创建分类未知错误", zap.Error(err))
+			return &productProto.CreateCategoryResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &productProto.CreateCategoryResponse{
+			Code: int32(appError.Code),
+			Msg:  appError.Message,
+		}, nil
+	}
+	return &productProto.CreateCategoryResponse{
+		Code:       utils.ErrCodeSuccess,
+		Msg:        "创建分类成功",
+		CategoryId: categoryID,
+	}, nil
+}
+
+// UpdateCategory 商家更新菜单分类
+func (p *ProductHandler) UpdateCategory(ctx context.Context, req *productProto.UpdateCategoryRequest) (*productProto.CommonResponse, error) {
+	err := p.categoryService.UpdateCategory(ctx, service.UpdateCategoryParam{
+		CategoryID: req.CategoryId,
+		MerchantID: req.MerchantId,
+		Name:       req.Name,
+		SortOrder:  req.SortOrder,
+	})
+	if err != nil {
+		var appError *utils.AppError
+		ok := errors.As(err, &appError)
+		if !ok {
+			zap.L().Error("更新分类未知错误", zap.Error(err))
+			return &productProto.CommonResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &productProto.CommonResponse{
+			Code: int32(appError.Code),
+			Msg:  appError.Message,
+		}, nil
+	}
+	return &productProto.CommonResponse{
+		Code: utils.ErrCodeSuccess,
+		Msg:  "更新分类成功",
+	}, nil
+}
+
+// DeleteCategory 商家删除菜单分类
+func (p *ProductHandler) DeleteCategory(ctx context.Context, req *productProto.DeleteCategoryRequest) (*productProto.CommonResponse, error) {
+	err := p.categoryService.DeleteCategory(ctx, service.DeleteCategoryParam{
+		CategoryID: req.CategoryId,
+		MerchantID: req.MerchantId,
+	})
+	if err != nil {
+		var appError *utils.AppError
+		ok := errors.As(err, &appError)
+		if !ok {
+			zap.L().Error("删除分类未知错误", zap.Error(err))
+			return &productProto.CommonResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &productProto.CommonResponse{
+			Code: int32(appError.Code),
+			Msg:  appError.Message,
+		}, nil
+	}
+	return &productProto.CommonResponse{
+		Code: utils.ErrCodeSuccess,
+		Msg:  "删除分类成功",
+	}, nil
+}
+
+// ListCategories 查询商家菜单分类
+func (p *ProductHandler) ListCategories(ctx context.Context, req *productProto.ListCategoriesRequest) (*productProto.ListCategoriesResponse, error) {
+	results, err := p.categoryService.ListCategories(ctx, req.MerchantId)
+	if err != nil {
+		var appError *utils.AppError
+		ok := errors.As(err, &appError)
+		if !ok {
+			zap.L().Error("查询分类未知错误", zap.Error(err))
+			return &productProto.ListCategoriesResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &productProto.ListCategoriesResponse{
+			Code: int32(appError.Code),
+			Msg:  appError.Message,
+		}, nil
+	}
+	categories := make([]*productProto.Category, 0, len(results))
+	for _, c := range results {
+		categories = append(categories, &productProto.Category{
+			CategoryId: c.CategoryID,
+			MerchantId: c.MerchantID,
+			Name:       c.Name,
+			SortOrder:  c.SortOrder,
+		})
+	}
+	return &productProto.ListCategoriesResponse{
+		Code:       utils.ErrCodeSuccess,
+		Msg:        "查询成功",
+		Categories: categories,
+	}, nil
+}
+
+// SetProductCategories 商家设置商品所属分类
+func (p *ProductHandler) SetProductCategories(ctx context.Context, req *productProto.SetProductCategoriesRequest) (*productProto.CommonResponse, error) {
+	err := p.categoryService.SetProductCategories(ctx, service.SetProductCategoriesParam{
+		ProductID:   req.ProductId,
+		MerchantID:  req.MerchantId,
+		CategoryIDs: req.CategoryIds,
+		SortOrder:   req.SortOrder,
+	})
+	if err != nil {
+		var appError *utils.AppError
+		ok := errors.As(err, &appError)
+		if !ok {
+			zap.L().Error("设置商品分类未知错误", zap.Error(err))
+			return &productProto.CommonResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &productProto.CommonResponse{
+			Code: int32(appError.Code),
+			Msg:  appError.Message,
+		}, nil
+	}
+	return &productProto.CommonResponse{
+		Code: utils.ErrCodeSuccess,
+		Msg:  "设置商品分类成功",
+	}, nil
+}
+
+// GetMerchantMenu 查询商家菜单（按分类分组）
+func (p *ProductHandler) GetMerchantMenu(ctx context.Context, req *productProto.GetMerchantMenuRequest) (*productProto.GetMerchantMenuResponse, error) {
+	result, err := p.categoryService.GetMerchantMenu(ctx, req.MerchantId)
+	if err != nil {
+		var appError *utils.AppError
+		ok := errors.As(err, &appError)
+		if !ok {
+			zap.L().Error("查询商家菜单未知错误", zap.Error(err), zap.Int64("merchant_id", req.MerchantId))
+			return &productProto.GetMerchantMenuResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &productProto.GetMerchantMenuResponse{
+			Code: int32(appError.Code),
+			Msg:  appError.Message,
+		}, nil
+	}
+	categories := make([]*productProto.MenuCategory, 0, len(result.Categories))
+	for _, c := range result.Categories {
+		category := &productProto.MenuCategory{
+			CategoryId: c.CategoryID,
+			Name:       c.Name,
+			SortOrder:  c.SortOrder,
+		}
+		for _, product := range c.Products {
+			category.Products = append(category.Products, toProtoProduct(product))
+		}
+		categories = append(categories, category)
+	}
+	return &productProto.GetMerchantMenuResponse{
+		Code:       utils.ErrCodeSuccess,
+		Msg:        "查询成功",
+		MerchantId: result.MerchantID,
+		Categories: categories,
+	}, nil
+}
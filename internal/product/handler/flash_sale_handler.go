@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	productProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/product/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// StartFlashSale 商家开启秒杀
+func (p *ProductHandler) StartFlashSale(ctx context.Context, req *productProto.StartFlashSaleRequest) (*productProto.FlashSaleResponse, error) {
+	result, err := p.productService.StartFlashSale(ctx, service.StartFlashSaleParam{
+		ProductID:    req.ProductId,
+		MerchantID:   req.MerchantId,
+		PerUserLimit: req.PerUserLimit,
+	})
+	return flashSaleResponse(result, err, "开启秒杀成功", "开启秒杀未知错误"), nil
+}
+
+// StopFlashSale 商家结束秒杀
+func (p *ProductHandler) StopFlashSale(ctx context.Context, req *productProto.StopFlashSaleRequest) (*productProto.FlashSaleResponse, error) {
+	result, err := p.productService.StopFlashSale(ctx, service.StopFlashSaleParam{
+		ProductID:  req.ProductId,
+		MerchantID: req.MerchantId,
+	})
+	return flashSaleResponse(result, err, "结束秒杀成功", "结束秒杀未知错误"), nil
+}
+
+// flashSaleResponse 领域层结果 → 响应
+func flashSaleResponse(result service.FlashSaleResult, err error, successMsg, errLog string) *productProto.FlashSaleResponse {
+	if err != nil {
+		var appError *utils.AppError
+		if !errors.As(err, &appError) {
+			zap.L().Error(errLog, zap.Error(err))
+			return &productProto.FlashSaleResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}
+		}
+		return &productProto.FlashSaleResponse{
+			Code: int32(appError.Code),
+			Msg:  appError.Message,
+		}
+	}
+	return &productProto.FlashSaleResponse{
+		Code: utils.ErrCodeSuccess,
+		Msg:  successMsg,
+		FlashSale: &productProto.FlashSale{
+			FlashSaleId:  result.FlashSaleID,
+			ProductId:    result.ProductID,
+			InitialStock: result.InitialStock,
+			PerUserLimit: result.PerUserLimit,
+			Status:       result.Status,
+			FinalStock:   result.FinalStock,
+		},
+	}
+}
+
+// ReconcileFlashSale 商家查询秒杀对账结果
+func (p *ProductHandler) ReconcileFlashSale(ctx context.Context, req *productProto.ReconcileFlashSaleRequest) (*productProto.ReconcileFlashSaleResponse, error) {
+	result, err := p.productService.ReconcileFlashSale(ctx, service.ReconcileFlashSaleParam{
+		ProductID:  req.ProductId,
+		MerchantID: req.MerchantId,
+	})
+	if err != nil {
+		var appError *utils.AppError
+		if !errors.As(err, &appError) {
+			zap.L().Error("秒杀对账未知错误", zap.Error(err))
+			return &productProto.ReconcileFlashSaleResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &productProto.ReconcileFlashSaleResponse{
+			Code: int32(appError.Code),
+			Msg:  appError.Message,
+		}, nil
+	}
+	return &productProto.ReconcileFlashSaleResponse{
+		Code:         utils.ErrCodeSuccess,
+		Msg:          "查询秒杀对账成功",
+		FlashSaleId:  result.FlashSaleID,
+		InitialStock: result.InitialStock,
+		RedisStock:   result.RedisStock,
+		DbStock:      result.DBStock,
+		AppliedDelta: result.AppliedDelta,
+		PendingDelta: result.PendingDelta,
+		Consistent:   result.Consistent,
+	}, nil
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	productProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/product/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/export"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// exportChunkSize 导出文件每个分块的大小
+const exportChunkSize = 64 * 1024
+
+// BulkUpsertProducts 商家批量导入商品
+func (p *ProductHandler) BulkUpsertProducts(ctx context.Context, req *productProto.BulkUpsertProductsRequest) (*productProto.BulkUpsertProductsResponse, error) {
+	param := service.BulkUpsertProductsParam{
+		MerchantID: req.MerchantId,
+		Mode:       req.Mode,
+		Rows:       make([]service.ProductRowParam, 0, len(req.Rows)),
+	}
+	for i, row := range req.Rows {
+		// 接口行的库存、打包费均视为已提供（未传即为0）
+		stock, packingFee := row.Stock, float64(row.PackingFee)
+		param.Rows = append(param.Rows, service.ProductRowParam{
+			Line:         int32(i + 1),
+			ProductID:    row.ProductId,
+			ExternalCode: row.ExternalCode,
+			Name:         row.Name,
+			Description:  row.Description,
+			Price:        float64(row.Price),
+			Stock:        &stock,
+			PackingFee:   &packingFee,
+			ImageURL:     row.ImageUrl,
+		})
+	}
+	result, err := p.productService.BulkUpsertProducts(ctx, param)
+	return bulkUpsertResponse(result, err, "批量导入商品未知错误"), nil
+}
+
+// ImportProductsCSV 商家通过CSV导入商品
+func (p *ProductHandler) ImportProductsCSV(ctx context.Context, req *productProto.ImportProductsCSVRequest) (*productProto.BulkUpsertProductsResponse, error) {
+	result, err := p.productService.ImportProductsCSV(ctx, service.ImportProductsCSVParam{
+		MerchantID: req.MerchantId,
+		Mode:       req.Mode,
+		Content:    req.Content,
+	})
+	return bulkUpsertResponse(result, err, "导入商品CSV未知错误"), nil
+}
+
+// bulkUpsertResponse 领域层结果 → 响应（失败时仍返回逐行错误）
+func bulkUpsertResponse(result service.BulkUpsertResult, err error, errLog string) *productProto.BulkUpsertProductsResponse {
+	resp := &productProto.BulkUpsertProductsResponse{
+		Code:      utils.ErrCodeSuccess,
+		Msg:       fmt.Sprintf("导入完成：新建%d个，更新%d个，失败%d行", result.Created, result.Updated, result.Failed),
+		Created:   result.Created,
+		Updated:   result.Updated,
+		Failed:    result.Failed,
+		Committed: result.Committed,
+	}
+	for _, e := range result.Errors {
+		resp.Errors = append(resp.Errors, &productProto.RowError{
+			Line:         e.Line,
+			ExternalCode: e.ExternalCode,
+			Msg:          e.Msg,
+		})
+	}
+	if err != nil {
+		var appError *utils.AppError
+		if !errors.As(err, &appError) {
+			zap.L().Error(errLog, zap.Error(err))
+			resp.Code = utils.ErrCodeSystem
+			resp.Msg = "系统错误"
+		} else {
+			resp.Code = int32(appError.Code)
+			resp.Msg = appError.Message
+		}
+	}
+	return resp
+}
+
+// ExportProducts 商家导出商品（服务端流式）
+func (p *ProductHandler) ExportProducts(req *productProto.ExportProductsRequest, stream productProto.ProductService_ExportProductsServer) error {
+	w := &chunkWriter{
+		stream:      stream,
+		fileName:    fmt.Sprintf("products_%d.%s", req.MerchantId, req.Format),
+		contentType: export.ContentType(req.Format),
+	}
+	rows, err := p.productService.ExportProducts(stream.Context(), service.ExportProductsParam{
+		MerchantID: req.MerchantId,
+		Format:     req.Format,
+	}, w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		var appErr *utils.AppError
+		if !errors.As(err, &appErr) {
+			zap.L().Error("导出商品未知错误", zap.Error(err), zap.Int64("merchant_id", req.MerchantId))
+			return stream.Send(&productProto.ExportProductsResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			})
+		}
+		return stream.Send(&productProto.ExportProductsResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		})
+	}
+
+	return stream.Send(&productProto.ExportProductsResponse{
+		Code: utils.ErrCodeSuccess,
+		Msg:  "导出成功",
+		Done: true,
+		Rows: rows,
+	})
+}
+
+// chunkWriter 将写入内容按exportChunkSize分块推送到流（首块携带文件名及类型）
+type chunkWriter struct {
+	stream      productProto.ProductService_ExportProductsServer
+	fileName    string
+	contentType string
+	buf         []byte
+	sent        bool
+}
+
+// Write 写入缓冲区，满一块即推送
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= exportChunkSize {
+		if err := w.send(w.buf[:exportChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = append(w.buf[:0], w.buf[exportChunkSize:]...)
+	}
+	return len(p), nil
+}
+
+// Flush 推送剩余内容
+func (w *chunkWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	err := w.send(w.buf)
+	w.buf = w.buf[:0]
+	return err
+}
+
+// send 推送一个分块（复制一份，避免复用缓冲区影响已发送消息）
+func (w *chunkWriter) send(data []byte) error {
+	chunk := make([]byte, len(data))
+	copy(chunk, data)
+	resp := &productProto.ExportProductsResponse{
+		Code:  utils.ErrCodeSuccess,
+		Chunk: chunk,
+	}
+	if !w.sent {
+		resp.FileName = w.fileName
+		resp.ContentType = w.contentType
+		w.sent = true
+	}
+	if err := w.stream.Send(resp); err != nil {
+		return utils.NewSystemError("推送导出内容失败：" + err.Error())
+	}
+	return nil
+}
@@ -13,24 +13,28 @@ import (
 // ProductHandler 商品gRPC接口实现
 type ProductHandler struct {
 	productProto.UnimplementedProductServiceServer
-	productService service.ProductService
+	productService  service.ProductService
+	categoryService service.CategoryService
 }
 
 // NewProductHandler 创建实例
-func NewProductHandler(productService service.ProductService) *ProductHandler {
+func NewProductHandler(productService service.ProductService, categoryService service.CategoryService) *ProductHandler {
 	return &ProductHandler{
-		productService: productService,
+		productService:  productService,
+		categoryService: categoryService,
 	}
 }
 
 func (p *ProductHandler) CreateProduct(ctx context.Context, req *productProto.CreateProductRequest) (*productProto.CreateProductResponse, error) {
 	param := service.CreateProductParam{
-		MerchantID:  req.MerchantId,
-		Name:        req.Name,
-		Description: req.Description,
-		Price:       float64(req.Price),
-		Stock:       req.Stock,
-		ImageURL:    req.ImageUrl,
+		MerchantID:   req.MerchantId,
+		Name:         req.Name,
+		Description:  req.Description,
+		Price:        float64(req.Price),
+		Stock:        req.Stock,
+		PackingFee:   float64(req.PackingFee),
+		ImageURL:     req.ImageUrl,
+		ExternalCode: req.ExternalCode,
 	}
 
 	productID, err := p.productService.CreateProduct(ctx, param)
@@ -59,14 +63,15 @@ func (p *ProductHandler) CreateProduct(ctx context.Context, req *productProto.Cr
 
 func (p *ProductHandler) UpdateProduct(ctx context.Context, req *productProto.UpdateProductRequest) (*productProto.CommonResponse, error) {
 	param := service.UpdateProductParam{
-		ProductID:   req.ProductId,
-		MerchantID:  req.MerchantId,
-		Name:        req.Name,
-		Description: req.Description,
-		Price:       float64(req.Price),
-		Stock:       req.Stock,
-		ImageURL:    req.ImageUrl,
-		IsSoldOut:   req.IsSoldOut,
+		ProductID:    req.ProductId,
+		MerchantID:   req.MerchantId,
+		Name:         req.Name,
+		Description:  req.Description,
+		Price:        float64(req.Price),
+		Stock:        req.Stock,
+		PackingFee:   float64(req.PackingFee),
+		ImageURL:     req.ImageUrl,
+		ExternalCode: req.ExternalCode,
 	}
 	err := p.productService.UpdateProduct(ctx, param)
 	if err != nil {
@@ -117,11 +122,13 @@ func (p *ProductHandler) DeleteProduct(ctx context.Context, req *productProto.De
 	}, nil
 }
 
-func (p *ProductHandler) ListProductByMerchantID(ctx context.Context, req *productProto.ListProductsRequest) (*productProto.ListProductsResponse, error) {
+func (p *ProductHandler) ListProductsByMerchantID(ctx context.Context, req *productProto.ListProductsRequest) (*productProto.ListProductsResponse, error) {
 	param := service.ListProductsParam{
 		MerchantID: req.MerchantId,
 		Page:       req.Page,
 		PageSize:   req.PageSize,
+		Cursor:     req.Cursor,
+		WithTotal:  req.WithTotal,
 	}
 
 	ListProductsResult, err := p.productService.ListProductsByMerchantID(ctx, param)
@@ -142,25 +149,16 @@ func (p *ProductHandler) ListProductByMerchantID(ctx context.Context, req *produ
 	}
 	var products []*productProto.Product
 	for _, productResult := range ListProductsResult.Products {
-		products = append(products, &productProto.Product{
-			ProductId:   productResult.ProductID,
-			Name:        productResult.Name,
-			Description: productResult.Description,
-			Price:       float32(productResult.Price),
-			Stock:       productResult.Stock,
-			ImageUrl:    productResult.ImageURL,
-			IsSoldOut:   productResult.IsSoldOut,
-			CreatedAt:   productResult.CreatedAt,
-			UpdatedAt:   productResult.UpdatedAt,
-		})
+		products = append(products, toProtoProduct(productResult))
 	}
 	return &productProto.ListProductsResponse{
-		Code:     utils.ErrCodeSuccess,
-		Msg:      "查询商品列表成功",
-		Products: products,
-		Total:    int32(ListProductsResult.Total),
-		Page:     ListProductsResult.Page,
-		PageSize: ListProductsResult.PageSize,
+		Code:       utils.ErrCodeSuccess,
+		Msg:        "查询商品列表成功",
+		Products:   products,
+		Total:      int32(ListProductsResult.Total),
+		Page:       ListProductsResult.Page,
+		PageSize:   ListProductsResult.PageSize,
+		NextCursor: ListProductsResult.NextCursor,
 	}, nil
 }
 func (p *ProductHandler) GetProductByID(ctx context.Context, req *productProto.GetProductRequest) (*productProto.GetProductResponse, error) {
@@ -183,26 +181,126 @@ func (p *ProductHandler) GetProductByID(ctx context.Context, req *productProto.G
 
 	// 转换为proto响应
 	return &productProto.GetProductResponse{
+		Code:    utils.ErrCodeSuccess,
+		Msg:     "查询成功",
+		Product: toProtoProduct(result),
+	}, nil
+}
+
+// toProtoProduct service结果 → proto
+func toProtoProduct(r service.ProductResult) *productProto.Product {
+	product := &productProto.Product{
+		ProductId:    r.ProductID,
+		MerchantId:   r.MerchantID,
+		Name:         r.Name,
+		Description:  r.Description,
+		Price:        float32(r.Price),
+		Stock:        r.Stock,
+		PackingFee:   float32(r.PackingFee),
+		ImageUrl:     r.ImageURL,
+		IsSoldOut:    r.IsSoldOut,
+		ExternalCode: r.ExternalCode,
+		Score:        float32(r.Score),
+		RatingCount:  r.RatingCount,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+		HasSku:       r.HasSku,
+
+		Suspended:         r.Suspended,
+		AvailableHours:    r.AvailableHours,
+		DailyStock:        r.DailyStock,
+		RestockTime:       r.RestockTime,
+		UnavailableReason: r.UnavailableReason,
+	}
+	for _, sku := range r.Skus {
+		product.Skus = append(product.Skus, &productProto.Sku{
+			SkuId:     sku.SkuID,
+			Name:      sku.Name,
+			Price:     float32(sku.Price),
+			Stock:     sku.Stock,
+			SortOrder: sku.SortOrder,
+		})
+	}
+	for _, group := range r.OptionGroups {
+		g := &productProto.OptionGroup{
+			GroupId:   group.GroupID,
+			Name:      group.Name,
+			MinSelect: group.MinSelect,
+			MaxSelect: group.MaxSelect,
+			SortOrder: group.SortOrder,
+		}
+		for _, option := range group.Options {
+			g.Options = append(g.Options, &productProto.Option{
+				OptionId:  option.OptionID,
+				Name:      option.Name,
+				Price:     float32(option.Price),
+				SortOrder: option.SortOrder,
+			})
+		}
+		product.OptionGroups = append(product.OptionGroups, g)
+	}
+	return product
+}
+
+// SetProductSpecs 商家设置商品规格及属性
+func (p *ProductHandler) SetProductSpecs(ctx context.Context, req *productProto.SetProductSpecsRequest) (*productProto.CommonResponse, error) {
+	param := service.SetProductSpecsParam{
+		ProductID:  req.ProductId,
+		MerchantID: req.MerchantId,
+	}
+	for _, sku := range req.Skus {
+		param.Skus = append(param.Skus, service.SkuParam{
+			SkuID:     sku.SkuId,
+			Name:      sku.Name,
+			Price:     float64(sku.Price),
+			Stock:     sku.Stock,
+			SortOrder: sku.SortOrder,
+		})
+	}
+	for _, group := range req.OptionGroups {
+		g := service.OptionGroupParam{
+			Name:      group.Name,
+			MinSelect: group.MinSelect,
+			MaxSelect: group.MaxSelect,
+			SortOrder: group.SortOrder,
+		}
+		for _, option := range group.Options {
+			g.Options = append(g.Options, service.OptionParam{
+				Name:      option.Name,
+				Price:     float64(option.Price),
+				SortOrder: option.SortOrder,
+			})
+		}
+		param.OptionGroups = append(param.OptionGroups, g)
+	}
+
+	err := p.productService.SetProductSpecs(ctx, param)
+	if err != nil {
+		var appError *utils.AppError
+		ok := errors.As(err, &appError)
+		if !ok {
+			zap.L().Error("设置商品规格未知错误", zap.Error(err))
+			return &productProto.CommonResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &productProto.CommonResponse{
+			Code: int32(appError.Code),
+			Msg:  appError.Message,
+		}, nil
+	}
+	return &productProto.CommonResponse{
 		Code: utils.ErrCodeSuccess,
-		Msg:  "查询成功",
-		Product: &productProto.Product{
-			ProductId:   result.ProductID,
-			MerchantId:  result.MerchantID,
-			Name:        result.Name,
-			Description: result.Description,
-			Price:       float32(result.Price),
-			Stock:       result.Stock,
-			ImageUrl:    result.ImageURL,
-			IsSoldOut:   result.IsSoldOut,
-			CreatedAt:   result.CreatedAt,
-			UpdatedAt:   result.UpdatedAt,
-		},
+		Msg:  "设置商品规格成功",
 	}, nil
 }
 
 func (p *ProductHandler) DeductStock(ctx context.Context, req *productProto.DeductStockRequest) (*productProto.CommonResponse, error) {
 	param := service.DeductStockParam{
 		ProductID: req.ProductId,
+		SkuID:     req.SkuId,
+		UserID:    req.UserId,
 		Num:       req.Num,
 	}
 	err := p.productService.DeductStock(ctx, param)
@@ -217,7 +315,7 @@ func (p *ProductHandler) DeductStock(ctx context.Context, req *productProto.Dedu
 			}, nil
 		}
 		return &productProto.CommonResponse{
-			Code: utils.ErrCodeSystem,
+			Code: int32(appError.Code),
 			Msg:  appError.Message,
 		}, nil
 	}
@@ -230,7 +328,10 @@ func (p *ProductHandler) DeductStock(ctx context.Context, req *productProto.Dedu
 func (p *ProductHandler) RestoreStock(ctx context.Context, req *productProto.RestoreStockRequest) (*productProto.CommonResponse, error) {
 	param := service.RestoreStockParam{
 		ProductID: req.ProductId,
+		SkuID:     req.SkuId,
+		UserID:    req.UserId,
 		Num:       req.Num,
+		RestoreNo: req.RestoreNo,
 	}
 	err := p.productService.RestoreStock(ctx, param)
 	if err != nil {
@@ -244,7 +345,7 @@ func (p *ProductHandler) RestoreStock(ctx context.Context, req *productProto.Res
 			}, nil
 		}
 		return &productProto.CommonResponse{
-			Code: utils.ErrCodeSuccess,
+			Code: int32(appError.Code),
 			Msg:  appError.Message,
 		}, nil
 	}
@@ -253,3 +354,25 @@ func (p *ProductHandler) RestoreStock(ctx context.Context, req *productProto.Res
 		Msg:  "恢复库存成功",
 	}, nil
 }
+
+// SetProductAvailability 商家设置暂停售卖及可售时段
+func (p *ProductHandler) SetProductAvailability(ctx context.Context, req *productProto.SetProductAvailabilityRequest) (*productProto.CommonResponse, error) {
+	err := p.productService.SetProductAvailability(ctx, service.SetProductAvailabilityParam{
+		ProductID:      req.ProductId,
+		MerchantID:     req.MerchantId,
+		Suspended:      req.Suspended,
+		AvailableHours: req.AvailableHours,
+	})
+	return commonResponse(err, "设置商品可售状态成功", "设置商品可售状态未知错误"), nil
+}
+
+// SetDailyRestock 商家设置每日补货
+func (p *ProductHandler) SetDailyRestock(ctx context.Context, req *productProto.SetDailyRestockRequest) (*productProto.CommonResponse, error) {
+	err := p.productService.SetDailyRestock(ctx, service.SetDailyRestockParam{
+		ProductID:   req.ProductId,
+		MerchantID:  req.MerchantId,
+		DailyStock:  req.DailyStock,
+		RestockTime: req.RestockTime,
+	})
+	return commonResponse(err, "设置每日补货成功", "设置每日补货未知错误"), nil
+}
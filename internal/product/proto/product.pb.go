@@ -10,7 +10,6 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -25,19 +24,31 @@ const (
 
 // 商品基础信息
 type Product struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`    // 商品ID
-	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"` // 商家ID
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                // 商品名称
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                  // 商品描述
-	Price         float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`                            // 商品价格（元）
-	Stock         int32                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`                             // 库存数量
-	ImageUrl      string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`        // 商品图片
-	IsSoldOut     bool                   `protobuf:"varint,8,opt,name=is_sold_out,json=isSoldOut,proto3" json:"is_sold_out,omitempty"`  // 是否售罄
-	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`     // 创建时间
-	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`    // 更新时间
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductId         int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`                         // 商品ID
+	MerchantId        int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`                      // 商家ID
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                     // 商品名称
+	Description       string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                                       // 商品描述
+	Price             float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`                                                 // 商品价格（元）
+	Stock             int32                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`                                                  // 库存数量
+	ImageUrl          string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`                             // 商品图片
+	IsSoldOut         bool                   `protobuf:"varint,8,opt,name=is_sold_out,json=isSoldOut,proto3" json:"is_sold_out,omitempty"`                       // 是否售罄
+	CreatedAt         string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                          // 创建时间
+	UpdatedAt         string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                         // 更新时间
+	PackingFee        float32                `protobuf:"fixed32,11,opt,name=packing_fee,json=packingFee,proto3" json:"packing_fee,omitempty"`                    // 单件打包费（元）
+	Score             float32                `protobuf:"fixed32,12,opt,name=score,proto3" json:"score,omitempty"`                                                // 商品评分
+	RatingCount       int64                  `protobuf:"varint,13,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`                  // 评分次数
+	HasSku            bool                   `protobuf:"varint,14,opt,name=has_sku,json=hasSku,proto3" json:"has_sku,omitempty"`                                 // 是否多规格（下单须指定sku_id，price为最低规格价）
+	Skus              []*Sku                 `protobuf:"bytes,15,rep,name=skus,proto3" json:"skus,omitempty"`                                                    // 规格（仅详情、菜单返回）
+	OptionGroups      []*OptionGroup         `protobuf:"bytes,16,rep,name=option_groups,json=optionGroups,proto3" json:"option_groups,omitempty"`                // 属性组（仅详情、菜单返回）
+	Suspended         bool                   `protobuf:"varint,17,opt,name=suspended,proto3" json:"suspended,omitempty"`                                         // 商家暂停售卖
+	AvailableHours    string                 `protobuf:"bytes,18,opt,name=available_hours,json=availableHours,proto3" json:"available_hours,omitempty"`          // 可售时段（HH:MM-HH:MM，为空表示全天）
+	DailyStock        int32                  `protobuf:"varint,19,opt,name=daily_stock,json=dailyStock,proto3" json:"daily_stock,omitempty"`                     // 每日补货库存（0表示不自动补货）
+	RestockTime       string                 `protobuf:"bytes,20,opt,name=restock_time,json=restockTime,proto3" json:"restock_time,omitempty"`                   // 每日补货时间（HH:MM）
+	UnavailableReason string                 `protobuf:"bytes,21,opt,name=unavailable_reason,json=unavailableReason,proto3" json:"unavailable_reason,omitempty"` // 当前不可售原因（为空表示可售）
+	ExternalCode      string                 `protobuf:"bytes,22,opt,name=external_code,json=externalCode,proto3" json:"external_code,omitempty"`                // 外部编码（商家自有SKU编码）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
@@ -140,29 +151,116 @@ func (x *Product) GetUpdatedAt() string {
 	return ""
 }
 
-// 通用响应
-type CommonResponse struct {
+func (x *Product) GetPackingFee() float32 {
+	if x != nil {
+		return x.PackingFee
+	}
+	return 0
+}
+
+func (x *Product) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Product) GetRatingCount() int64 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *Product) GetHasSku() bool {
+	if x != nil {
+		return x.HasSku
+	}
+	return false
+}
+
+func (x *Product) GetSkus() []*Sku {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *Product) GetOptionGroups() []*OptionGroup {
+	if x != nil {
+		return x.OptionGroups
+	}
+	return nil
+}
+
+func (x *Product) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *Product) GetAvailableHours() string {
+	if x != nil {
+		return x.AvailableHours
+	}
+	return ""
+}
+
+func (x *Product) GetDailyStock() int32 {
+	if x != nil {
+		return x.DailyStock
+	}
+	return 0
+}
+
+func (x *Product) GetRestockTime() string {
+	if x != nil {
+		return x.RestockTime
+	}
+	return ""
+}
+
+func (x *Product) GetUnavailableReason() string {
+	if x != nil {
+		return x.UnavailableReason
+	}
+	return ""
+}
+
+func (x *Product) GetExternalCode() string {
+	if x != nil {
+		return x.ExternalCode
+	}
+	return ""
+}
+
+// 商品规格（如大份/中份/小份）
+type Sku struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"` // 规格ID（新增时为0）
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price         float32                `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`                         // 规格价格（元）
+	Stock         int32                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`                          // 规格库存
+	SortOrder     int32                  `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // 排序（升序）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommonResponse) Reset() {
-	*x = CommonResponse{}
+func (x *Sku) Reset() {
+	*x = Sku{}
 	mi := &file_product_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommonResponse) String() string {
+func (x *Sku) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommonResponse) ProtoMessage() {}
+func (*Sku) ProtoMessage() {}
 
-func (x *CommonResponse) ProtoReflect() protoreflect.Message {
+func (x *Sku) ProtoReflect() protoreflect.Message {
 	mi := &file_product_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -174,52 +272,73 @@ func (x *CommonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommonResponse.ProtoReflect.Descriptor instead.
-func (*CommonResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Sku.ProtoReflect.Descriptor instead.
+func (*Sku) Descriptor() ([]byte, []int) {
 	return file_product_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CommonResponse) GetCode() int32 {
+func (x *Sku) GetSkuId() int64 {
 	if x != nil {
-		return x.Code
+		return x.SkuId
 	}
 	return 0
 }
 
-func (x *CommonResponse) GetMsg() string {
+func (x *Sku) GetName() string {
 	if x != nil {
-		return x.Msg
+		return x.Name
 	}
 	return ""
 }
 
-// 创建商品请求
-type CreateProductRequest struct {
+func (x *Sku) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Sku) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *Sku) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+// 商品属性组（如口味、加料）
+type OptionGroup struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // 属性组ID（仅响应返回）
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float32                `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`
-	Stock         int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	MinSelect     int32                  `protobuf:"varint,3,opt,name=min_select,json=minSelect,proto3" json:"min_select,omitempty"` // 最少选择数（0表示可不选）
+	MaxSelect     int32                  `protobuf:"varint,4,opt,name=max_select,json=maxSelect,proto3" json:"max_select,omitempty"` // 最多选择数
+	SortOrder     int32                  `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	Options       []*Option              `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductRequest) Reset() {
-	*x = CreateProductRequest{}
+func (x *OptionGroup) Reset() {
+	*x = OptionGroup{}
 	mi := &file_product_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductRequest) String() string {
+func (x *OptionGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductRequest) ProtoMessage() {}
+func (*OptionGroup) ProtoMessage() {}
 
-func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+func (x *OptionGroup) ProtoReflect() protoreflect.Message {
 	mi := &file_product_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -231,77 +350,78 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
-func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OptionGroup.ProtoReflect.Descriptor instead.
+func (*OptionGroup) Descriptor() ([]byte, []int) {
 	return file_product_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateProductRequest) GetMerchantId() int64 {
+func (x *OptionGroup) GetGroupId() int64 {
 	if x != nil {
-		return x.MerchantId
+		return x.GroupId
 	}
 	return 0
 }
 
-func (x *CreateProductRequest) GetName() string {
+func (x *OptionGroup) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetDescription() string {
+func (x *OptionGroup) GetMinSelect() int32 {
 	if x != nil {
-		return x.Description
+		return x.MinSelect
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateProductRequest) GetPrice() float32 {
+func (x *OptionGroup) GetMaxSelect() int32 {
 	if x != nil {
-		return x.Price
+		return x.MaxSelect
 	}
 	return 0
 }
 
-func (x *CreateProductRequest) GetStock() int32 {
+func (x *OptionGroup) GetSortOrder() int32 {
 	if x != nil {
-		return x.Stock
+		return x.SortOrder
 	}
 	return 0
 }
 
-func (x *CreateProductRequest) GetImageUrl() string {
+func (x *OptionGroup) GetOptions() []*Option {
 	if x != nil {
-		return x.ImageUrl
+		return x.Options
 	}
-	return ""
+	return nil
 }
 
-// 创建商品响应
-type CreateProductResponse struct {
+// 商品属性选项（如微辣、加蛋）
+type Option struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	OptionId      int64                  `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"` // 选项ID（仅响应返回）
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price         float32                `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"` // 加价（元）
+	SortOrder     int32                  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductResponse) Reset() {
-	*x = CreateProductResponse{}
+func (x *Option) Reset() {
+	*x = Option{}
 	mi := &file_product_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductResponse) String() string {
+func (x *Option) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductResponse) ProtoMessage() {}
+func (*Option) ProtoMessage() {}
 
-func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+func (x *Option) ProtoReflect() protoreflect.Message {
 	mi := &file_product_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -313,61 +433,62 @@ func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
-func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Option.ProtoReflect.Descriptor instead.
+func (*Option) Descriptor() ([]byte, []int) {
 	return file_product_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateProductResponse) GetCode() int32 {
+func (x *Option) GetOptionId() int64 {
 	if x != nil {
-		return x.Code
+		return x.OptionId
 	}
 	return 0
 }
 
-func (x *CreateProductResponse) GetMsg() string {
+func (x *Option) GetName() string {
 	if x != nil {
-		return x.Msg
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateProductResponse) GetProductId() int64 {
+func (x *Option) GetPrice() float32 {
 	if x != nil {
-		return x.ProductId
+		return x.Price
 	}
 	return 0
 }
 
-// 更新商品请求
-type UpdateProductRequest struct {
+func (x *Option) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+// 通用响应
+type CommonResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
-	Stock         int32                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	IsSoldOut     bool                   `protobuf:"varint,8,opt,name=is_sold_out,json=isSoldOut,proto3" json:"is_sold_out,omitempty"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
+func (x *CommonResponse) Reset() {
+	*x = CommonResponse{}
 	mi := &file_product_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *CommonResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*CommonResponse) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+func (x *CommonResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_product_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -379,79 +500,316 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommonResponse.ProtoReflect.Descriptor instead.
+func (*CommonResponse) Descriptor() ([]byte, []int) {
 	return file_product_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UpdateProductRequest) GetProductId() int64 {
+func (x *CommonResponse) GetCode() int32 {
 	if x != nil {
-		return x.ProductId
+		return x.Code
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetMerchantId() int64 {
+func (x *CommonResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// 创建商品请求
+type CreateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float32                `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`
+	Stock         int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	PackingFee    float32                `protobuf:"fixed32,7,opt,name=packing_fee,json=packingFee,proto3" json:"packing_fee,omitempty"`     // 单件打包费（可选）
+	ExternalCode  string                 `protobuf:"bytes,8,opt,name=external_code,json=externalCode,proto3" json:"external_code,omitempty"` // 外部编码（可选，同一商家内唯一）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_product_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateProductRequest) GetMerchantId() int64 {
 	if x != nil {
 		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetName() string {
+func (x *CreateProductRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetDescription() string {
+func (x *CreateProductRequest) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetPrice() float32 {
+func (x *CreateProductRequest) GetPrice() float32 {
 	if x != nil {
 		return x.Price
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetStock() int32 {
+func (x *CreateProductRequest) GetStock() int32 {
 	if x != nil {
 		return x.Stock
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetImageUrl() string {
+func (x *CreateProductRequest) GetImageUrl() string {
 	if x != nil {
 		return x.ImageUrl
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetIsSoldOut() bool {
+func (x *CreateProductRequest) GetPackingFee() float32 {
 	if x != nil {
-		return x.IsSoldOut
+		return x.PackingFee
 	}
-	return false
+	return 0
 }
 
-// 删除商品请求
-type DeleteProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateProductRequest) GetExternalCode() string {
+	if x != nil {
+		return x.ExternalCode
+	}
+	return ""
+}
+
+// 创建商品响应
+type CreateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_product_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductResponse) ProtoMessage() {}
+
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateProductResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateProductResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CreateProductResponse) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+// 更新商品请求
+type UpdateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
+	Stock         int32                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	IsSoldOut     bool                   `protobuf:"varint,8,opt,name=is_sold_out,json=isSoldOut,proto3" json:"is_sold_out,omitempty"`        // 已废弃：售罄状态由库存自动维护，暂停售卖请使用SetProductAvailability
+	PackingFee    float32                `protobuf:"fixed32,9,opt,name=packing_fee,json=packingFee,proto3" json:"packing_fee,omitempty"`      // 单件打包费（可选）
+	ExternalCode  string                 `protobuf:"bytes,10,opt,name=external_code,json=externalCode,proto3" json:"external_code,omitempty"` // 外部编码（同一商家内唯一，为空时保持不变）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_product_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateProductRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetIsSoldOut() bool {
+	if x != nil {
+		return x.IsSoldOut
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetPackingFee() float32 {
+	if x != nil {
+		return x.PackingFee
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetExternalCode() string {
+	if x != nil {
+		return x.ExternalCode
+	}
+	return ""
+}
+
+// 删除商品请求
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteProductRequest) Reset() {
 	*x = DeleteProductRequest{}
-	mi := &file_product_proto_msgTypes[5]
+	mi := &file_product_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -463,7 +821,7 @@ func (x *DeleteProductRequest) String() string {
 func (*DeleteProductRequest) ProtoMessage() {}
 
 func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[5]
+	mi := &file_product_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -476,7 +834,7 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{5}
+	return file_product_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteProductRequest) GetProductId() int64 {
@@ -497,15 +855,17 @@ func (x *DeleteProductRequest) GetMerchantId() int64 {
 type ListProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 页码（默认1）
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页条数
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                            // 页码（0表示游标分页）
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`    // 每页条数
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                         // 游标（上一页返回的next_cursor，传入时忽略page）
+	WithTotal     bool                   `protobuf:"varint,5,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"` // 游标分页时是否统计总数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListProductsRequest) Reset() {
 	*x = ListProductsRequest{}
-	mi := &file_product_proto_msgTypes[6]
+	mi := &file_product_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -517,7 +877,7 @@ func (x *ListProductsRequest) String() string {
 func (*ListProductsRequest) ProtoMessage() {}
 
 func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[6]
+	mi := &file_product_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -530,7 +890,7 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
 func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{6}
+	return file_product_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListProductsRequest) GetMerchantId() int64 {
@@ -554,22 +914,37 @@ func (x *ListProductsRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListProductsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
 // 查询商品列表响应
 type ListProductsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
 	Products      []*Product             `protobuf:"bytes,3,rep,name=products,proto3" json:"products,omitempty"`
-	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`                       // 总条数
-	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`                         // 当前页码
-	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页条数
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`                            // 总条数（未统计时为-1）
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`                              // 当前页码
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`      // 每页条数
+	NextCursor    string                 `protobuf:"bytes,7,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标（为空表示没有更多）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListProductsResponse) Reset() {
 	*x = ListProductsResponse{}
-	mi := &file_product_proto_msgTypes[7]
+	mi := &file_product_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -581,7 +956,7 @@ func (x *ListProductsResponse) String() string {
 func (*ListProductsResponse) ProtoMessage() {}
 
 func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[7]
+	mi := &file_product_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +969,7 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
 func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{7}
+	return file_product_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListProductsResponse) GetCode() int32 {
@@ -639,6 +1014,13 @@ func (x *ListProductsResponse) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListProductsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 // 查询商品详情请求
 type GetProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -649,7 +1031,7 @@ type GetProductRequest struct {
 
 func (x *GetProductRequest) Reset() {
 	*x = GetProductRequest{}
-	mi := &file_product_proto_msgTypes[8]
+	mi := &file_product_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -661,7 +1043,7 @@ func (x *GetProductRequest) String() string {
 func (*GetProductRequest) ProtoMessage() {}
 
 func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[8]
+	mi := &file_product_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,7 +1056,7 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
 func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{8}
+	return file_product_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetProductRequest) GetProductId() int64 {
@@ -696,7 +1078,7 @@ type GetProductResponse struct {
 
 func (x *GetProductResponse) Reset() {
 	*x = GetProductResponse{}
-	mi := &file_product_proto_msgTypes[9]
+	mi := &file_product_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -708,7 +1090,7 @@ func (x *GetProductResponse) String() string {
 func (*GetProductResponse) ProtoMessage() {}
 
 func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[9]
+	mi := &file_product_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,7 +1103,7 @@ func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
 func (*GetProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{9}
+	return file_product_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetProductResponse) GetCode() int32 {
@@ -749,14 +1131,16 @@ func (x *GetProductResponse) GetProduct() *Product {
 type DeductStockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Num           int32                  `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"` // 扣减数量
+	Num           int32                  `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"`                     // 扣减数量
+	SkuId         int64                  `protobuf:"varint,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`    // 规格ID（多规格商品必填）
+	UserId        int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 下单用户ID（秒杀商品按用户限购）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeductStockRequest) Reset() {
 	*x = DeductStockRequest{}
-	mi := &file_product_proto_msgTypes[10]
+	mi := &file_product_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -768,7 +1152,7 @@ func (x *DeductStockRequest) String() string {
 func (*DeductStockRequest) ProtoMessage() {}
 
 func (x *DeductStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[10]
+	mi := &file_product_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -781,7 +1165,7 @@ func (x *DeductStockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeductStockRequest.ProtoReflect.Descriptor instead.
 func (*DeductStockRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{10}
+	return file_product_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeductStockRequest) GetProductId() int64 {
@@ -798,18 +1182,35 @@ func (x *DeductStockRequest) GetNum() int32 {
 	return 0
 }
 
+func (x *DeductStockRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *DeductStockRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
 // 恢复库存请求
 type RestoreStockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Num           int32                  `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"` // 恢复数量
+	Num           int32                  `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"`                             // 恢复数量
+	SkuId         int64                  `protobuf:"varint,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`            // 规格ID（下单时指定的规格）
+	UserId        int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`         // 下单用户ID（秒杀商品退还限购额度）
+	RestoreNo     string                 `protobuf:"bytes,5,opt,name=restore_no,json=restoreNo,proto3" json:"restore_no,omitempty"` // 恢复流水号（幂等键，同一流水号只恢复一次，为空不去重）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RestoreStockRequest) Reset() {
 	*x = RestoreStockRequest{}
-	mi := &file_product_proto_msgTypes[11]
+	mi := &file_product_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -821,7 +1222,7 @@ func (x *RestoreStockRequest) String() string {
 func (*RestoreStockRequest) ProtoMessage() {}
 
 func (x *RestoreStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[11]
+	mi := &file_product_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,7 +1235,7 @@ func (x *RestoreStockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreStockRequest.ProtoReflect.Descriptor instead.
 func (*RestoreStockRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{11}
+	return file_product_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RestoreStockRequest) GetProductId() int64 {
@@ -851,19 +1252,2545 @@ func (x *RestoreStockRequest) GetNum() int32 {
 	return 0
 }
 
-var File_product_proto protoreflect.FileDescriptor
+func (x *RestoreStockRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
 
-const file_product_proto_rawDesc = "" +
-	"\n" +
-	"\rproduct.proto\x12\aproduct\x1a\x1bgoogle/protobuf/empty.proto\x1a\x0evalidate.proto\"\xa6\x02\n" +
-	"\aProduct\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1f\n" +
-	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
-	"merchantId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x02R\x05price\x12\x14\n" +
+func (x *RestoreStockRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RestoreStockRequest) GetRestoreNo() string {
+	if x != nil {
+		return x.RestoreNo
+	}
+	return ""
+}
+
+// 设置商品规格及属性请求
+type SetProductSpecsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Skus          []*Sku                 `protobuf:"bytes,3,rep,name=skus,proto3" json:"skus,omitempty"`                                     // 规格（最多20个，已有规格需传sku_id，为空表示取消多规格）
+	OptionGroups  []*OptionGroup         `protobuf:"bytes,4,rep,name=option_groups,json=optionGroups,proto3" json:"option_groups,omitempty"` // 属性组（最多10个，整体覆盖）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductSpecsRequest) Reset() {
+	*x = SetProductSpecsRequest{}
+	mi := &file_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductSpecsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductSpecsRequest) ProtoMessage() {}
+
+func (x *SetProductSpecsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductSpecsRequest.ProtoReflect.Descriptor instead.
+func (*SetProductSpecsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetProductSpecsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetProductSpecsRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *SetProductSpecsRequest) GetSkus() []*Sku {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *SetProductSpecsRequest) GetOptionGroups() []*OptionGroup {
+	if x != nil {
+		return x.OptionGroups
+	}
+	return nil
+}
+
+// 菜单分类
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                             // 分类名称（如热销、主食、饮品）
+	SortOrder     int32                  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // 排序（升序）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Category) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Category) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+// 创建分类请求
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateCategoryRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+// 创建分类响应
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateCategoryResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateCategoryResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CreateCategoryResponse) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+// 更新分类请求
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateCategoryRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+// 删除分类请求
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteCategoryRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *DeleteCategoryRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+// 查询分类请求
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListCategoriesRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+// 查询分类响应
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Categories    []*Category            `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListCategoriesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListCategoriesResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// 设置商品分类请求
+type SetProductCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	CategoryIds   []int64                `protobuf:"varint,3,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"` // 所属分类（最多10个，为空表示移出所有分类）
+	SortOrder     int32                  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`              // 分类内排序（升序）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductCategoriesRequest) Reset() {
+	*x = SetProductCategoriesRequest{}
+	mi := &file_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductCategoriesRequest) ProtoMessage() {}
+
+func (x *SetProductCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*SetProductCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetProductCategoriesRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetProductCategoriesRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *SetProductCategoriesRequest) GetCategoryIds() []int64 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *SetProductCategoriesRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+// 菜单分类（含商品）
+type MenuCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 0表示未分类（"其他"）
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	Products      []*Product             `protobuf:"bytes,4,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuCategory) Reset() {
+	*x = MenuCategory{}
+	mi := &file_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuCategory) ProtoMessage() {}
+
+func (x *MenuCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuCategory.ProtoReflect.Descriptor instead.
+func (*MenuCategory) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MenuCategory) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *MenuCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MenuCategory) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *MenuCategory) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// 查询商家菜单请求
+type GetMerchantMenuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMerchantMenuRequest) Reset() {
+	*x = GetMerchantMenuRequest{}
+	mi := &file_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMerchantMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMerchantMenuRequest) ProtoMessage() {}
+
+func (x *GetMerchantMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMerchantMenuRequest.ProtoReflect.Descriptor instead.
+func (*GetMerchantMenuRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetMerchantMenuRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+// 查询商家菜单响应
+type GetMerchantMenuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Categories    []*MenuCategory        `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMerchantMenuResponse) Reset() {
+	*x = GetMerchantMenuResponse{}
+	mi := &file_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMerchantMenuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMerchantMenuResponse) ProtoMessage() {}
+
+func (x *GetMerchantMenuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMerchantMenuResponse.ProtoReflect.Descriptor instead.
+func (*GetMerchantMenuResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetMerchantMenuResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetMerchantMenuResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetMerchantMenuResponse) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *GetMerchantMenuResponse) GetCategories() []*MenuCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// 购物车商品
+type CartItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductId         int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ImageUrl          string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Price             float32                `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`                             // 当前单价
+	PackingFee        float32                `protobuf:"fixed32,5,opt,name=packing_fee,json=packingFee,proto3" json:"packing_fee,omitempty"` // 单件打包费
+	Quantity          int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	TotalPrice        float32                `protobuf:"fixed32,7,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"` // 小计（单价*数量）
+	Available         bool                   `protobuf:"varint,8,opt,name=available,proto3" json:"available,omitempty"`                      // 是否可购买（未下架、未售罄、库存充足）
+	UnavailableReason string                 `protobuf:"bytes,9,opt,name=unavailable_reason,json=unavailableReason,proto3" json:"unavailable_reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CartItem) Reset() {
+	*x = CartItem{}
+	mi := &file_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItem) ProtoMessage() {}
+
+func (x *CartItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
+func (*CartItem) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CartItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CartItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CartItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CartItem) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CartItem) GetPackingFee() float32 {
+	if x != nil {
+		return x.PackingFee
+	}
+	return 0
+}
+
+func (x *CartItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CartItem) GetTotalPrice() float32 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *CartItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *CartItem) GetUnavailableReason() string {
+	if x != nil {
+		return x.UnavailableReason
+	}
+	return ""
+}
+
+// 加入购物车请求
+type AddCartItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCartItemRequest) Reset() {
+	*x = AddCartItemRequest{}
+	mi := &file_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCartItemRequest) ProtoMessage() {}
+
+func (x *AddCartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCartItemRequest.ProtoReflect.Descriptor instead.
+func (*AddCartItemRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AddCartItemRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddCartItemRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *AddCartItemRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *AddCartItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// 修改购物车商品数量请求
+type UpdateCartItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"` // 0表示移除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCartItemRequest) Reset() {
+	*x = UpdateCartItemRequest{}
+	mi := &file_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCartItemRequest) ProtoMessage() {}
+
+func (x *UpdateCartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCartItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCartItemRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateCartItemRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateCartItemRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *UpdateCartItemRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *UpdateCartItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// 移除购物车商品请求
+type RemoveCartItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCartItemRequest) Reset() {
+	*x = RemoveCartItemRequest{}
+	mi := &file_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCartItemRequest) ProtoMessage() {}
+
+func (x *RemoveCartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCartItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCartItemRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RemoveCartItemRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveCartItemRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *RemoveCartItemRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+// 查询购物车请求
+type ListCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCartRequest) Reset() {
+	*x = ListCartRequest{}
+	mi := &file_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCartRequest) ProtoMessage() {}
+
+func (x *ListCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCartRequest.ProtoReflect.Descriptor instead.
+func (*ListCartRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListCartRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListCartRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+// 查询购物车响应
+type ListCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Items         []*CartItem            `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	GoodsAmount   float32                `protobuf:"fixed32,4,opt,name=goods_amount,json=goodsAmount,proto3" json:"goods_amount,omitempty"`      // 可购买商品总金额
+	PackingFee    float32                `protobuf:"fixed32,5,opt,name=packing_fee,json=packingFee,proto3" json:"packing_fee,omitempty"`         // 可购买商品打包费
+	CheckoutReady bool                   `protobuf:"varint,6,opt,name=checkout_ready,json=checkoutReady,proto3" json:"checkout_ready,omitempty"` // 是否可直接结算（存在商品且全部可购买）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCartResponse) Reset() {
+	*x = ListCartResponse{}
+	mi := &file_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCartResponse) ProtoMessage() {}
+
+func (x *ListCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCartResponse.ProtoReflect.Descriptor instead.
+func (*ListCartResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListCartResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListCartResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListCartResponse) GetItems() []*CartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListCartResponse) GetGoodsAmount() float32 {
+	if x != nil {
+		return x.GoodsAmount
+	}
+	return 0
+}
+
+func (x *ListCartResponse) GetPackingFee() float32 {
+	if x != nil {
+		return x.PackingFee
+	}
+	return 0
+}
+
+func (x *ListCartResponse) GetCheckoutReady() bool {
+	if x != nil {
+		return x.CheckoutReady
+	}
+	return false
+}
+
+// 清空购物车请求
+type ClearCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearCartRequest) Reset() {
+	*x = ClearCartRequest{}
+	mi := &file_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartRequest) ProtoMessage() {}
+
+func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
+func (*ClearCartRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ClearCartRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ClearCartRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+// 购物车结算请求
+type CheckoutCartRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MerchantId         int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ExpectDeliveryTime string                 `protobuf:"bytes,6,opt,name=expect_delivery_time,json=expectDeliveryTime,proto3" json:"expect_delivery_time,omitempty"` // 可选
+	CouponIds          []int64                `protobuf:"varint,7,rep,packed,name=coupon_ids,json=couponIds,proto3" json:"coupon_ids,omitempty"`
+	AddressId          int64                  `protobuf:"varint,10,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"` // 用户收货地址ID
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CheckoutCartRequest) Reset() {
+	*x = CheckoutCartRequest{}
+	mi := &file_product_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutCartRequest) ProtoMessage() {}
+
+func (x *CheckoutCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutCartRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutCartRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CheckoutCartRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CheckoutCartRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CheckoutCartRequest) GetExpectDeliveryTime() string {
+	if x != nil {
+		return x.ExpectDeliveryTime
+	}
+	return ""
+}
+
+func (x *CheckoutCartRequest) GetCouponIds() []int64 {
+	if x != nil {
+		return x.CouponIds
+	}
+	return nil
+}
+
+func (x *CheckoutCartRequest) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+// 购物车结算响应
+type CheckoutCartResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg            string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	OrderId        int64                  `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderNo        string                 `protobuf:"bytes,4,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	GoodsAmount    float32                `protobuf:"fixed32,5,opt,name=goods_amount,json=goodsAmount,proto3" json:"goods_amount,omitempty"`
+	PackingFee     float32                `protobuf:"fixed32,6,opt,name=packing_fee,json=packingFee,proto3" json:"packing_fee,omitempty"`
+	DeliveryFee    float32                `protobuf:"fixed32,7,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
+	DiscountAmount float32                `protobuf:"fixed32,8,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	TotalAmount    float32                `protobuf:"fixed32,9,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckoutCartResponse) Reset() {
+	*x = CheckoutCartResponse{}
+	mi := &file_product_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutCartResponse) ProtoMessage() {}
+
+func (x *CheckoutCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutCartResponse.ProtoReflect.Descriptor instead.
+func (*CheckoutCartResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CheckoutCartResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CheckoutCartResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CheckoutCartResponse) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CheckoutCartResponse) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *CheckoutCartResponse) GetGoodsAmount() float32 {
+	if x != nil {
+		return x.GoodsAmount
+	}
+	return 0
+}
+
+func (x *CheckoutCartResponse) GetPackingFee() float32 {
+	if x != nil {
+		return x.PackingFee
+	}
+	return 0
+}
+
+func (x *CheckoutCartResponse) GetDeliveryFee() float32 {
+	if x != nil {
+		return x.DeliveryFee
+	}
+	return 0
+}
+
+func (x *CheckoutCartResponse) GetDiscountAmount() float32 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *CheckoutCartResponse) GetTotalAmount() float32 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+// 秒杀信息
+type FlashSale struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlashSaleId   int64                  `protobuf:"varint,1,opt,name=flash_sale_id,json=flashSaleId,proto3" json:"flash_sale_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	InitialStock  int32                  `protobuf:"varint,3,opt,name=initial_stock,json=initialStock,proto3" json:"initial_stock,omitempty"`   // 开始时库存
+	PerUserLimit  int32                  `protobuf:"varint,4,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"` // 每人限购数量（0表示不限）
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                    // 进行中/结算中（在途库存流水落库后自动结束）/已结束
+	FinalStock    int32                  `protobuf:"varint,6,opt,name=final_stock,json=finalStock,proto3" json:"final_stock,omitempty"`         // 停止扣减时剩余库存
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlashSale) Reset() {
+	*x = FlashSale{}
+	mi := &file_product_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlashSale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlashSale) ProtoMessage() {}
+
+func (x *FlashSale) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlashSale.ProtoReflect.Descriptor instead.
+func (*FlashSale) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FlashSale) GetFlashSaleId() int64 {
+	if x != nil {
+		return x.FlashSaleId
+	}
+	return 0
+}
+
+func (x *FlashSale) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *FlashSale) GetInitialStock() int32 {
+	if x != nil {
+		return x.InitialStock
+	}
+	return 0
+}
+
+func (x *FlashSale) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *FlashSale) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FlashSale) GetFinalStock() int32 {
+	if x != nil {
+		return x.FinalStock
+	}
+	return 0
+}
+
+// 开启秒杀请求
+type StartFlashSaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	PerUserLimit  int32                  `protobuf:"varint,3,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"` // 每人限购数量（0表示不限）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartFlashSaleRequest) Reset() {
+	*x = StartFlashSaleRequest{}
+	mi := &file_product_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartFlashSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartFlashSaleRequest) ProtoMessage() {}
+
+func (x *StartFlashSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartFlashSaleRequest.ProtoReflect.Descriptor instead.
+func (*StartFlashSaleRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StartFlashSaleRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StartFlashSaleRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *StartFlashSaleRequest) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+// 结束秒杀请求
+type StopFlashSaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopFlashSaleRequest) Reset() {
+	*x = StopFlashSaleRequest{}
+	mi := &file_product_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopFlashSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopFlashSaleRequest) ProtoMessage() {}
+
+func (x *StopFlashSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopFlashSaleRequest.ProtoReflect.Descriptor instead.
+func (*StopFlashSaleRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StopFlashSaleRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StopFlashSaleRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type FlashSaleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	FlashSale     *FlashSale             `protobuf:"bytes,3,opt,name=flash_sale,json=flashSale,proto3" json:"flash_sale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlashSaleResponse) Reset() {
+	*x = FlashSaleResponse{}
+	mi := &file_product_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlashSaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlashSaleResponse) ProtoMessage() {}
+
+func (x *FlashSaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlashSaleResponse.ProtoReflect.Descriptor instead.
+func (*FlashSaleResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FlashSaleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *FlashSaleResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *FlashSaleResponse) GetFlashSale() *FlashSale {
+	if x != nil {
+		return x.FlashSale
+	}
+	return nil
+}
+
+// 秒杀对账请求
+type ReconcileFlashSaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileFlashSaleRequest) Reset() {
+	*x = ReconcileFlashSaleRequest{}
+	mi := &file_product_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileFlashSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileFlashSaleRequest) ProtoMessage() {}
+
+func (x *ReconcileFlashSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileFlashSaleRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileFlashSaleRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReconcileFlashSaleRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReconcileFlashSaleRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type ReconcileFlashSaleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	FlashSaleId   int64                  `protobuf:"varint,3,opt,name=flash_sale_id,json=flashSaleId,proto3" json:"flash_sale_id,omitempty"`
+	InitialStock  int32                  `protobuf:"varint,4,opt,name=initial_stock,json=initialStock,proto3" json:"initial_stock,omitempty"` // 开始时库存
+	RedisStock    int64                  `protobuf:"varint,5,opt,name=redis_stock,json=redisStock,proto3" json:"redis_stock,omitempty"`       // Redis剩余库存（-1表示数据缺失）
+	DbStock       int32                  `protobuf:"varint,6,opt,name=db_stock,json=dbStock,proto3" json:"db_stock,omitempty"`                // 商品表库存
+	AppliedDelta  int64                  `protobuf:"varint,7,opt,name=applied_delta,json=appliedDelta,proto3" json:"applied_delta,omitempty"` // 已落库的库存变化量
+	PendingDelta  int64                  `protobuf:"varint,8,opt,name=pending_delta,json=pendingDelta,proto3" json:"pending_delta,omitempty"` // 未落库的库存变化量（消息在途）
+	Consistent    bool                   `protobuf:"varint,9,opt,name=consistent,proto3" json:"consistent,omitempty"`                         // 是否一致
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileFlashSaleResponse) Reset() {
+	*x = ReconcileFlashSaleResponse{}
+	mi := &file_product_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileFlashSaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileFlashSaleResponse) ProtoMessage() {}
+
+func (x *ReconcileFlashSaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileFlashSaleResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileFlashSaleResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReconcileFlashSaleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ReconcileFlashSaleResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ReconcileFlashSaleResponse) GetFlashSaleId() int64 {
+	if x != nil {
+		return x.FlashSaleId
+	}
+	return 0
+}
+
+func (x *ReconcileFlashSaleResponse) GetInitialStock() int32 {
+	if x != nil {
+		return x.InitialStock
+	}
+	return 0
+}
+
+func (x *ReconcileFlashSaleResponse) GetRedisStock() int64 {
+	if x != nil {
+		return x.RedisStock
+	}
+	return 0
+}
+
+func (x *ReconcileFlashSaleResponse) GetDbStock() int32 {
+	if x != nil {
+		return x.DbStock
+	}
+	return 0
+}
+
+func (x *ReconcileFlashSaleResponse) GetAppliedDelta() int64 {
+	if x != nil {
+		return x.AppliedDelta
+	}
+	return 0
+}
+
+func (x *ReconcileFlashSaleResponse) GetPendingDelta() int64 {
+	if x != nil {
+		return x.PendingDelta
+	}
+	return 0
+}
+
+func (x *ReconcileFlashSaleResponse) GetConsistent() bool {
+	if x != nil {
+		return x.Consistent
+	}
+	return false
+}
+
+// 设置商品可售状态请求
+type SetProductAvailabilityRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MerchantId     int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Suspended      bool                   `protobuf:"varint,3,opt,name=suspended,proto3" json:"suspended,omitempty"`                                // 暂停售卖
+	AvailableHours string                 `protobuf:"bytes,4,opt,name=available_hours,json=availableHours,proto3" json:"available_hours,omitempty"` // 可售时段（HH:MM-HH:MM，为空表示全天）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetProductAvailabilityRequest) Reset() {
+	*x = SetProductAvailabilityRequest{}
+	mi := &file_product_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductAvailabilityRequest) ProtoMessage() {}
+
+func (x *SetProductAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SetProductAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetProductAvailabilityRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetProductAvailabilityRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *SetProductAvailabilityRequest) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *SetProductAvailabilityRequest) GetAvailableHours() string {
+	if x != nil {
+		return x.AvailableHours
+	}
+	return ""
+}
+
+// 设置每日补货请求
+type SetDailyRestockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	DailyStock    int32                  `protobuf:"varint,3,opt,name=daily_stock,json=dailyStock,proto3" json:"daily_stock,omitempty"`   // 每日补货库存（0表示关闭）
+	RestockTime   string                 `protobuf:"bytes,4,opt,name=restock_time,json=restockTime,proto3" json:"restock_time,omitempty"` // 补货时间（HH:MM）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDailyRestockRequest) Reset() {
+	*x = SetDailyRestockRequest{}
+	mi := &file_product_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDailyRestockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDailyRestockRequest) ProtoMessage() {}
+
+func (x *SetDailyRestockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDailyRestockRequest.ProtoReflect.Descriptor instead.
+func (*SetDailyRestockRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetDailyRestockRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetDailyRestockRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *SetDailyRestockRequest) GetDailyStock() int32 {
+	if x != nil {
+		return x.DailyStock
+	}
+	return 0
+}
+
+func (x *SetDailyRestockRequest) GetRestockTime() string {
+	if x != nil {
+		return x.RestockTime
+	}
+	return ""
+}
+
+// 批量导入的商品行
+type ProductRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExternalCode  string                 `protobuf:"bytes,1,opt,name=external_code,json=externalCode,proto3" json:"external_code,omitempty"` // 外部编码（新建商品必填；未填商品ID时按此匹配已有商品）
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float32                `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`
+	Stock         int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
+	PackingFee    float32                `protobuf:"fixed32,6,opt,name=packing_fee,json=packingFee,proto3" json:"packing_fee,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	ProductId     int64                  `protobuf:"varint,8,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // 商品ID（可选，填写时按ID匹配已有商品，外部编码非空则一并更新）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductRow) Reset() {
+	*x = ProductRow{}
+	mi := &file_product_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRow) ProtoMessage() {}
+
+func (x *ProductRow) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRow.ProtoReflect.Descriptor instead.
+func (*ProductRow) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ProductRow) GetExternalCode() string {
+	if x != nil {
+		return x.ExternalCode
+	}
+	return ""
+}
+
+func (x *ProductRow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductRow) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProductRow) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductRow) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *ProductRow) GetPackingFee() float32 {
+	if x != nil {
+		return x.PackingFee
+	}
+	return 0
+}
+
+func (x *ProductRow) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *ProductRow) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+// 批量导入商品请求
+type BulkUpsertProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"` // all_or_nothing：存在错误行时不写入；partial：跳过错误行
+	Rows          []*ProductRow          `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"` // 最多1000行
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpsertProductsRequest) Reset() {
+	*x = BulkUpsertProductsRequest{}
+	mi := &file_product_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertProductsRequest) ProtoMessage() {}
+
+func (x *BulkUpsertProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertProductsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpsertProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BulkUpsertProductsRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *BulkUpsertProductsRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *BulkUpsertProductsRequest) GetRows() []*ProductRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// CSV导入商品请求
+type ImportProductsCSVRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`       // 同BulkUpsertProductsRequest.mode
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"` // CSV文件内容（首行为表头，最多1000行）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsCSVRequest) Reset() {
+	*x = ImportProductsCSVRequest{}
+	mi := &file_product_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsCSVRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsCSVRequest) ProtoMessage() {}
+
+func (x *ImportProductsCSVRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsCSVRequest.ProtoReflect.Descriptor instead.
+func (*ImportProductsCSVRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ImportProductsCSVRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *ImportProductsCSVRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ImportProductsCSVRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// 导入错误行
+type RowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // 行号（CSV为文件行号，批量接口为rows序号，从1开始）
+	ExternalCode  string                 `protobuf:"bytes,2,opt,name=external_code,json=externalCode,proto3" json:"external_code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowError) Reset() {
+	*x = RowError{}
+	mi := &file_product_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowError) ProtoMessage() {}
+
+func (x *RowError) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowError.ProtoReflect.Descriptor instead.
+func (*RowError) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *RowError) GetExternalCode() string {
+	if x != nil {
+		return x.ExternalCode
+	}
+	return ""
+}
+
+func (x *RowError) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type BulkUpsertProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Created       int32                  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`     // 新建商品数
+	Updated       int32                  `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`     // 更新商品数
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`       // 错误行数
+	Committed     bool                   `protobuf:"varint,6,opt,name=committed,proto3" json:"committed,omitempty"` // 是否已写入
+	Errors        []*RowError            `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`        // 逐行错误
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpsertProductsResponse) Reset() {
+	*x = BulkUpsertProductsResponse{}
+	mi := &file_product_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertProductsResponse) ProtoMessage() {}
+
+func (x *BulkUpsertProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertProductsResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpsertProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BulkUpsertProductsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BulkUpsertProductsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *BulkUpsertProductsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *BulkUpsertProductsResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *BulkUpsertProductsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkUpsertProductsResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *BulkUpsertProductsResponse) GetErrors() []*RowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// 导出商品请求
+type ExportProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // 导出格式：csv/xlsx
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsRequest) Reset() {
+	*x = ExportProductsRequest{}
+	mi := &file_product_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsRequest) ProtoMessage() {}
+
+func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ExportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ExportProductsRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *ExportProductsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// 导出商品响应（流式：首块携带文件名，末条done=true；code非0表示导出失败，已接收内容应丢弃）
+type ExportProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`          // 文件名（仅首块携带）
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME类型（仅首块携带）
+	Chunk         []byte                 `protobuf:"bytes,5,opt,name=chunk,proto3" json:"chunk,omitempty"`                                // 文件内容分块
+	Done          bool                   `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`                                 // 是否导出完成
+	Rows          int64                  `protobuf:"varint,7,opt,name=rows,proto3" json:"rows,omitempty"`                                 // 导出商品数（完成时返回）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsResponse) Reset() {
+	*x = ExportProductsResponse{}
+	mi := &file_product_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsResponse) ProtoMessage() {}
+
+func (x *ExportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsResponse.ProtoReflect.Descriptor instead.
+func (*ExportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExportProductsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ExportProductsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ExportProductsResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportProductsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportProductsResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ExportProductsResponse) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ExportProductsResponse) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+var File_product_proto protoreflect.FileDescriptor
+
+const file_product_proto_rawDesc = "" +
+	"\n" +
+	"\rproduct.proto\x12\aproduct\x1a\x0evalidate.proto\"\xd5\x05\n" +
+	"\aProduct\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x02R\x05price\x12\x14\n" +
 	"\x05stock\x18\x06 \x01(\x05R\x05stock\x12\x1b\n" +
 	"\timage_url\x18\a \x01(\tR\bimageUrl\x12\x1e\n" +
 	"\vis_sold_out\x18\b \x01(\bR\tisSoldOut\x12\x1d\n" +
@@ -871,10 +3798,51 @@ const file_product_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\tR\tupdatedAt\"6\n" +
+	" \x01(\tR\tupdatedAt\x12\x1f\n" +
+	"\vpacking_fee\x18\v \x01(\x02R\n" +
+	"packingFee\x12\x14\n" +
+	"\x05score\x18\f \x01(\x02R\x05score\x12!\n" +
+	"\frating_count\x18\r \x01(\x03R\vratingCount\x12\x17\n" +
+	"\ahas_sku\x18\x0e \x01(\bR\x06hasSku\x12 \n" +
+	"\x04skus\x18\x0f \x03(\v2\f.product.SkuR\x04skus\x129\n" +
+	"\roption_groups\x18\x10 \x03(\v2\x14.product.OptionGroupR\foptionGroups\x12\x1c\n" +
+	"\tsuspended\x18\x11 \x01(\bR\tsuspended\x12'\n" +
+	"\x0favailable_hours\x18\x12 \x01(\tR\x0eavailableHours\x12\x1f\n" +
+	"\vdaily_stock\x18\x13 \x01(\x05R\n" +
+	"dailyStock\x12!\n" +
+	"\frestock_time\x18\x14 \x01(\tR\vrestockTime\x12-\n" +
+	"\x12unavailable_reason\x18\x15 \x01(\tR\x11unavailableReason\x12#\n" +
+	"\rexternal_code\x18\x16 \x01(\tR\fexternalCode\"\x9b\x01\n" +
+	"\x03Sku\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x04name\x12 \n" +
+	"\x05price\x18\x03 \x01(\x02B\n" +
+	"\xfaB\a\n" +
+	"\x05%\x00\x00\x00\x00R\x05price\x12\x1d\n" +
+	"\x05stock\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05stock\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\x05R\tsortOrder\"\xcf\x01\n" +
+	"\vOptionGroup\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x04name\x12\x1d\n" +
+	"\n" +
+	"min_select\x18\x03 \x01(\x05R\tminSelect\x12\x1d\n" +
+	"\n" +
+	"max_select\x18\x04 \x01(\x05R\tmaxSelect\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\x05R\tsortOrder\x12)\n" +
+	"\aoptions\x18\x06 \x03(\v2\x0f.product.OptionR\aoptions\"\x85\x01\n" +
+	"\x06Option\x12\x1b\n" +
+	"\toption_id\x18\x01 \x01(\x03R\boptionId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x04name\x12 \n" +
+	"\x05price\x18\x03 \x01(\x02B\n" +
+	"\xfaB\a\n" +
+	"\x05-\x00\x00\x00\x00R\x05price\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\x05R\tsortOrder\"6\n" +
 	"\x0eCommonResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg\"\xf3\x01\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\"\xce\x02\n" +
 	"\x14CreateProductRequest\x12(\n" +
 	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
 	"merchantId\x12\x1d\n" +
@@ -884,12 +3852,17 @@ const file_product_proto_rawDesc = "" +
 	"\xfaB\a\n" +
 	"\x05%\x00\x00\x00\x00R\x05price\x12\x1d\n" +
 	"\x05stock\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05stock\x12%\n" +
-	"\timage_url\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\bimageUrl\"\\\n" +
+	"\timage_url\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\bimageUrl\x12+\n" +
+	"\vpacking_fee\x18\a \x01(\x02B\n" +
+	"\xfaB\a\n" +
+	"\x05-\x00\x00\x00\x00R\n" +
+	"packingFee\x12,\n" +
+	"\rexternal_code\x18\b \x01(\tB\a\xfaB\x04r\x02\x18@R\fexternalCode\"\\\n" +
 	"\x15CreateProductResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x03 \x01(\x03R\tproductId\"\xbb\x02\n" +
+	"product_id\x18\x03 \x01(\x03R\tproductId\"\x96\x03\n" +
 	"\x14UpdateProductRequest\x12&\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12(\n" +
@@ -902,40 +3875,307 @@ const file_product_proto_rawDesc = "" +
 	"\x05%\x00\x00\x00\x00R\x05price\x12\x1d\n" +
 	"\x05stock\x18\x06 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05stock\x12%\n" +
 	"\timage_url\x18\a \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\bimageUrl\x12\x1e\n" +
-	"\vis_sold_out\x18\b \x01(\bR\tisSoldOut\"h\n" +
+	"\vis_sold_out\x18\b \x01(\bR\tisSoldOut\x12+\n" +
+	"\vpacking_fee\x18\t \x01(\x02B\n" +
+	"\xfaB\a\n" +
+	"\x05-\x00\x00\x00\x00R\n" +
+	"packingFee\x12,\n" +
+	"\rexternal_code\x18\n" +
+	" \x01(\tB\a\xfaB\x04r\x02\x18@R\fexternalCode\"h\n" +
 	"\x14DeleteProductRequest\x12&\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12(\n" +
 	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
-	"merchantId\"\x84\x01\n" +
+	"merchantId\"\xbb\x01\n" +
 	"\x13ListProductsRequest\x12(\n" +
 	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
 	"merchantId\x12\x1b\n" +
-	"\x04page\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\x04page\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12&\n" +
 	"\tpage_size\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\n" +
-	"R\bpageSize\"\xb1\x01\n" +
+	"R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"with_total\x18\x05 \x01(\bR\twithTotal\"\xd2\x01\n" +
 	"\x14ListProductsResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x02 \x01(\tR\x03msg\x12,\n" +
 	"\bproducts\x18\x03 \x03(\v2\x10.product.ProductR\bproducts\x12\x14\n" +
 	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\";\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vnext_cursor\x18\a \x01(\tR\n" +
+	"nextCursor\";\n" +
 	"\x11GetProductRequest\x12&\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\"f\n" +
 	"\x12GetProductResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x02 \x01(\tR\x03msg\x12*\n" +
-	"\aproduct\x18\x03 \x01(\v2\x10.product.ProductR\aproduct\"W\n" +
+	"\aproduct\x18\x03 \x01(\v2\x10.product.ProductR\aproduct\"\x87\x01\n" +
 	"\x12DeductStockRequest\x12&\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12\x19\n" +
-	"\x03num\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x03num\"X\n" +
+	"\x03num\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x03num\x12\x15\n" +
+	"\x06sku_id\x18\x03 \x01(\x03R\x05skuId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\"\xa7\x01\n" +
 	"\x13RestoreStockRequest\x12&\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12\x19\n" +
-	"\x03num\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x03num2\xa2\x04\n" +
+	"\x03num\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x03num\x12\x15\n" +
+	"\x06sku_id\x18\x03 \x01(\x03R\x05skuId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"restore_no\x18\x05 \x01(\tR\trestoreNo\"\xc7\x01\n" +
+	"\x16SetProductSpecsRequest\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12 \n" +
+	"\x04skus\x18\x03 \x03(\v2\f.product.SkuR\x04skus\x129\n" +
+	"\roption_groups\x18\x04 \x03(\v2\x14.product.OptionGroupR\foptionGroups\"\x7f\n" +
+	"\bCategory\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\x05R\tsortOrder\"\x88\x01\n" +
+	"\x15CreateCategoryRequest\x12(\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x04name\x12&\n" +
+	"\n" +
+	"sort_order\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\tsortOrder\"_\n" +
+	"\x16CreateCategoryResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x03R\n" +
+	"categoryId\"\xb2\x01\n" +
+	"\x15UpdateCategoryRequest\x12(\n" +
+	"\vcategory_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"categoryId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12\x1d\n" +
+	"\x04name\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x04name\x12&\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\tsortOrder\"k\n" +
+	"\x15DeleteCategoryRequest\x12(\n" +
+	"\vcategory_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"categoryId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\"A\n" +
+	"\x15ListCategoriesRequest\x12(\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\"q\n" +
+	"\x16ListCategoriesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x121\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\v2\x11.product.CategoryR\n" +
+	"categories\"\xba\x01\n" +
+	"\x1bSetProductCategoriesRequest\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12!\n" +
+	"\fcategory_ids\x18\x03 \x03(\x03R\vcategoryIds\x12&\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\tsortOrder\"\x90\x01\n" +
+	"\fMenuCategory\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x03 \x01(\x05R\tsortOrder\x12,\n" +
+	"\bproducts\x18\x04 \x03(\v2\x10.product.ProductR\bproducts\"B\n" +
+	"\x16GetMerchantMenuRequest\x12(\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\"\x97\x01\n" +
+	"\x17GetMerchantMenuResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x1f\n" +
+	"\vmerchant_id\x18\x03 \x01(\x03R\n" +
+	"merchantId\x125\n" +
+	"\n" +
+	"categories\x18\x04 \x03(\v2\x15.product.MenuCategoryR\n" +
+	"categories\"\x9b\x02\n" +
+	"\bCartItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\timage_url\x18\x03 \x01(\tR\bimageUrl\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x02R\x05price\x12\x1f\n" +
+	"\vpacking_fee\x18\x05 \x01(\x02R\n" +
+	"packingFee\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x1f\n" +
+	"\vtotal_price\x18\a \x01(\x02R\n" +
+	"totalPrice\x12\x1c\n" +
+	"\tavailable\x18\b \x01(\bR\tavailable\x12-\n" +
+	"\x12unavailable_reason\x18\t \x01(\tR\x11unavailableReason\"\xad\x01\n" +
+	"\x12AddCartItemRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12&\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12#\n" +
+	"\bquantity\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bquantity\"\xb0\x01\n" +
+	"\x15UpdateCartItemRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12&\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12#\n" +
+	"\bquantity\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bquantity\"\x8b\x01\n" +
+	"\x15RemoveCartItemRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12&\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\"]\n" +
+	"\x0fListCartRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\"\xcc\x01\n" +
+	"\x10ListCartResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12'\n" +
+	"\x05items\x18\x03 \x03(\v2\x11.product.CartItemR\x05items\x12!\n" +
+	"\fgoods_amount\x18\x04 \x01(\x02R\vgoodsAmount\x12\x1f\n" +
+	"\vpacking_fee\x18\x05 \x01(\x02R\n" +
+	"packingFee\x12%\n" +
+	"\x0echeckout_ready\x18\x06 \x01(\bR\rcheckoutReady\"^\n" +
+	"\x10ClearCartRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\"\xb7\x02\n" +
+	"\x13CheckoutCartRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x120\n" +
+	"\x14expect_delivery_time\x18\x06 \x01(\tR\x12expectDeliveryTime\x12'\n" +
+	"\n" +
+	"coupon_ids\x18\a \x03(\x03B\b\xfaB\x05\x92\x01\x02\x10\x02R\tcouponIds\x12&\n" +
+	"\n" +
+	"address_id\x18\n" +
+	" \x01(\x03B\a\xfaB\x04\"\x02 \x00R\taddressIdJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06J\x04\b\b\x10\tJ\x04\b\t\x10\n" +
+	"R\tuser_nameR\n" +
+	"user_phoneR\aaddressR\tlongitudeR\blatitude\"\xa5\x02\n" +
+	"\x14CheckoutCartResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\x03R\aorderId\x12\x19\n" +
+	"\border_no\x18\x04 \x01(\tR\aorderNo\x12!\n" +
+	"\fgoods_amount\x18\x05 \x01(\x02R\vgoodsAmount\x12\x1f\n" +
+	"\vpacking_fee\x18\x06 \x01(\x02R\n" +
+	"packingFee\x12!\n" +
+	"\fdelivery_fee\x18\a \x01(\x02R\vdeliveryFee\x12'\n" +
+	"\x0fdiscount_amount\x18\b \x01(\x02R\x0ediscountAmount\x12!\n" +
+	"\ftotal_amount\x18\t \x01(\x02R\vtotalAmount\"\xd2\x01\n" +
+	"\tFlashSale\x12\"\n" +
+	"\rflash_sale_id\x18\x01 \x01(\x03R\vflashSaleId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12#\n" +
+	"\rinitial_stock\x18\x03 \x01(\x05R\finitialStock\x12$\n" +
+	"\x0eper_user_limit\x18\x04 \x01(\x05R\fperUserLimit\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1f\n" +
+	"\vfinal_stock\x18\x06 \x01(\x05R\n" +
+	"finalStock\"\x9a\x01\n" +
+	"\x15StartFlashSaleRequest\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12/\n" +
+	"\x0eper_user_limit\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\fperUserLimit\"h\n" +
+	"\x14StopFlashSaleRequest\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\"l\n" +
+	"\x11FlashSaleResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x121\n" +
+	"\n" +
+	"flash_sale\x18\x03 \x01(\v2\x12.product.FlashSaleR\tflashSale\"m\n" +
+	"\x19ReconcileFlashSaleRequest\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\"\xb1\x02\n" +
+	"\x1aReconcileFlashSaleResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\"\n" +
+	"\rflash_sale_id\x18\x03 \x01(\x03R\vflashSaleId\x12#\n" +
+	"\rinitial_stock\x18\x04 \x01(\x05R\finitialStock\x12\x1f\n" +
+	"\vredis_stock\x18\x05 \x01(\x03R\n" +
+	"redisStock\x12\x19\n" +
+	"\bdb_stock\x18\x06 \x01(\x05R\adbStock\x12#\n" +
+	"\rapplied_delta\x18\a \x01(\x03R\fappliedDelta\x12#\n" +
+	"\rpending_delta\x18\b \x01(\x03R\fpendingDelta\x12\x1e\n" +
+	"\n" +
+	"consistent\x18\t \x01(\bR\n" +
+	"consistent\"\xc1\x01\n" +
+	"\x1dSetProductAvailabilityRequest\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12\x1c\n" +
+	"\tsuspended\x18\x03 \x01(\bR\tsuspended\x120\n" +
+	"\x0favailable_hours\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18\x10R\x0eavailableHours\"\xbb\x01\n" +
+	"\x16SetDailyRestockRequest\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tproductId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12,\n" +
+	"\vdaily_stock\x18\x03 \x01(\x05B\v\xfaB\b\x1a\x06\x18\xa0\x8d\x06(\x00R\n" +
+	"dailyStock\x12!\n" +
+	"\frestock_time\x18\x04 \x01(\tR\vrestockTime\"\xf0\x01\n" +
+	"\n" +
+	"ProductRow\x12#\n" +
+	"\rexternal_code\x18\x01 \x01(\tR\fexternalCode\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x02R\x05price\x12\x14\n" +
+	"\x05stock\x18\x05 \x01(\x05R\x05stock\x12\x1f\n" +
+	"\vpacking_fee\x18\x06 \x01(\x02R\n" +
+	"packingFee\x12\x1b\n" +
+	"\timage_url\x18\a \x01(\tR\bimageUrl\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\b \x01(\x03R\tproductId\"\x82\x01\n" +
+	"\x19BulkUpsertProductsRequest\x12(\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12'\n" +
+	"\x04rows\x18\x03 \x03(\v2\x13.product.ProductRowR\x04rows\"r\n" +
+	"\x18ImportProductsCSVRequest\x12(\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"U\n" +
+	"\bRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12#\n" +
+	"\rexternal_code\x18\x02 \x01(\tR\fexternalCode\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\"\xd7\x01\n" +
+	"\x1aBulkUpsertProductsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x04 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12\x1c\n" +
+	"\tcommitted\x18\x06 \x01(\bR\tcommitted\x12)\n" +
+	"\x06errors\x18\a \x03(\v2\x11.product.RowErrorR\x06errors\"Y\n" +
+	"\x15ExportProductsRequest\x12(\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"merchantId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"\xbc\x01\n" +
+	"\x16ExportProductsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05chunk\x18\x05 \x01(\fR\x05chunk\x12\x12\n" +
+	"\x04done\x18\x06 \x01(\bR\x04done\x12\x12\n" +
+	"\x04rows\x18\a \x01(\x03R\x04rows2\x8a\x0e\n" +
 	"\x0eProductService\x12N\n" +
 	"\rCreateProduct\x12\x1d.product.CreateProductRequest\x1a\x1e.product.CreateProductResponse\x12G\n" +
 	"\rUpdateProduct\x12\x1d.product.UpdateProductRequest\x1a\x17.product.CommonResponse\x12G\n" +
@@ -943,7 +4183,29 @@ const file_product_proto_rawDesc = "" +
 	"\x18ListProductsByMerchantID\x12\x1c.product.ListProductsRequest\x1a\x1d.product.ListProductsResponse\x12I\n" +
 	"\x0eGetProductByID\x12\x1a.product.GetProductRequest\x1a\x1b.product.GetProductResponse\x12C\n" +
 	"\vDeductStock\x12\x1b.product.DeductStockRequest\x1a\x17.product.CommonResponse\x12E\n" +
-	"\fRestoreStock\x12\x1c.product.RestoreStockRequest\x1a\x17.product.CommonResponseB'Z%./internal/product/proto;productProtob\x06proto3"
+	"\fRestoreStock\x12\x1c.product.RestoreStockRequest\x1a\x17.product.CommonResponse\x12K\n" +
+	"\x0fSetProductSpecs\x12\x1f.product.SetProductSpecsRequest\x1a\x17.product.CommonResponse\x12Q\n" +
+	"\x0eCreateCategory\x12\x1e.product.CreateCategoryRequest\x1a\x1f.product.CreateCategoryResponse\x12I\n" +
+	"\x0eUpdateCategory\x12\x1e.product.UpdateCategoryRequest\x1a\x17.product.CommonResponse\x12I\n" +
+	"\x0eDeleteCategory\x12\x1e.product.DeleteCategoryRequest\x1a\x17.product.CommonResponse\x12Q\n" +
+	"\x0eListCategories\x12\x1e.product.ListCategoriesRequest\x1a\x1f.product.ListCategoriesResponse\x12U\n" +
+	"\x14SetProductCategories\x12$.product.SetProductCategoriesRequest\x1a\x17.product.CommonResponse\x12T\n" +
+	"\x0fGetMerchantMenu\x12\x1f.product.GetMerchantMenuRequest\x1a .product.GetMerchantMenuResponse\x12L\n" +
+	"\x0eStartFlashSale\x12\x1e.product.StartFlashSaleRequest\x1a\x1a.product.FlashSaleResponse\x12J\n" +
+	"\rStopFlashSale\x12\x1d.product.StopFlashSaleRequest\x1a\x1a.product.FlashSaleResponse\x12]\n" +
+	"\x12ReconcileFlashSale\x12\".product.ReconcileFlashSaleRequest\x1a#.product.ReconcileFlashSaleResponse\x12Y\n" +
+	"\x16SetProductAvailability\x12&.product.SetProductAvailabilityRequest\x1a\x17.product.CommonResponse\x12K\n" +
+	"\x0fSetDailyRestock\x12\x1f.product.SetDailyRestockRequest\x1a\x17.product.CommonResponse\x12]\n" +
+	"\x12BulkUpsertProducts\x12\".product.BulkUpsertProductsRequest\x1a#.product.BulkUpsertProductsResponse\x12[\n" +
+	"\x11ImportProductsCSV\x12!.product.ImportProductsCSVRequest\x1a#.product.BulkUpsertProductsResponse\x12S\n" +
+	"\x0eExportProducts\x12\x1e.product.ExportProductsRequest\x1a\x1f.product.ExportProductsResponse0\x012\xb7\x03\n" +
+	"\vCartService\x12C\n" +
+	"\vAddCartItem\x12\x1b.product.AddCartItemRequest\x1a\x17.product.CommonResponse\x12I\n" +
+	"\x0eUpdateCartItem\x12\x1e.product.UpdateCartItemRequest\x1a\x17.product.CommonResponse\x12I\n" +
+	"\x0eRemoveCartItem\x12\x1e.product.RemoveCartItemRequest\x1a\x17.product.CommonResponse\x12?\n" +
+	"\bListCart\x12\x18.product.ListCartRequest\x1a\x19.product.ListCartResponse\x12?\n" +
+	"\tClearCart\x12\x19.product.ClearCartRequest\x1a\x17.product.CommonResponse\x12K\n" +
+	"\fCheckoutCart\x12\x1c.product.CheckoutCartRequest\x1a\x1d.product.CheckoutCartResponseB'Z%./internal/product/proto;productProtob\x06proto3"
 
 var (
 	file_product_proto_rawDescOnce sync.Once
@@ -957,43 +4219,136 @@ func file_product_proto_rawDescGZIP() []byte {
 	return file_product_proto_rawDescData
 }
 
-var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_product_proto_goTypes = []any{
-	(*Product)(nil),               // 0: product.Product
-	(*CommonResponse)(nil),        // 1: product.CommonResponse
-	(*CreateProductRequest)(nil),  // 2: product.CreateProductRequest
-	(*CreateProductResponse)(nil), // 3: product.CreateProductResponse
-	(*UpdateProductRequest)(nil),  // 4: product.UpdateProductRequest
-	(*DeleteProductRequest)(nil),  // 5: product.DeleteProductRequest
-	(*ListProductsRequest)(nil),   // 6: product.ListProductsRequest
-	(*ListProductsResponse)(nil),  // 7: product.ListProductsResponse
-	(*GetProductRequest)(nil),     // 8: product.GetProductRequest
-	(*GetProductResponse)(nil),    // 9: product.GetProductResponse
-	(*DeductStockRequest)(nil),    // 10: product.DeductStockRequest
-	(*RestoreStockRequest)(nil),   // 11: product.RestoreStockRequest
+	(*Product)(nil),                       // 0: product.Product
+	(*Sku)(nil),                           // 1: product.Sku
+	(*OptionGroup)(nil),                   // 2: product.OptionGroup
+	(*Option)(nil),                        // 3: product.Option
+	(*CommonResponse)(nil),                // 4: product.CommonResponse
+	(*CreateProductRequest)(nil),          // 5: product.CreateProductRequest
+	(*CreateProductResponse)(nil),         // 6: product.CreateProductResponse
+	(*UpdateProductRequest)(nil),          // 7: product.UpdateProductRequest
+	(*DeleteProductRequest)(nil),          // 8: product.DeleteProductRequest
+	(*ListProductsRequest)(nil),           // 9: product.ListProductsRequest
+	(*ListProductsResponse)(nil),          // 10: product.ListProductsResponse
+	(*GetProductRequest)(nil),             // 11: product.GetProductRequest
+	(*GetProductResponse)(nil),            // 12: product.GetProductResponse
+	(*DeductStockRequest)(nil),            // 13: product.DeductStockRequest
+	(*RestoreStockRequest)(nil),           // 14: product.RestoreStockRequest
+	(*SetProductSpecsRequest)(nil),        // 15: product.SetProductSpecsRequest
+	(*Category)(nil),                      // 16: product.Category
+	(*CreateCategoryRequest)(nil),         // 17: product.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),        // 18: product.CreateCategoryResponse
+	(*UpdateCategoryRequest)(nil),         // 19: product.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),         // 20: product.DeleteCategoryRequest
+	(*ListCategoriesRequest)(nil),         // 21: product.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),        // 22: product.ListCategoriesResponse
+	(*SetProductCategoriesRequest)(nil),   // 23: product.SetProductCategoriesRequest
+	(*MenuCategory)(nil),                  // 24: product.MenuCategory
+	(*GetMerchantMenuRequest)(nil),        // 25: product.GetMerchantMenuRequest
+	(*GetMerchantMenuResponse)(nil),       // 26: product.GetMerchantMenuResponse
+	(*CartItem)(nil),                      // 27: product.CartItem
+	(*AddCartItemRequest)(nil),            // 28: product.AddCartItemRequest
+	(*UpdateCartItemRequest)(nil),         // 29: product.UpdateCartItemRequest
+	(*RemoveCartItemRequest)(nil),         // 30: product.RemoveCartItemRequest
+	(*ListCartRequest)(nil),               // 31: product.ListCartRequest
+	(*ListCartResponse)(nil),              // 32: product.ListCartResponse
+	(*ClearCartRequest)(nil),              // 33: product.ClearCartRequest
+	(*CheckoutCartRequest)(nil),           // 34: product.CheckoutCartRequest
+	(*CheckoutCartResponse)(nil),          // 35: product.CheckoutCartResponse
+	(*FlashSale)(nil),                     // 36: product.FlashSale
+	(*StartFlashSaleRequest)(nil),         // 37: product.StartFlashSaleRequest
+	(*StopFlashSaleRequest)(nil),          // 38: product.StopFlashSaleRequest
+	(*FlashSaleResponse)(nil),             // 39: product.FlashSaleResponse
+	(*ReconcileFlashSaleRequest)(nil),     // 40: product.ReconcileFlashSaleRequest
+	(*ReconcileFlashSaleResponse)(nil),    // 41: product.ReconcileFlashSaleResponse
+	(*SetProductAvailabilityRequest)(nil), // 42: product.SetProductAvailabilityRequest
+	(*SetDailyRestockRequest)(nil),        // 43: product.SetDailyRestockRequest
+	(*ProductRow)(nil),                    // 44: product.ProductRow
+	(*BulkUpsertProductsRequest)(nil),     // 45: product.BulkUpsertProductsRequest
+	(*ImportProductsCSVRequest)(nil),      // 46: product.ImportProductsCSVRequest
+	(*RowError)(nil),                      // 47: product.RowError
+	(*BulkUpsertProductsResponse)(nil),    // 48: product.BulkUpsertProductsResponse
+	(*ExportProductsRequest)(nil),         // 49: product.ExportProductsRequest
+	(*ExportProductsResponse)(nil),        // 50: product.ExportProductsResponse
 }
 var file_product_proto_depIdxs = []int32{
-	0,  // 0: product.ListProductsResponse.products:type_name -> product.Product
-	0,  // 1: product.GetProductResponse.product:type_name -> product.Product
-	2,  // 2: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
-	4,  // 3: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
-	5,  // 4: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
-	6,  // 5: product.ProductService.ListProductsByMerchantID:input_type -> product.ListProductsRequest
-	8,  // 6: product.ProductService.GetProductByID:input_type -> product.GetProductRequest
-	10, // 7: product.ProductService.DeductStock:input_type -> product.DeductStockRequest
-	11, // 8: product.ProductService.RestoreStock:input_type -> product.RestoreStockRequest
-	3,  // 9: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
-	1,  // 10: product.ProductService.UpdateProduct:output_type -> product.CommonResponse
-	1,  // 11: product.ProductService.DeleteProduct:output_type -> product.CommonResponse
-	7,  // 12: product.ProductService.ListProductsByMerchantID:output_type -> product.ListProductsResponse
-	9,  // 13: product.ProductService.GetProductByID:output_type -> product.GetProductResponse
-	1,  // 14: product.ProductService.DeductStock:output_type -> product.CommonResponse
-	1,  // 15: product.ProductService.RestoreStock:output_type -> product.CommonResponse
-	9,  // [9:16] is the sub-list for method output_type
-	2,  // [2:9] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	1,  // 0: product.Product.skus:type_name -> product.Sku
+	2,  // 1: product.Product.option_groups:type_name -> product.OptionGroup
+	3,  // 2: product.OptionGroup.options:type_name -> product.Option
+	0,  // 3: product.ListProductsResponse.products:type_name -> product.Product
+	0,  // 4: product.GetProductResponse.product:type_name -> product.Product
+	1,  // 5: product.SetProductSpecsRequest.skus:type_name -> product.Sku
+	2,  // 6: product.SetProductSpecsRequest.option_groups:type_name -> product.OptionGroup
+	16, // 7: product.ListCategoriesResponse.categories:type_name -> product.Category
+	0,  // 8: product.MenuCategory.products:type_name -> product.Product
+	24, // 9: product.GetMerchantMenuResponse.categories:type_name -> product.MenuCategory
+	27, // 10: product.ListCartResponse.items:type_name -> product.CartItem
+	36, // 11: product.FlashSaleResponse.flash_sale:type_name -> product.FlashSale
+	44, // 12: product.BulkUpsertProductsRequest.rows:type_name -> product.ProductRow
+	47, // 13: product.BulkUpsertProductsResponse.errors:type_name -> product.RowError
+	5,  // 14: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
+	7,  // 15: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
+	8,  // 16: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
+	9,  // 17: product.ProductService.ListProductsByMerchantID:input_type -> product.ListProductsRequest
+	11, // 18: product.ProductService.GetProductByID:input_type -> product.GetProductRequest
+	13, // 19: product.ProductService.DeductStock:input_type -> product.DeductStockRequest
+	14, // 20: product.ProductService.RestoreStock:input_type -> product.RestoreStockRequest
+	15, // 21: product.ProductService.SetProductSpecs:input_type -> product.SetProductSpecsRequest
+	17, // 22: product.ProductService.CreateCategory:input_type -> product.CreateCategoryRequest
+	19, // 23: product.ProductService.UpdateCategory:input_type -> product.UpdateCategoryRequest
+	20, // 24: product.ProductService.DeleteCategory:input_type -> product.DeleteCategoryRequest
+	21, // 25: product.ProductService.ListCategories:input_type -> product.ListCategoriesRequest
+	23, // 26: product.ProductService.SetProductCategories:input_type -> product.SetProductCategoriesRequest
+	25, // 27: product.ProductService.GetMerchantMenu:input_type -> product.GetMerchantMenuRequest
+	37, // 28: product.ProductService.StartFlashSale:input_type -> product.StartFlashSaleRequest
+	38, // 29: product.ProductService.StopFlashSale:input_type -> product.StopFlashSaleRequest
+	40, // 30: product.ProductService.ReconcileFlashSale:input_type -> product.ReconcileFlashSaleRequest
+	42, // 31: product.ProductService.SetProductAvailability:input_type -> product.SetProductAvailabilityRequest
+	43, // 32: product.ProductService.SetDailyRestock:input_type -> product.SetDailyRestockRequest
+	45, // 33: product.ProductService.BulkUpsertProducts:input_type -> product.BulkUpsertProductsRequest
+	46, // 34: product.ProductService.ImportProductsCSV:input_type -> product.ImportProductsCSVRequest
+	49, // 35: product.ProductService.ExportProducts:input_type -> product.ExportProductsRequest
+	28, // 36: product.CartService.AddCartItem:input_type -> product.AddCartItemRequest
+	29, // 37: product.CartService.UpdateCartItem:input_type -> product.UpdateCartItemRequest
+	30, // 38: product.CartService.RemoveCartItem:input_type -> product.RemoveCartItemRequest
+	31, // 39: product.CartService.ListCart:input_type -> product.ListCartRequest
+	33, // 40: product.CartService.ClearCart:input_type -> product.ClearCartRequest
+	34, // 41: product.CartService.CheckoutCart:input_type -> product.CheckoutCartRequest
+	6,  // 42: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
+	4,  // 43: product.ProductService.UpdateProduct:output_type -> product.CommonResponse
+	4,  // 44: product.ProductService.DeleteProduct:output_type -> product.CommonResponse
+	10, // 45: product.ProductService.ListProductsByMerchantID:output_type -> product.ListProductsResponse
+	12, // 46: product.ProductService.GetProductByID:output_type -> product.GetProductResponse
+	4,  // 47: product.ProductService.DeductStock:output_type -> product.CommonResponse
+	4,  // 48: product.ProductService.RestoreStock:output_type -> product.CommonResponse
+	4,  // 49: product.ProductService.SetProductSpecs:output_type -> product.CommonResponse
+	18, // 50: product.ProductService.CreateCategory:output_type -> product.CreateCategoryResponse
+	4,  // 51: product.ProductService.UpdateCategory:output_type -> product.CommonResponse
+	4,  // 52: product.ProductService.DeleteCategory:output_type -> product.CommonResponse
+	22, // 53: product.ProductService.ListCategories:output_type -> product.ListCategoriesResponse
+	4,  // 54: product.ProductService.SetProductCategories:output_type -> product.CommonResponse
+	26, // 55: product.ProductService.GetMerchantMenu:output_type -> product.GetMerchantMenuResponse
+	39, // 56: product.ProductService.StartFlashSale:output_type -> product.FlashSaleResponse
+	39, // 57: product.ProductService.StopFlashSale:output_type -> product.FlashSaleResponse
+	41, // 58: product.ProductService.ReconcileFlashSale:output_type -> product.ReconcileFlashSaleResponse
+	4,  // 59: product.ProductService.SetProductAvailability:output_type -> product.CommonResponse
+	4,  // 60: product.ProductService.SetDailyRestock:output_type -> product.CommonResponse
+	48, // 61: product.ProductService.BulkUpsertProducts:output_type -> product.BulkUpsertProductsResponse
+	48, // 62: product.ProductService.ImportProductsCSV:output_type -> product.BulkUpsertProductsResponse
+	50, // 63: product.ProductService.ExportProducts:output_type -> product.ExportProductsResponse
+	4,  // 64: product.CartService.AddCartItem:output_type -> product.CommonResponse
+	4,  // 65: product.CartService.UpdateCartItem:output_type -> product.CommonResponse
+	4,  // 66: product.CartService.RemoveCartItem:output_type -> product.CommonResponse
+	32, // 67: product.CartService.ListCart:output_type -> product.ListCartResponse
+	4,  // 68: product.CartService.ClearCart:output_type -> product.CommonResponse
+	35, // 69: product.CartService.CheckoutCart:output_type -> product.CheckoutCartResponse
+	42, // [42:70] is the sub-list for method output_type
+	14, // [14:42] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_product_proto_init() }
@@ -1007,9 +4362,9 @@ func file_product_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_proto_rawDesc), len(file_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   51,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_product_proto_goTypes,
 		DependencyIndexes: file_product_proto_depIdxs,
@@ -26,6 +26,21 @@ const (
 	ProductService_GetProductByID_FullMethodName           = "/product.ProductService/GetProductByID"
 	ProductService_DeductStock_FullMethodName              = "/product.ProductService/DeductStock"
 	ProductService_RestoreStock_FullMethodName             = "/product.ProductService/RestoreStock"
+	ProductService_SetProductSpecs_FullMethodName          = "/product.ProductService/SetProductSpecs"
+	ProductService_CreateCategory_FullMethodName           = "/product.ProductService/CreateCategory"
+	ProductService_UpdateCategory_FullMethodName           = "/product.ProductService/UpdateCategory"
+	ProductService_DeleteCategory_FullMethodName           = "/product.ProductService/DeleteCategory"
+	ProductService_ListCategories_FullMethodName           = "/product.ProductService/ListCategories"
+	ProductService_SetProductCategories_FullMethodName     = "/product.ProductService/SetProductCategories"
+	ProductService_GetMerchantMenu_FullMethodName          = "/product.ProductService/GetMerchantMenu"
+	ProductService_StartFlashSale_FullMethodName           = "/product.ProductService/StartFlashSale"
+	ProductService_StopFlashSale_FullMethodName            = "/product.ProductService/StopFlashSale"
+	ProductService_ReconcileFlashSale_FullMethodName       = "/product.ProductService/ReconcileFlashSale"
+	ProductService_SetProductAvailability_FullMethodName   = "/product.ProductService/SetProductAvailability"
+	ProductService_SetDailyRestock_FullMethodName          = "/product.ProductService/SetDailyRestock"
+	ProductService_BulkUpsertProducts_FullMethodName       = "/product.ProductService/BulkUpsertProducts"
+	ProductService_ImportProductsCSV_FullMethodName        = "/product.ProductService/ImportProductsCSV"
+	ProductService_ExportProducts_FullMethodName           = "/product.ProductService/ExportProducts"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -46,6 +61,36 @@ type ProductServiceClient interface {
 	DeductStock(ctx context.Context, in *DeductStockRequest, opts ...grpc.CallOption) (*CommonResponse, error)
 	// 恢复库存（订单取消时调用）
 	RestoreStock(ctx context.Context, in *RestoreStockRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// 商家设置商品规格及属性（整体覆盖）
+	SetProductSpecs(ctx context.Context, in *SetProductSpecsRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// 商家创建菜单分类
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
+	// 商家更新菜单分类（名称、排序）
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// 商家删除菜单分类（分类下商品保留）
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// 查询商家菜单分类
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	// 商家设置商品所属分类（覆盖原有分类）
+	SetProductCategories(ctx context.Context, in *SetProductCategoriesRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// 查询商家菜单（按分类分组）
+	GetMerchantMenu(ctx context.Context, in *GetMerchantMenuRequest, opts ...grpc.CallOption) (*GetMerchantMenuResponse, error)
+	// 商家开启秒杀（库存预热到Redis，秒杀期间库存异步落库）
+	StartFlashSale(ctx context.Context, in *StartFlashSaleRequest, opts ...grpc.CallOption) (*FlashSaleResponse, error)
+	// 商家结束秒杀（先停止扣减进入结算，在途库存流水落库后结束）
+	StopFlashSale(ctx context.Context, in *StopFlashSaleRequest, opts ...grpc.CallOption) (*FlashSaleResponse, error)
+	// 商家查询秒杀库存对账结果（Redis与数据库）
+	ReconcileFlashSale(ctx context.Context, in *ReconcileFlashSaleRequest, opts ...grpc.CallOption) (*ReconcileFlashSaleResponse, error)
+	// 商家设置商品暂停售卖及可售时段
+	SetProductAvailability(ctx context.Context, in *SetProductAvailabilityRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// 商家设置每日补货（每天定时将库存重置为指定数量）
+	SetDailyRestock(ctx context.Context, in *SetDailyRestockRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// 商家批量导入商品（按外部编码匹配，已存在则更新，否则创建）
+	BulkUpsertProducts(ctx context.Context, in *BulkUpsertProductsRequest, opts ...grpc.CallOption) (*BulkUpsertProductsResponse, error)
+	// 商家通过CSV导入商品（列同导出文件）
+	ImportProductsCSV(ctx context.Context, in *ImportProductsCSVRequest, opts ...grpc.CallOption) (*BulkUpsertProductsResponse, error)
+	// 商家导出商品（服务端流式返回文件内容）
+	ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductsResponse], error)
 }
 
 type productServiceClient struct {
@@ -126,6 +171,165 @@ func (c *productServiceClient) RestoreStock(ctx context.Context, in *RestoreStoc
 	return out, nil
 }
 
+func (c *productServiceClient) SetProductSpecs(ctx context.Context, in *SetProductSpecsRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetProductSpecs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCategoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProductCategories(ctx context.Context, in *SetProductCategoriesRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetProductCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetMerchantMenu(ctx context.Context, in *GetMerchantMenuRequest, opts ...grpc.CallOption) (*GetMerchantMenuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMerchantMenuResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetMerchantMenu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) StartFlashSale(ctx context.Context, in *StartFlashSaleRequest, opts ...grpc.CallOption) (*FlashSaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlashSaleResponse)
+	err := c.cc.Invoke(ctx, ProductService_StartFlashSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) StopFlashSale(ctx context.Context, in *StopFlashSaleRequest, opts ...grpc.CallOption) (*FlashSaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlashSaleResponse)
+	err := c.cc.Invoke(ctx, ProductService_StopFlashSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReconcileFlashSale(ctx context.Context, in *ReconcileFlashSaleRequest, opts ...grpc.CallOption) (*ReconcileFlashSaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileFlashSaleResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReconcileFlashSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProductAvailability(ctx context.Context, in *SetProductAvailabilityRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetProductAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetDailyRestock(ctx context.Context, in *SetDailyRestockRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetDailyRestock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) BulkUpsertProducts(ctx context.Context, in *BulkUpsertProductsRequest, opts ...grpc.CallOption) (*BulkUpsertProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpsertProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_BulkUpsertProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ImportProductsCSV(ctx context.Context, in *ImportProductsCSVRequest, opts ...grpc.CallOption) (*BulkUpsertProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpsertProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ImportProductsCSV_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[0], ProductService_ExportProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportProductsRequest, ExportProductsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ExportProductsClient = grpc.ServerStreamingClient[ExportProductsResponse]
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -144,6 +348,36 @@ type ProductServiceServer interface {
 	DeductStock(context.Context, *DeductStockRequest) (*CommonResponse, error)
 	// 恢复库存（订单取消时调用）
 	RestoreStock(context.Context, *RestoreStockRequest) (*CommonResponse, error)
+	// 商家设置商品规格及属性（整体覆盖）
+	SetProductSpecs(context.Context, *SetProductSpecsRequest) (*CommonResponse, error)
+	// 商家创建菜单分类
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
+	// 商家更新菜单分类（名称、排序）
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*CommonResponse, error)
+	// 商家删除菜单分类（分类下商品保留）
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*CommonResponse, error)
+	// 查询商家菜单分类
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	// 商家设置商品所属分类（覆盖原有分类）
+	SetProductCategories(context.Context, *SetProductCategoriesRequest) (*CommonResponse, error)
+	// 查询商家菜单（按分类分组）
+	GetMerchantMenu(context.Context, *GetMerchantMenuRequest) (*GetMerchantMenuResponse, error)
+	// 商家开启秒杀（库存预热到Redis，秒杀期间库存异步落库）
+	StartFlashSale(context.Context, *StartFlashSaleRequest) (*FlashSaleResponse, error)
+	// 商家结束秒杀（先停止扣减进入结算，在途库存流水落库后结束）
+	StopFlashSale(context.Context, *StopFlashSaleRequest) (*FlashSaleResponse, error)
+	// 商家查询秒杀库存对账结果（Redis与数据库）
+	ReconcileFlashSale(context.Context, *ReconcileFlashSaleRequest) (*ReconcileFlashSaleResponse, error)
+	// 商家设置商品暂停售卖及可售时段
+	SetProductAvailability(context.Context, *SetProductAvailabilityRequest) (*CommonResponse, error)
+	// 商家设置每日补货（每天定时将库存重置为指定数量）
+	SetDailyRestock(context.Context, *SetDailyRestockRequest) (*CommonResponse, error)
+	// 商家批量导入商品（按外部编码匹配，已存在则更新，否则创建）
+	BulkUpsertProducts(context.Context, *BulkUpsertProductsRequest) (*BulkUpsertProductsResponse, error)
+	// 商家通过CSV导入商品（列同导出文件）
+	ImportProductsCSV(context.Context, *ImportProductsCSVRequest) (*BulkUpsertProductsResponse, error)
+	// 商家导出商品（服务端流式返回文件内容）
+	ExportProducts(*ExportProductsRequest, grpc.ServerStreamingServer[ExportProductsResponse]) error
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -175,6 +409,51 @@ func (UnimplementedProductServiceServer) DeductStock(context.Context, *DeductSto
 func (UnimplementedProductServiceServer) RestoreStock(context.Context, *RestoreStockRequest) (*CommonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestoreStock not implemented")
 }
+func (UnimplementedProductServiceServer) SetProductSpecs(context.Context, *SetProductSpecsRequest) (*CommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductSpecs not implemented")
+}
+func (UnimplementedProductServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*CommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*CommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedProductServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedProductServiceServer) SetProductCategories(context.Context, *SetProductCategoriesRequest) (*CommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductCategories not implemented")
+}
+func (UnimplementedProductServiceServer) GetMerchantMenu(context.Context, *GetMerchantMenuRequest) (*GetMerchantMenuResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantMenu not implemented")
+}
+func (UnimplementedProductServiceServer) StartFlashSale(context.Context, *StartFlashSaleRequest) (*FlashSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartFlashSale not implemented")
+}
+func (UnimplementedProductServiceServer) StopFlashSale(context.Context, *StopFlashSaleRequest) (*FlashSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopFlashSale not implemented")
+}
+func (UnimplementedProductServiceServer) ReconcileFlashSale(context.Context, *ReconcileFlashSaleRequest) (*ReconcileFlashSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileFlashSale not implemented")
+}
+func (UnimplementedProductServiceServer) SetProductAvailability(context.Context, *SetProductAvailabilityRequest) (*CommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductAvailability not implemented")
+}
+func (UnimplementedProductServiceServer) SetDailyRestock(context.Context, *SetDailyRestockRequest) (*CommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDailyRestock not implemented")
+}
+func (UnimplementedProductServiceServer) BulkUpsertProducts(context.Context, *BulkUpsertProductsRequest) (*BulkUpsertProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpsertProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ImportProductsCSV(context.Context, *ImportProductsCSVRequest) (*BulkUpsertProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportProductsCSV not implemented")
+}
+func (UnimplementedProductServiceServer) ExportProducts(*ExportProductsRequest, grpc.ServerStreamingServer[ExportProductsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportProducts not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -322,40 +601,673 @@ func _ProductService_RestoreStock_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-// ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ProductService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "product.ProductService",
-	HandlerType: (*ProductServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateProduct",
-			Handler:    _ProductService_CreateProduct_Handler,
-		},
-		{
-			MethodName: "UpdateProduct",
-			Handler:    _ProductService_UpdateProduct_Handler,
-		},
-		{
-			MethodName: "DeleteProduct",
-			Handler:    _ProductService_DeleteProduct_Handler,
-		},
-		{
-			MethodName: "ListProductsByMerchantID",
-			Handler:    _ProductService_ListProductsByMerchantID_Handler,
-		},
-		{
-			MethodName: "GetProductByID",
-			Handler:    _ProductService_GetProductByID_Handler,
-		},
-		{
-			MethodName: "DeductStock",
-			Handler:    _ProductService_DeductStock_Handler,
-		},
-		{
-			MethodName: "RestoreStock",
-			Handler:    _ProductService_RestoreStock_Handler,
+func _ProductService_SetProductSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductSpecsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductSpecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetProductSpecs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductSpecs(ctx, req.(*SetProductSpecsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetProductCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetProductCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductCategories(ctx, req.(*SetProductCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetMerchantMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMerchantMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetMerchantMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetMerchantMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetMerchantMenu(ctx, req.(*GetMerchantMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_StartFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartFlashSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).StartFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_StartFlashSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).StartFlashSale(ctx, req.(*StartFlashSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_StopFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopFlashSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).StopFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_StopFlashSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).StopFlashSale(ctx, req.(*StopFlashSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReconcileFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileFlashSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReconcileFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReconcileFlashSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReconcileFlashSale(ctx, req.(*ReconcileFlashSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetProductAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetProductAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductAvailability(ctx, req.(*SetProductAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetDailyRestock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDailyRestockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetDailyRestock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetDailyRestock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetDailyRestock(ctx, req.(*SetDailyRestockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_BulkUpsertProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpsertProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).BulkUpsertProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_BulkUpsertProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).BulkUpsertProducts(ctx, req.(*BulkUpsertProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ImportProductsCSV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportProductsCSVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ImportProductsCSV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ImportProductsCSV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ImportProductsCSV(ctx, req.(*ImportProductsCSVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ExportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServiceServer).ExportProducts(m, &grpc.GenericServerStream[ExportProductsRequest, ExportProductsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ExportProductsServer = grpc.ServerStreamingServer[ExportProductsResponse]
+
+// ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProductService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product.ProductService",
+	HandlerType: (*ProductServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProduct",
+			Handler:    _ProductService_CreateProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _ProductService_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _ProductService_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "ListProductsByMerchantID",
+			Handler:    _ProductService_ListProductsByMerchantID_Handler,
+		},
+		{
+			MethodName: "GetProductByID",
+			Handler:    _ProductService_GetProductByID_Handler,
+		},
+		{
+			MethodName: "DeductStock",
+			Handler:    _ProductService_DeductStock_Handler,
+		},
+		{
+			MethodName: "RestoreStock",
+			Handler:    _ProductService_RestoreStock_Handler,
+		},
+		{
+			MethodName: "SetProductSpecs",
+			Handler:    _ProductService_SetProductSpecs_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _ProductService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _ProductService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _ProductService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _ProductService_ListCategories_Handler,
+		},
+		{
+			MethodName: "SetProductCategories",
+			Handler:    _ProductService_SetProductCategories_Handler,
+		},
+		{
+			MethodName: "GetMerchantMenu",
+			Handler:    _ProductService_GetMerchantMenu_Handler,
+		},
+		{
+			MethodName: "StartFlashSale",
+			Handler:    _ProductService_StartFlashSale_Handler,
+		},
+		{
+			MethodName: "StopFlashSale",
+			Handler:    _ProductService_StopFlashSale_Handler,
+		},
+		{
+			MethodName: "ReconcileFlashSale",
+			Handler:    _ProductService_ReconcileFlashSale_Handler,
+		},
+		{
+			MethodName: "SetProductAvailability",
+			Handler:    _ProductService_SetProductAvailability_Handler,
+		},
+		{
+			MethodName: "SetDailyRestock",
+			Handler:    _ProductService_SetDailyRestock_Handler,
+		},
+		{
+			MethodName: "BulkUpsertProducts",
+			Handler:    _ProductService_BulkUpsertProducts_Handler,
+		},
+		{
+			MethodName: "ImportProductsCSV",
+			Handler:    _ProductService_ImportProductsCSV_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportProducts",
+			Handler:       _ProductService_ExportProducts_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "product.proto",
+}
+
+const (
+	CartService_AddCartItem_FullMethodName    = "/product.CartService/AddCartItem"
+	CartService_UpdateCartItem_FullMethodName = "/product.CartService/UpdateCartItem"
+	CartService_RemoveCartItem_FullMethodName = "/product.CartService/RemoveCartItem"
+	CartService_ListCart_FullMethodName       = "/product.CartService/ListCart"
+	CartService_ClearCart_FullMethodName      = "/product.CartService/ClearCart"
+	CartService_CheckoutCart_FullMethodName   = "/product.CartService/CheckoutCart"
+)
+
+// CartServiceClient is the client API for CartService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 购物车服务（按用户+商家维度存储于Redis）
+type CartServiceClient interface {
+	// 加入购物车（已存在则累加数量）
+	AddCartItem(ctx context.Context, in *AddCartItemRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// 修改购物车商品数量（数量为0时移除）
+	UpdateCartItem(ctx context.Context, in *UpdateCartItemRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// 移除购物车商品
+	RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// 查询购物车（按当前价格和库存校验）
+	ListCart(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*ListCartResponse, error)
+	// 清空购物车
+	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// 购物车结算下单
+	CheckoutCart(ctx context.Context, in *CheckoutCartRequest, opts ...grpc.CallOption) (*CheckoutCartResponse, error)
+}
+
+type cartServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCartServiceClient(cc grpc.ClientConnInterface) CartServiceClient {
+	return &cartServiceClient{cc}
+}
+
+func (c *cartServiceClient) AddCartItem(ctx context.Context, in *AddCartItemRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, CartService_AddCartItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) UpdateCartItem(ctx context.Context, in *UpdateCartItemRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, CartService_UpdateCartItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, CartService_RemoveCartItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ListCart(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*ListCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCartResponse)
+	err := c.cc.Invoke(ctx, CartService_ListCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, CartService_ClearCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) CheckoutCart(ctx context.Context, in *CheckoutCartRequest, opts ...grpc.CallOption) (*CheckoutCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckoutCartResponse)
+	err := c.cc.Invoke(ctx, CartService_CheckoutCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CartServiceServer is the server API for CartService service.
+// All implementations must embed UnimplementedCartServiceServer
+// for forward compatibility.
+//
+// 购物车服务（按用户+商家维度存储于Redis）
+type CartServiceServer interface {
+	// 加入购物车（已存在则累加数量）
+	AddCartItem(context.Context, *AddCartItemRequest) (*CommonResponse, error)
+	// 修改购物车商品数量（数量为0时移除）
+	UpdateCartItem(context.Context, *UpdateCartItemRequest) (*CommonResponse, error)
+	// 移除购物车商品
+	RemoveCartItem(context.Context, *RemoveCartItemRequest) (*CommonResponse, error)
+	// 查询购物车（按当前价格和库存校验）
+	ListCart(context.Context, *ListCartRequest) (*ListCartResponse, error)
+	// 清空购物车
+	ClearCart(context.Context, *ClearCartRequest) (*CommonResponse, error)
+	// 购物车结算下单
+	CheckoutCart(context.Context, *CheckoutCartRequest) (*CheckoutCartResponse, error)
+	mustEmbedUnimplementedCartServiceServer()
+}
+
+// UnimplementedCartServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCartServiceServer struct{}
+
+func (UnimplementedCartServiceServer) AddCartItem(context.Context, *AddCartItemRequest) (*CommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCartItem not implemented")
+}
+func (UnimplementedCartServiceServer) UpdateCartItem(context.Context, *UpdateCartItemRequest) (*CommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCartItem not implemented")
+}
+func (UnimplementedCartServiceServer) RemoveCartItem(context.Context, *RemoveCartItemRequest) (*CommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCartItem not implemented")
+}
+func (UnimplementedCartServiceServer) ListCart(context.Context, *ListCartRequest) (*ListCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCart not implemented")
+}
+func (UnimplementedCartServiceServer) ClearCart(context.Context, *ClearCartRequest) (*CommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
+}
+func (UnimplementedCartServiceServer) CheckoutCart(context.Context, *CheckoutCartRequest) (*CheckoutCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckoutCart not implemented")
+}
+func (UnimplementedCartServiceServer) mustEmbedUnimplementedCartServiceServer() {}
+func (UnimplementedCartServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeCartServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CartServiceServer will
+// result in compilation errors.
+type UnsafeCartServiceServer interface {
+	mustEmbedUnimplementedCartServiceServer()
+}
+
+func RegisterCartServiceServer(s grpc.ServiceRegistrar, srv CartServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCartServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CartService_ServiceDesc, srv)
+}
+
+func _CartService_AddCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).AddCartItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_AddCartItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).AddCartItem(ctx, req.(*AddCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_UpdateCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).UpdateCartItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_UpdateCartItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).UpdateCartItem(ctx, req.(*UpdateCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_RemoveCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).RemoveCartItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_RemoveCartItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).RemoveCartItem(ctx, req.(*RemoveCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ListCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ListCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_ListCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ListCart(ctx, req.(*ListCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ClearCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ClearCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_ClearCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ClearCart(ctx, req.(*ClearCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_CheckoutCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).CheckoutCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_CheckoutCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).CheckoutCart(ctx, req.(*CheckoutCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CartService_ServiceDesc is the grpc.ServiceDesc for CartService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CartService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product.CartService",
+	HandlerType: (*CartServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddCartItem",
+			Handler:    _CartService_AddCartItem_Handler,
+		},
+		{
+			MethodName: "UpdateCartItem",
+			Handler:    _CartService_UpdateCartItem_Handler,
+		},
+		{
+			MethodName: "RemoveCartItem",
+			Handler:    _CartService_RemoveCartItem_Handler,
+		},
+		{
+			MethodName: "ListCart",
+			Handler:    _CartService_ListCart_Handler,
+		},
+		{
+			MethodName: "ClearCart",
+			Handler:    _CartService_ClearCart_Handler,
+		},
+		{
+			MethodName: "CheckoutCart",
+			Handler:    _CartService_CheckoutCart_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
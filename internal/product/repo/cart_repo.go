@@ -0,0 +1,100 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/redis"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// cartExpiration 购物车过期时间（每次写入刷新）
+const cartExpiration = 7 * 24 * time.Hour
+
+// CartRepo 购物车数据访问接口（Redis Hash：商品ID -> 数量）
+type CartRepo interface {
+	GetItem(ctx context.Context, userID, merchantID, productID int64) (int32, error)
+	SetItem(ctx context.Context, userID, merchantID, productID int64, quantity int32) error
+	RemoveItem(ctx context.Context, userID, merchantID, productID int64) error
+	GetItems(ctx context.Context, userID, merchantID int64) (map[int64]int32, error)
+	Clear(ctx context.Context, userID, merchantID int64) error
+}
+
+type cartRepo struct{}
+
+// NewCartRepo 创建实例
+func NewCartRepo() CartRepo {
+	return &cartRepo{}
+}
+
+// cartKey 购物车缓存key：cart:{用户ID}:{商家ID}
+func cartKey(userID, merchantID int64) string {
+	return fmt.Sprintf("cart:%d:%d", userID, merchantID)
+}
+
+// GetItem 查询购物车中某商品数量，不存在返回0
+func (c *cartRepo) GetItem(ctx context.Context, userID, merchantID, productID int64) (int32, error) {
+	val, err := redis.RedisClient.HGet(ctx, cartKey(userID, merchantID), strconv.FormatInt(productID, 10)).Int()
+	if err != nil {
+		if redis.IsNil(err) {
+			return 0, nil
+		}
+		zap.L().Error("查询购物车商品失败", zap.Int64("user_id", userID), zap.Int64("product_id", productID), zap.Error(err))
+		return 0, utils.NewDBError("查询购物车商品失败：" + err.Error())
+	}
+	return int32(val), nil
+}
+
+// SetItem 设置购物车商品数量并刷新过期时间
+func (c *cartRepo) SetItem(ctx context.Context, userID, merchantID, productID int64, quantity int32) error {
+	key := cartKey(userID, merchantID)
+	pipe := redis.RedisClient.TxPipeline()
+	pipe.HSet(ctx, key, strconv.FormatInt(productID, 10), quantity)
+	pipe.Expire(ctx, key, cartExpiration)
+	if _, err := pipe.Exec(ctx); err != nil {
+		zap.L().Error("写入购物车失败", zap.Int64("user_id", userID), zap.Int64("product_id", productID), zap.Error(err))
+		return utils.NewDBError("写入购物车失败：" + err.Error())
+	}
+	return nil
+}
+
+// RemoveItem 移除购物车商品
+func (c *cartRepo) RemoveItem(ctx context.Context, userID, merchantID, productID int64) error {
+	if err := redis.RedisClient.HDel(ctx, cartKey(userID, merchantID), strconv.FormatInt(productID, 10)).Err(); err != nil {
+		zap.L().Error("移除购物车商品失败", zap.Int64("user_id", userID), zap.Int64("product_id", productID), zap.Error(err))
+		return utils.NewDBError("移除购物车商品失败：" + err.Error())
+	}
+	return nil
+}
+
+// GetItems 查询购物车全部商品
+func (c *cartRepo) GetItems(ctx context.Context, userID, merchantID int64) (map[int64]int32, error) {
+	vals, err := redis.RedisClient.HGetAll(ctx, cartKey(userID, merchantID)).Result()
+	if err != nil {
+		zap.L().Error("查询购物车失败", zap.Int64("user_id", userID), zap.Int64("merchant_id", merchantID), zap.Error(err))
+		return nil, utils.NewDBError("查询购物车失败：" + err.Error())
+	}
+	items := make(map[int64]int32, len(vals))
+	for field, val := range vals {
+		productID, err1 := strconv.ParseInt(field, 10, 64)
+		quantity, err2 := strconv.ParseInt(val, 10, 32)
+		if err1 != nil || err2 != nil {
+			zap.L().Warn("购物车数据格式错误，已忽略", zap.String("field", field), zap.String("value", val))
+			continue
+		}
+		items[productID] = int32(quantity)
+	}
+	return items, nil
+}
+
+// Clear 清空购物车
+func (c *cartRepo) Clear(ctx context.Context, userID, merchantID int64) error {
+	if err := redis.RedisClient.Del(ctx, cartKey(userID, merchantID)).Err(); err != nil {
+		zap.L().Error("清空购物车失败", zap.Int64("user_id", userID), zap.Int64("merchant_id", merchantID), zap.Error(err))
+		return utils.NewDBError("清空购物车失败：" + err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// msgCategoryNameConflict 同一商家分类名称重复（唯一索引冲突）
+const msgCategoryNameConflict = "分类名称已存在"
+
+// CategoryRepo 菜单分类数据访问接口
+type CategoryRepo interface {
+	CreateCategory(ctx context.Context, category *model.Category) error
+	UpdateCategory(ctx context.Context, category *model.Category) error
+	DeleteCategory(ctx context.Context, categoryID, merchantID int64) error // 删除分类及其商品关联（商品本身保留）
+	ListCategories(ctx context.Context, merchantID int64) ([]*model.Category, error)
+	SetProductCategories(ctx context.Context, productID int64, categoryIDs []int64, sortOrder int32) error // 覆盖商品所属分类
+	ListMenuProducts(ctx context.Context, merchantID int64) ([]*model.Product, []*model.ProductCategory, error)
+}
+
+// categoryRepo 实现
+type categoryRepo struct{}
+
+// NewCategoryRepo 创建实例
+func NewCategoryRepo() CategoryRepo {
+	return &categoryRepo{}
+}
+
+func (c *categoryRepo) CreateCategory(ctx context.Context, category *model.Category) error {
+	tx := db.Mysql.WithContext(ctx).Create(category)
+	if errors.Is(tx.Error, gorm.ErrDuplicatedKey) {
+		return utils.NewBizError(msgCategoryNameConflict)
+	}
+	if tx.Error != nil {
+		zap.L().Error("创建分类失败", zap.Any("category", category), zap.Error(tx.Error))
+		return utils.NewDBError("创建分类失败：" + tx.Error.Error())
+	}
+	return nil
+}
+
+func (c *categoryRepo) UpdateCategory(ctx context.Context, category *model.Category) error {
+	tx := db.Mysql.WithContext(ctx).Model(&model.Category{}).
+		Where("category_id = ? AND merchant_id = ?", category.CategoryID, category.MerchantID).
+		Updates(map[string]interface{}{
+			"name":       category.Name,
+			"sort_order": category.SortOrder,
+		})
+	if errors.Is(tx.Error, gorm.ErrDuplicatedKey) {
+		return utils.NewBizError(msgCategoryNameConflict)
+	}
+	if tx.Error != nil {
+		zap.L().Error("更新分类失败", zap.Any("category", category), zap.Error(tx.Error))
+		return utils.NewDBError("更新分类失败：" + tx.Error.Error())
+	}
+	if tx.RowsAffected == 0 {
+		return utils.NewBizError("分类不存在或无权限更新")
+	}
+	return nil
+}
+
+func (c *categoryRepo) DeleteCategory(ctx context.Context, categoryID, merchantID int64) error {
+	return db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("category_id = ? AND merchant_id = ?", categoryID, merchantID).Delete(&model.Category{})
+		if result.Error != nil {
+			zap.L().Error("删除分类失败", zap.Int64("category_id", categoryID), zap.Int64("merchant_id", merchantID), zap.Error(result.Error))
+			return utils.NewDBError("删除分类失败：" + result.Error.Error())
+		}
+		if result.RowsAffected == 0 {
+			return utils.NewBizError("分类不存在或无权限删除")
+		}
+		if err := tx.Where("category_id = ?", categoryID).Delete(&model.ProductCategory{}).Error; err != nil {
+			zap.L().Error("删除分类商品关联失败", zap.Int64("category_id", categoryID), zap.Error(err))
+			return utils.NewDBError("删除分类失败：" + err.Error())
+		}
+		return nil
+	})
+}
+
+// ListCategories 查询商家分类（按排序、分类ID升序）
+func (c *categoryRepo) ListCategories(ctx context.Context, merchantID int64) ([]*model.Category, error) {
+	var categories []*model.Category
+	err := db.Mysql.WithContext(ctx).Where("merchant_id = ?", merchantID).
+		Order("sort_order, category_id").Find(&categories).Error
+	if err != nil {
+		zap.L().Error("查询分类失败", zap.Int64("merchant_id", merchantID), zap.Error(err))
+		return nil, utils.NewDBError("查询分类失败：" + err.Error())
+	}
+	return categories, nil
+}
+
+// SetProductCategories 事务覆盖商品的分类关联（categoryIDs为空表示移出所有分类）
+func (c *categoryRepo) SetProductCategories(ctx context.Context, productID int64, categoryIDs []int64, sortOrder int32) error {
+	err := db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("product_id = ?", productID).Delete(&model.ProductCategory{}).Error; err != nil {
+			return err
+		}
+		if len(categoryIDs) == 0 {
+			return nil
+		}
+		relations := make([]*model.ProductCategory, 0, len(categoryIDs))
+		for _, categoryID := range categoryIDs {
+			relations = append(relations, &model.ProductCategory{CategoryID: categoryID, ProductID: productID, SortOrder: sortOrder})
+		}
+		return tx.Create(&relations).Error
+	})
+	if err != nil {
+		zap.L().Error("设置商品分类失败", zap.Int64("product_id", productID), zap.Int64s("category_ids", categoryIDs), zap.Error(err))
+		return utils.NewDBError("设置商品分类失败：" + err.Error())
+	}
+	return nil
+}
+
+// ListMenuProducts 查询商家全部商品及其分类关联（用于组装菜单）
+func (c *categoryRepo) ListMenuProducts(ctx context.Context, merchantID int64) ([]*model.Product, []*model.ProductCategory, error) {
+	var products []*model.Product
+	if err := db.Mysql.WithContext(ctx).Where("merchant_id = ?", merchantID).Order("product_id").Find(&products).Error; err != nil {
+		zap.L().Error("查询菜单商品失败", zap.Int64("merchant_id", merchantID), zap.Error(err))
+		return nil, nil, utils.NewDBError("查询菜单失败：" + err.Error())
+	}
+	if len(products) == 0 {
+		return products, nil, nil
+	}
+
+	var relations []*model.ProductCategory
+	err := db.Mysql.WithContext(ctx).Table("t_product_category AS pc").
+		Select("pc.category_id, pc.product_id, pc.sort_order").
+		Joins("JOIN t_category AS c ON c.category_id = pc.category_id").
+		Where("c.merchant_id = ? AND c.deleted_at IS NULL", merchantID).
+		Order("pc.sort_order, pc.product_id").
+		Scan(&relations).Error
+	if err != nil {
+		zap.L().Error("查询商品分类关联失败", zap.Int64("merchant_id", merchantID), zap.Error(err))
+		return nil, nil, utils.NewDBError("查询菜单失败：" + err.Error())
+	}
+	return products, relations, nil
+}
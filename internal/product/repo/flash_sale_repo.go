@@ -0,0 +1,194 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FlashSaleRepo 商品秒杀数据访问接口（MySQL）
+type FlashSaleRepo interface {
+	StartFlashSale(ctx context.Context, sale *model.FlashSale, preload func(*model.FlashSale) error) error // 锁定商品，以当前库存为初始库存创建秒杀，提交前预热Redis
+	GetActiveFlashSale(ctx context.Context, productID int64) (*model.FlashSale, error)                     // 查询商品进行中或结算中的秒杀（无则返回nil）
+	ListActiveFlashSales(ctx context.Context) ([]*model.FlashSale, error)                                  // 查询全部进行中或结算中的秒杀（对账用）
+	SettleFlashSale(ctx context.Context, flashSaleID int64, finalStock int32) error                        // 停止扣减，进入结算（等待在途流水落库）
+	EndFlashSale(ctx context.Context, flashSaleID int64, finalStock int32) error                           // 结束秒杀
+	ApplyStockLog(ctx context.Context, log *model.FlashStockLog) (bool, bool, error)                       // 写入流水并同步商品库存（流水号已存在时跳过，库存不足时记录超卖），返回是否本次生效、售罄状态是否变化
+	SumStockDelta(ctx context.Context, flashSaleID int64) (int64, error)                                   // 已落库的库存变化量之和
+}
+
+// flashSaleRepo 实现
+type flashSaleRepo struct{}
+
+// NewFlashSaleRepo 创建实例
+func NewFlashSaleRepo() FlashSaleRepo {
+	return &flashSaleRepo{}
+}
+
+// hasUnfinishedFlashSale 商品是否存在进行中或结算中的秒杀（调用方须已锁定商品行，与开启秒杀互斥）
+func hasUnfinishedFlashSale(tx *gorm.DB, productID int64) (bool, error) {
+	var count int64
+	err := tx.Model(&model.FlashSale{}).Where("product_id = ? AND status IN ?", productID, model.FlashSaleUnfinishedStatuses).Count(&count).Error
+	return count > 0, err
+}
+
+// StartFlashSale 事务创建秒杀：锁定商品行读取库存作为初始库存，同一商品只允许一个未结束的秒杀
+// 持有商品行锁期间预热Redis，秒杀对数据库扣减可见前Redis库存已就绪；预热失败时回滚
+func (r *flashSaleRepo) StartFlashSale(ctx context.Context, sale *model.FlashSale, preload func(*model.FlashSale) error) error {
+	return db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var product model.Product
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("product_id = ?", sale.ProductID).First(&product).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return utils.NewBizError("商品不存在")
+			}
+			zap.L().Error("锁定商品失败", zap.Int64("product_id", sale.ProductID), zap.Error(err))
+			return utils.NewDBError("开启秒杀失败：" + err.Error())
+		}
+		if product.MerchantID != sale.MerchantID {
+			return utils.NewBizError("商品不存在或无权限操作")
+		}
+		if product.HasSku {
+			return utils.NewBizError("多规格商品暂不支持秒杀")
+		}
+		if product.Stock <= 0 {
+			return utils.NewBizError("商品库存不足，无法开启秒杀")
+		}
+		inSale, err := hasUnfinishedFlashSale(tx, sale.ProductID)
+		if err != nil {
+			zap.L().Error("查询进行中秒杀失败", zap.Int64("product_id", sale.ProductID), zap.Error(err))
+			return utils.NewDBError("开启秒杀失败：" + err.Error())
+		}
+		if inSale {
+			return utils.NewBizError("商品已在秒杀中或秒杀结算中")
+		}
+		sale.InitialStock = product.Stock
+		sale.Status = model.FlashSaleStatusActive
+		if err := tx.Create(sale).Error; err != nil {
+			zap.L().Error("创建秒杀失败", zap.Any("sale", sale), zap.Error(err))
+			return utils.NewDBError("开启秒杀失败：" + err.Error())
+		}
+		return preload(sale)
+	})
+}
+
+func (r *flashSaleRepo) GetActiveFlashSale(ctx context.Context, productID int64) (*model.FlashSale, error) {
+	var sale model.FlashSale
+	err := db.Mysql.WithContext(ctx).Where("product_id = ? AND status IN ?", productID, model.FlashSaleUnfinishedStatuses).First(&sale).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		zap.L().Error("查询进行中秒杀失败", zap.Int64("product_id", productID), zap.Error(err))
+		return nil, utils.NewDBError("查询秒杀失败：" + err.Error())
+	}
+	return &sale, nil
+}
+
+func (r *flashSaleRepo) ListActiveFlashSales(ctx context.Context) ([]*model.FlashSale, error) {
+	var sales []*model.FlashSale
+	if err := db.Mysql.WithContext(ctx).Where("status IN ?", model.FlashSaleUnfinishedStatuses).Find(&sales).Error; err != nil {
+		zap.L().Error("查询进行中秒杀列表失败", zap.Error(err))
+		return nil, utils.NewDBError("查询秒杀失败：" + err.Error())
+	}
+	return sales, nil
+}
+
+// SettleFlashSale 进行中的秒杀进入结算，记录停止扣减时的Redis剩余库存
+func (r *flashSaleRepo) SettleFlashSale(ctx context.Context, flashSaleID int64, finalStock int32) error {
+	tx := db.Mysql.WithContext(ctx).Model(&model.FlashSale{}).
+		Where("flash_sale_id = ? AND status = ?", flashSaleID, model.FlashSaleStatusActive).
+		Updates(map[string]interface{}{
+			"status":      model.FlashSaleStatusSettling,
+			"final_stock": finalStock,
+		})
+	if tx.Error != nil {
+		zap.L().Error("秒杀进入结算失败", zap.Int64("flash_sale_id", flashSaleID), zap.Error(tx.Error))
+		return utils.NewDBError("结束秒杀失败：" + tx.Error.Error())
+	}
+	if tx.RowsAffected == 0 {
+		return utils.NewBizError("秒杀已结束")
+	}
+	return nil
+}
+
+// EndFlashSale 结束秒杀（仅进行中、结算中的秒杀可结束）
+func (r *flashSaleRepo) EndFlashSale(ctx context.Context, flashSaleID int64, finalStock int32) error {
+	now := time.Now()
+	tx := db.Mysql.WithContext(ctx).Model(&model.FlashSale{}).
+		Where("flash_sale_id = ? AND status IN ?", flashSaleID, model.FlashSaleUnfinishedStatuses).
+		Updates(map[string]interface{}{
+			"status":      model.FlashSaleStatusEnded,
+			"final_stock": finalStock,
+			"ended_at":    &now,
+		})
+	if tx.Error != nil {
+		zap.L().Error("结束秒杀失败", zap.Int64("flash_sale_id", flashSaleID), zap.Error(tx.Error))
+		return utils.NewDBError("结束秒杀失败：" + tx.Error.Error())
+	}
+	if tx.RowsAffected == 0 {
+		return utils.NewBizError("秒杀已结束")
+	}
+	return nil
+}
+
+// ApplyStockLog 事务写入库存流水并同步商品库存（流水号唯一，重复消费时跳过）
+// 商品库存不足以扣减时（Redis已校验库存，出现即数据不一致）库存置0并在秒杀上累计超卖数量待人工对账，
+// 流水照常写入，保证结算时已落库变化量与Redis一致，不阻塞结算
+func (r *flashSaleRepo) ApplyStockLog(ctx context.Context, log *model.FlashStockLog) (bool, bool, error) {
+	applied, soldOutChanged := false, false
+	err := db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(log)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		var product model.Product
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("stock", "is_sold_out").
+			Where("product_id = ?", log.ProductID).First(&product).Error; err != nil {
+			return err
+		}
+		stock := product.Stock + log.Delta
+		if stock < 0 {
+			zap.L().Error("秒杀库存流水落库时商品库存不足，记录超卖待人工对账", zap.Any("log", log), zap.Int32("stock", product.Stock))
+			res = tx.Model(&model.FlashSale{}).Where("flash_sale_id = ?", log.FlashSaleID).
+				UpdateColumn("shortage", gorm.Expr("shortage + ?", -stock))
+			if res.Error != nil {
+				return res.Error
+			}
+			stock = 0
+		}
+		res = tx.Model(&model.Product{}).Where("product_id = ?", log.ProductID).
+			UpdateColumns(map[string]interface{}{"stock": stock, "is_sold_out": stock <= 0})
+		if res.Error != nil {
+			return res.Error
+		}
+		applied = true
+		soldOutChanged = product.IsSoldOut != (stock <= 0)
+		return nil
+	})
+	if err != nil {
+		zap.L().Error("秒杀库存流水落库失败", zap.Any("log", log), zap.Error(err))
+		return false, false, utils.NewDBError("秒杀库存落库失败：" + err.Error())
+	}
+	return applied, soldOutChanged, nil
+}
+
+func (r *flashSaleRepo) SumStockDelta(ctx context.Context, flashSaleID int64) (int64, error) {
+	var sum int64
+	err := db.Mysql.WithContext(ctx).Model(&model.FlashStockLog{}).Where("flash_sale_id = ?", flashSaleID).
+		Select("COALESCE(SUM(delta), 0)").Scan(&sum).Error
+	if err != nil {
+		zap.L().Error("汇总秒杀库存流水失败", zap.Int64("flash_sale_id", flashSaleID), zap.Error(err))
+		return 0, utils.NewDBError("汇总秒杀库存流水失败：" + err.Error())
+	}
+	return sum, nil
+}
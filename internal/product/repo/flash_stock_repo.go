@@ -0,0 +1,188 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/redis"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	goredis "github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// flashUsersExpiration 用户已购数量过期时间（兜底清理，秒杀结束时主动删除）
+const flashUsersExpiration = 7 * 24 * time.Hour
+
+// 秒杀扣减结果
+const (
+	FlashDeductOK          = 0 // 扣减成功
+	FlashDeductNotActive   = 1 // 商品未在秒杀中（Redis无库存数据）
+	FlashDeductOutOfStock  = 2 // 库存不足
+	FlashDeductLimitExceed = 3 // 超出每人限购数量
+	FlashDeductSettling    = 4 // 秒杀已停止扣减，等待在途流水落库
+)
+
+// flashDeductScript 原子校验库存、限购并扣减
+// KEYS[1] 秒杀信息Hash（stock/sale_id/limit/settling） KEYS[2] 用户已购数量Hash
+// ARGV[1] 扣减数量 ARGV[2] 用户ID
+// 返回 {结果码, 秒杀ID, 剩余库存}
+var flashDeductScript = goredis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return {1, 0, 0}
+end
+local num = tonumber(ARGV[1])
+local stock = tonumber(redis.call('HGET', KEYS[1], 'stock'))
+local limit = tonumber(redis.call('HGET', KEYS[1], 'limit'))
+local saleID = tonumber(redis.call('HGET', KEYS[1], 'sale_id'))
+if redis.call('HEXISTS', KEYS[1], 'settling') == 1 then
+	return {4, saleID, stock}
+end
+if stock < num then
+	return {2, saleID, stock}
+end
+if limit > 0 then
+	local bought = tonumber(redis.call('HGET', KEYS[2], ARGV[2]) or '0')
+	if bought + num > limit then
+		return {3, saleID, stock}
+	end
+	redis.call('HINCRBY', KEYS[2], ARGV[2], num)
+end
+redis.call('HINCRBY', KEYS[1], 'stock', -num)
+return {0, saleID, stock - num}
+`)
+
+// flashRestoreScript 恢复库存并退还用户限购额度
+// KEYS、ARGV同flashDeductScript，返回 {结果码, 秒杀ID, 剩余库存}
+var flashRestoreScript = goredis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return {1, 0, 0}
+end
+local num = tonumber(ARGV[1])
+local saleID = tonumber(redis.call('HGET', KEYS[1], 'sale_id'))
+if redis.call('HEXISTS', KEYS[1], 'settling') == 1 then
+	return {4, saleID, tonumber(redis.call('HGET', KEYS[1], 'stock'))}
+end
+local stock = redis.call('HINCRBY', KEYS[1], 'stock', num)
+local bought = tonumber(redis.call('HGET', KEYS[2], ARGV[2]) or '0')
+if bought > 0 then
+	redis.call('HINCRBY', KEYS[2], ARGV[2], -math.min(bought, num))
+end
+return {0, saleID, stock}
+`)
+
+// flashSettleScript 标记秒杀停止扣减（保留秒杀数据，阻止扣减回到数据库），返回剩余库存（不存在返回-1）
+var flashSettleScript = goredis.NewScript(`
+local stock = redis.call('HGET', KEYS[1], 'stock')
+if not stock then
+	return -1
+end
+redis.call('HSET', KEYS[1], 'settling', 1)
+redis.call('DEL', KEYS[2])
+return tonumber(stock)
+`)
+
+// FlashStockResult 秒杀库存操作结果
+type FlashStockResult struct {
+	Code        int
+	FlashSaleID int64
+	Stock       int64 // 操作后剩余库存
+}
+
+// FlashStockRepo 秒杀库存数据访问接口（Redis）
+type FlashStockRepo interface {
+	Preload(ctx context.Context, sale *model.FlashSale) error // 预热库存及限购配置（清空上一场的用户已购数量）
+	Deduct(ctx context.Context, productID, userID int64, num int32) (FlashStockResult, error)
+	Restore(ctx context.Context, productID, userID int64, num int32) (FlashStockResult, error)
+	GetStock(ctx context.Context, productID int64) (int64, bool, error) // 查询剩余库存（不存在返回false）
+	Settle(ctx context.Context, productID int64) (int64, error)         // 停止扣减，返回剩余库存（不存在返回-1）
+	Clear(ctx context.Context, productID int64) error                   // 删除秒杀数据（结算完成后调用，之后扣减回到数据库）
+}
+
+type flashStockRepo struct{}
+
+// NewFlashStockRepo 创建实例
+func NewFlashStockRepo() FlashStockRepo {
+	return &flashStockRepo{}
+}
+
+// flashSaleKey 秒杀信息key（hash tag保证同一商品的key在集群同一slot）
+func flashSaleKey(productID int64) string {
+	return fmt.Sprintf("flash:{%d}:sale", productID)
+}
+
+// flashUsersKey 用户已购数量key
+func flashUsersKey(productID int64) string {
+	return fmt.Sprintf("flash:{%d}:users", productID)
+}
+
+func (r *flashStockRepo) Preload(ctx context.Context, sale *model.FlashSale) error {
+	_, err := redis.RedisClient.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Del(ctx, flashSaleKey(sale.ProductID), flashUsersKey(sale.ProductID))
+		pipe.HSet(ctx, flashSaleKey(sale.ProductID), map[string]interface{}{
+			"stock":   sale.InitialStock,
+			"limit":   sale.PerUserLimit,
+			"sale_id": sale.FlashSaleID,
+		})
+		return nil
+	})
+	if err != nil {
+		zap.L().Error("预热秒杀库存失败", zap.Any("sale", sale), zap.Error(err))
+		return utils.NewDBError("预热秒杀库存失败：" + err.Error())
+	}
+	return nil
+}
+
+func (r *flashStockRepo) Deduct(ctx context.Context, productID, userID int64, num int32) (FlashStockResult, error) {
+	return r.run(ctx, flashDeductScript, productID, userID, num)
+}
+
+func (r *flashStockRepo) Restore(ctx context.Context, productID, userID int64, num int32) (FlashStockResult, error) {
+	return r.run(ctx, flashRestoreScript, productID, userID, num)
+}
+
+// run 执行扣减/恢复脚本，成功扣减后刷新用户已购数量的过期时间
+func (r *flashStockRepo) run(ctx context.Context, script *goredis.Script, productID, userID int64, num int32) (FlashStockResult, error) {
+	keys := []string{flashSaleKey(productID), flashUsersKey(productID)}
+	vals, err := script.Run(ctx, redis.RedisClient, keys, num, strconv.FormatInt(userID, 10)).Int64Slice()
+	if err != nil || len(vals) != 3 {
+		zap.L().Error("执行秒杀库存脚本失败", zap.Int64("product_id", productID), zap.Int64("user_id", userID), zap.Int32("num", num), zap.Error(err))
+		return FlashStockResult{}, utils.NewDBError("秒杀库存操作失败")
+	}
+	if vals[0] == FlashDeductOK && script == flashDeductScript {
+		redis.RedisClient.Expire(ctx, flashUsersKey(productID), flashUsersExpiration)
+	}
+	return FlashStockResult{Code: int(vals[0]), FlashSaleID: vals[1], Stock: vals[2]}, nil
+}
+
+func (r *flashStockRepo) GetStock(ctx context.Context, productID int64) (int64, bool, error) {
+	stock, err := redis.RedisClient.HGet(ctx, flashSaleKey(productID), "stock").Int64()
+	if err != nil {
+		if redis.IsNil(err) {
+			return 0, false, nil
+		}
+		zap.L().Error("查询秒杀库存失败", zap.Int64("product_id", productID), zap.Error(err))
+		return 0, false, utils.NewDBError("查询秒杀库存失败：" + err.Error())
+	}
+	return stock, true, nil
+}
+
+func (r *flashStockRepo) Settle(ctx context.Context, productID int64) (int64, error) {
+	keys := []string{flashSaleKey(productID), flashUsersKey(productID)}
+	stock, err := flashSettleScript.Run(ctx, redis.RedisClient, keys).Int64()
+	if err != nil {
+		zap.L().Error("停止秒杀扣减失败", zap.Int64("product_id", productID), zap.Error(err))
+		return 0, utils.NewDBError("结束秒杀失败：" + err.Error())
+	}
+	return stock, nil
+}
+
+func (r *flashStockRepo) Clear(ctx context.Context, productID int64) error {
+	if err := redis.RedisClient.Del(ctx, flashSaleKey(productID), flashUsersKey(productID)).Err(); err != nil {
+		zap.L().Error("删除秒杀库存失败", zap.Int64("product_id", productID), zap.Error(err))
+		return utils.NewDBError("删除秒杀库存失败：" + err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Category 商家菜单分类表（如热销、主食、饮品）
+type Category struct {
+	CategoryID int64          `gorm:"column:category_id;primaryKey;autoIncrement" json:"category_id"`
+	MerchantID int64          `gorm:"column:merchant_id;not null;uniqueIndex:uk_category_merchant_name,priority:1;comment:'商家ID'" json:"merchant_id"`
+	Name       string         `gorm:"column:name;not null;size:32;comment:'分类名称'" json:"name"`
+	NameKey    *string        `gorm:"column:name_key;type:varchar(32) GENERATED ALWAYS AS (IF(deleted_at IS NULL, name, NULL)) STORED;->:false;<-:false;uniqueIndex:uk_category_merchant_name,priority:2;comment:'分类名称唯一键（生成列：已删除分类为NULL，不参与唯一约束）'" json:"-"`
+	SortOrder  int32          `gorm:"column:sort_order;not null;default:0;comment:'排序（升序）'" json:"sort_order"`
+	CreatedAt  time.Time      `gorm:"column:created_at;autoCreateTime;comment:'创建时间'" json:"created_at"`
+	UpdatedAt  time.Time      `gorm:"column:updated_at;autoUpdateTime;comment:'更新时间'" json:"updated_at"`
+	DeletedAt  gorm.DeletedAt `gorm:"column:deleted_at;index;comment:'软删除时间'" json:"-"`
+}
+
+// TableName 表名
+func (c *Category) TableName() string {
+	return "t_category"
+}
+
+// ProductCategory 商品分类关联表（一个商品可属于多个分类，如同时属于热销和主食）
+type ProductCategory struct {
+	CategoryID int64     `gorm:"column:category_id;primaryKey;autoIncrement:false;comment:'分类ID'" json:"category_id"`
+	ProductID  int64     `gorm:"column:product_id;primaryKey;autoIncrement:false;index;comment:'商品ID'" json:"product_id"`
+	SortOrder  int32     `gorm:"column:sort_order;not null;default:0;comment:'分类内排序（升序）'" json:"sort_order"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime;comment:'创建时间'" json:"created_at"`
+}
+
+// TableName 表名
+func (pc *ProductCategory) TableName() string {
+	return "t_product_category"
+}
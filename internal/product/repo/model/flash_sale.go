@@ -0,0 +1,49 @@
+package model
+
+import "time"
+
+// 秒杀状态
+const (
+	FlashSaleStatusActive   = "进行中"
+	FlashSaleStatusSettling = "结算中" // 已停止扣减，等待在途流水落库
+	FlashSaleStatusEnded    = "已结束"
+)
+
+// FlashSaleUnfinishedStatuses 未结束的秒杀状态（期间商品库存以Redis及流水为准）
+var FlashSaleUnfinishedStatuses = []string{FlashSaleStatusActive, FlashSaleStatusSettling}
+
+// FlashSale 商品秒杀表（进行中时库存以Redis为准，扣减流水异步同步到商品表）
+type FlashSale struct {
+	FlashSaleID  int64      `gorm:"column:flash_sale_id;primaryKey;autoIncrement" json:"flash_sale_id"`
+	ProductID    int64      `gorm:"column:product_id;not null;index;comment:'商品ID'" json:"product_id"`
+	MerchantID   int64      `gorm:"column:merchant_id;not null;index;comment:'商家ID'" json:"merchant_id"`
+	InitialStock int32      `gorm:"column:initial_stock;not null;comment:'开始时库存（对账基准）'" json:"initial_stock"`
+	PerUserLimit int32      `gorm:"column:per_user_limit;not null;default:0;comment:'每人限购数量（0表示不限）'" json:"per_user_limit"`
+	Status       string     `gorm:"column:status;not null;size:16;index;comment:'状态：进行中/结算中/已结束'" json:"status"`
+	FinalStock   int32      `gorm:"column:final_stock;not null;default:0;comment:'停止扣减时Redis剩余库存'" json:"final_stock"`
+	Shortage     int32      `gorm:"column:shortage;not null;default:0;comment:'流水落库时商品库存不足的数量（超卖，需人工对账）'" json:"shortage"`
+	EndedAt      *time.Time `gorm:"column:ended_at;comment:'结束时间'" json:"ended_at"`
+	CreatedAt    time.Time  `gorm:"column:created_at;autoCreateTime;comment:'开始时间'" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at;autoUpdateTime;comment:'更新时间'" json:"updated_at"`
+}
+
+// TableName 表名
+func (f *FlashSale) TableName() string {
+	return "t_flash_sale"
+}
+
+// FlashStockLog 秒杀库存流水表（异步落库的幂等依据，对账时按秒杀汇总）
+type FlashStockLog struct {
+	LogID       int64     `gorm:"column:log_id;primaryKey;autoIncrement" json:"log_id"`
+	FlashSaleID int64     `gorm:"column:flash_sale_id;not null;index;comment:'秒杀ID'" json:"flash_sale_id"`
+	ProductID   int64     `gorm:"column:product_id;not null;comment:'商品ID'" json:"product_id"`
+	UserID      int64     `gorm:"column:user_id;not null;default:0;comment:'用户ID'" json:"user_id"`
+	StockNo     string    `gorm:"column:stock_no;not null;size:32;uniqueIndex;comment:'流水号（幂等键）'" json:"stock_no"`
+	Delta       int32     `gorm:"column:delta;not null;comment:'库存变化量（扣减为负，恢复为正）'" json:"delta"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime;comment:'落库时间'" json:"created_at"`
+}
+
+// TableName 表名
+func (l *FlashStockLog) TableName() string {
+	return "t_flash_stock_log"
+}
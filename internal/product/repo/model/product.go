@@ -8,31 +8,31 @@ import (
 
 // Product 商品表模型
 type Product struct {
-	ProductID   int64          `gorm:"column:product_id;primaryKey;autoIncrement" json:"product_id"`
-	MerchantID  int64          `gorm:"column:merchant_id;not null;index;comment:'商家ID'" json:"merchant_id"`
-	Name        string         `gorm:"column:name;not null;size:64;comment:'商品名称'" json:"name"`
-	Description string         `gorm:"column:description;size:512;comment:'商品描述'" json:"description"`
-	Price       float64        `gorm:"column:price;not null;type:decimal(10,2);comment:'商品价格（元）'" json:"price"`
-	Stock       int32          `gorm:"column:stock;not null;default:0;comment:'库存数量'" json:"stock"`
-	ImageURL    string         `gorm:"column:image_url;size:255;comment:'商品图片'" json:"image_url"`
-	IsSoldOut   bool           `gorm:"column:is_sold_out;not null;default:false;comment:'是否售罄'" json:"is_sold_out"`
-	CreatedAt   time.Time      `gorm:"column:created_at;autoCreateTime;comment:'创建时间'" json:"created_at"`
-	UpdatedAt   time.Time      `gorm:"column:updated_at;autoUpdateTime;comment:'更新时间'" json:"updated_at"`
-	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at;index;comment:'软删除时间'" json:"-"`
+	ProductID       int64          `gorm:"column:product_id;primaryKey;autoIncrement;index:idx_product_merchant_created,priority:3" json:"product_id"`
+	MerchantID      int64          `gorm:"column:merchant_id;not null;index:idx_product_merchant_created,priority:1;uniqueIndex:uk_product_merchant_code,priority:1;comment:'商家ID'" json:"merchant_id"`
+	ExternalCode    string         `gorm:"column:external_code;not null;size:64;default:'';comment:'外部编码（商家自有SKU编码，批量导入按此匹配）'" json:"external_code"`
+	ExternalCodeKey *string        `gorm:"column:external_code_key;type:varchar(64) GENERATED ALWAYS AS (IF(deleted_at IS NULL, NULLIF(external_code, ''), NULL)) STORED;->:false;<-:false;uniqueIndex:uk_product_merchant_code,priority:2;comment:'外部编码唯一键（生成列：空编码、已删除商品为NULL，不参与唯一约束）'" json:"-"`
+	Name            string         `gorm:"column:name;not null;size:64;comment:'商品名称'" json:"name"`
+	Description     string         `gorm:"column:description;size:512;comment:'商品描述'" json:"description"`
+	Price           float64        `gorm:"column:price;not null;type:decimal(10,2);comment:'商品价格（元，多规格商品为最低规格价）'" json:"price"`
+	Stock           int32          `gorm:"column:stock;not null;default:0;comment:'库存数量（多规格商品为各规格库存之和）'" json:"stock"`
+	HasSku          bool           `gorm:"column:has_sku;not null;default:false;comment:'是否多规格（下单须指定规格）'" json:"has_sku"`
+	PackingFee      float64        `gorm:"column:packing_fee;not null;default:0;type:decimal(10,2);comment:'单件打包费（元）'" json:"packing_fee"`
+	ImageURL        string         `gorm:"column:image_url;size:255;comment:'商品图片'" json:"image_url"`
+	IsSoldOut       bool           `gorm:"column:is_sold_out;not null;default:false;comment:'是否售罄（库存变化时同步维护）'" json:"is_sold_out"`
+	Suspended       bool           `gorm:"column:suspended;not null;default:false;comment:'商家手动暂停售卖'" json:"suspended"`
+	AvailableHours  string         `gorm:"column:available_hours;not null;size:16;default:'';comment:'可售时段（HH:MM-HH:MM，为空表示全天）'" json:"available_hours"`
+	DailyStock      int32          `gorm:"column:daily_stock;not null;default:0;comment:'每日补货库存（每天补货时间将库存重置为该值，0表示不自动补货）'" json:"daily_stock"`
+	RestockTime     string         `gorm:"column:restock_time;not null;size:5;default:'';comment:'每日补货时间（HH:MM）'" json:"restock_time"`
+	LastRestockDate string         `gorm:"column:last_restock_date;not null;size:10;default:'';comment:'最近补货日期（YYYY-MM-DD）'" json:"last_restock_date"`
+	Score           float64        `gorm:"column:score;not null;default:5.0;type:decimal(2,1);comment:'商品评分'" json:"score"`
+	RatingCount     int64          `gorm:"column:rating_count;not null;default:0;comment:'评分次数'" json:"rating_count"`
+	CreatedAt       time.Time      `gorm:"column:created_at;autoCreateTime;index:idx_product_merchant_created,priority:2;comment:'创建时间'" json:"created_at"`
+	UpdatedAt       time.Time      `gorm:"column:updated_at;autoUpdateTime;comment:'更新时间'" json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"column:deleted_at;index;comment:'软删除时间'" json:"-"`
 }
 
 // TableName 表名
 func (p *Product) TableName() string {
 	return "t_product"
 }
-
-// BeforeUpdate 钩子：更新时自动设置售罄状态
-func (p *Product) BeforeUpdate(tx *gorm.DB) error {
-	// 库存为0时设为售罄，否则设为未售罄
-	if p.Stock <= 0 {
-		p.IsSoldOut = true
-	} else {
-		p.IsSoldOut = false
-	}
-	return nil
-}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ProductSku 商品规格表（如大份/中份/小份，每个规格独立定价和库存）
+type ProductSku struct {
+	SkuID     int64          `gorm:"column:sku_id;primaryKey;autoIncrement" json:"sku_id"`
+	ProductID int64          `gorm:"column:product_id;not null;index;comment:'商品ID'" json:"product_id"`
+	Name      string         `gorm:"column:name;not null;size:32;comment:'规格名称'" json:"name"`
+	Price     float64        `gorm:"column:price;not null;type:decimal(10,2);comment:'规格价格（元）'" json:"price"`
+	Stock     int32          `gorm:"column:stock;not null;default:0;comment:'规格库存'" json:"stock"`
+	SortOrder int32          `gorm:"column:sort_order;not null;default:0;comment:'排序（升序）'" json:"sort_order"`
+	CreatedAt time.Time      `gorm:"column:created_at;autoCreateTime;comment:'创建时间'" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"column:updated_at;autoUpdateTime;comment:'更新时间'" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index;comment:'软删除时间'" json:"-"`
+}
+
+// TableName 表名
+func (s *ProductSku) TableName() string {
+	return "t_product_sku"
+}
+
+// ProductOptionGroup 商品属性组表（如口味、加料，组内可选项数量受最少/最多选择数限制）
+type ProductOptionGroup struct {
+	GroupID   int64            `gorm:"column:group_id;primaryKey;autoIncrement" json:"group_id"`
+	ProductID int64            `gorm:"column:product_id;not null;index;comment:'商品ID'" json:"product_id"`
+	Name      string           `gorm:"column:name;not null;size:32;comment:'属性组名称'" json:"name"`
+	MinSelect int32            `gorm:"column:min_select;not null;default:0;comment:'最少选择数（0表示可不选）'" json:"min_select"`
+	MaxSelect int32            `gorm:"column:max_select;not null;default:1;comment:'最多选择数'" json:"max_select"`
+	SortOrder int32            `gorm:"column:sort_order;not null;default:0;comment:'排序（升序）'" json:"sort_order"`
+	CreatedAt time.Time        `gorm:"column:created_at;autoCreateTime;comment:'创建时间'" json:"created_at"`
+	Options   []*ProductOption `gorm:"-" json:"options"`
+}
+
+// TableName 表名
+func (g *ProductOptionGroup) TableName() string {
+	return "t_product_option_group"
+}
+
+// ProductOption 商品属性选项表（如微辣、加蛋，可设置加价）
+type ProductOption struct {
+	OptionID  int64     `gorm:"column:option_id;primaryKey;autoIncrement" json:"option_id"`
+	GroupID   int64     `gorm:"column:group_id;not null;index;comment:'属性组ID'" json:"group_id"`
+	ProductID int64     `gorm:"column:product_id;not null;index;comment:'商品ID'" json:"product_id"`
+	Name      string    `gorm:"column:name;not null;size:32;comment:'选项名称'" json:"name"`
+	Price     float64   `gorm:"column:price;not null;default:0;type:decimal(10,2);comment:'加价（元）'" json:"price"`
+	SortOrder int32     `gorm:"column:sort_order;not null;default:0;comment:'排序（升序）'" json:"sort_order"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime;comment:'创建时间'" json:"created_at"`
+}
+
+// TableName 表名
+func (o *ProductOption) TableName() string {
+	return "t_product_option"
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// StockRestoreLog 库存恢复流水表（订单取消/拒单等恢复库存的幂等依据，重复请求按流水号跳过）
+type StockRestoreLog struct {
+	LogID     int64     `gorm:"column:log_id;primaryKey;autoIncrement" json:"log_id"`
+	RestoreNo string    `gorm:"column:restore_no;not null;size:64;uniqueIndex;comment:'恢复流水号（幂等键）'" json:"restore_no"`
+	ProductID int64     `gorm:"column:product_id;not null;comment:'商品ID'" json:"product_id"`
+	SkuID     int64     `gorm:"column:sku_id;not null;default:0;comment:'规格ID'" json:"sku_id"`
+	Num       int32     `gorm:"column:num;not null;comment:'恢复数量'" json:"num"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime;comment:'恢复时间'" json:"created_at"`
+}
+
+// TableName 表名
+func (l *StockRestoreLog) TableName() string {
+	return "t_stock_restore_log"
+}
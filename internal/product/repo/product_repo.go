@@ -3,9 +3,11 @@ package repo
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo/model"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/pagination"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
@@ -17,10 +19,21 @@ type ProductRepo interface {
 	CreateProduct(ctx context.Context, product *model.Product) error
 	UpdateProduct(ctx context.Context, product *model.Product) error
 	DeleteProduct(ctx context.Context, productID, merchantID int64) error
-	ListProductsByMerchantID(ctx context.Context, merchantID int64, page, pageSize int32) ([]*model.Product, int64, error)
+	ListProductsByMerchantID(ctx context.Context, merchantID int64, page pagination.Param) ([]*model.Product, pagination.Result, error)
 	GetProductByID(ctx context.Context, productID int64) (*model.Product, error)
-	DeductStock(ctx context.Context, productID int64, num int32) error  // 扣减库存（悲观锁）
-	RestoreStock(ctx context.Context, productID int64, num int32) error // 恢复库存
+	DeductStock(ctx context.Context, productID, skuID int64, num int32) (bool, error)                      // 扣减库存（悲观锁，多规格商品须指定规格），返回售罄状态是否变化
+	RestoreStock(ctx context.Context, log *model.StockRestoreLog) (bool, bool, error)                      // 恢复库存（流水号已存在时跳过），返回是否本次生效、售罄状态是否变化
+	ClaimStockRestore(ctx context.Context, log *model.StockRestoreLog) (bool, error)                       // 仅写入恢复流水（秒杀商品库存在Redis恢复），返回是否本次写入
+	ReleaseStockRestore(ctx context.Context, restoreNo string) error                                       // 删除恢复流水（恢复失败时释放，允许重试）
+	UpdateScore(ctx context.Context, productID int64, score float64, ratingCount int64) error              // 更新评分（仅评分次数增加时生效）
+	SetAvailability(ctx context.Context, product *model.Product) error                                     // 更新暂停售卖及可售时段
+	SetDailyRestock(ctx context.Context, product *model.Product) error                                     // 更新每日补货配置（仅单规格商品）
+	ListRestockDue(ctx context.Context, date, clock string) ([]*model.Product, error)                      // 查询今日已到补货时间且未补货的商品
+	Restock(ctx context.Context, productID int64, date, clock string) (bool, error)                        // 将库存重置为每日补货库存（同一天只补一次）
+	ListByExternalCodes(ctx context.Context, merchantID int64, codes []string) ([]*model.Product, error)   // 按外部编码查询商家商品
+	ListProductsByIDs(ctx context.Context, merchantID int64, productIDs []int64) ([]*model.Product, error) // 按商品ID批量查询商家商品
+	ListProductsAfter(ctx context.Context, merchantID, afterID int64, limit int) ([]*model.Product, error) // 按ID升序分批读取商家商品（导出用）
+	BulkUpsertProducts(ctx context.Context, creates, updates []*BulkProduct) error                         // 事务批量创建、更新商品
 }
 
 // productRepo 实现
@@ -31,8 +44,14 @@ func NewProductRepo() ProductRepo {
 	return &productRepo{}
 }
 
+// msgExternalCodeConflict 外部编码在商家内重复（唯一键冲突）
+const msgExternalCodeConflict = "外部编码已被本店其他商品使用"
+
 func (p *productRepo) CreateProduct(ctx context.Context, product *model.Product) error {
 	tx := db.Mysql.WithContext(ctx).Create(product)
+	if errors.Is(tx.Error, gorm.ErrDuplicatedKey) {
+		return utils.NewBizError(msgExternalCodeConflict)
+	}
 	if tx.Error != nil {
 		zap.L().Error("创建商品失败", zap.Any("product", product), zap.Error(tx.Error))
 		return utils.NewDBError("创建商品失败：" + tx.Error.Error())
@@ -40,15 +59,36 @@ func (p *productRepo) CreateProduct(ctx context.Context, product *model.Product)
 	return nil
 }
 
-func (p *productRepo) UpdateProduct(ctx context.Context, product *model.Product) error {
-	tx := db.Mysql.WithContext(ctx).Model(&model.Product{}).Where("product_id = ? AND merchant_id = ?", product.ProductID, product.MerchantID).Updates(map[string]interface{}{
+// productUpdates 商家可编辑的商品字段（多规格商品价格、库存由规格决定，外部编码为空时保持不变）
+// omit为不更新的列，不更新库存时售罄状态一并保持不变
+func productUpdates(product *model.Product, omit ...string) map[string]interface{} {
+	updates := map[string]interface{}{
 		"name":        product.Name,
 		"description": product.Description,
-		"price":       product.Price,
-		"stock":       product.Stock,
+		"price":       gorm.Expr("IF(has_sku, price, ?)", product.Price),
+		"stock":       gorm.Expr("IF(has_sku, stock, ?)", product.Stock),
+		"packing_fee": product.PackingFee,
 		"image_url":   product.ImageURL,
-		"is_sold_out": product.IsSoldOut,
-	})
+		"is_sold_out": gorm.Expr("IF(has_sku, is_sold_out, ?)", product.Stock <= 0),
+	}
+	if product.ExternalCode != "" {
+		updates["external_code"] = product.ExternalCode
+	}
+	for _, column := range omit {
+		delete(updates, column)
+		if column == "stock" {
+			delete(updates, "is_sold_out")
+		}
+	}
+	return updates
+}
+
+func (p *productRepo) UpdateProduct(ctx context.Context, product *model.Product) error {
+	tx := db.Mysql.WithContext(ctx).Model(&model.Product{}).Where("product_id = ? AND merchant_id = ?", product.ProductID, product.MerchantID).
+		Updates(productUpdates(product))
+	if errors.Is(tx.Error, gorm.ErrDuplicatedKey) {
+		return utils.NewBizError(msgExternalCodeConflict)
+	}
 	if tx.Error != nil {
 		zap.L().Error("更新商品失败", zap.Any("product", product), zap.Error(tx.Error))
 		return utils.NewDBError("更新商品失败：" + tx.Error.Error())
@@ -71,24 +111,30 @@ func (p *productRepo) DeleteProduct(ctx context.Context, productID, merchantID i
 	return nil
 }
 
-func (p *productRepo) ListProductsByMerchantID(ctx context.Context, merchantID int64, page, pageSize int32) ([]*model.Product, int64, error) {
-	var (
-		total    int64
-		products []*model.Product
-	)
-	//先求数量
-	err := db.Mysql.WithContext(ctx).Model(&model.Product{}).Where("merchant_id = ?", merchantID).Count(&total).Error
+// productKeyset 商品列表按创建时间、商品ID倒序分页（扣库存/编辑会刷新updated_at，不能作为游标）
+var productKeyset = pagination.Keyset{TimeColumn: "created_at", IDColumn: "product_id"}
+
+func (p *productRepo) ListProductsByMerchantID(ctx context.Context, merchantID int64, page pagination.Param) ([]*model.Product, pagination.Result, error) {
+	query := db.Mysql.WithContext(ctx).Model(&model.Product{}).Where("merchant_id = ?", merchantID)
+	//按需求数量
+	total, err := pagination.Count(query, page)
 	if err != nil {
 		zap.L().Error("统计商品总数失败", zap.Int64("merchant_id", merchantID), zap.Error(err))
-		return nil, 0, utils.NewDBError("查询商品失败：" + err.Error())
+		return nil, pagination.Result{}, utils.NewDBError("查询商品失败：" + err.Error())
 	}
-	offset := int((page - 1) * pageSize)
-	tx := db.Mysql.WithContext(ctx).Model(&model.Product{}).Where("merchant_id = ?", merchantID).Offset(offset).Limit(int(pageSize)).Order("updated_at desc").Find(&products)
-	if tx.Error != nil {
-		zap.L().Error("查询商品列表失败", zap.Int64("merchant_id", merchantID), zap.Error(tx.Error))
-		return nil, 0, utils.NewDBError("查询商品失败：" + tx.Error.Error())
+	query, err = productKeyset.Apply(query, page)
+	if err != nil {
+		return nil, pagination.Result{}, err
+	}
+	var products []*model.Product
+	if err = query.Find(&products).Error; err != nil {
+		zap.L().Error("查询商品列表失败", zap.Int64("merchant_id", merchantID), zap.Error(err))
+		return nil, pagination.Result{}, utils.NewDBError("查询商品失败：" + err.Error())
 	}
-	return products, total, nil
+	products, next := pagination.Trim(products, page.PageSize, func(p *model.Product) (time.Time, int64) {
+		return p.CreatedAt, p.ProductID
+	})
+	return products, pagination.Result{Total: total, NextCursor: next}, nil
 }
 
 func (p *productRepo) GetProductByID(ctx context.Context, productID int64) (*model.Product, error) {
@@ -104,8 +150,8 @@ func (p *productRepo) GetProductByID(ctx context.Context, productID int64) (*mod
 	return product, nil
 }
 
-// DeductStock 扣减库存
-func (p *productRepo) DeductStock(ctx context.Context, productID int64, num int32) error {
+// DeductStock 扣减库存（指定规格时同时扣减规格库存，商品库存始终为各规格库存之和）
+func (p *productRepo) DeductStock(ctx context.Context, productID, skuID int64, num int32) (bool, error) {
 	tx := db.Mysql.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -117,37 +163,302 @@ func (p *productRepo) DeductStock(ctx context.Context, productID int64, num int3
 	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("product_id = ?", productID).First(&product).Error; err != nil {
 		tx.Rollback()
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return utils.NewBizError("商品不存在")
+			return false, utils.NewBizError("商品不存在")
+		}
+		return false, utils.NewDBError("扣减库存失败" + err.Error())
+	}
+	if product.HasSku && skuID == 0 {
+		tx.Rollback()
+		return false, utils.NewBizError("请选择商品规格")
+	}
+	// 秒杀未结束时库存以Redis为准（Redis数据尚未预热或已丢失才会走到这里），拒绝按商品表扣减避免超卖
+	if inSale, err := hasUnfinishedFlashSale(tx, productID); err != nil {
+		tx.Rollback()
+		zap.L().Error("查询进行中秒杀失败", zap.Int64("product_id", productID), zap.Error(err))
+		return false, utils.NewDBError("扣减库存失败：" + err.Error())
+	} else if inSale {
+		tx.Rollback()
+		return false, utils.NewBizError("商品秒杀库存同步中，请稍后再试")
+	}
+	if skuID > 0 {
+		var sku model.ProductSku
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("sku_id = ? AND product_id = ?", skuID, productID).First(&sku).Error; err != nil {
+			tx.Rollback()
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return false, utils.NewBizError("商品规格不存在")
+			}
+			return false, utils.NewDBError("扣减库存失败" + err.Error())
+		}
+		if sku.Stock < num {
+			tx.Rollback()
+			return false, utils.NewBizError("库存不足")
+		}
+		if err := tx.Model(&sku).UpdateColumn("stock", gorm.Expr("stock - ?", num)).Error; err != nil {
+			tx.Rollback()
+			zap.L().Error("扣减规格库存失败", zap.Int64("sku_id", skuID), zap.Int32("num", num), zap.Error(err))
+			return false, utils.NewDBError("扣减库存失败：" + err.Error())
 		}
-		return utils.NewDBError("扣减库存失败" + err.Error())
 	}
 	//校验库存
 	if product.Stock < num {
 		tx.Rollback()
-		return utils.NewBizError("库存不足")
+		return false, utils.NewBizError("库存不足")
 	}
+	wasSoldOut := product.IsSoldOut
 	product.Stock -= num
+	product.IsSoldOut = product.Stock <= 0
 	if err := tx.Save(&product).Error; err != nil {
 		tx.Rollback()
 		zap.L().Error("扣减库存失败", zap.Int64("product_id", productID), zap.Int32("num", num), zap.Error(err))
-		return utils.NewDBError("扣减库存失败：" + err.Error())
+		return false, utils.NewDBError("扣减库存失败：" + err.Error())
 	}
 
 	if err := tx.Commit().Error; err != nil {
 		tx.Rollback()
-		return utils.NewDBError("扣减库存失败：" + err.Error())
+		return false, utils.NewDBError("扣减库存失败：" + err.Error())
+	}
+	return wasSoldOut != product.IsSoldOut, nil
+}
+
+// RestoreStock 事务写入恢复流水并恢复库存（流水号为空不去重；规格已删除时不再恢复，避免商品库存与各规格库存之和不一致）
+func (p *productRepo) RestoreStock(ctx context.Context, log *model.StockRestoreLog) (bool, bool, error) {
+	productID, skuID, num := log.ProductID, log.SkuID, log.Num
+	applied, soldOutChanged := false, false
+	err := db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if log.RestoreNo != "" {
+			res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(log)
+			if res.Error != nil {
+				zap.L().Error("写入库存恢复流水失败", zap.Any("log", log), zap.Error(res.Error))
+				return utils.NewDBError("恢复库存失败：" + res.Error.Error())
+			}
+			if res.RowsAffected == 0 {
+				return nil
+			}
+		}
+		applied = true
+		// 与DeductStock保持相同加锁顺序（先商品后规格），避免并发扣减/恢复同一规格时死锁；商品不存在时交由下方更新判断
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("product_id").Where("product_id = ?", productID).
+			Take(&model.Product{}).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			zap.L().Error("锁定商品失败", zap.Int64("product_id", productID), zap.Error(err))
+			return utils.NewDBError("恢复库存失败：" + err.Error())
+		}
+		// 秒杀未结束时库存以Redis为准，由调用方重试至秒杀结束
+		if inSale, err := hasUnfinishedFlashSale(tx, productID); err != nil {
+			zap.L().Error("查询进行中秒杀失败", zap.Int64("product_id", productID), zap.Error(err))
+			return utils.NewDBError("恢复库存失败：" + err.Error())
+		} else if inSale {
+			return utils.NewSystemError("商品秒杀库存同步中，请稍后重试")
+		}
+		if skuID > 0 {
+			result := tx.Model(&model.ProductSku{}).Where("sku_id = ? AND product_id = ?", skuID, productID).
+				UpdateColumn("stock", gorm.Expr("stock + ?", num))
+			if result.Error != nil {
+				zap.L().Error("恢复规格库存失败", zap.Int64("sku_id", skuID), zap.Int32("num", num), zap.Error(result.Error))
+				return utils.NewDBError("恢复库存失败：" + result.Error.Error())
+			}
+			if result.RowsAffected == 0 {
+				zap.L().Warn("商品规格已删除，跳过恢复库存", zap.Int64("product_id", productID), zap.Int64("sku_id", skuID), zap.Int32("num", num))
+				return nil
+			}
+		}
+		result := tx.Model(&model.Product{}).Where("product_id = ?", productID).Update("stock", gorm.Expr("stock + ?", num))
+		if result.Error != nil {
+			zap.L().Error("恢复库存失败", zap.Int64("product_id", productID), zap.Int32("num", num), zap.Error(result.Error))
+			return utils.NewDBError("恢复库存失败：" + result.Error.Error())
+		}
+		if result.RowsAffected == 0 {
+			return utils.NewBizError("商品不存在")
+		}
+		// 按恢复后的库存同步售罄状态（MySQL按实际变更行数返回，影响行数即售罄状态是否变化）
+		result = tx.Model(&model.Product{}).Where("product_id = ?", productID).UpdateColumn("is_sold_out", gorm.Expr("stock <= 0"))
+		if result.Error != nil {
+			zap.L().Error("同步商品售罄状态失败", zap.Int64("product_id", productID), zap.Error(result.Error))
+			return utils.NewDBError("恢复库存失败：" + result.Error.Error())
+		}
+		soldOutChanged = result.RowsAffected > 0
+		return nil
+	})
+	if err != nil {
+		return false, false, err
+	}
+	return applied, soldOutChanged, nil
+}
+
+func (p *productRepo) ClaimStockRestore(ctx context.Context, log *model.StockRestoreLog) (bool, error) {
+	res := db.Mysql.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(log)
+	if res.Error != nil {
+		zap.L().Error("写入库存恢复流水失败", zap.Any("log", log), zap.Error(res.Error))
+		return false, utils.NewDBError("恢复库存失败：" + res.Error.Error())
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (p *productRepo) ReleaseStockRestore(ctx context.Context, restoreNo string) error {
+	if err := db.Mysql.WithContext(ctx).Where("restore_no = ?", restoreNo).Delete(&model.StockRestoreLog{}).Error; err != nil {
+		zap.L().Error("删除库存恢复流水失败", zap.String("restore_no", restoreNo), zap.Error(err))
+		return utils.NewDBError("删除库存恢复流水失败：" + err.Error())
 	}
 	return nil
 }
 
-func (p *productRepo) RestoreStock(ctx context.Context, productID int64, num int32) error {
-	tx := db.Mysql.WithContext(ctx).Model(&model.Product{}).Where("product_id = ?", productID).Update("stock", gorm.Expr("stock + ?", num))
+// UpdateScore 更新商品评分（消息可能乱序或重复，仅当评分次数大于当前值时更新）
+func (p *productRepo) UpdateScore(ctx context.Context, productID int64, score float64, ratingCount int64) error {
+	tx := db.Mysql.WithContext(ctx).Model(&model.Product{}).
+		Where("product_id = ? AND rating_count < ?", productID, ratingCount).
+		UpdateColumns(map[string]interface{}{
+			"score":        score,
+			"rating_count": ratingCount,
+		})
 	if tx.Error != nil {
-		zap.L().Error("恢复库存失败", zap.Int64("product_id", productID), zap.Int32("num", num), zap.Error(tx.Error))
-		return utils.NewDBError("恢复库存失败：" + tx.Error.Error())
+		zap.L().Error("更新商品评分失败", zap.Int64("product_id", productID), zap.Float64("score", score), zap.Error(tx.Error))
+		return utils.NewDBError("更新商品评分失败：" + tx.Error.Error())
+	}
+	return nil
+}
+
+func (p *productRepo) SetAvailability(ctx context.Context, product *model.Product) error {
+	tx := db.Mysql.WithContext(ctx).Model(&model.Product{}).
+		Where("product_id = ? AND merchant_id = ?", product.ProductID, product.MerchantID).
+		Updates(map[string]interface{}{
+			"suspended":       product.Suspended,
+			"available_hours": product.AvailableHours,
+		})
+	if tx.Error != nil {
+		zap.L().Error("更新商品可售状态失败", zap.Any("product", product), zap.Error(tx.Error))
+		return utils.NewDBError("更新商品可售状态失败：" + tx.Error.Error())
+	}
+	if tx.RowsAffected == 0 {
+		return utils.NewBizError("商品不存在或无权限更新")
+	}
+	return nil
+}
+
+func (p *productRepo) SetDailyRestock(ctx context.Context, product *model.Product) error {
+	tx := db.Mysql.WithContext(ctx).Model(&model.Product{}).
+		Where("product_id = ? AND merchant_id = ? AND has_sku = ?", product.ProductID, product.MerchantID, false).
+		Updates(map[string]interface{}{
+			"daily_stock":       product.DailyStock,
+			"restock_time":      product.RestockTime,
+			"last_restock_date": product.LastRestockDate,
+		})
+	if tx.Error != nil {
+		zap.L().Error("更新每日补货配置失败", zap.Any("product", product), zap.Error(tx.Error))
+		return utils.NewDBError("更新每日补货配置失败：" + tx.Error.Error())
 	}
 	if tx.RowsAffected == 0 {
-		return utils.NewBizError("商品不存在")
+		return utils.NewBizError("商品不存在或无权限更新")
+	}
+	return nil
+}
+
+// restockDue 已到补货时间且今日未补货的单规格商品（秒杀中、结算中的商品库存以Redis及流水为准，跳过）
+func restockDue(tx *gorm.DB, date, clock string) *gorm.DB {
+	return tx.Where("daily_stock > 0 AND has_sku = ? AND restock_time <= ? AND last_restock_date < ?", false, clock, date).
+		Where("NOT EXISTS (SELECT 1 FROM t_flash_sale AS f WHERE f.product_id = t_product.product_id AND f.status IN ?)", model.FlashSaleUnfinishedStatuses)
+}
+
+// ListRestockDue 查询待补货商品（date为YYYY-MM-DD，clock为HH:MM）
+func (p *productRepo) ListRestockDue(ctx context.Context, date, clock string) ([]*model.Product, error) {
+	var products []*model.Product
+	err := restockDue(db.Mysql.WithContext(ctx).Model(&model.Product{}), date, clock).
+		Select("product_id, merchant_id").Find(&products).Error
+	if err != nil {
+		zap.L().Error("查询待补货商品失败", zap.String("date", date), zap.String("clock", clock), zap.Error(err))
+		return nil, utils.NewDBError("查询待补货商品失败：" + err.Error())
+	}
+	return products, nil
+}
+
+// Restock 补货（条件更新保证多实例并发执行时同一天只补一次）
+func (p *productRepo) Restock(ctx context.Context, productID int64, date, clock string) (bool, error) {
+	tx := restockDue(db.Mysql.WithContext(ctx).Model(&model.Product{}), date, clock).
+		Where("product_id = ?", productID).
+		Updates(map[string]interface{}{
+			"stock":             gorm.Expr("daily_stock"),
+			"is_sold_out":       false,
+			"last_restock_date": date,
+		})
+	if tx.Error != nil {
+		zap.L().Error("商品补货失败", zap.Int64("product_id", productID), zap.Error(tx.Error))
+		return false, utils.NewDBError("商品补货失败：" + tx.Error.Error())
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+// ListByExternalCodes 按外部编码查询（编码在商家内唯一，每个编码至多一个商品）
+func (p *productRepo) ListByExternalCodes(ctx context.Context, merchantID int64, codes []string) ([]*model.Product, error) {
+	var products []*model.Product
+	if len(codes) == 0 {
+		return products, nil
+	}
+	err := db.Mysql.WithContext(ctx).Where("merchant_id = ? AND external_code_key IN ?", merchantID, codes).
+		Find(&products).Error
+	if err != nil {
+		zap.L().Error("按外部编码查询商品失败", zap.Int64("merchant_id", merchantID), zap.Int("codes", len(codes)), zap.Error(err))
+		return nil, utils.NewDBError("查询商品失败：" + err.Error())
+	}
+	return products, nil
+}
+
+func (p *productRepo) ListProductsByIDs(ctx context.Context, merchantID int64, productIDs []int64) ([]*model.Product, error) {
+	var products []*model.Product
+	if len(productIDs) == 0 {
+		return products, nil
+	}
+	err := db.Mysql.WithContext(ctx).Where("merchant_id = ? AND product_id IN ?", merchantID, productIDs).Find(&products).Error
+	if err != nil {
+		zap.L().Error("按ID批量查询商品失败", zap.Int64("merchant_id", merchantID), zap.Int("count", len(productIDs)), zap.Error(err))
+		return nil, utils.NewDBError("查询商品失败：" + err.Error())
+	}
+	return products, nil
+}
+
+func (p *productRepo) ListProductsAfter(ctx context.Context, merchantID, afterID int64, limit int) ([]*model.Product, error) {
+	var products []*model.Product
+	err := db.Mysql.WithContext(ctx).Where("merchant_id = ? AND product_id > ?", merchantID, afterID).
+		Order("product_id").Limit(limit).Find(&products).Error
+	if err != nil {
+		zap.L().Error("分批查询商家商品失败", zap.Int64("merchant_id", merchantID), zap.Int64("after_id", afterID), zap.Error(err))
+		return nil, utils.NewDBError("查询商品失败：" + err.Error())
+	}
+	return products, nil
+}
+
+// BulkProduct 批量写入的商品，Omit为更新已有商品时不修改的列（如导入时未提供的库存、打包费）
+type BulkProduct struct {
+	Product *model.Product
+	Omit    []string
+}
+
+// BulkUpsertProducts 事务批量写入，任一商品失败时整体回滚
+// 新建商品按（商家ID，外部编码）唯一键upsert：并发导入已创建同编码商品时更新该商品
+func (p *productRepo) BulkUpsertProducts(ctx context.Context, creates, updates []*BulkProduct) error {
+	err := db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, bp := range creates {
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "merchant_id"}, {Name: "external_code_key"}},
+				DoUpdates: clause.Assignments(productUpdates(bp.Product, bp.Omit...)),
+			}).Create(bp.Product).Error
+			if err != nil {
+				zap.L().Error("批量创建商品失败", zap.Any("product", bp.Product), zap.Error(err))
+				return err
+			}
+		}
+		for _, bp := range updates {
+			err := tx.Model(&model.Product{}).Where("product_id = ? AND merchant_id = ?", bp.Product.ProductID, bp.Product.MerchantID).
+				Updates(productUpdates(bp.Product, bp.Omit...)).Error
+			if err != nil {
+				zap.L().Error("批量更新商品失败", zap.Any("product", bp.Product), zap.Error(err))
+				return err
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return utils.NewBizError(msgExternalCodeConflict)
+	}
+	if err != nil {
+		return utils.NewDBError("批量导入商品失败：" + err.Error())
 	}
 	return nil
 }
@@ -0,0 +1,173 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SpecRepo 商品规格、属性数据访问接口
+type SpecRepo interface {
+	SaveProductSpecs(ctx context.Context, productID int64, skus []*model.ProductSku, groups []*model.ProductOptionGroup) error // 覆盖保存商品规格及属性
+	ListSpecs(ctx context.Context, productIDs []int64) (map[int64][]*model.ProductSku, map[int64][]*model.ProductOptionGroup, error)
+}
+
+// specRepo 实现
+type specRepo struct{}
+
+// NewSpecRepo 创建实例
+func NewSpecRepo() SpecRepo {
+	return &specRepo{}
+}
+
+// SaveProductSpecs 事务保存商品规格及属性：
+// 规格按sku_id更新（sku_id为0新建，未传入的已有规格删除），保证购物车、订单引用的规格ID稳定；
+// 属性组及选项整体覆盖；有规格时商品价格取最低规格价、库存取各规格库存之和
+func (r *specRepo) SaveProductSpecs(ctx context.Context, productID int64, skus []*model.ProductSku, groups []*model.ProductOptionGroup) error {
+	return db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. 锁定商品，避免与扣减库存并发
+		var product model.Product
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("product_id = ?", productID).First(&product).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return utils.NewBizError("商品不存在")
+			}
+			zap.L().Error("锁定商品失败", zap.Int64("product_id", productID), zap.Error(err))
+			return utils.NewDBError("保存商品规格失败：" + err.Error())
+		}
+
+		// 2. 保存规格
+		var existing []*model.ProductSku
+		if err := tx.Where("product_id = ?", productID).Find(&existing).Error; err != nil {
+			zap.L().Error("查询商品规格失败", zap.Int64("product_id", productID), zap.Error(err))
+			return utils.NewDBError("保存商品规格失败：" + err.Error())
+		}
+		existingIDs := make(map[int64]bool, len(existing))
+		for _, sku := range existing {
+			existingIDs[sku.SkuID] = true
+		}
+		keepIDs := make([]int64, 0, len(skus))
+		var minPrice float64
+		var totalStock int32
+		for i, sku := range skus {
+			sku.ProductID = productID
+			if sku.SkuID > 0 {
+				if !existingIDs[sku.SkuID] {
+					return utils.NewBizError("规格" + strconv.FormatInt(sku.SkuID, 10) + "不存在")
+				}
+				err := tx.Model(&model.ProductSku{}).Where("sku_id = ?", sku.SkuID).Updates(map[string]interface{}{
+					"name":       sku.Name,
+					"price":      sku.Price,
+					"stock":      sku.Stock,
+					"sort_order": sku.SortOrder,
+				}).Error
+				if err != nil {
+					zap.L().Error("更新商品规格失败", zap.Any("sku", sku), zap.Error(err))
+					return utils.NewDBError("保存商品规格失败：" + err.Error())
+				}
+			} else if err := tx.Create(sku).Error; err != nil {
+				zap.L().Error("创建商品规格失败", zap.Any("sku", sku), zap.Error(err))
+				return utils.NewDBError("保存商品规格失败：" + err.Error())
+			}
+			keepIDs = append(keepIDs, sku.SkuID)
+			if i == 0 || sku.Price < minPrice {
+				minPrice = sku.Price
+			}
+			totalStock += sku.Stock
+		}
+		deleteQuery := tx.Where("product_id = ?", productID)
+		if len(keepIDs) > 0 {
+			deleteQuery = deleteQuery.Where("sku_id NOT IN ?", keepIDs)
+		}
+		if err := deleteQuery.Delete(&model.ProductSku{}).Error; err != nil {
+			zap.L().Error("删除商品规格失败", zap.Int64("product_id", productID), zap.Error(err))
+			return utils.NewDBError("保存商品规格失败：" + err.Error())
+		}
+
+		// 3. 覆盖属性组及选项
+		if err := tx.Where("product_id = ?", productID).Delete(&model.ProductOption{}).Error; err != nil {
+			zap.L().Error("删除商品属性选项失败", zap.Int64("product_id", productID), zap.Error(err))
+			return utils.NewDBError("保存商品属性失败：" + err.Error())
+		}
+		if err := tx.Where("product_id = ?", productID).Delete(&model.ProductOptionGroup{}).Error; err != nil {
+			zap.L().Error("删除商品属性组失败", zap.Int64("product_id", productID), zap.Error(err))
+			return utils.NewDBError("保存商品属性失败：" + err.Error())
+		}
+		for _, group := range groups {
+			group.ProductID = productID
+			if err := tx.Create(group).Error; err != nil {
+				zap.L().Error("创建商品属性组失败", zap.Any("group", group), zap.Error(err))
+				return utils.NewDBError("保存商品属性失败：" + err.Error())
+			}
+			for _, option := range group.Options {
+				option.GroupID = group.GroupID
+				option.ProductID = productID
+			}
+			if len(group.Options) > 0 {
+				if err := tx.Create(&group.Options).Error; err != nil {
+					zap.L().Error("创建商品属性选项失败", zap.Int64("group_id", group.GroupID), zap.Error(err))
+					return utils.NewDBError("保存商品属性失败：" + err.Error())
+				}
+			}
+		}
+
+		// 4. 同步商品多规格标记、展示价格及总库存（无规格时保留商品原价格、库存）
+		updates := map[string]interface{}{"has_sku": len(skus) > 0}
+		if len(skus) > 0 {
+			updates["price"] = minPrice
+			updates["stock"] = totalStock
+			updates["is_sold_out"] = totalStock <= 0
+		}
+		if err := tx.Model(&model.Product{}).Where("product_id = ?", productID).UpdateColumns(updates).Error; err != nil {
+			zap.L().Error("同步商品规格信息失败", zap.Int64("product_id", productID), zap.Error(err))
+			return utils.NewDBError("保存商品规格失败：" + err.Error())
+		}
+		return nil
+	})
+}
+
+// ListSpecs 批量查询商品规格及属性（按商品ID分组，组内按排序升序）
+func (r *specRepo) ListSpecs(ctx context.Context, productIDs []int64) (map[int64][]*model.ProductSku, map[int64][]*model.ProductOptionGroup, error) {
+	skuMap := make(map[int64][]*model.ProductSku)
+	groupMap := make(map[int64][]*model.ProductOptionGroup)
+	if len(productIDs) == 0 {
+		return skuMap, groupMap, nil
+	}
+
+	var skus []*model.ProductSku
+	if err := db.Mysql.WithContext(ctx).Where("product_id IN ?", productIDs).Order("sort_order, sku_id").Find(&skus).Error; err != nil {
+		zap.L().Error("查询商品规格失败", zap.Int64s("product_ids", productIDs), zap.Error(err))
+		return nil, nil, utils.NewDBError("查询商品规格失败：" + err.Error())
+	}
+	var groups []*model.ProductOptionGroup
+	if err := db.Mysql.WithContext(ctx).Where("product_id IN ?", productIDs).Order("sort_order, group_id").Find(&groups).Error; err != nil {
+		zap.L().Error("查询商品属性组失败", zap.Int64s("product_ids", productIDs), zap.Error(err))
+		return nil, nil, utils.NewDBError("查询商品属性失败：" + err.Error())
+	}
+	var options []*model.ProductOption
+	if err := db.Mysql.WithContext(ctx).Where("product_id IN ?", productIDs).Order("sort_order, option_id").Find(&options).Error; err != nil {
+		zap.L().Error("查询商品属性选项失败", zap.Int64s("product_ids", productIDs), zap.Error(err))
+		return nil, nil, utils.NewDBError("查询商品属性失败：" + err.Error())
+	}
+
+	for _, sku := range skus {
+		skuMap[sku.ProductID] = append(skuMap[sku.ProductID], sku)
+	}
+	groupByID := make(map[int64]*model.ProductOptionGroup, len(groups))
+	for _, group := range groups {
+		groupByID[group.GroupID] = group
+		groupMap[group.ProductID] = append(groupMap[group.ProductID], group)
+	}
+	for _, option := range options {
+		if group, ok := groupByID[option.GroupID]; ok {
+			group.Options = append(group.Options, option)
+		}
+	}
+	return skuMap, groupMap, nil
+}
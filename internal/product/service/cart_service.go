@@ -0,0 +1,317 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	orderProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/order/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/client"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// maxCartQuantity 购物车单个商品最大数量
+const maxCartQuantity = 99
+
+type AddCartItemParam struct {
+	UserID     int64 `validate:"required,gt=0"`
+	MerchantID int64 `validate:"required,gt=0"`
+	ProductID  int64 `validate:"required,gt=0"`
+	Quantity   int32 `validate:"required,gt=0,lte=99"`
+}
+
+type UpdateCartItemParam struct {
+	UserID     int64 `validate:"required,gt=0"`
+	MerchantID int64 `validate:"required,gt=0"`
+	ProductID  int64 `validate:"required,gt=0"`
+	Quantity   int32 `validate:"gte=0,lte=99"` // 0表示移除
+}
+
+type RemoveCartItemParam struct {
+	UserID     int64 `validate:"required,gt=0"`
+	MerchantID int64 `validate:"required,gt=0"`
+	ProductID  int64 `validate:"required,gt=0"`
+}
+
+type CartParam struct {
+	UserID     int64 `validate:"required,gt=0"`
+	MerchantID int64 `validate:"required,gt=0"`
+}
+
+type CheckoutCartParam struct {
+	UserID             int64   `validate:"required,gt=0"`
+	MerchantID         int64   `validate:"required,gt=0"`
+	AddressID          int64   `validate:"required,gt=0"`
+	ExpectDeliveryTime string  `validate:"omitempty"`
+	CouponIDs          []int64 `validate:"max=2,dive,gt=0"`
+}
+
+type CartItemResult struct {
+	ProductID         int64   `json:"product_id"`
+	Name              string  `json:"name"`
+	ImageURL          string  `json:"image_url"`
+	Price             float64 `json:"price"`
+	PackingFee        float64 `json:"packing_fee"`
+	Quantity          int32   `json:"quantity"`
+	TotalPrice        float64 `json:"total_price"`
+	Available         bool    `json:"available"`
+	UnavailableReason string  `json:"unavailable_reason"`
+}
+
+type CartResult struct {
+	Items         []CartItemResult `json:"items"`
+	GoodsAmount   float64          `json:"goods_amount"`
+	PackingFee    float64          `json:"packing_fee"`
+	CheckoutReady bool             `json:"checkout_ready"`
+}
+
+type CheckoutCartResult struct {
+	OrderID        int64   `json:"order_id"`
+	OrderNo        string  `json:"order_no"`
+	GoodsAmount    float64 `json:"goods_amount"`
+	PackingFee     float64 `json:"packing_fee"`
+	DeliveryFee    float64 `json:"delivery_fee"`
+	DiscountAmount float64 `json:"discount_amount"`
+	TotalAmount    float64 `json:"total_amount"`
+}
+
+// CartService 购物车业务逻辑接口
+type CartService interface {
+	AddCartItem(ctx context.Context, param AddCartItemParam) error
+	UpdateCartItem(ctx context.Context, param UpdateCartItemParam) error
+	RemoveCartItem(ctx context.Context, param RemoveCartItemParam) error
+	ListCart(ctx context.Context, param CartParam) (CartResult, error)
+	ClearCart(ctx context.Context, param CartParam) error
+	CheckoutCart(ctx context.Context, param CheckoutCartParam) (CheckoutCartResult, error)
+}
+
+type cartService struct {
+	cartRepo    repo.CartRepo
+	productRepo repo.ProductRepo
+	validate    *validator.Validate
+}
+
+// NewCartService 创建实例
+func NewCartService(cartRepo repo.CartRepo, productRepo repo.ProductRepo) CartService {
+	return &cartService{
+		cartRepo:    cartRepo,
+		productRepo: productRepo,
+		validate:    validator.New(),
+	}
+}
+
+// checkProduct 校验商品归属商家且可购买指定数量
+func (s *cartService) checkProduct(ctx context.Context, merchantID, productID int64, quantity int32) (*model.Product, error) {
+	product, err := s.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if reason := unavailableReason(product, merchantID, quantity); reason != "" {
+		return nil, utils.NewBizError(reason)
+	}
+	return product, nil
+}
+
+// unavailableReason 返回商品不可购买的原因，可购买时返回空字符串
+func unavailableReason(product *model.Product, merchantID int64, quantity int32) string {
+	switch {
+	case product.MerchantID != merchantID:
+		return "商品不属于该商家"
+	case product.HasSku:
+		return "多规格商品请选择规格后直接下单" // 购物车暂不区分规格
+	}
+	if reason := unavailableReasonAt(product.IsSoldOut || product.Stock <= 0, product.Suspended, product.AvailableHours, time.Now()); reason != "" {
+		return reason
+	}
+	if product.Stock < quantity {
+		return fmt.Sprintf("库存不足，剩余%d件", product.Stock)
+	}
+	return ""
+}
+
+func (s *cartService) AddCartItem(ctx context.Context, param AddCartItemParam) error {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("加入购物车参数校验失败", zap.Error(err))
+		return utils.NewParamError("加入购物车参数校验失败：" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "user", param.UserID); err != nil {
+		return err
+	}
+	current, err := s.cartRepo.GetItem(ctx, param.UserID, param.MerchantID, param.ProductID)
+	if err != nil {
+		return err
+	}
+	quantity := current + param.Quantity
+	if quantity > maxCartQuantity {
+		return utils.NewBizError(fmt.Sprintf("单个商品最多购买%d件", maxCartQuantity))
+	}
+	if _, err = s.checkProduct(ctx, param.MerchantID, param.ProductID, quantity); err != nil {
+		return err
+	}
+	return s.cartRepo.SetItem(ctx, param.UserID, param.MerchantID, param.ProductID, quantity)
+}
+
+func (s *cartService) UpdateCartItem(ctx context.Context, param UpdateCartItemParam) error {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("修改购物车参数校验失败", zap.Error(err))
+		return utils.NewParamError("修改购物车参数校验失败：" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "user", param.UserID); err != nil {
+		return err
+	}
+	if param.Quantity == 0 {
+		return s.cartRepo.RemoveItem(ctx, param.UserID, param.MerchantID, param.ProductID)
+	}
+	if _, err := s.checkProduct(ctx, param.MerchantID, param.ProductID, param.Quantity); err != nil {
+		return err
+	}
+	return s.cartRepo.SetItem(ctx, param.UserID, param.MerchantID, param.ProductID, param.Quantity)
+}
+
+func (s *cartService) RemoveCartItem(ctx context.Context, param RemoveCartItemParam) error {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("移除购物车商品参数校验失败", zap.Error(err))
+		return utils.NewParamError("移除购物车商品参数校验失败：" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "user", param.UserID); err != nil {
+		return err
+	}
+	return s.cartRepo.RemoveItem(ctx, param.UserID, param.MerchantID, param.ProductID)
+}
+
+func (s *cartService) ListCart(ctx context.Context, param CartParam) (CartResult, error) {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("查询购物车参数校验失败", zap.Error(err))
+		return CartResult{}, utils.NewParamError("查询购物车参数校验失败：" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "user", param.UserID); err != nil {
+		return CartResult{}, err
+	}
+	return s.loadCart(ctx, param.UserID, param.MerchantID)
+}
+
+// loadCart 读取购物车并按商品当前价格、库存逐项校验
+func (s *cartService) loadCart(ctx context.Context, userID, merchantID int64) (CartResult, error) {
+	items, err := s.cartRepo.GetItems(ctx, userID, merchantID)
+	if err != nil {
+		return CartResult{}, err
+	}
+	productIDs := make([]int64, 0, len(items))
+	for productID := range items {
+		productIDs = append(productIDs, productID)
+	}
+	sort.Slice(productIDs, func(i, j int) bool { return productIDs[i] < productIDs[j] })
+
+	result := CartResult{Items: make([]CartItemResult, 0, len(productIDs)), CheckoutReady: len(productIDs) > 0}
+	for _, productID := range productIDs {
+		item := CartItemResult{ProductID: productID, Quantity: items[productID]}
+		product, err := s.productRepo.GetProductByID(ctx, productID)
+		if err != nil {
+			var appErr *utils.AppError
+			if !errors.As(err, &appErr) || appErr.Code != utils.ErrCodeBiz {
+				return CartResult{}, err
+			}
+			// 商品已删除，保留在购物车中提示用户
+			item.UnavailableReason = "商品已下架"
+		} else {
+			item.Name = product.Name
+			item.ImageURL = product.ImageURL
+			item.Price = product.Price
+			item.PackingFee = product.PackingFee
+			item.TotalPrice = utils.RoundMoney(product.Price * float64(item.Quantity))
+			item.UnavailableReason = unavailableReason(product, merchantID, item.Quantity)
+		}
+		item.Available = item.UnavailableReason == ""
+		if item.Available {
+			result.GoodsAmount += item.TotalPrice
+			result.PackingFee += item.PackingFee * float64(item.Quantity)
+		} else {
+			result.CheckoutReady = false
+		}
+		result.Items = append(result.Items, item)
+	}
+	result.GoodsAmount = utils.RoundMoney(result.GoodsAmount)
+	result.PackingFee = utils.RoundMoney(result.PackingFee)
+	return result, nil
+}
+
+func (s *cartService) ClearCart(ctx context.Context, param CartParam) error {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("清空购物车参数校验失败", zap.Error(err))
+		return utils.NewParamError("清空购物车参数校验失败：" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "user", param.UserID); err != nil {
+		return err
+	}
+	return s.cartRepo.Clear(ctx, param.UserID, param.MerchantID)
+}
+
+// CheckoutCart 按当前价格将购物车转为下单请求，下单成功后清空购物车
+func (s *cartService) CheckoutCart(ctx context.Context, param CheckoutCartParam) (CheckoutCartResult, error) {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("购物车结算参数校验失败", zap.Error(err))
+		return CheckoutCartResult{}, utils.NewParamError("购物车结算参数校验失败：" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "user", param.UserID); err != nil {
+		return CheckoutCartResult{}, err
+	}
+	cart, err := s.loadCart(ctx, param.UserID, param.MerchantID)
+	if err != nil {
+		return CheckoutCartResult{}, err
+	}
+	if len(cart.Items) == 0 {
+		return CheckoutCartResult{}, utils.NewBizError("购物车为空")
+	}
+	orderItems := make([]*orderProto.OrderItem, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		if !item.Available {
+			return CheckoutCartResult{}, utils.NewBizError(fmt.Sprintf("商品【%s】%s，请调整购物车后再结算", item.Name, item.UnavailableReason))
+		}
+		orderItems = append(orderItems, &orderProto.OrderItem{
+			ProductId:   item.ProductID,
+			ProductName: item.Name,
+			Price:       float32(item.Price),
+			Quantity:    item.Quantity,
+			TotalPrice:  float32(item.TotalPrice),
+		})
+	}
+
+	resp, err := client.OrderClient.CreateOrder(ctx, &orderProto.CreateOrderRequest{
+		UserId:             param.UserID,
+		MerchantId:         param.MerchantID,
+		Items:              orderItems,
+		TotalAmount:        float32(cart.GoodsAmount),
+		AddressId:          param.AddressID,
+		ExpectDeliveryTime: param.ExpectDeliveryTime,
+		CouponIds:          param.CouponIDs,
+	})
+	if err != nil {
+		zap.L().Error("购物车结算调用订单服务失败", zap.Int64("user_id", param.UserID), zap.Error(err))
+		return CheckoutCartResult{}, utils.NewSystemError("调用订单服务失败")
+	}
+	if resp.Code != utils.ErrCodeSuccess {
+		return CheckoutCartResult{}, utils.NewAppError(int(resp.Code), resp.Msg)
+	}
+
+	// 订单已创建，清空购物车失败不影响下单结果
+	if err = s.cartRepo.Clear(ctx, param.UserID, param.MerchantID); err != nil {
+		zap.L().Warn("结算后清空购物车失败", zap.Int64("user_id", param.UserID), zap.Int64("merchant_id", param.MerchantID), zap.Error(err))
+	}
+
+	result := CheckoutCartResult{OrderID: resp.OrderId, OrderNo: resp.OrderNo}
+	if fee := resp.Fee; fee != nil {
+		result.GoodsAmount = float64(fee.GoodsAmount)
+		result.PackingFee = float64(fee.PackingFee)
+		result.DeliveryFee = float64(fee.DeliveryFee)
+		result.DiscountAmount = float64(fee.DiscountAmount)
+		result.TotalAmount = float64(fee.TotalAmount)
+	}
+	return result, nil
+}
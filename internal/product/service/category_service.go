@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+const (
+	maxMerchantCategories = 50   // 单个商家最多分类数
+	uncategorizedMenuName = "其他" // 未分类商品在菜单中的分组名（分类ID为0）
+)
+
+// 入参结构体
+type CreateCategoryParam struct {
+	MerchantID int64  `validate:"required,gt=0"`
+	Name       string `validate:"required,min=1,max=32"`
+	SortOrder  int32  `validate:"gte=0"`
+}
+
+type UpdateCategoryParam struct {
+	CategoryID int64  `validate:"required,gt=0"`
+	MerchantID int64  `validate:"required,gt=0"`
+	Name       string `validate:"required,min=1,max=32"`
+	SortOrder  int32  `validate:"gte=0"`
+}
+
+type DeleteCategoryParam struct {
+	CategoryID int64 `validate:"required,gt=0"`
+	MerchantID int64 `validate:"required,gt=0"`
+}
+
+type SetProductCategoriesParam struct {
+	ProductID   int64   `validate:"required,gt=0"`
+	MerchantID  int64   `validate:"required,gt=0"`
+	CategoryIDs []int64 `validate:"omitempty,max=10,unique,dive,gt=0"` // 为空表示移出所有分类
+	SortOrder   int32   `validate:"gte=0"`                             // 分类内排序（升序）
+}
+
+// 响应结构体
+type CategoryResult struct {
+	CategoryID int64  `json:"category_id"`
+	MerchantID int64  `json:"merchant_id"`
+	Name       string `json:"name"`
+	SortOrder  int32  `json:"sort_order"`
+}
+
+type MenuCategoryResult struct {
+	CategoryID int64           `json:"category_id"` // 0表示未分类
+	Name       string          `json:"name"`
+	SortOrder  int32           `json:"sort_order"`
+	Products   []ProductResult `json:"products"`
+}
+
+type MerchantMenuResult struct {
+	MerchantID int64                `json:"merchant_id"`
+	Categories []MenuCategoryResult `json:"categories"`
+}
+
+// CategoryService 菜单分类业务逻辑接口
+type CategoryService interface {
+	CreateCategory(ctx context.Context, param CreateCategoryParam) (int64, error)
+	UpdateCategory(ctx context.Context, param UpdateCategoryParam) error
+	DeleteCategory(ctx context.Context, param DeleteCategoryParam) error
+	ListCategories(ctx context.Context, merchantID int64) ([]CategoryResult, error)
+	SetProductCategories(ctx context.Context, param SetProductCategoriesParam) error   // 设置商品所属分类
+	GetMerchantMenu(ctx context.Context, merchantID int64) (MerchantMenuResult, error) // 按分类分组返回整个菜单
+}
+
+// categoryService 实现
+type categoryService struct {
+	categoryRepo repo.CategoryRepo
+	productRepo  repo.ProductRepo
+	specRepo     repo.SpecRepo
+	validate     *validator.Validate
+}
+
+// NewCategoryService 创建实例
+func NewCategoryService(categoryRepo repo.CategoryRepo, productRepo repo.ProductRepo, specRepo repo.SpecRepo) CategoryService {
+	return &categoryService{
+		categoryRepo: categoryRepo,
+		productRepo:  productRepo,
+		specRepo:     specRepo,
+		validate:     validator.New(),
+	}
+}
+
+// CreateCategory 商家创建分类（同一商家分类名不可重复，并发创建由唯一索引兜底）
+func (s *categoryService) CreateCategory(ctx context.Context, param CreateCategoryParam) (int64, error) {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("创建分类参数校验失败", zap.Error(err))
+		return 0, utils.NewParamError("创建分类参数校验失败" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+		return 0, err
+	}
+
+	categories, err := s.categoryRepo.ListCategories(ctx, param.MerchantID)
+	if err != nil {
+		return 0, err
+	}
+	if len(categories) >= maxMerchantCategories {
+		return 0, utils.NewBizError("分类数量已达上限" + strconv.Itoa(maxMerchantCategories))
+	}
+	if findCategoryByName(categories, param.Name, 0) != nil {
+		return 0, utils.NewBizError("分类名称已存在")
+	}
+
+	category := &model.Category{
+		MerchantID: param.MerchantID,
+		Name:       param.Name,
+		SortOrder:  param.SortOrder,
+	}
+	if err = s.categoryRepo.CreateCategory(ctx, category); err != nil {
+		return 0, err
+	}
+	zap.L().Info("创建分类成功", zap.Any("category", category))
+	return category.CategoryID, nil
+}
+
+// UpdateCategory 商家修改分类名称及排序
+func (s *categoryService) UpdateCategory(ctx context.Context, param UpdateCategoryParam) error {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("更新分类参数校验失败", zap.Error(err))
+		return utils.NewParamError("更新分类参数校验失败" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+		return err
+	}
+
+	categories, err := s.categoryRepo.ListCategories(ctx, param.MerchantID)
+	if err != nil {
+		return err
+	}
+	if findCategoryByName(categories, param.Name, param.CategoryID) != nil {
+		return utils.NewBizError("分类名称已存在")
+	}
+
+	category := &model.Category{
+		CategoryID: param.CategoryID,
+		MerchantID: param.MerchantID,
+		Name:       param.Name,
+		SortOrder:  param.SortOrder,
+	}
+	if err = s.categoryRepo.UpdateCategory(ctx, category); err != nil {
+		return err
+	}
+	zap.L().Info("更新分类成功", zap.Any("category", category))
+	kafka.NotifySearchSync(kafka.SearchTargetMerchant, param.MerchantID) // 分类名称冗余在商品索引中
+	return nil
+}
+
+// DeleteCategory 商家删除分类（分类下商品不删除，无其他分类时在菜单中归入"其他"）
+func (s *categoryService) DeleteCategory(ctx context.Context, param DeleteCategoryParam) error {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("删除分类参数校验失败", zap.Error(err))
+		return utils.NewParamError("删除分类参数校验失败" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+		return err
+	}
+	if err := s.categoryRepo.DeleteCategory(ctx, param.CategoryID, param.MerchantID); err != nil {
+		return err
+	}
+	zap.L().Info("删除分类成功", zap.Int64("category_id", param.CategoryID), zap.Int64("merchant_id", param.MerchantID))
+	kafka.NotifySearchSync(kafka.SearchTargetMerchant, param.MerchantID)
+	return nil
+}
+
+// ListCategories 查询商家分类
+func (s *categoryService) ListCategories(ctx context.Context, merchantID int64) ([]CategoryResult, error) {
+	if merchantID <= 0 {
+		return nil, utils.NewParamError("商家ID为空")
+	}
+	categories, err := s.categoryRepo.ListCategories(ctx, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]CategoryResult, 0, len(categories))
+	for _, c := range categories {
+		results = append(results, CategoryResult{
+			CategoryID: c.CategoryID,
+			MerchantID: c.MerchantID,
+			Name:       c.Name,
+			SortOrder:  c.SortOrder,
+		})
+	}
+	return results, nil
+}
+
+// SetProductCategories 设置商品所属分类（覆盖原有分类，商品和分类均需属于该商家）
+func (s *categoryService) SetProductCategories(ctx context.Context, param SetProductCategoriesParam) error {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("设置商品分类参数校验失败", zap.Error(err))
+		return utils.NewParamError("设置商品分类参数校验失败" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+		return err
+	}
+
+	product, err := s.productRepo.GetProductByID(ctx, param.ProductID)
+	if err != nil {
+		return err
+	}
+	if product.MerchantID != param.MerchantID {
+		return utils.NewBizError("商品不存在或无权限更新")
+	}
+	if len(param.CategoryIDs) > 0 {
+		categories, err := s.categoryRepo.ListCategories(ctx, param.MerchantID)
+		if err != nil {
+			return err
+		}
+		owned := make(map[int64]bool, len(categories))
+		for _, c := range categories {
+			owned[c.CategoryID] = true
+		}
+		for _, categoryID := range param.CategoryIDs {
+			if !owned[categoryID] {
+				return utils.NewBizError("分类" + strconv.FormatInt(categoryID, 10) + "不存在")
+			}
+		}
+	}
+
+	if err = s.categoryRepo.SetProductCategories(ctx, param.ProductID, param.CategoryIDs, param.SortOrder); err != nil {
+		return err
+	}
+	zap.L().Info("设置商品分类成功", zap.Int64("product_id", param.ProductID), zap.Int64s("category_ids", param.CategoryIDs))
+	kafka.NotifySearchSync(kafka.SearchTargetMerchant, param.MerchantID) // 商家分类为其商品分类的并集
+	return nil
+}
+
+// GetMerchantMenu 查询商家菜单：分类按排序返回，分类内商品按关联排序返回，未分类商品归入末尾的"其他"
+func (s *categoryService) GetMerchantMenu(ctx context.Context, merchantID int64) (MerchantMenuResult, error) {
+	if merchantID <= 0 {
+		return MerchantMenuResult{}, utils.NewParamError("商家ID为空")
+	}
+	categories, err := s.categoryRepo.ListCategories(ctx, merchantID)
+	if err != nil {
+		return MerchantMenuResult{}, err
+	}
+	products, relations, err := s.categoryRepo.ListMenuProducts(ctx, merchantID)
+	if err != nil {
+		return MerchantMenuResult{}, err
+	}
+
+	productIDs := make([]int64, 0, len(products))
+	for _, p := range products {
+		productIDs = append(productIDs, p.ProductID)
+	}
+	skuMap, groupMap, err := s.specRepo.ListSpecs(ctx, productIDs)
+	if err != nil {
+		return MerchantMenuResult{}, err
+	}
+	now := time.Now()
+	productMap := make(map[int64]ProductResult, len(products))
+	for _, p := range products {
+		result := toProductResult(p)
+		attachSpecs(&result, skuMap[p.ProductID], groupMap[p.ProductID])
+		result.refreshAvailability(now)
+		productMap[p.ProductID] = result
+	}
+
+	grouped := make(map[int64][]ProductResult, len(categories))
+	categorized := make(map[int64]bool, len(products))
+	for _, rel := range relations { // 已按分类内排序
+		p, ok := productMap[rel.ProductID]
+		if !ok {
+			continue // 商品已删除
+		}
+		grouped[rel.CategoryID] = append(grouped[rel.CategoryID], p)
+		categorized[rel.ProductID] = true
+	}
+
+	result := MerchantMenuResult{MerchantID: merchantID, Categories: make([]MenuCategoryResult, 0, len(categories)+1)}
+	for _, c := range categories {
+		result.Categories = append(result.Categories, MenuCategoryResult{
+			CategoryID: c.CategoryID,
+			Name:       c.Name,
+			SortOrder:  c.SortOrder,
+			Products:   grouped[c.CategoryID],
+		})
+	}
+	var uncategorized []ProductResult
+	for _, p := range products {
+		if !categorized[p.ProductID] {
+			uncategorized = append(uncategorized, productMap[p.ProductID])
+		}
+	}
+	if len(uncategorized) > 0 {
+		result.Categories = append(result.Categories, MenuCategoryResult{
+			Name:     uncategorizedMenuName,
+			Products: uncategorized,
+		})
+	}
+	return result, nil
+}
+
+// findCategoryByName 按名称查找分类（excludeID用于更新时排除自身）
+func findCategoryByName(categories []*model.Category, name string, excludeID int64) *model.Category {
+	for _, c := range categories {
+		if c.Name == name && c.CategoryID != excludeID {
+			return c
+		}
+	}
+	return nil
+}
@@ -0,0 +1,419 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// 入参结构体（领域层）
+type StartFlashSaleParam struct {
+	ProductID    int64 `validate:"required,gt=0"`
+	MerchantID   int64 `validate:"required,gt=0"`
+	PerUserLimit int32 `validate:"gte=0,lte=100"` // 0表示不限购
+}
+
+type StopFlashSaleParam struct {
+	ProductID  int64 `validate:"required,gt=0"`
+	MerchantID int64 `validate:"required,gt=0"`
+}
+
+type ReconcileFlashSaleParam struct {
+	ProductID  int64 `validate:"required,gt=0"`
+	MerchantID int64 `validate:"required,gt=0"`
+}
+
+// 响应结构体（领域层）
+type FlashSaleResult struct {
+	FlashSaleID  int64  `json:"flash_sale_id"`
+	ProductID    int64  `json:"product_id"`
+	InitialStock int32  `json:"initial_stock"`
+	PerUserLimit int32  `json:"per_user_limit"`
+	Status       string `json:"status"`
+	FinalStock   int32  `json:"final_stock"`
+}
+
+// FlashReconcileResult 秒杀对账结果
+// Redis库存变化量 = 已落库变化量 + 未落库变化量（消息在途）；商品表库存应等于初始库存 + 已落库变化量
+type FlashReconcileResult struct {
+	FlashSaleID  int64 `json:"flash_sale_id"`
+	ProductID    int64 `json:"product_id"`
+	InitialStock int32 `json:"initial_stock"`
+	RedisStock   int64 `json:"redis_stock"`   // Redis剩余库存（-1表示Redis数据缺失）
+	DBStock      int32 `json:"db_stock"`      // 商品表库存
+	AppliedDelta int64 `json:"applied_delta"` // 已落库的库存变化量
+	PendingDelta int64 `json:"pending_delta"` // 未落库的库存变化量
+	Shortage     int32 `json:"shortage"`      // 落库时商品库存不足的数量（超卖，需人工对账）
+	Consistent   bool  `json:"consistent"`
+}
+
+// StartFlashSale 开启秒杀：以商品当前库存为初始库存预热到Redis，秒杀期间库存扣减走Redis
+// 秒杀未结束期间数据库扣减一律拒绝（Redis数据丢失时不回落数据库，由商家结束秒杀后恢复）
+func (s *productService) StartFlashSale(ctx context.Context, param StartFlashSaleParam) (FlashSaleResult, error) {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("开启秒杀参数校验失败", zap.Error(err))
+		return FlashSaleResult{}, utils.NewParamError("开启秒杀参数校验失败" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+		return FlashSaleResult{}, err
+	}
+
+	sale := &model.FlashSale{
+		ProductID:    param.ProductID,
+		MerchantID:   param.MerchantID,
+		PerUserLimit: param.PerUserLimit,
+	}
+	// 持有商品行锁时预热Redis：秒杀提交后数据库扣减即被拒绝，此前Redis须已就绪
+	preloaded := false
+	err := s.flashSaleRepo.StartFlashSale(ctx, sale, func(sale *model.FlashSale) error {
+		if err := s.flashStockRepo.Preload(ctx, sale); err != nil {
+			return err
+		}
+		preloaded = true
+		return nil
+	})
+	if err != nil {
+		// 预热后事务提交失败，删除Redis数据，商品继续按普通库存售卖
+		if preloaded {
+			if clearErr := s.flashStockRepo.Clear(ctx, sale.ProductID); clearErr != nil {
+				zap.L().Error("开启秒杀失败后删除Redis库存失败", zap.Int64("product_id", sale.ProductID), zap.Error(clearErr))
+			}
+		}
+		return FlashSaleResult{}, err
+	}
+	zap.L().Info("开启秒杀成功", zap.Any("sale", sale))
+	return toFlashSaleResult(sale), nil
+}
+
+// StopFlashSale 结束秒杀：先停止Redis扣减进入结算，在途流水全部落库后才删除Redis库存，之后的扣减回到数据库
+// 结算期间扣减、恢复均被拒绝，避免按未同步的商品表库存超卖
+func (s *productService) StopFlashSale(ctx context.Context, param StopFlashSaleParam) (FlashSaleResult, error) {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("结束秒杀参数校验失败", zap.Error(err))
+		return FlashSaleResult{}, utils.NewParamError("结束秒杀参数校验失败" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+		return FlashSaleResult{}, err
+	}
+	sale, err := s.getMerchantFlashSale(ctx, param.ProductID, param.MerchantID)
+	if err != nil {
+		return FlashSaleResult{}, err
+	}
+
+	// 1. 停止扣减（重复调用时秒杀已在结算中，直接尝试完成结算）
+	if sale.Status == model.FlashSaleStatusActive {
+		finalStock, err := s.flashStockRepo.Settle(ctx, param.ProductID)
+		if err != nil {
+			return FlashSaleResult{}, err
+		}
+		if finalStock < 0 {
+			// Redis数据缺失时无法得知剩余库存，直接结束（缺失期间扣减已被拒绝，在途流水仍会落库）
+			zap.L().Warn("结束秒杀时Redis库存数据缺失", zap.Int64("flash_sale_id", sale.FlashSaleID))
+			if err = s.flashSaleRepo.EndFlashSale(ctx, sale.FlashSaleID, 0); err != nil {
+				return FlashSaleResult{}, err
+			}
+			sale.Status = model.FlashSaleStatusEnded
+			return toFlashSaleResult(sale), nil
+		}
+		if err = s.flashSaleRepo.SettleFlashSale(ctx, sale.FlashSaleID, int32(finalStock)); err != nil {
+			return FlashSaleResult{}, err
+		}
+		sale.Status = model.FlashSaleStatusSettling
+		sale.FinalStock = int32(finalStock)
+	}
+
+	// 2. 在途流水已全部落库则立即结束，否则由流水消费及对账任务完成结算
+	if _, err = s.settleFlashSale(ctx, sale); err != nil {
+		return FlashSaleResult{}, err
+	}
+	zap.L().Info("结束秒杀成功", zap.Any("sale", sale))
+	return toFlashSaleResult(sale), nil
+}
+
+// settleFlashSale 结算中的秒杀在途流水全部落库（已落库变化量等于Redis库存变化量）后删除Redis库存并结束秒杀，返回是否已结束
+func (s *productService) settleFlashSale(ctx context.Context, sale *model.FlashSale) (bool, error) {
+	applied, err := s.flashSaleRepo.SumStockDelta(ctx, sale.FlashSaleID)
+	if err != nil {
+		return false, err
+	}
+	if applied != int64(sale.FinalStock)-int64(sale.InitialStock) {
+		return false, nil
+	}
+	if err = s.flashStockRepo.Clear(ctx, sale.ProductID); err != nil {
+		return false, err
+	}
+	if err = s.flashSaleRepo.EndFlashSale(ctx, sale.FlashSaleID, sale.FinalStock); err != nil {
+		return false, err
+	}
+	sale.Status = model.FlashSaleStatusEnded
+	s.invalidateProduct(ctx, sale.ProductID, sale.MerchantID)
+	zap.L().Info("秒杀结算完成", zap.Int64("flash_sale_id", sale.FlashSaleID), zap.Int32("final_stock", sale.FinalStock))
+	return true, nil
+}
+
+// ReconcileFlashSale 商家查询进行中秒杀的对账结果
+func (s *productService) ReconcileFlashSale(ctx context.Context, param ReconcileFlashSaleParam) (FlashReconcileResult, error) {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("秒杀对账参数校验失败", zap.Error(err))
+		return FlashReconcileResult{}, utils.NewParamError("秒杀对账参数校验失败" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+		return FlashReconcileResult{}, err
+	}
+	sale, err := s.getMerchantFlashSale(ctx, param.ProductID, param.MerchantID)
+	if err != nil {
+		return FlashReconcileResult{}, err
+	}
+	return s.reconcile(ctx, sale)
+}
+
+// ReconcileActiveFlashSales 对账全部进行中的秒杀并完成可结算的秒杀（定时任务调用），不一致时记录告警日志
+func (s *productService) ReconcileActiveFlashSales(ctx context.Context) (int, error) {
+	sales, err := s.flashSaleRepo.ListActiveFlashSales(ctx)
+	if err != nil {
+		return 0, err
+	}
+	inconsistent := 0
+	for _, sale := range sales {
+		if sale.Status == model.FlashSaleStatusSettling {
+			ended, err := s.settleFlashSale(ctx, sale)
+			if err != nil {
+				return inconsistent, err
+			}
+			if ended {
+				continue
+			}
+		}
+		result, err := s.reconcile(ctx, sale)
+		if err != nil {
+			return inconsistent, err
+		}
+		if !result.Consistent {
+			inconsistent++
+			zap.L().Warn("秒杀库存对账不一致", zap.Any("result", result))
+		}
+	}
+	return inconsistent, nil
+}
+
+// reconcile 比对Redis库存、库存流水与商品表库存
+func (s *productService) reconcile(ctx context.Context, sale *model.FlashSale) (FlashReconcileResult, error) {
+	redisStock, ok, err := s.flashStockRepo.GetStock(ctx, sale.ProductID)
+	if err != nil {
+		return FlashReconcileResult{}, err
+	}
+	applied, err := s.flashSaleRepo.SumStockDelta(ctx, sale.FlashSaleID)
+	if err != nil {
+		return FlashReconcileResult{}, err
+	}
+	product, err := s.productRepo.GetProductByID(ctx, sale.ProductID)
+	if err != nil {
+		return FlashReconcileResult{}, err
+	}
+
+	result := FlashReconcileResult{
+		FlashSaleID:  sale.FlashSaleID,
+		ProductID:    sale.ProductID,
+		InitialStock: sale.InitialStock,
+		RedisStock:   -1,
+		DBStock:      product.Stock,
+		AppliedDelta: applied,
+		Shortage:     sale.Shortage,
+	}
+	if ok {
+		result.RedisStock = redisStock
+		result.PendingDelta = redisStock - int64(sale.InitialStock) - applied
+	}
+	// Redis数据缺失（扣减被拒绝，秒杀需结束后恢复）或落库出现超卖时视为不一致
+	result.Consistent = ok && sale.Shortage == 0 && int64(product.Stock) == int64(sale.InitialStock)+applied
+	return result, nil
+}
+
+// getMerchantFlashSale 查询商家商品进行中的秒杀
+func (s *productService) getMerchantFlashSale(ctx context.Context, productID, merchantID int64) (*model.FlashSale, error) {
+	sale, err := s.flashSaleRepo.GetActiveFlashSale(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if sale == nil || sale.MerchantID != merchantID {
+		return nil, utils.NewBizError("商品未在秒杀中")
+	}
+	return sale, nil
+}
+
+// checkNotInFlashSale 秒杀期间库存以Redis为准，禁止修改商品库存及规格
+func (s *productService) checkNotInFlashSale(ctx context.Context, productID int64) error {
+	sale, err := s.flashSaleRepo.GetActiveFlashSale(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if sale != nil && sale.Status == model.FlashSaleStatusSettling {
+		return utils.NewBizError("商品秒杀结算中，请稍后再试")
+	}
+	if sale != nil {
+		return utils.NewBizError("商品秒杀中，请先结束秒杀")
+	}
+	return nil
+}
+
+// deductFlashStock 秒杀商品从Redis原子扣减库存并投递流水消息，返回false表示商品未在秒杀中
+func (s *productService) deductFlashStock(ctx context.Context, param DeductStockParam) (bool, error) {
+	res, err := s.flashStockRepo.Deduct(ctx, param.ProductID, param.UserID, param.Num)
+	if err != nil {
+		return s.flashFallback(ctx, param.ProductID, err)
+	}
+	switch res.Code {
+	case repo.FlashDeductNotActive:
+		return false, nil
+	case repo.FlashDeductOutOfStock:
+		return true, utils.NewBizError("库存不足")
+	case repo.FlashDeductLimitExceed:
+		return true, utils.NewBizError("超出每人限购数量")
+	case repo.FlashDeductSettling:
+		return true, utils.NewBizError("商品秒杀结算中，请稍后再试")
+	}
+
+	// 流水投递失败时回补Redis库存，由调用方重试
+	if err = sendFlashStockEvent(res.FlashSaleID, param.ProductID, param.UserID, -param.Num); err != nil {
+		if _, restoreErr := s.flashStockRepo.Restore(ctx, param.ProductID, param.UserID, param.Num); restoreErr != nil {
+			zap.L().Error("回补秒杀库存失败", zap.Any("param", param), zap.Error(restoreErr))
+		}
+		return true, utils.NewSystemError("下单人数过多，请稍后重试")
+	}
+	return true, nil
+}
+
+// restoreFlashStock 秒杀商品恢复库存，返回false表示商品未在秒杀中
+// 按恢复流水号先写入流水去重，恢复失败或商品未在秒杀中时删除流水，由调用方重试或走数据库恢复
+func (s *productService) restoreFlashStock(ctx context.Context, param RestoreStockParam) (bool, error) {
+	if param.RestoreNo != "" {
+		claimed, err := s.productRepo.ClaimStockRestore(ctx, &model.StockRestoreLog{
+			RestoreNo: param.RestoreNo,
+			ProductID: param.ProductID,
+			Num:       param.Num,
+		})
+		if err != nil {
+			return true, err
+		}
+		if !claimed {
+			zap.L().Info("库存已恢复，跳过", zap.String("restore_no", param.RestoreNo))
+			return true, nil
+		}
+	}
+	handled, err := s.restoreRedisFlashStock(ctx, param)
+	if param.RestoreNo != "" && (!handled || err != nil) {
+		if releaseErr := s.productRepo.ReleaseStockRestore(ctx, param.RestoreNo); releaseErr != nil {
+			return true, releaseErr
+		}
+	}
+	return handled, err
+}
+
+// restoreRedisFlashStock 恢复Redis库存并投递流水消息，返回false表示商品未在秒杀中
+func (s *productService) restoreRedisFlashStock(ctx context.Context, param RestoreStockParam) (bool, error) {
+	res, err := s.flashStockRepo.Restore(ctx, param.ProductID, param.UserID, param.Num)
+	if err != nil {
+		return s.flashFallback(ctx, param.ProductID, err)
+	}
+	switch res.Code {
+	case repo.FlashDeductNotActive:
+		return false, nil
+	case repo.FlashDeductSettling:
+		return true, utils.NewSystemError("商品秒杀结算中，请稍后重试")
+	}
+
+	// 流水投递失败时撤销本次恢复，由调用方重试
+	if err = sendFlashStockEvent(res.FlashSaleID, param.ProductID, param.UserID, param.Num); err != nil {
+		if _, deductErr := s.flashStockRepo.Deduct(ctx, param.ProductID, param.UserID, param.Num); deductErr != nil {
+			zap.L().Error("撤销秒杀库存恢复失败", zap.Any("param", param), zap.Error(deductErr))
+		}
+		return true, utils.NewSystemError("恢复库存失败，请稍后重试")
+	}
+	return true, nil
+}
+
+// flashFallback Redis异常时确认商品是否在秒杀中：非秒杀商品降级走数据库，秒杀商品直接返回错误（避免超卖）
+func (s *productService) flashFallback(ctx context.Context, productID int64, redisErr error) (bool, error) {
+	sale, err := s.flashSaleRepo.GetActiveFlashSale(ctx, productID)
+	if err != nil {
+		return true, err
+	}
+	if sale != nil {
+		return true, redisErr
+	}
+	return false, nil
+}
+
+// sendFlashStockEvent 投递秒杀库存流水（按商品分区）
+func sendFlashStockEvent(flashSaleID, productID, userID int64, delta int32) error {
+	event := kafka.FlashStockEvent{
+		StockNo:     "FS" + time.Now().Format("20060102150405") + utils.RandomString(8),
+		FlashSaleID: flashSaleID,
+		ProductID:   productID,
+		UserID:      userID,
+		Delta:       delta,
+	}
+	if err := kafka.SendJSON(kafka.TopicFlashStock, strconv.FormatInt(productID, 10), event); err != nil {
+		zap.L().Error("投递秒杀库存流水失败", zap.Any("event", event), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// HandleFlashStock 消费秒杀库存流水，写入流水并同步商品表库存（流水号幂等）
+func (s *productService) HandleFlashStock(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var event kafka.FlashStockEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil || event.StockNo == "" || event.ProductID == 0 {
+		zap.L().Error("秒杀库存流水消息格式错误", zap.ByteString("value", msg.Value), zap.Error(err))
+		return nil // 格式错误无法重试，直接跳过
+	}
+	applied, soldOutChanged, err := s.flashSaleRepo.ApplyStockLog(ctx, &model.FlashStockLog{
+		FlashSaleID: event.FlashSaleID,
+		ProductID:   event.ProductID,
+		UserID:      event.UserID,
+		StockNo:     event.StockNo,
+		Delta:       event.Delta,
+	})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return nil
+	}
+	s.invalidateStock(ctx, event.ProductID, soldOutChanged)
+
+	// 秒杀结算中则尝试完成结算（失败由对账任务重试）
+	sale, err := s.flashSaleRepo.GetActiveFlashSale(ctx, event.ProductID)
+	if err != nil {
+		zap.L().Error("查询秒杀失败，结算由对账任务重试", zap.Int64("product_id", event.ProductID), zap.Error(err))
+		return nil
+	}
+	if sale == nil || sale.FlashSaleID != event.FlashSaleID || sale.Status != model.FlashSaleStatusSettling {
+		return nil
+	}
+	if _, err = s.settleFlashSale(ctx, sale); err != nil {
+		zap.L().Error("秒杀结算失败", zap.Int64("flash_sale_id", sale.FlashSaleID), zap.Error(err))
+	}
+	return nil
+}
+
+// toFlashSaleResult 模型 → 领域层结果
+func toFlashSaleResult(sale *model.FlashSale) FlashSaleResult {
+	return FlashSaleResult{
+		FlashSaleID:  sale.FlashSaleID,
+		ProductID:    sale.ProductID,
+		InitialStock: sale.InitialStock,
+		PerUserLimit: sale.PerUserLimit,
+		Status:       sale.Status,
+		FinalStock:   sale.FinalStock,
+	}
+}
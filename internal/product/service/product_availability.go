@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// 入参结构体（领域层）
+type SetProductAvailabilityParam struct {
+	ProductID      int64  `validate:"required,gt=0"`
+	MerchantID     int64  `validate:"required,gt=0"`
+	Suspended      bool   // 暂停售卖（如临时缺货、设备故障）
+	AvailableHours string `validate:"omitempty,max=16"` // 可售时段HH:MM-HH:MM，为空表示全天
+}
+
+type SetDailyRestockParam struct {
+	ProductID   int64  `validate:"required,gt=0"`
+	MerchantID  int64  `validate:"required,gt=0"`
+	DailyStock  int32  `validate:"gte=0,lte=100000"`             // 0表示关闭每日补货
+	RestockTime string `validate:"required_unless=DailyStock 0"` // 补货时间HH:MM
+}
+
+// SetProductAvailability 商家设置暂停售卖及可售时段
+func (s *productService) SetProductAvailability(ctx context.Context, param SetProductAvailabilityParam) error {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("设置商品可售状态参数校验失败", zap.Error(err))
+		return utils.NewParamError("设置商品可售状态参数校验失败" + err.Error())
+	}
+	param.AvailableHours = strings.TrimSpace(param.AvailableHours)
+	if param.AvailableHours != "" && utils.ParseOpenHours(param.AvailableHours).OpenMinute < 0 {
+		return utils.NewParamError("可售时段格式错误，应为HH:MM-HH:MM")
+	}
+	if err := middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+		return err
+	}
+
+	product := &model.Product{
+		ProductID:      param.ProductID,
+		MerchantID:     param.MerchantID,
+		Suspended:      param.Suspended,
+		AvailableHours: param.AvailableHours,
+	}
+	if err := s.productRepo.SetAvailability(ctx, product); err != nil {
+		return err
+	}
+	zap.L().Info("设置商品可售状态成功", zap.Any("param", param))
+	s.invalidateProduct(ctx, param.ProductID, param.MerchantID)
+	kafka.NotifySearchSync(kafka.SearchTargetProduct, param.ProductID)
+	return nil
+}
+
+// SetDailyRestock 商家设置每日补货（仅单规格商品），今日已过补货时间的从明天开始补货
+func (s *productService) SetDailyRestock(ctx context.Context, param SetDailyRestockParam) error {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("设置每日补货参数校验失败", zap.Error(err))
+		return utils.NewParamError("设置每日补货参数校验失败" + err.Error())
+	}
+	if param.DailyStock > 0 {
+		clock, err := time.Parse("15:04", strings.TrimSpace(param.RestockTime))
+		if err != nil {
+			return utils.NewParamError("补货时间格式错误，应为HH:MM")
+		}
+		param.RestockTime = clock.Format("15:04") // 统一补零，按字符串比较时间先后
+	} else {
+		param.RestockTime = ""
+	}
+	if err := middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+		return err
+	}
+	product, err := s.productRepo.GetProductByID(ctx, param.ProductID)
+	if err != nil {
+		return err
+	}
+	if product.MerchantID != param.MerchantID {
+		return utils.NewBizError("商品不存在或无权限更新")
+	}
+	if product.HasSku {
+		return utils.NewBizError("多规格商品暂不支持每日补货")
+	}
+
+	now := time.Now()
+	update := &model.Product{
+		ProductID:       param.ProductID,
+		MerchantID:      param.MerchantID,
+		DailyStock:      param.DailyStock,
+		RestockTime:     param.RestockTime,
+		LastRestockDate: product.LastRestockDate,
+	}
+	if param.RestockTime != "" && param.RestockTime <= now.Format("15:04") {
+		update.LastRestockDate = now.Format("2006-01-02")
+	}
+	if err = s.productRepo.SetDailyRestock(ctx, update); err != nil {
+		return err
+	}
+	zap.L().Info("设置每日补货成功", zap.Any("param", param))
+	s.invalidateProduct(ctx, param.ProductID, param.MerchantID)
+	return nil
+}
+
+// RestockDailyProducts 将已到补货时间的商品库存重置为每日补货库存（定时任务调用），返回补货商品数
+func (s *productService) RestockDailyProducts(ctx context.Context) (int, error) {
+	now := time.Now()
+	date, clock := now.Format("2006-01-02"), now.Format("15:04")
+	products, err := s.productRepo.ListRestockDue(ctx, date, clock)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, product := range products {
+		restocked, err := s.productRepo.Restock(ctx, product.ProductID, date, clock)
+		if err != nil {
+			return count, err
+		}
+		if !restocked {
+			continue // 其他实例已补货
+		}
+		count++
+		s.invalidateProduct(ctx, product.ProductID, product.MerchantID)
+		kafka.NotifySearchSync(kafka.SearchTargetProduct, product.ProductID)
+	}
+	if count > 0 {
+		zap.L().Info("每日补货完成", zap.String("date", date), zap.Int("count", count))
+	}
+	return count, nil
+}
+
+// unavailableReasonAt 商品在指定时间不可售的原因（暂停售卖、不在可售时段、售罄），可售时返回空字符串
+func unavailableReasonAt(isSoldOut, suspended bool, availableHours string, now time.Time) string {
+	switch {
+	case suspended:
+		return "商品暂停售卖"
+	case !utils.ParseOpenHours(availableHours).Contains(utils.MinuteOfDay(now)):
+		return "商品仅在" + availableHours + "供应"
+	case isSoldOut:
+		return "商品已售罄"
+	}
+	return ""
+}
+
+// refreshAvailability 按当前时间计算不可售原因（可售时段随时间变化，不随缓存保存）
+func (r *ProductResult) refreshAvailability(now time.Time) {
+	r.UnavailableReason = unavailableReasonAt(r.IsSoldOut, r.Suspended, r.AvailableHours, now)
+}
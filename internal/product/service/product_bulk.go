@@ -0,0 +1,486 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/export"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	maxBulkRows          = 1000             // 单次批量导入商品数上限
+	productExportBatch   = 200              // 导出时每批查询的商品数
+	BulkModeAllOrNothing = "all_or_nothing" // 存在错误行时不写入任何数据
+	BulkModePartial      = "partial"        // 跳过错误行，写入其余行
+)
+
+// productColumn 商品导入导出列（导入按表头匹配，表头可用中文名或字段名，未知列忽略）
+type productColumn struct {
+	key      string
+	header   string
+	required bool // 导入时必须包含
+	numeric  bool
+	value    func(p *model.Product) string
+}
+
+// productColumns 导出列顺序即导入模板顺序，售罄仅导出；商品ID、外部编码至少包含一列用于匹配已有商品
+var productColumns = []productColumn{
+	{key: "product_id", header: "商品ID", numeric: true, value: func(p *model.Product) string { return strconv.FormatInt(p.ProductID, 10) }},
+	{key: "external_code", header: "外部编码", value: func(p *model.Product) string { return p.ExternalCode }},
+	{key: "name", header: "商品名称", required: true, value: func(p *model.Product) string { return p.Name }},
+	{key: "description", header: "商品描述", value: func(p *model.Product) string { return p.Description }},
+	{key: "price", header: "价格", required: true, numeric: true, value: func(p *model.Product) string { return formatPrice(p.Price) }},
+	{key: "stock", header: "库存", numeric: true, value: func(p *model.Product) string { return strconv.Itoa(int(p.Stock)) }},
+	{key: "packing_fee", header: "打包费", numeric: true, value: func(p *model.Product) string { return formatPrice(p.PackingFee) }},
+	{key: "image_url", header: "图片链接", required: true, value: func(p *model.Product) string { return p.ImageURL }},
+	{key: "is_sold_out", header: "是否售罄", value: func(p *model.Product) string {
+		if p.IsSoldOut {
+			return "是"
+		}
+		return "否"
+	}},
+}
+
+// 入参结构体（领域层）
+type BulkUpsertProductsParam struct {
+	MerchantID int64             `validate:"required,gt=0"`
+	Mode       string            `validate:"required,oneof=all_or_nothing partial"`
+	Rows       []ProductRowParam `validate:"required,min=1,max=1000"` // 逐行单独校验
+}
+
+type ProductRowParam struct {
+	Line         int32    `validate:"-"`      // 行号（CSV为文件行号，批量接口为序号），用于错误报告
+	ProductID    int64    `validate:"gte=0"`  // 填写时按ID匹配已有商品
+	ExternalCode string   `validate:"max=64"` // 未填商品ID时按此匹配，新建商品必填
+	Name         string   `validate:"required,min=2,max=64"`
+	Description  string   `validate:"max=512"`
+	Price        float64  `validate:"required,gt=0"`
+	Stock        *int32   `validate:"omitempty,gte=0"` // 未提供时新建商品取0，更新已有商品保持不变
+	PackingFee   *float64 `validate:"omitempty,gte=0"` // 同上
+	ImageURL     string   `validate:"required,url"`
+}
+
+type ImportProductsCSVParam struct {
+	MerchantID int64  `validate:"required,gt=0"`
+	Mode       string `validate:"required,oneof=all_or_nothing partial"`
+	Content    []byte `validate:"required"`
+}
+
+type ExportProductsParam struct {
+	MerchantID int64  `validate:"required,gt=0"`
+	Format     string `validate:"required,oneof=csv xlsx"`
+}
+
+// 响应结构体（领域层）
+type RowErrorResult struct {
+	Line         int32  `json:"line"`
+	ExternalCode string `json:"external_code"`
+	Msg          string `json:"msg"`
+}
+
+type BulkUpsertResult struct {
+	Created   int32            `json:"created"`
+	Updated   int32            `json:"updated"`
+	Failed    int32            `json:"failed"`
+	Committed bool             `json:"committed"` // 是否已写入（整体模式存在错误行时为false）
+	Errors    []RowErrorResult `json:"errors"`
+}
+
+// BulkUpsertProducts 批量导入商品：填写商品ID的行按ID更新（外部编码非空则一并更新），
+// 否则按外部编码匹配已有商品，匹配到则更新，未匹配则创建
+// 整体模式下任一行错误则不写入；部分模式下跳过错误行。返回错误时result仍携带逐行错误
+func (s *productService) BulkUpsertProducts(ctx context.Context, param BulkUpsertProductsParam) (BulkUpsertResult, error) {
+	return s.bulkUpsert(ctx, param, nil)
+}
+
+// bulkUpsert 批量导入，preErrors为调用方已发现的错误行（如CSV解析失败），与校验错误一并计入
+func (s *productService) bulkUpsert(ctx context.Context, param BulkUpsertProductsParam, preErrors []RowErrorResult) (BulkUpsertResult, error) {
+	// 1. 参数校验及鉴权
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("批量导入商品参数校验失败", zap.Int64("merchant_id", param.MerchantID), zap.Error(err))
+		return BulkUpsertResult{}, utils.NewParamError("批量导入商品参数校验失败" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+		return BulkUpsertResult{}, err
+	}
+
+	// 2. 逐行校验（字段、批次内外部编码及商品ID重复）
+	result := BulkUpsertResult{Errors: preErrors}
+	rowError := func(row ProductRowParam, msg string) {
+		result.Errors = append(result.Errors, RowErrorResult{Line: row.Line, ExternalCode: row.ExternalCode, Msg: msg})
+	}
+	codeLine := make(map[string]int32, len(param.Rows))
+	idLine := make(map[int64]int32, len(param.Rows))
+	valid := make([]ProductRowParam, 0, len(param.Rows))
+	for _, row := range param.Rows {
+		row.ExternalCode = strings.TrimSpace(row.ExternalCode)
+		row.Name = strings.TrimSpace(row.Name)
+		if err := s.validate.Struct(row); err != nil {
+			rowError(row, "参数错误："+err.Error())
+			continue
+		}
+		if row.ProductID == 0 && row.ExternalCode == "" {
+			rowError(row, "新建商品须填写外部编码，更新已有商品须填写商品ID或外部编码")
+			continue
+		}
+		if line, ok := codeLine[row.ExternalCode]; ok && row.ExternalCode != "" {
+			rowError(row, fmt.Sprintf("外部编码与第%d行重复", line))
+			continue
+		}
+		if line, ok := idLine[row.ProductID]; ok && row.ProductID > 0 {
+			rowError(row, fmt.Sprintf("商品ID与第%d行重复", line))
+			continue
+		}
+		if row.ExternalCode != "" {
+			codeLine[row.ExternalCode] = row.Line
+		}
+		if row.ProductID > 0 {
+			idLine[row.ProductID] = row.Line
+		}
+		valid = append(valid, row)
+	}
+
+	// 3. 按商品ID、外部编码匹配已有商品（秒杀中的商品不允许更新）
+	codes := make([]string, 0, len(codeLine))
+	for code := range codeLine {
+		codes = append(codes, code)
+	}
+	ids := make([]int64, 0, len(idLine))
+	for id := range idLine {
+		ids = append(ids, id)
+	}
+	byCode, err := s.productRepo.ListByExternalCodes(ctx, param.MerchantID, codes)
+	if err != nil {
+		return BulkUpsertResult{}, err
+	}
+	byID, err := s.productRepo.ListProductsByIDs(ctx, param.MerchantID, ids)
+	if err != nil {
+		return BulkUpsertResult{}, err
+	}
+	codeOwner := make(map[string]*model.Product, len(byCode))
+	for _, p := range byCode {
+		codeOwner[p.ExternalCode] = p
+	}
+	idProduct := make(map[int64]*model.Product, len(byID))
+	for _, p := range byID {
+		idProduct[p.ProductID] = p
+	}
+	sales, err := s.flashSaleRepo.ListActiveFlashSales(ctx)
+	if err != nil {
+		return BulkUpsertResult{}, err
+	}
+	inFlashSale := make(map[int64]bool)
+	for _, sale := range sales {
+		if sale.MerchantID == param.MerchantID {
+			inFlashSale[sale.ProductID] = true
+		}
+	}
+
+	var creates, updates []*repo.BulkProduct
+	for _, row := range valid {
+		product := &model.Product{
+			MerchantID:   param.MerchantID,
+			ExternalCode: row.ExternalCode,
+			Name:         row.Name,
+			Description:  row.Description,
+			Price:        row.Price,
+			ImageURL:     row.ImageURL,
+		}
+		var omit []string
+		if row.Stock != nil {
+			product.Stock = *row.Stock
+		} else {
+			omit = append(omit, "stock")
+		}
+		if row.PackingFee != nil {
+			product.PackingFee = *row.PackingFee
+		} else {
+			omit = append(omit, "packing_fee")
+		}
+		product.IsSoldOut = product.Stock <= 0
+		bp := &repo.BulkProduct{Product: product, Omit: omit}
+		old, owner := idProduct[row.ProductID], codeOwner[row.ExternalCode]
+		if row.ProductID > 0 && old == nil {
+			rowError(row, "商品不存在或不属于本店")
+			continue
+		}
+		if old == nil && owner == nil {
+			creates = append(creates, bp)
+			continue
+		}
+		if old == nil {
+			old = owner
+		} else if owner != nil && owner.ProductID != old.ProductID {
+			rowError(row, fmt.Sprintf("外部编码已被商品%d使用", owner.ProductID))
+			continue
+		}
+		if inFlashSale[old.ProductID] {
+			rowError(row, "商品秒杀中，请先结束秒杀")
+			continue
+		}
+		product.ProductID = old.ProductID
+		updates = append(updates, bp)
+	}
+	result.Failed = int32(len(result.Errors))
+	sort.SliceStable(result.Errors, func(i, j int) bool { return result.Errors[i].Line < result.Errors[j].Line })
+
+	// 4. 写入（同一事务）
+	if result.Failed > 0 && param.Mode == BulkModeAllOrNothing {
+		return result, utils.NewBizError(fmt.Sprintf("存在%d行错误，未导入任何商品", result.Failed))
+	}
+	result.Committed = true
+	if len(creates)+len(updates) == 0 {
+		return result, nil
+	}
+	if err = s.productRepo.BulkUpsertProducts(ctx, creates, updates); err != nil {
+		result.Committed = false
+		return result, err
+	}
+	result.Created = int32(len(creates))
+	result.Updated = int32(len(updates))
+	zap.L().Info("批量导入商品完成", zap.Int64("merchant_id", param.MerchantID), zap.String("mode", param.Mode),
+		zap.Int32("created", result.Created), zap.Int32("updated", result.Updated), zap.Int32("failed", result.Failed))
+
+	// 5. 失效缓存并同步搜索索引（商家级同步会覆盖其全部商品）
+	if ids := s.bulkTouchedProductIDs(ctx, param.MerchantID, creates, updates); len(ids) > 0 {
+		keys := make([]string, 0, len(ids))
+		for _, id := range ids {
+			keys = append(keys, productCacheKey(id))
+		}
+		s.cache.Delete(ctx, keys...)
+	}
+	s.cache.BumpVersion(ctx, productListNamespace(param.MerchantID))
+	kafka.NotifySearchSync(kafka.SearchTargetMerchant, param.MerchantID)
+	return result, nil
+}
+
+// bulkTouchedProductIDs 批量写入涉及的商品ID（用于失效详情缓存）
+// 新建行可能因并发导入命中已有外部编码而更新已有商品，此时回写的ProductID不是已有商品ID，须按外部编码重新查询
+func (s *productService) bulkTouchedProductIDs(ctx context.Context, merchantID int64, creates, updates []*repo.BulkProduct) []int64 {
+	seen := make(map[int64]bool, len(creates)+len(updates))
+	var ids []int64
+	add := func(id int64) {
+		if id > 0 && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	for _, bp := range updates {
+		add(bp.Product.ProductID)
+	}
+	codes := make([]string, 0, len(creates))
+	for _, bp := range creates {
+		codes = append(codes, bp.Product.ExternalCode)
+	}
+	existing, err := s.productRepo.ListByExternalCodes(ctx, merchantID, codes)
+	if err != nil {
+		// 查询失败时按回写的ID失效，可能遗漏的缓存由TTL兜底
+		zap.L().Warn("批量导入后查询新建商品ID失败", zap.Int64("merchant_id", merchantID), zap.Error(err))
+		for _, bp := range creates {
+			add(bp.Product.ProductID)
+		}
+		return ids
+	}
+	for _, p := range existing {
+		add(p.ProductID)
+	}
+	return ids
+}
+
+// ImportProductsCSV 解析CSV后批量导入（首行为表头），无法解析的行计入逐行错误
+func (s *productService) ImportProductsCSV(ctx context.Context, param ImportProductsCSVParam) (BulkUpsertResult, error) {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("导入商品CSV参数校验失败", zap.Int64("merchant_id", param.MerchantID), zap.Error(err))
+		return BulkUpsertResult{}, utils.NewParamError("导入商品CSV参数校验失败" + err.Error())
+	}
+	rows, parseErrors, err := parseProductCSV(param.Content)
+	if err != nil {
+		return BulkUpsertResult{}, err
+	}
+	if len(rows) == 0 {
+		return BulkUpsertResult{Failed: int32(len(parseErrors)), Errors: parseErrors}, utils.NewParamError("CSV中没有可导入的商品")
+	}
+
+	return s.bulkUpsert(ctx, BulkUpsertProductsParam{
+		MerchantID: param.MerchantID,
+		Mode:       param.Mode,
+		Rows:       rows,
+	}, parseErrors)
+}
+
+// parseProductCSV 按表头解析商品行，返回可解析的行及解析失败的行
+func parseProductCSV(content []byte) ([]ProductRowParam, []RowErrorResult, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\xEF\xBB\xBF"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	// 1. 表头：匹配中文名或字段名
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, utils.NewParamError("CSV表头读取失败")
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		for _, col := range productColumns {
+			if name == col.header || name == col.key {
+				index[col.key] = i
+			}
+		}
+	}
+	for _, col := range productColumns {
+		if _, ok := index[col.key]; col.required && !ok {
+			return nil, nil, utils.NewParamError("CSV缺少必填列：" + col.header)
+		}
+	}
+	_, hasID := index["product_id"]
+	_, hasCode := index["external_code"]
+	if !hasID && !hasCode {
+		return nil, nil, utils.NewParamError("CSV须包含商品ID或外部编码列")
+	}
+
+	// 2. 数据行
+	var rows []ProductRowParam
+	var rowErrors []RowErrorResult
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, nil, utils.NewParamError("CSV读取失败：" + err.Error())
+			}
+			rowErrors = append(rowErrors, RowErrorResult{Line: int32(parseErr.StartLine), Msg: "CSV格式错误：" + parseErr.Err.Error()})
+			continue
+		}
+		if len(rows)+len(rowErrors) >= maxBulkRows {
+			return nil, nil, utils.NewParamError(fmt.Sprintf("单次最多导入%d个商品", maxBulkRows))
+		}
+		line, _ := reader.FieldPos(0)
+		field := func(key string) string {
+			i, ok := index[key]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(export.UnescapeText(record[i]))
+		}
+		row := ProductRowParam{
+			Line:         int32(line),
+			ExternalCode: field("external_code"),
+			Name:         field("name"),
+			Description:  field("description"),
+			ImageURL:     field("image_url"),
+		}
+		if msg := parseRowNumbers(&row, field); msg != "" {
+			rowErrors = append(rowErrors, RowErrorResult{Line: row.Line, ExternalCode: row.ExternalCode, Msg: msg})
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows, rowErrors, nil
+}
+
+// parseRowNumbers 解析数值列（商品ID为空时取0；库存、打包费列缺失或为空时视为未提供），失败返回错误信息
+func parseRowNumbers(row *ProductRowParam, field func(key string) string) string {
+	var err error
+	if v := field("product_id"); v != "" {
+		if row.ProductID, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return "商品ID格式错误"
+		}
+	}
+	if row.Price, err = strconv.ParseFloat(field("price"), 64); err != nil {
+		return "价格格式错误"
+	}
+	if v := field("stock"); v != "" {
+		stock, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return "库存格式错误"
+		}
+		v := int32(stock)
+		row.Stock = &v
+	}
+	if v := field("packing_fee"); v != "" {
+		fee, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return "打包费格式错误"
+		}
+		row.PackingFee = &fee
+	}
+	return ""
+}
+
+// ExportProducts 导出商家全部商品到w（列与导入模板一致，可修改后重新导入）
+// 参数或鉴权失败时不会向w写入任何内容
+func (s *productService) ExportProducts(ctx context.Context, param ExportProductsParam, w io.Writer) (int64, error) {
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("导出商品参数校验失败", zap.Int64("merchant_id", param.MerchantID), zap.Error(err))
+		return 0, utils.NewParamError("参数错误：" + err.Error())
+	}
+	if err := middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+		return 0, err
+	}
+
+	writer, err := export.NewWriter(param.Format, w)
+	if err != nil {
+		return 0, err
+	}
+	header := make([]export.Cell, 0, len(productColumns))
+	for _, col := range productColumns {
+		header = append(header, export.Cell{Value: col.header})
+	}
+	if err = writer.WriteRow(header); err != nil {
+		return 0, utils.NewSystemError("写入导出文件失败：" + err.Error())
+	}
+
+	var rows int64
+	var afterID int64
+	row := make([]export.Cell, len(productColumns))
+	for {
+		if err = ctx.Err(); err != nil {
+			return rows, utils.NewSystemError("导出已取消")
+		}
+		products, err := s.productRepo.ListProductsAfter(ctx, param.MerchantID, afterID, productExportBatch)
+		if err != nil {
+			return rows, err
+		}
+		for _, p := range products {
+			for i, col := range productColumns {
+				row[i] = export.Cell{Value: col.value(p), Numeric: col.numeric}
+			}
+			if err = writer.WriteRow(row); err != nil {
+				return rows, utils.NewSystemError("写入导出文件失败：" + err.Error())
+			}
+			rows++
+		}
+		if len(products) < productExportBatch {
+			break
+		}
+		afterID = products[len(products)-1].ProductID
+	}
+
+	if err = writer.Close(); err != nil {
+		return rows, utils.NewSystemError("写入导出文件失败：" + err.Error())
+	}
+	zap.L().Info("导出商品完成", zap.Int64("merchant_id", param.MerchantID), zap.String("format", param.Format), zap.Int64("rows", rows))
+	return rows, nil
+}
+
+// formatPrice 金额格式化为两位小数
+func formatPrice(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
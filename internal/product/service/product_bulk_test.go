@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProductRepo 按外部编码查询的内存商品库（其余方法未实现）
+type fakeProductRepo struct {
+	repo.ProductRepo
+	byCode map[string]int64 // 外部编码 -> 商品ID（写入后库中的状态）
+	err    error
+}
+
+func (f *fakeProductRepo) ListByExternalCodes(ctx context.Context, merchantID int64, codes []string) ([]*model.Product, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	var products []*model.Product
+	for _, code := range codes {
+		if id, ok := f.byCode[code]; ok {
+			products = append(products, &model.Product{ProductID: id, MerchantID: merchantID, ExternalCode: code})
+		}
+	}
+	return products, nil
+}
+
+func TestBulkTouchedProductIDs(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		repo    *fakeProductRepo
+		creates []*repo.BulkProduct
+		updates []*repo.BulkProduct
+		want    []int64
+	}{
+		{"新建行与更新行均失效",
+			&fakeProductRepo{byCode: map[string]int64{"A": 31}},
+			[]*repo.BulkProduct{{Product: &model.Product{ProductID: 31, ExternalCode: "A"}}},
+			[]*repo.BulkProduct{{Product: &model.Product{ProductID: 12, ExternalCode: "B"}}},
+			[]int64{12, 31}},
+		// 并发导入已创建同编码商品：upsert更新了已有商品7，回写的ProductID不是7
+		{"新建行命中已有外部编码时失效已有商品",
+			&fakeProductRepo{byCode: map[string]int64{"A": 7}},
+			[]*repo.BulkProduct{{Product: &model.Product{ProductID: 40, ExternalCode: "A"}}},
+			nil,
+			[]int64{7}},
+		{"更新行与命中的已有商品相同时去重",
+			&fakeProductRepo{byCode: map[string]int64{"A": 12}},
+			[]*repo.BulkProduct{{Product: &model.Product{ExternalCode: "A"}}},
+			[]*repo.BulkProduct{{Product: &model.Product{ProductID: 12}}},
+			[]int64{12}},
+		{"查询失败时按回写ID失效",
+			&fakeProductRepo{err: errors.New("db down")},
+			[]*repo.BulkProduct{{Product: &model.Product{ProductID: 40, ExternalCode: "A"}}},
+			[]*repo.BulkProduct{{Product: &model.Product{ProductID: 12}}},
+			[]int64{12, 40}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &productService{productRepo: tt.repo}
+			assert.ElementsMatch(t, tt.want, svc.bulkTouchedProductIDs(ctx, 1, tt.creates, tt.updates))
+		})
+	}
+}
+
+func TestParseProductCSVOptionalColumns(t *testing.T) {
+	int32Ptr := func(v int32) *int32 { return &v }
+	float64Ptr := func(v float64) *float64 { return &v }
+	tests := []struct {
+		name       string
+		csv        string
+		stock      *int32
+		packingFee *float64
+	}{
+		{"仅价格列时库存、打包费视为未提供",
+			"外部编码,商品名称,价格,图片链接\nA,黄焖鸡米饭,22.5,https://a.com/1.png\n", nil, nil},
+		{"库存、打包费单元格为空时视为未提供",
+			"外部编码,商品名称,价格,库存,打包费,图片链接\nA,黄焖鸡米饭,22.5,,,https://a.com/1.png\n", nil, nil},
+		{"填写0时按0更新",
+			"外部编码,商品名称,价格,库存,打包费,图片链接\nA,黄焖鸡米饭,22.5,0,0,https://a.com/1.png\n", int32Ptr(0), float64Ptr(0)},
+		{"填写数值时按数值更新",
+			"external_code,name,price,stock,packing_fee,image_url\nA,黄焖鸡米饭,22.5,30,1.5,https://a.com/1.png\n", int32Ptr(30), float64Ptr(1.5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, rowErrors, err := parseProductCSV([]byte(tt.csv))
+			require.NoError(t, err)
+			require.Empty(t, rowErrors)
+			require.Len(t, rows, 1)
+			assert.Equal(t, 22.5, rows[0].Price)
+			assert.Equal(t, tt.stock, rows[0].Stock)
+			assert.Equal(t, tt.packingFee, rows[0].PackingFee)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/cache"
+)
+
+// productCacheKey 商品详情缓存key：product:info:{商品ID}
+func productCacheKey(productID int64) string {
+	return fmt.Sprintf("product:info:%d", productID)
+}
+
+// productListNamespace 商家商品列表缓存命名空间（各分页结果共用一个版本号）
+func productListNamespace(merchantID int64) string {
+	return fmt.Sprintf("product:list:%d", merchantID)
+}
+
+// cachedProductList 读取商家商品列表缓存，key包含版本号及分页参数；版本号读取失败时直接查库
+func (s *productService) cachedProductList(ctx context.Context, param ListProductsParam) (ListProductsResult, error) {
+	namespace := productListNamespace(param.MerchantID)
+	ver, err := s.cache.Version(ctx, namespace)
+	if err != nil {
+		return s.listProducts(ctx, param)
+	}
+	key := fmt.Sprintf("%s:v%d:%d:%d:%t:%s", namespace, ver, param.Page, param.PageSize, param.WithTotal, param.Cursor)
+	return cache.Load(ctx, s.cache, key, func(ctx context.Context) (ListProductsResult, error) {
+		return s.listProducts(ctx, param)
+	})
+}
+
+// invalidateProduct 商品变更后删除详情缓存并使商家商品列表缓存失效
+// merchantID为0时（评分变更等只知道商品ID）先查询商品所属商家
+func (s *productService) invalidateProduct(ctx context.Context, productID, merchantID int64) {
+	if merchantID == 0 {
+		product, err := s.GetProductByID(ctx, productID)
+		if err == nil {
+			merchantID = product.MerchantID
+		}
+	}
+	s.cache.Delete(ctx, productCacheKey(productID))
+	if merchantID > 0 {
+		s.cache.BumpVersion(ctx, productListNamespace(merchantID))
+	}
+}
+
+// invalidateStock 库存变更后删除详情缓存；仅售罄状态变化时使商家商品列表缓存失效
+// （下单扣减频繁，列表中的库存数量允许在缓存有效期内滞后，售罄状态须及时更新）
+func (s *productService) invalidateStock(ctx context.Context, productID int64, soldOutChanged bool) {
+	if soldOutChanged {
+		s.invalidateProduct(ctx, productID, 0)
+		return
+	}
+	s.cache.Delete(ctx, productCacheKey(productID))
+}
@@ -2,32 +2,43 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
 
+	"github.com/IBM/sarama"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/cache"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/pagination"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
 	"github.com/go-playground/validator/v10"
 	"go.uber.org/zap"
 )
 
 type CreateProductParam struct {
-	MerchantID  int64   `validate:"required,gt=0"`
-	Name        string  `validate:"required,min=2,max=64"`
-	Description string  `validate:"max=512"`
-	Price       float64 `validate:"required,gt=0"`
-	Stock       int32   `validate:"required,gte=0"`
-	ImageURL    string  `validate:"required,url"`
+	MerchantID   int64   `validate:"required,gt=0"`
+	Name         string  `validate:"required,min=2,max=64"`
+	Description  string  `validate:"max=512"`
+	Price        float64 `validate:"required,gt=0"`
+	Stock        int32   `validate:"required,gte=0"`
+	PackingFee   float64 `validate:"gte=0"`
+	ImageURL     string  `validate:"required,url"`
+	ExternalCode string  `validate:"max=64"` // 可选，同一商家内唯一
 }
 
 type UpdateProductParam struct {
-	ProductID   int64   `validate:"required,gt=0"`
-	MerchantID  int64   `validate:"required,gt=0"`
-	Name        string  `validate:"required,min=2,max=64"`
-	Description string  `validate:"max=512"`
-	Price       float64 `validate:"required,gt=0"`
-	Stock       int32   `validate:"required,gte=0"`
-	ImageURL    string  `validate:"required,url"`
-	IsSoldOut   bool    `validate:"required"`
+	ProductID    int64   `validate:"required,gt=0"`
+	MerchantID   int64   `validate:"required,gt=0"`
+	Name         string  `validate:"required,min=2,max=64"`
+	Description  string  `validate:"max=512"`
+	Price        float64 `validate:"required,gt=0"`
+	Stock        int32   `validate:"required,gte=0"`
+	PackingFee   float64 `validate:"gte=0"`
+	ImageURL     string  `validate:"required,url"`
+	ExternalCode string  `validate:"max=64"` // 为空时保持不变
 }
 
 type DeleteProductParam struct {
@@ -36,40 +47,62 @@ type DeleteProductParam struct {
 }
 
 type ListProductsParam struct {
-	MerchantID int64 `validate:"required,gt=0"`
-	Page       int32 `validate:"required,gte=1"`
-	PageSize   int32 `validate:"required,gte=10,lte=100"`
+	MerchantID int64  `validate:"required,gt=0"`
+	Page       int32  `validate:"gte=0"` // 0表示游标分页
+	PageSize   int32  `validate:"required,gte=10,lte=100"`
+	Cursor     string `validate:"omitempty,max=256"`
+	WithTotal  bool
 }
 
 type DeductStockParam struct {
 	ProductID int64 `validate:"required,gt=0"`
+	SkuID     int64 `validate:"gte=0"` // 多规格商品必填
+	UserID    int64 `validate:"gte=0"` // 秒杀商品按用户限购
 	Num       int32 `validate:"required,gt=0"`
 }
 
 type RestoreStockParam struct {
-	ProductID int64 `validate:"required,gt=0"`
-	Num       int32 `validate:"required,gt=0"`
+	ProductID int64  `validate:"required,gt=0"`
+	SkuID     int64  `validate:"gte=0"`
+	UserID    int64  `validate:"gte=0"`
+	Num       int32  `validate:"required,gt=0"`
+	RestoreNo string `validate:"max=64"` // 恢复流水号（幂等键，为空不去重）
 }
 
 // 响应结构体（领域层）
 type ProductResult struct {
-	ProductID   int64   `json:"product_id"`
-	MerchantID  int64   `json:"merchant_id"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price"`
-	Stock       int32   `json:"stock"`
-	ImageURL    string  `json:"image_url"`
-	IsSoldOut   bool    `json:"is_sold_out"`
-	CreatedAt   string  `json:"created_at"`
-	UpdatedAt   string  `json:"updated_at"`
+	ProductID    int64   `json:"product_id"`
+	MerchantID   int64   `json:"merchant_id"`
+	Name         string  `json:"name"`
+	Description  string  `json:"description"`
+	Price        float64 `json:"price"`
+	Stock        int32   `json:"stock"`
+	PackingFee   float64 `json:"packing_fee"`
+	ImageURL     string  `json:"image_url"`
+	IsSoldOut    bool    `json:"is_sold_out"`
+	ExternalCode string  `json:"external_code"`
+	Score        float64 `json:"score"`
+	RatingCount  int64   `json:"rating_count"`
+	CreatedAt    string  `json:"created_at"`
+	UpdatedAt    string  `json:"updated_at"`
+
+	Suspended         bool   `json:"suspended"`
+	AvailableHours    string `json:"available_hours"`
+	DailyStock        int32  `json:"daily_stock"`
+	RestockTime       string `json:"restock_time"`
+	UnavailableReason string `json:"-"` // 当前不可售原因（为空表示可售），读取时按当前时间计算
+
+	HasSku       bool                `json:"has_sku"`
+	Skus         []SkuResult         `json:"skus"`          // 仅详情、菜单返回
+	OptionGroups []OptionGroupResult `json:"option_groups"` // 仅详情、菜单返回
 }
 
 type ListProductsResult struct {
-	Products []ProductResult `json:"products"`
-	Total    int64           `json:"total"`
-	Page     int32           `json:"page"`
-	PageSize int32           `json:"page_size"`
+	Products   []ProductResult `json:"products"`
+	Total      int64           `json:"total"` // 未统计时为-1
+	Page       int32           `json:"page"`
+	PageSize   int32           `json:"page_size"`
+	NextCursor string          `json:"next_cursor"`
 }
 
 // ProductService 商品业务逻辑接口
@@ -81,19 +114,44 @@ type ProductService interface {
 	GetProductByID(ctx context.Context, productID int64) (ProductResult, error)
 	DeductStock(ctx context.Context, param DeductStockParam) error
 	RestoreStock(ctx context.Context, param RestoreStockParam) error
+	SetProductSpecs(ctx context.Context, param SetProductSpecsParam) error      // 商家设置商品规格及属性
+	HandleRatingUpdated(ctx context.Context, msg *sarama.ConsumerMessage) error // 消费评分更新消息，同步商品评分
+
+	SetProductAvailability(ctx context.Context, param SetProductAvailabilityParam) error // 商家设置暂停售卖及可售时段
+	SetDailyRestock(ctx context.Context, param SetDailyRestockParam) error               // 商家设置每日补货
+	RestockDailyProducts(ctx context.Context) (int, error)                               // 执行每日补货，返回补货商品数
+
+	BulkUpsertProducts(ctx context.Context, param BulkUpsertProductsParam) (BulkUpsertResult, error) // 商家批量导入商品（按外部编码匹配更新）
+	ImportProductsCSV(ctx context.Context, param ImportProductsCSVParam) (BulkUpsertResult, error)   // 商家通过CSV导入商品
+	ExportProducts(ctx context.Context, param ExportProductsParam, w io.Writer) (int64, error)       // 商家导出商品到w，返回导出行数
+
+	StartFlashSale(ctx context.Context, param StartFlashSaleParam) (FlashSaleResult, error)              // 商家开启秒杀（库存预热到Redis）
+	StopFlashSale(ctx context.Context, param StopFlashSaleParam) (FlashSaleResult, error)                // 商家结束秒杀
+	ReconcileFlashSale(ctx context.Context, param ReconcileFlashSaleParam) (FlashReconcileResult, error) // 商家查询秒杀对账结果
+	ReconcileActiveFlashSales(ctx context.Context) (int, error)                                          // 对账全部进行中的秒杀，返回不一致数
+	HandleFlashStock(ctx context.Context, msg *sarama.ConsumerMessage) error                             // 消费秒杀库存流水，异步落库
 }
 
 // productService 实现
 type productService struct {
-	productRepo repo.ProductRepo
-	validate    *validator.Validate
+	productRepo    repo.ProductRepo
+	specRepo       repo.SpecRepo
+	flashSaleRepo  repo.FlashSaleRepo
+	flashStockRepo repo.FlashStockRepo
+	cache          *cache.Cache
+	validate       *validator.Validate
 }
 
 // NewProductService 创建实例
-func NewProductService(productRepo repo.ProductRepo) ProductService {
+func NewProductService(productRepo repo.ProductRepo, specRepo repo.SpecRepo, flashSaleRepo repo.FlashSaleRepo,
+	flashStockRepo repo.FlashStockRepo, productCache *cache.Cache) ProductService {
 	return &productService{
-		productRepo: productRepo,
-		validate:    validator.New(),
+		productRepo:    productRepo,
+		specRepo:       specRepo,
+		flashSaleRepo:  flashSaleRepo,
+		flashStockRepo: flashStockRepo,
+		cache:          productCache,
+		validate:       validator.New(),
 	}
 }
 
@@ -103,19 +161,23 @@ func (s *productService) CreateProduct(ctx context.Context, param CreateProductP
 		return 0, utils.NewParamError("创建商品参数校验失败" + err.Error())
 	}
 	product := &model.Product{
-		MerchantID:  param.MerchantID,
-		Name:        param.Name,
-		Description: param.Description,
-		Price:       param.Price,
-		Stock:       param.Stock,
-		ImageURL:    param.ImageURL,
-		IsSoldOut:   param.Stock <= 0,
+		MerchantID:   param.MerchantID,
+		ExternalCode: strings.TrimSpace(param.ExternalCode),
+		Name:         param.Name,
+		Description:  param.Description,
+		Price:        param.Price,
+		Stock:        param.Stock,
+		PackingFee:   param.PackingFee,
+		ImageURL:     param.ImageURL,
+		IsSoldOut:    param.Stock <= 0,
 	}
 	err := s.productRepo.CreateProduct(ctx, product)
 	if err != nil {
 		return 0, err
 	}
 	zap.L().Info("创建商品成功", zap.Any("product", product))
+	s.cache.BumpVersion(ctx, productListNamespace(product.MerchantID))
+	kafka.NotifySearchSync(kafka.SearchTargetProduct, product.ProductID)
 	return product.ProductID, nil
 }
 
@@ -125,19 +187,27 @@ func (s *productService) UpdateProduct(ctx context.Context, param UpdateProductP
 		return utils.NewParamError("更新商品参数校验失败" + err.Error())
 	}
 	product := &model.Product{
-		MerchantID:  param.MerchantID,
-		Name:        param.Name,
-		Description: param.Description,
-		Price:       param.Price,
-		Stock:       param.Stock,
-		ImageURL:    param.ImageURL,
-		IsSoldOut:   param.IsSoldOut,
+		ProductID:    param.ProductID,
+		MerchantID:   param.MerchantID,
+		ExternalCode: strings.TrimSpace(param.ExternalCode),
+		Name:         param.Name,
+		Description:  param.Description,
+		Price:        param.Price,
+		Stock:        param.Stock,
+		PackingFee:   param.PackingFee,
+		ImageURL:     param.ImageURL,
+		IsSoldOut:    param.Stock <= 0,
+	}
+	if err := s.checkNotInFlashSale(ctx, product.ProductID); err != nil {
+		return err
 	}
 	err := s.productRepo.UpdateProduct(ctx, product)
 	if err != nil {
 		return err
 	}
 	zap.L().Info("更新商品成功", zap.Any("product", product))
+	s.invalidateProduct(ctx, product.ProductID, product.MerchantID)
+	kafka.NotifySearchSync(kafka.SearchTargetProduct, product.ProductID)
 	return nil
 }
 
@@ -146,10 +216,15 @@ func (s *productService) DeleteProduct(ctx context.Context, param DeleteProductP
 		zap.L().Warn("删除商品参数校验失败", zap.Error(err))
 		return utils.NewParamError("删除商品参数校验失败" + err.Error())
 	}
+	if err := s.checkNotInFlashSale(ctx, param.ProductID); err != nil {
+		return err
+	}
 	if err := s.productRepo.DeleteProduct(ctx, param.ProductID, param.MerchantID); err != nil {
 		return err
 	}
 	zap.L().Info("删除商品成功", zap.Int64("product", param.ProductID), zap.Int64("merchant", param.MerchantID))
+	s.invalidateProduct(ctx, param.ProductID, param.MerchantID)
+	kafka.NotifySearchSync(kafka.SearchTargetProduct, param.ProductID)
 	return nil
 }
 
@@ -158,30 +233,38 @@ func (s *productService) ListProductsByMerchantID(ctx context.Context, param Lis
 		zap.L().Warn("查询商品列表参数校验错误", zap.Error(err))
 		return ListProductsResult{}, utils.NewParamError("查询商品列表参数校验错误" + err.Error())
 	}
-	products, total, err := s.productRepo.ListProductsByMerchantID(ctx, param.MerchantID, param.Page, param.PageSize)
+	result, err := s.cachedProductList(ctx, param)
+	if err != nil {
+		return ListProductsResult{}, err
+	}
+	now := time.Now()
+	for i := range result.Products {
+		result.Products[i].refreshAvailability(now)
+	}
+	return result, nil
+}
+
+// listProducts 从数据库查询商家商品列表
+func (s *productService) listProducts(ctx context.Context, param ListProductsParam) (ListProductsResult, error) {
+	products, page, err := s.productRepo.ListProductsByMerchantID(ctx, param.MerchantID, pagination.Param{
+		Page:      param.Page,
+		PageSize:  param.PageSize,
+		Cursor:    param.Cursor,
+		WithTotal: param.WithTotal,
+	})
 	if err != nil {
 		return ListProductsResult{}, err
 	}
-	productsResult := make([]ProductResult, len(products))
+	productsResult := make([]ProductResult, 0, len(products))
 	for _, product := range products {
-		productsResult = append(productsResult, ProductResult{
-			ProductID:   product.ProductID,
-			MerchantID:  product.MerchantID,
-			Name:        product.Name,
-			Description: product.Description,
-			Price:       product.Price,
-			Stock:       product.Stock,
-			ImageURL:    product.ImageURL,
-			IsSoldOut:   product.IsSoldOut,
-			CreatedAt:   product.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:   product.UpdatedAt.Format("2006-01-02 15:04:05"),
-		})
+		productsResult = append(productsResult, toProductResult(product))
 	}
 	return ListProductsResult{
-		Products: productsResult,
-		Total:    total,
-		Page:     param.Page,
-		PageSize: param.PageSize,
+		Products:   productsResult,
+		Total:      page.Total,
+		Page:       param.Page,
+		PageSize:   param.PageSize,
+		NextCursor: page.NextCursor,
 	}, nil
 }
 
@@ -190,23 +273,56 @@ func (s *productService) GetProductByID(ctx context.Context, productID int64) (P
 		zap.L().Warn("商品ID不能为空")
 		return ProductResult{}, utils.NewParamError("商品ID为空")
 	}
+	result, err := cache.Load(ctx, s.cache, productCacheKey(productID), func(ctx context.Context) (ProductResult, error) {
+		return s.loadProduct(ctx, productID)
+	})
+	if err != nil {
+		return ProductResult{}, err
+	}
+	result.refreshAvailability(time.Now())
+	return result, nil
+}
+
+// loadProduct 从数据库查询商品详情（含规格及属性）
+func (s *productService) loadProduct(ctx context.Context, productID int64) (ProductResult, error) {
 	product, err := s.productRepo.GetProductByID(ctx, productID)
 	if err != nil {
 		return ProductResult{}, err
 	}
+	skuMap, groupMap, err := s.specRepo.ListSpecs(ctx, []int64{productID})
+	if err != nil {
+		return ProductResult{}, err
+	}
 
+	result := toProductResult(product)
+	attachSpecs(&result, skuMap[productID], groupMap[productID])
+	return result, nil
+}
+
+// toProductResult 模型 → 领域层结果
+func toProductResult(product *model.Product) ProductResult {
 	return ProductResult{
-		ProductID:   product.ProductID,
-		MerchantID:  product.MerchantID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		Stock:       product.Stock,
-		ImageURL:    product.ImageURL,
-		IsSoldOut:   product.IsSoldOut,
-		CreatedAt:   product.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:   product.UpdatedAt.Format("2006-01-02 15:04:05"),
-	}, nil
+		ProductID:    product.ProductID,
+		MerchantID:   product.MerchantID,
+		Name:         product.Name,
+		Description:  product.Description,
+		Price:        product.Price,
+		Stock:        product.Stock,
+		PackingFee:   product.PackingFee,
+		ImageURL:     product.ImageURL,
+		IsSoldOut:    product.IsSoldOut,
+		ExternalCode: product.ExternalCode,
+		Score:        product.Score,
+		RatingCount:  product.RatingCount,
+		CreatedAt:    product.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:    product.UpdatedAt.Format("2006-01-02 15:04:05"),
+		HasSku:       product.HasSku,
+
+		Suspended:      product.Suspended,
+		AvailableHours: product.AvailableHours,
+		DailyStock:     product.DailyStock,
+		RestockTime:    product.RestockTime,
+	}
 }
 
 func (s *productService) DeductStock(ctx context.Context, param DeductStockParam) error {
@@ -214,10 +330,17 @@ func (s *productService) DeductStock(ctx context.Context, param DeductStockParam
 		zap.L().Warn("删减库存参数校验失败", zap.Error(err))
 		return utils.NewParamError("删减库存参数校验失败" + err.Error())
 	}
-	err := s.productRepo.DeductStock(ctx, param.ProductID, param.Num)
+	// 秒杀商品走Redis扣减，流水异步落库
+	if param.SkuID == 0 {
+		if handled, err := s.deductFlashStock(ctx, param); handled || err != nil {
+			return err
+		}
+	}
+	soldOutChanged, err := s.productRepo.DeductStock(ctx, param.ProductID, param.SkuID, param.Num)
 	if err != nil {
 		return err
 	}
+	s.invalidateStock(ctx, param.ProductID, soldOutChanged)
 	return nil
 }
 
@@ -226,9 +349,42 @@ func (s *productService) RestoreStock(ctx context.Context, param RestoreStockPar
 		zap.L().Warn("增加库存参数校验失败", zap.Error(err))
 		return utils.NewParamError("增加库存参数校验失败" + err.Error())
 	}
-	err := s.productRepo.RestoreStock(ctx, param.ProductID, param.Num)
+	if param.SkuID == 0 {
+		if handled, err := s.restoreFlashStock(ctx, param); handled || err != nil {
+			return err
+		}
+	}
+	applied, soldOutChanged, err := s.productRepo.RestoreStock(ctx, &model.StockRestoreLog{
+		RestoreNo: param.RestoreNo,
+		ProductID: param.ProductID,
+		SkuID:     param.SkuID,
+		Num:       param.Num,
+	})
 	if err != nil {
 		return err
 	}
+	if !applied {
+		zap.L().Info("库存已恢复，跳过", zap.String("restore_no", param.RestoreNo))
+		return nil
+	}
+	s.invalidateStock(ctx, param.ProductID, soldOutChanged)
+	return nil
+}
+
+// HandleRatingUpdated 消费评分更新消息（订单服务按评价增量计算评分后投递），仅处理商品评分
+func (s *productService) HandleRatingUpdated(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var event kafka.RatingUpdatedEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil || event.TargetID == 0 {
+		zap.L().Error("评分更新消息格式错误", zap.ByteString("value", msg.Value), zap.Error(err))
+		return nil // 格式错误无法重试，直接跳过
+	}
+	if event.TargetType != kafka.RatingTargetProduct {
+		return nil
+	}
+	if err := s.productRepo.UpdateScore(ctx, event.TargetID, event.Score, event.RatingCount); err != nil {
+		return err
+	}
+	s.invalidateProduct(ctx, event.TargetID, 0)
+	kafka.NotifySearchSync(kafka.SearchTargetProduct, event.TargetID)
 	return nil
 }
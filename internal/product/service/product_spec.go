@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/product/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// 入参结构体
+type SetProductSpecsParam struct {
+	ProductID    int64              `validate:"required,gt=0"`
+	MerchantID   int64              `validate:"required,gt=0"`
+	Skus         []SkuParam         `validate:"omitempty,max=20,dive"` // 为空表示取消多规格
+	OptionGroups []OptionGroupParam `validate:"omitempty,max=10,dive"`
+}
+
+type SkuParam struct {
+	SkuID     int64   `validate:"gte=0"` // 0表示新增规格
+	Name      string  `validate:"required,min=1,max=32"`
+	Price     float64 `validate:"required,gt=0"`
+	Stock     int32   `validate:"gte=0"`
+	SortOrder int32   `validate:"gte=0"`
+}
+
+type OptionGroupParam struct {
+	Name      string        `validate:"required,min=1,max=32"`
+	MinSelect int32         `validate:"gte=0"`
+	MaxSelect int32         `validate:"required,gt=0,gtefield=MinSelect"`
+	SortOrder int32         `validate:"gte=0"`
+	Options   []OptionParam `validate:"required,min=1,max=20,dive"`
+}
+
+type OptionParam struct {
+	Name      string  `validate:"required,min=1,max=32"`
+	Price     float64 `validate:"gte=0"` // 加价（口味等不加价的属性为0）
+	SortOrder int32   `validate:"gte=0"`
+}
+
+// 响应结构体
+type SkuResult struct {
+	SkuID     int64   `json:"sku_id"`
+	Name      string  `json:"name"`
+	Price     float64 `json:"price"`
+	Stock     int32   `json:"stock"`
+	SortOrder int32   `json:"sort_order"`
+}
+
+type OptionGroupResult struct {
+	GroupID   int64          `json:"group_id"`
+	Name      string         `json:"name"`
+	MinSelect int32          `json:"min_select"`
+	MaxSelect int32          `json:"max_select"`
+	SortOrder int32          `json:"sort_order"`
+	Options   []OptionResult `json:"options"`
+}
+
+type OptionResult struct {
+	OptionID  int64   `json:"option_id"`
+	Name      string  `json:"name"`
+	Price     float64 `json:"price"`
+	SortOrder int32   `json:"sort_order"`
+}
+
+// SetProductSpecs 设置商品规格及属性（整体覆盖，已有规格需传入sku_id以保留其ID）
+func (s *productService) SetProductSpecs(ctx context.Context, param SetProductSpecsParam) error {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("设置商品规格参数校验失败", zap.Error(err))
+		return utils.NewParamError("设置商品规格参数校验失败" + err.Error())
+	}
+	skuNames := make(map[string]bool, len(param.Skus))
+	for _, sku := range param.Skus {
+		if skuNames[sku.Name] {
+			return utils.NewParamError("规格名称重复：" + sku.Name)
+		}
+		skuNames[sku.Name] = true
+	}
+	groupNames := make(map[string]bool, len(param.OptionGroups))
+	for _, group := range param.OptionGroups {
+		if groupNames[group.Name] {
+			return utils.NewParamError("属性组名称重复：" + group.Name)
+		}
+		groupNames[group.Name] = true
+		if int(group.MaxSelect) > len(group.Options) {
+			return utils.NewParamError("属性组【" + group.Name + "】最多选择数不能超过选项数")
+		}
+		optionNames := make(map[string]bool, len(group.Options))
+		for _, option := range group.Options {
+			if optionNames[option.Name] {
+				return utils.NewParamError("属性组【" + group.Name + "】选项名称重复：" + option.Name)
+			}
+			optionNames[option.Name] = true
+		}
+	}
+
+	// 2. 鉴权：仅商家本人可设置本店商品
+	if err := middleware.CheckIdentity(ctx, "merchant", param.MerchantID); err != nil {
+		return err
+	}
+	product, err := s.productRepo.GetProductByID(ctx, param.ProductID)
+	if err != nil {
+		return err
+	}
+	if product.MerchantID != param.MerchantID {
+		return utils.NewBizError("商品不存在或无权限更新")
+	}
+	if err = s.checkNotInFlashSale(ctx, param.ProductID); err != nil {
+		return err
+	}
+
+	// 3. 转换为模型并保存
+	skus := make([]*model.ProductSku, 0, len(param.Skus))
+	for _, sku := range param.Skus {
+		skus = append(skus, &model.ProductSku{
+			SkuID:     sku.SkuID,
+			Name:      sku.Name,
+			Price:     sku.Price,
+			Stock:     sku.Stock,
+			SortOrder: sku.SortOrder,
+		})
+	}
+	groups := make([]*model.ProductOptionGroup, 0, len(param.OptionGroups))
+	for _, group := range param.OptionGroups {
+		g := &model.ProductOptionGroup{
+			Name:      group.Name,
+			MinSelect: group.MinSelect,
+			MaxSelect: group.MaxSelect,
+			SortOrder: group.SortOrder,
+		}
+		for _, option := range group.Options {
+			g.Options = append(g.Options, &model.ProductOption{
+				Name:      option.Name,
+				Price:     option.Price,
+				SortOrder: option.SortOrder,
+			})
+		}
+		groups = append(groups, g)
+	}
+	if err = s.specRepo.SaveProductSpecs(ctx, param.ProductID, skus, groups); err != nil {
+		return err
+	}
+	zap.L().Info("设置商品规格成功", zap.Int64("product_id", param.ProductID), zap.Int("skus", len(skus)), zap.Int("option_groups", len(groups)))
+	s.invalidateProduct(ctx, param.ProductID, param.MerchantID)
+	kafka.NotifySearchSync(kafka.SearchTargetProduct, param.ProductID) // 规格价格影响商品最低价
+	return nil
+}
+
+// attachSpecs 将规格及属性填充到商品结果
+func attachSpecs(result *ProductResult, skus []*model.ProductSku, groups []*model.ProductOptionGroup) {
+	for _, sku := range skus {
+		result.Skus = append(result.Skus, SkuResult{
+			SkuID:     sku.SkuID,
+			Name:      sku.Name,
+			Price:     sku.Price,
+			Stock:     sku.Stock,
+			SortOrder: sku.SortOrder,
+		})
+	}
+	for _, group := range groups {
+		g := OptionGroupResult{
+			GroupID:   group.GroupID,
+			Name:      group.Name,
+			MinSelect: group.MinSelect,
+			MaxSelect: group.MaxSelect,
+			SortOrder: group.SortOrder,
+		}
+		for _, option := range group.Options {
+			g.Options = append(g.Options, OptionResult{
+				OptionID:  option.OptionID,
+				Name:      option.Name,
+				Price:     option.Price,
+				SortOrder: option.SortOrder,
+			})
+		}
+		result.OptionGroups = append(result.OptionGroups, g)
+	}
+}
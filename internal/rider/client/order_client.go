@@ -2,6 +2,7 @@ package client
 
 import (
 	orderProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/order/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -12,7 +13,10 @@ var OrderClient orderProto.OrderServiceClient
 func InitOrderClient() {
 	addr := "localhost:50054"
 
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(middleware.GRPCAuthForwardInterceptor()),
+	)
 	if err != nil {
 		zap.L().Fatal("连接订单服务失败", zap.Error(err), zap.String("addr", addr))
 	}
@@ -0,0 +1,55 @@
+package earning
+
+import (
+	"math"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+)
+
+// Breakdown 单笔配送收入明细
+type Breakdown struct {
+	BaseFee        float64
+	DistanceBonus  float64
+	PeakMultiplier float64 // 未命中高峰时段为1
+	PeakBonus      float64 // 高峰加成金额 = (基础收入+距离补贴)×(倍数-1)
+	Tip            float64
+	Amount         float64 // 合计
+}
+
+// Calculate 计算单笔配送收入 = (基础收入 + 超距补贴) × 高峰倍数 + 小费
+// 超距补贴不足1公里按1公里计算；距离未知（0）时仅计基础收入
+func Calculate(cfg config.RiderConfig, distanceMeters int32, completeTime time.Time, tip float64) Breakdown {
+	cfg = cfg.WithDefaults()
+	b := Breakdown{
+		BaseFee:        utils.RoundMoney(cfg.BaseFee),
+		PeakMultiplier: 1,
+		Tip:            utils.RoundMoney(math.Max(tip, 0)),
+	}
+
+	// 1. 距离补贴
+	distanceKm := float64(distanceMeters) / 1000
+	if distanceKm > cfg.BaseDistanceKm {
+		b.DistanceBonus = utils.RoundMoney(math.Ceil(distanceKm-cfg.BaseDistanceKm) * cfg.PerKmBonus)
+	}
+
+	// 2. 高峰加成（按送达时间判断）
+	if inPeakHours(cfg.PeakHours, completeTime) {
+		b.PeakMultiplier = cfg.PeakMultiplier
+		b.PeakBonus = utils.RoundMoney((b.BaseFee + b.DistanceBonus) * (cfg.PeakMultiplier - 1))
+	}
+
+	b.Amount = utils.RoundMoney(b.BaseFee + b.DistanceBonus + b.PeakBonus + b.Tip)
+	return b
+}
+
+// inPeakHours 时间是否命中任一高峰时段
+func inPeakHours(windows []config.TimeWindow, t time.Time) bool {
+	for _, w := range windows {
+		if utils.InClockRange(w.Start, w.End, t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	riderProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// GetRiderEarnings 查询骑手收入
+func (h *RiderHandler) GetRiderEarnings(ctx context.Context, req *riderProto.GetRiderEarningsRequest) (*riderProto.GetRiderEarningsResponse, error) {
+	// 转换参数
+	param := service.GetRiderEarningsParam{
+		RiderID:     req.RiderId,
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+		Granularity: req.Granularity,
+	}
+
+	// 调用service
+	result, err := h.earningService.GetRiderEarnings(ctx, param)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("查询骑手收入未知错误", zap.Error(err), zap.Int64("rider_id", req.RiderId))
+			return &riderProto.GetRiderEarningsResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &riderProto.GetRiderEarningsResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	// 转换结果
+	periods := make([]*riderProto.EarningSummary, 0, len(result.Periods))
+	for _, p := range result.Periods {
+		periods = append(periods, toProtoEarningSummary(p))
+	}
+
+	return &riderProto.GetRiderEarningsResponse{
+		Code:    utils.ErrCodeSuccess,
+		Msg:     "查询成功",
+		Total:   toProtoEarningSummary(result.Total),
+		Periods: periods,
+	}, nil
+}
+
+// ListRiderStatements 查询骑手周结算单
+func (h *RiderHandler) ListRiderStatements(ctx context.Context, req *riderProto.ListRiderStatementsRequest) (*riderProto.ListRiderStatementsResponse, error) {
+	// 转换参数
+	param := service.ListRiderStatementsParam{
+		RiderID:  req.RiderId,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	// 调用service
+	result, err := h.earningService.ListRiderStatements(ctx, param)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("查询骑手结算单未知错误", zap.Error(err), zap.Int64("rider_id", req.RiderId))
+			return &riderProto.ListRiderStatementsResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &riderProto.ListRiderStatementsResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	// 转换结果
+	var statements []*riderProto.RiderStatement
+	for _, st := range result.Statements {
+		statements = append(statements, toProtoRiderStatement(st))
+	}
+
+	return &riderProto.ListRiderStatementsResponse{
+		Code:       utils.ErrCodeSuccess,
+		Msg:        "查询成功",
+		Statements: statements,
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+	}, nil
+}
+
+// GetRiderStatement 查询骑手结算单详情
+func (h *RiderHandler) GetRiderStatement(ctx context.Context, req *riderProto.GetRiderStatementRequest) (*riderProto.GetRiderStatementResponse, error) {
+	// 调用service
+	result, err := h.earningService.GetRiderStatement(ctx, req.StatementId)
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("查询骑手结算单详情未知错误", zap.Error(err), zap.Int64("statement_id", req.StatementId))
+			return &riderProto.GetRiderStatementResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &riderProto.GetRiderStatementResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	// 转换结果
+	earnings := make([]*riderProto.RiderEarning, 0, len(result.Earnings))
+	for _, e := range result.Earnings {
+		earnings = append(earnings, &riderProto.RiderEarning{
+			EarningId:        e.EarningID,
+			OrderId:          e.OrderID,
+			OrderNo:          e.OrderNo,
+			DeliveryDistance: e.DeliveryDistance,
+			BaseFee:          float32(e.BaseFee),
+			DistanceBonus:    float32(e.DistanceBonus),
+			PeakMultiplier:   float32(e.PeakMultiplier),
+			PeakBonus:        float32(e.PeakBonus),
+			Tip:              float32(e.Tip),
+			Amount:           float32(e.Amount),
+			CompleteTime:     e.CompleteTime,
+		})
+	}
+
+	return &riderProto.GetRiderStatementResponse{
+		Code:      utils.ErrCodeSuccess,
+		Msg:       "查询成功",
+		Statement: toProtoRiderStatement(result.Statement),
+		Earnings:  earnings,
+	}, nil
+}
+
+// toProtoEarningSummary 领域层结果 → proto
+func toProtoEarningSummary(result service.EarningSummaryResult) *riderProto.EarningSummary {
+	return &riderProto.EarningSummary{
+		PeriodStart:   result.PeriodStart,
+		DeliveryCount: result.DeliveryCount,
+		BaseFee:       float32(result.BaseFee),
+		DistanceBonus: float32(result.DistanceBonus),
+		PeakBonus:     float32(result.PeakBonus),
+		Tip:           float32(result.Tip),
+		Amount:        float32(result.Amount),
+	}
+}
+
+// toProtoRiderStatement 领域层结果 → proto
+func toProtoRiderStatement(result service.RiderStatementResult) *riderProto.RiderStatement {
+	return &riderProto.RiderStatement{
+		StatementId:   result.StatementID,
+		StatementNo:   result.StatementNo,
+		RiderId:       result.RiderID,
+		PeriodStart:   result.PeriodStart,
+		PeriodEnd:     result.PeriodEnd,
+		DeliveryCount: result.DeliveryCount,
+		BaseFee:       float32(result.BaseFee),
+		DistanceBonus: float32(result.DistanceBonus),
+		PeakBonus:     float32(result.PeakBonus),
+		Tip:           float32(result.Tip),
+		Amount:        float32(result.Amount),
+		Status:        result.Status,
+		CreateTime:    result.CreateTime,
+	}
+}
@@ -13,13 +13,15 @@ import (
 // RiderHandler 骑手gRPC接口实现
 type RiderHandler struct {
 	riderProto.UnimplementedRiderServiceServer
-	riderService service.RiderService
+	riderService   service.RiderService
+	earningService service.EarningService
 }
 
 // NewRiderHandler 创建实例
-func NewRiderHandler(riderService service.RiderService) *RiderHandler {
+func NewRiderHandler(riderService service.RiderService, earningService service.EarningService) *RiderHandler {
 	return &RiderHandler{
-		riderService: riderService,
+		riderService:   riderService,
+		earningService: earningService,
 	}
 }
 
@@ -212,9 +214,11 @@ func (h *RiderHandler) UpdateDeliveryStatus(ctx context.Context, req *riderProto
 func (h *RiderHandler) ListPendingOrders(ctx context.Context, req *riderProto.ListPendingOrdersRequest) (*riderProto.ListPendingOrdersResponse, error) {
 	// 转换参数
 	param := service.ListPendingOrdersParam{
-		Area:     req.Area,
-		Page:     req.Page,
-		PageSize: req.PageSize,
+		Area:      req.Area,
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		Cursor:    req.Cursor,
+		WithTotal: req.WithTotal,
 	}
 
 	// 调用service
@@ -255,12 +259,13 @@ func (h *RiderHandler) ListPendingOrders(ctx context.Context, req *riderProto.Li
 	}
 
 	return &riderProto.ListPendingOrdersResponse{
-		Code:     utils.ErrCodeSuccess,
-		Msg:      "查询成功",
-		Orders:   protoOrders,
-		Total:    result.Total,
-		Page:     result.Page,
-		PageSize: result.PageSize,
+		Code:       utils.ErrCodeSuccess,
+		Msg:        "查询成功",
+		Orders:     protoOrders,
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		NextCursor: result.NextCursor,
 	}, nil
 }
 
@@ -272,6 +277,8 @@ func (h *RiderHandler) ListRiderOrders(ctx context.Context, req *riderProto.List
 		DeliveryStatus: req.DeliveryStatus,
 		Page:           req.Page,
 		PageSize:       req.PageSize,
+		Cursor:         req.Cursor,
+		WithTotal:      req.WithTotal,
 	}
 
 	// 调用service
@@ -312,11 +319,12 @@ func (h *RiderHandler) ListRiderOrders(ctx context.Context, req *riderProto.List
 	}
 
 	return &riderProto.ListRiderOrdersResponse{
-		Code:     utils.ErrCodeSuccess,
-		Msg:      "查询成功",
-		Orders:   protoOrders,
-		Total:    result.Total,
-		Page:     result.Page,
-		PageSize: result.PageSize,
+		Code:       utils.ErrCodeSuccess,
+		Msg:        "查询成功",
+		Orders:     protoOrders,
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		NextCursor: result.NextCursor,
 	}, nil
 }
@@ -264,29 +264,34 @@ func (x *DeliveryOrder) GetCompleteTime() string {
 	return ""
 }
 
-// 通用响应
-type CommonResponse struct {
+// 收入汇总（单个日/周或合计）
+type EarningSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	PeriodStart   string                 `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`         // 周期开始日期（按日为当天，按周为周一）
+	DeliveryCount int64                  `protobuf:"varint,2,opt,name=delivery_count,json=deliveryCount,proto3" json:"delivery_count,omitempty"`  // 配送单数
+	BaseFee       float32                `protobuf:"fixed32,3,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`                   // 基础收入
+	DistanceBonus float32                `protobuf:"fixed32,4,opt,name=distance_bonus,json=distanceBonus,proto3" json:"distance_bonus,omitempty"` // 距离补贴
+	PeakBonus     float32                `protobuf:"fixed32,5,opt,name=peak_bonus,json=peakBonus,proto3" json:"peak_bonus,omitempty"`             // 高峰加成
+	Tip           float32                `protobuf:"fixed32,6,opt,name=tip,proto3" json:"tip,omitempty"`                                          // 小费
+	Amount        float32                `protobuf:"fixed32,7,opt,name=amount,proto3" json:"amount,omitempty"`                                    // 收入合计
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommonResponse) Reset() {
-	*x = CommonResponse{}
+func (x *EarningSummary) Reset() {
+	*x = EarningSummary{}
 	mi := &file_rider_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommonResponse) String() string {
+func (x *EarningSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommonResponse) ProtoMessage() {}
+func (*EarningSummary) ProtoMessage() {}
 
-func (x *CommonResponse) ProtoReflect() protoreflect.Message {
+func (x *EarningSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_rider_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -298,50 +303,92 @@ func (x *CommonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommonResponse.ProtoReflect.Descriptor instead.
-func (*CommonResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EarningSummary.ProtoReflect.Descriptor instead.
+func (*EarningSummary) Descriptor() ([]byte, []int) {
 	return file_rider_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CommonResponse) GetCode() int32 {
+func (x *EarningSummary) GetPeriodStart() string {
 	if x != nil {
-		return x.Code
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *EarningSummary) GetDeliveryCount() int64 {
+	if x != nil {
+		return x.DeliveryCount
 	}
 	return 0
 }
 
-func (x *CommonResponse) GetMsg() string {
+func (x *EarningSummary) GetBaseFee() float32 {
 	if x != nil {
-		return x.Msg
+		return x.BaseFee
 	}
-	return ""
+	return 0
 }
 
-// 骑手注册请求
-type RiderRegisterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Avatar        string                 `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EarningSummary) GetDistanceBonus() float32 {
+	if x != nil {
+		return x.DistanceBonus
+	}
+	return 0
 }
 
-func (x *RiderRegisterRequest) Reset() {
-	*x = RiderRegisterRequest{}
+func (x *EarningSummary) GetPeakBonus() float32 {
+	if x != nil {
+		return x.PeakBonus
+	}
+	return 0
+}
+
+func (x *EarningSummary) GetTip() float32 {
+	if x != nil {
+		return x.Tip
+	}
+	return 0
+}
+
+func (x *EarningSummary) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 单笔配送收入流水
+type RiderEarning struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EarningId        int64                  `protobuf:"varint,1,opt,name=earning_id,json=earningId,proto3" json:"earning_id,omitempty"`                      // 流水ID
+	OrderId          int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                            // 订单ID
+	OrderNo          string                 `protobuf:"bytes,3,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`                             // 订单编号
+	DeliveryDistance int32                  `protobuf:"varint,4,opt,name=delivery_distance,json=deliveryDistance,proto3" json:"delivery_distance,omitempty"` // 配送距离（米）
+	BaseFee          float32                `protobuf:"fixed32,5,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`                           // 基础收入
+	DistanceBonus    float32                `protobuf:"fixed32,6,opt,name=distance_bonus,json=distanceBonus,proto3" json:"distance_bonus,omitempty"`         // 距离补贴
+	PeakMultiplier   float32                `protobuf:"fixed32,7,opt,name=peak_multiplier,json=peakMultiplier,proto3" json:"peak_multiplier,omitempty"`      // 高峰倍数
+	PeakBonus        float32                `protobuf:"fixed32,8,opt,name=peak_bonus,json=peakBonus,proto3" json:"peak_bonus,omitempty"`                     // 高峰加成
+	Tip              float32                `protobuf:"fixed32,9,opt,name=tip,proto3" json:"tip,omitempty"`                                                  // 小费
+	Amount           float32                `protobuf:"fixed32,10,opt,name=amount,proto3" json:"amount,omitempty"`                                           // 收入合计
+	CompleteTime     string                 `protobuf:"bytes,11,opt,name=complete_time,json=completeTime,proto3" json:"complete_time,omitempty"`             // 送达时间
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RiderEarning) Reset() {
+	*x = RiderEarning{}
 	mi := &file_rider_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RiderRegisterRequest) String() string {
+func (x *RiderEarning) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RiderRegisterRequest) ProtoMessage() {}
+func (*RiderEarning) ProtoMessage() {}
 
-func (x *RiderRegisterRequest) ProtoReflect() protoreflect.Message {
+func (x *RiderEarning) ProtoReflect() protoreflect.Message {
 	mi := &file_rider_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -353,64 +400,122 @@ func (x *RiderRegisterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RiderRegisterRequest.ProtoReflect.Descriptor instead.
-func (*RiderRegisterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RiderEarning.ProtoReflect.Descriptor instead.
+func (*RiderEarning) Descriptor() ([]byte, []int) {
 	return file_rider_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *RiderRegisterRequest) GetName() string {
+func (x *RiderEarning) GetEarningId() int64 {
 	if x != nil {
-		return x.Name
+		return x.EarningId
 	}
-	return ""
+	return 0
 }
 
-func (x *RiderRegisterRequest) GetPhone() string {
+func (x *RiderEarning) GetOrderId() int64 {
 	if x != nil {
-		return x.Phone
+		return x.OrderId
 	}
-	return ""
+	return 0
 }
 
-func (x *RiderRegisterRequest) GetPassword() string {
+func (x *RiderEarning) GetOrderNo() string {
 	if x != nil {
-		return x.Password
+		return x.OrderNo
 	}
 	return ""
 }
 
-func (x *RiderRegisterRequest) GetAvatar() string {
+func (x *RiderEarning) GetDeliveryDistance() int32 {
 	if x != nil {
-		return x.Avatar
+		return x.DeliveryDistance
+	}
+	return 0
+}
+
+func (x *RiderEarning) GetBaseFee() float32 {
+	if x != nil {
+		return x.BaseFee
+	}
+	return 0
+}
+
+func (x *RiderEarning) GetDistanceBonus() float32 {
+	if x != nil {
+		return x.DistanceBonus
+	}
+	return 0
+}
+
+func (x *RiderEarning) GetPeakMultiplier() float32 {
+	if x != nil {
+		return x.PeakMultiplier
+	}
+	return 0
+}
+
+func (x *RiderEarning) GetPeakBonus() float32 {
+	if x != nil {
+		return x.PeakBonus
+	}
+	return 0
+}
+
+func (x *RiderEarning) GetTip() float32 {
+	if x != nil {
+		return x.Tip
+	}
+	return 0
+}
+
+func (x *RiderEarning) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RiderEarning) GetCompleteTime() string {
+	if x != nil {
+		return x.CompleteTime
 	}
 	return ""
 }
 
-// 骑手注册响应
-type RiderRegisterResponse struct {
+// 骑手周结算单
+type RiderStatement struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	RiderId       int64                  `protobuf:"varint,3,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
-	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	StatementId   int64                  `protobuf:"varint,1,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`        // 结算单ID
+	StatementNo   string                 `protobuf:"bytes,2,opt,name=statement_no,json=statementNo,proto3" json:"statement_no,omitempty"`         // 结算单号（唯一）
+	RiderId       int64                  `protobuf:"varint,3,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`                    // 骑手ID
+	PeriodStart   string                 `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`         // 结算周期开始日期（周一）
+	PeriodEnd     string                 `protobuf:"bytes,5,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`               // 结算周期结束日期（周日）
+	DeliveryCount int64                  `protobuf:"varint,6,opt,name=delivery_count,json=deliveryCount,proto3" json:"delivery_count,omitempty"`  // 配送单数
+	BaseFee       float32                `protobuf:"fixed32,7,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`                   // 基础收入合计
+	DistanceBonus float32                `protobuf:"fixed32,8,opt,name=distance_bonus,json=distanceBonus,proto3" json:"distance_bonus,omitempty"` // 距离补贴合计
+	PeakBonus     float32                `protobuf:"fixed32,9,opt,name=peak_bonus,json=peakBonus,proto3" json:"peak_bonus,omitempty"`             // 高峰加成合计
+	Tip           float32                `protobuf:"fixed32,10,opt,name=tip,proto3" json:"tip,omitempty"`                                         // 小费合计
+	Amount        float32                `protobuf:"fixed32,11,opt,name=amount,proto3" json:"amount,omitempty"`                                   // 收入合计
+	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`                                     // 状态：待打款/已打款
+	CreateTime    string                 `protobuf:"bytes,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`           // 创建时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RiderRegisterResponse) Reset() {
-	*x = RiderRegisterResponse{}
+func (x *RiderStatement) Reset() {
+	*x = RiderStatement{}
 	mi := &file_rider_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RiderRegisterResponse) String() string {
+func (x *RiderStatement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RiderRegisterResponse) ProtoMessage() {}
+func (*RiderStatement) ProtoMessage() {}
 
-func (x *RiderRegisterResponse) ProtoReflect() protoreflect.Message {
+func (x *RiderStatement) ProtoReflect() protoreflect.Message {
 	mi := &file_rider_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -422,119 +527,126 @@ func (x *RiderRegisterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RiderRegisterResponse.ProtoReflect.Descriptor instead.
-func (*RiderRegisterResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RiderStatement.ProtoReflect.Descriptor instead.
+func (*RiderStatement) Descriptor() ([]byte, []int) {
 	return file_rider_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *RiderRegisterResponse) GetCode() int32 {
+func (x *RiderStatement) GetStatementId() int64 {
 	if x != nil {
-		return x.Code
+		return x.StatementId
 	}
 	return 0
 }
 
-func (x *RiderRegisterResponse) GetMsg() string {
+func (x *RiderStatement) GetStatementNo() string {
 	if x != nil {
-		return x.Msg
+		return x.StatementNo
 	}
 	return ""
 }
 
-func (x *RiderRegisterResponse) GetRiderId() int64 {
+func (x *RiderStatement) GetRiderId() int64 {
 	if x != nil {
 		return x.RiderId
 	}
 	return 0
 }
 
-func (x *RiderRegisterResponse) GetToken() string {
+func (x *RiderStatement) GetPeriodStart() string {
 	if x != nil {
-		return x.Token
+		return x.PeriodStart
 	}
 	return ""
 }
 
-// 骑手登录请求
-type RiderLoginRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RiderStatement) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
 }
 
-func (x *RiderLoginRequest) Reset() {
-	*x = RiderLoginRequest{}
-	mi := &file_rider_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RiderStatement) GetDeliveryCount() int64 {
+	if x != nil {
+		return x.DeliveryCount
+	}
+	return 0
 }
 
-func (x *RiderLoginRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RiderStatement) GetBaseFee() float32 {
+	if x != nil {
+		return x.BaseFee
+	}
+	return 0
 }
 
-func (*RiderLoginRequest) ProtoMessage() {}
+func (x *RiderStatement) GetDistanceBonus() float32 {
+	if x != nil {
+		return x.DistanceBonus
+	}
+	return 0
+}
 
-func (x *RiderLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rider_proto_msgTypes[5]
+func (x *RiderStatement) GetPeakBonus() float32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PeakBonus
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RiderLoginRequest.ProtoReflect.Descriptor instead.
-func (*RiderLoginRequest) Descriptor() ([]byte, []int) {
-	return file_rider_proto_rawDescGZIP(), []int{5}
+func (x *RiderStatement) GetTip() float32 {
+	if x != nil {
+		return x.Tip
+	}
+	return 0
 }
 
-func (x *RiderLoginRequest) GetPhone() string {
+func (x *RiderStatement) GetAmount() float32 {
 	if x != nil {
-		return x.Phone
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RiderStatement) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *RiderLoginRequest) GetPassword() string {
+func (x *RiderStatement) GetCreateTime() string {
 	if x != nil {
-		return x.Password
+		return x.CreateTime
 	}
 	return ""
 }
 
-// 骑手登录响应
-type RiderLoginResponse struct {
+// 通用响应
+type CommonResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	RiderId       int64                  `protobuf:"varint,3,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RiderLoginResponse) Reset() {
-	*x = RiderLoginResponse{}
-	mi := &file_rider_proto_msgTypes[6]
+func (x *CommonResponse) Reset() {
+	*x = CommonResponse{}
+	mi := &file_rider_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RiderLoginResponse) String() string {
+func (x *CommonResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RiderLoginResponse) ProtoMessage() {}
+func (*CommonResponse) ProtoMessage() {}
 
-func (x *RiderLoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rider_proto_msgTypes[6]
+func (x *CommonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,69 +657,51 @@ func (x *RiderLoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RiderLoginResponse.ProtoReflect.Descriptor instead.
-func (*RiderLoginResponse) Descriptor() ([]byte, []int) {
-	return file_rider_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CommonResponse.ProtoReflect.Descriptor instead.
+func (*CommonResponse) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RiderLoginResponse) GetCode() int32 {
+func (x *CommonResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *RiderLoginResponse) GetMsg() string {
+func (x *CommonResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *RiderLoginResponse) GetRiderId() int64 {
-	if x != nil {
-		return x.RiderId
-	}
-	return 0
-}
-
-func (x *RiderLoginResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RiderLoginResponse) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
-}
-
-// 获取骑手信息请求
-type GetRiderInfoRequest struct {
+// 骑手注册请求
+type RiderRegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RiderId       int64                  `protobuf:"varint,1,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Avatar        string                 `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRiderInfoRequest) Reset() {
-	*x = GetRiderInfoRequest{}
-	mi := &file_rider_proto_msgTypes[7]
+func (x *RiderRegisterRequest) Reset() {
+	*x = RiderRegisterRequest{}
+	mi := &file_rider_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRiderInfoRequest) String() string {
+func (x *RiderRegisterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRiderInfoRequest) ProtoMessage() {}
+func (*RiderRegisterRequest) ProtoMessage() {}
 
-func (x *GetRiderInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rider_proto_msgTypes[7]
+func (x *RiderRegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,43 +712,750 @@ func (x *GetRiderInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRiderInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetRiderInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RiderRegisterRequest.ProtoReflect.Descriptor instead.
+func (*RiderRegisterRequest) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RiderRegisterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RiderRegisterRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *RiderRegisterRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RiderRegisterRequest) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+// 骑手注册响应
+type RiderRegisterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	RiderId       int64                  `protobuf:"varint,3,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiderRegisterResponse) Reset() {
+	*x = RiderRegisterResponse{}
+	mi := &file_rider_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiderRegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiderRegisterResponse) ProtoMessage() {}
+
+func (x *RiderRegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiderRegisterResponse.ProtoReflect.Descriptor instead.
+func (*RiderRegisterResponse) Descriptor() ([]byte, []int) {
 	return file_rider_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetRiderInfoRequest) GetRiderId() int64 {
+func (x *RiderRegisterResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RiderRegisterResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RiderRegisterResponse) GetRiderId() int64 {
 	if x != nil {
 		return x.RiderId
 	}
 	return 0
 }
 
-// 获取骑手信息响应
-type GetRiderInfoResponse struct {
+func (x *RiderRegisterResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 骑手登录请求
+type RiderLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiderLoginRequest) Reset() {
+	*x = RiderLoginRequest{}
+	mi := &file_rider_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiderLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiderLoginRequest) ProtoMessage() {}
+
+func (x *RiderLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiderLoginRequest.ProtoReflect.Descriptor instead.
+func (*RiderLoginRequest) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RiderLoginRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *RiderLoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// 骑手登录响应
+type RiderLoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Rider         *Rider                 `protobuf:"bytes,3,opt,name=rider,proto3" json:"rider,omitempty"`
+	RiderId       int64                  `protobuf:"varint,3,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRiderInfoResponse) Reset() {
-	*x = GetRiderInfoResponse{}
-	mi := &file_rider_proto_msgTypes[8]
+func (x *RiderLoginResponse) Reset() {
+	*x = RiderLoginResponse{}
+	mi := &file_rider_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRiderInfoResponse) String() string {
+func (x *RiderLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiderLoginResponse) ProtoMessage() {}
+
+func (x *RiderLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiderLoginResponse.ProtoReflect.Descriptor instead.
+func (*RiderLoginResponse) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RiderLoginResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RiderLoginResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RiderLoginResponse) GetRiderId() int64 {
+	if x != nil {
+		return x.RiderId
+	}
+	return 0
+}
+
+func (x *RiderLoginResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RiderLoginResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 获取骑手信息请求
+type GetRiderInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RiderId       int64                  `protobuf:"varint,1,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRiderInfoRequest) Reset() {
+	*x = GetRiderInfoRequest{}
+	mi := &file_rider_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiderInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiderInfoRequest) ProtoMessage() {}
+
+func (x *GetRiderInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiderInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetRiderInfoRequest) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetRiderInfoRequest) GetRiderId() int64 {
+	if x != nil {
+		return x.RiderId
+	}
+	return 0
+}
+
+// 获取骑手信息响应
+type GetRiderInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Rider         *Rider                 `protobuf:"bytes,3,opt,name=rider,proto3" json:"rider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRiderInfoResponse) Reset() {
+	*x = GetRiderInfoResponse{}
+	mi := &file_rider_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiderInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiderInfoResponse) ProtoMessage() {}
+
+func (x *GetRiderInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiderInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetRiderInfoResponse) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetRiderInfoResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetRiderInfoResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetRiderInfoResponse) GetRider() *Rider {
+	if x != nil {
+		return x.Rider
+	}
+	return nil
+}
+
+// 骑手接单请求
+type AcceptOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	RiderId       int64                  `protobuf:"varint,2,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptOrderRequest) Reset() {
+	*x = AcceptOrderRequest{}
+	mi := &file_rider_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrderRequest) ProtoMessage() {}
+
+func (x *AcceptOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrderRequest.ProtoReflect.Descriptor instead.
+func (*AcceptOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AcceptOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *AcceptOrderRequest) GetRiderId() int64 {
+	if x != nil {
+		return x.RiderId
+	}
+	return 0
+}
+
+// 更新配送状态请求
+type UpdateDeliveryStatusRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	RiderId        int64                  `protobuf:"varint,2,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	DeliveryStatus string                 `protobuf:"bytes,3,opt,name=delivery_status,json=deliveryStatus,proto3" json:"delivery_status,omitempty"`
+	Time           string                 `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"` // 状态变更时间（取餐/完成时间）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateDeliveryStatusRequest) Reset() {
+	*x = UpdateDeliveryStatusRequest{}
+	mi := &file_rider_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeliveryStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeliveryStatusRequest) ProtoMessage() {}
+
+func (x *UpdateDeliveryStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeliveryStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeliveryStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateDeliveryStatusRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *UpdateDeliveryStatusRequest) GetRiderId() int64 {
+	if x != nil {
+		return x.RiderId
+	}
+	return 0
+}
+
+func (x *UpdateDeliveryStatusRequest) GetDeliveryStatus() string {
+	if x != nil {
+		return x.DeliveryStatus
+	}
+	return ""
+}
+
+func (x *UpdateDeliveryStatusRequest) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+// 查询待接订单列表请求
+type ListPendingOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Area          string                 `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`  // 配送区域（可选）
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"` // 页码（0表示游标分页）
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                         // 游标（上一页返回的next_cursor，传入时忽略page）
+	WithTotal     bool                   `protobuf:"varint,5,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"` // 游标分页时是否统计总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingOrdersRequest) Reset() {
+	*x = ListPendingOrdersRequest{}
+	mi := &file_rider_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingOrdersRequest) ProtoMessage() {}
+
+func (x *ListPendingOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListPendingOrdersRequest) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *ListPendingOrdersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPendingOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPendingOrdersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListPendingOrdersRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+// 查询待接订单列表响应
+type ListPendingOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Orders        []*DeliveryOrder       `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"` // 总条数（未统计时为-1）
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,7,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标（为空表示没有更多）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingOrdersResponse) Reset() {
+	*x = ListPendingOrdersResponse{}
+	mi := &file_rider_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingOrdersResponse) ProtoMessage() {}
+
+func (x *ListPendingOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListPendingOrdersResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListPendingOrdersResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListPendingOrdersResponse) GetOrders() []*DeliveryOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListPendingOrdersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListPendingOrdersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPendingOrdersResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPendingOrdersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// 查询骑手配送订单请求
+type ListRiderOrdersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RiderId        int64                  `protobuf:"varint,1,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	DeliveryStatus string                 `protobuf:"bytes,2,opt,name=delivery_status,json=deliveryStatus,proto3" json:"delivery_status,omitempty"` // 配送状态（可选）
+	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                                          // 页码（0表示游标分页）
+	PageSize       int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor         string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`                         // 游标（上一页返回的next_cursor，传入时忽略page）
+	WithTotal      bool                   `protobuf:"varint,6,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"` // 游标分页时是否统计总数
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRiderOrdersRequest) Reset() {
+	*x = ListRiderOrdersRequest{}
+	mi := &file_rider_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiderOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiderOrdersRequest) ProtoMessage() {}
+
+func (x *ListRiderOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiderOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListRiderOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListRiderOrdersRequest) GetRiderId() int64 {
+	if x != nil {
+		return x.RiderId
+	}
+	return 0
+}
+
+func (x *ListRiderOrdersRequest) GetDeliveryStatus() string {
+	if x != nil {
+		return x.DeliveryStatus
+	}
+	return ""
+}
+
+func (x *ListRiderOrdersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRiderOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRiderOrdersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRiderOrdersRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+// 查询骑手配送订单响应
+type ListRiderOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Orders        []*DeliveryOrder       `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"` // 总条数（未统计时为-1）
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,7,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标（为空表示没有更多）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRiderOrdersResponse) Reset() {
+	*x = ListRiderOrdersResponse{}
+	mi := &file_rider_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiderOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRiderInfoResponse) ProtoMessage() {}
+func (*ListRiderOrdersResponse) ProtoMessage() {}
 
-func (x *GetRiderInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rider_proto_msgTypes[8]
+func (x *ListRiderOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -665,56 +1466,86 @@ func (x *GetRiderInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRiderInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetRiderInfoResponse) Descriptor() ([]byte, []int) {
-	return file_rider_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListRiderOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListRiderOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetRiderInfoResponse) GetCode() int32 {
+func (x *ListRiderOrdersResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetRiderInfoResponse) GetMsg() string {
+func (x *ListRiderOrdersResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *GetRiderInfoResponse) GetRider() *Rider {
+func (x *ListRiderOrdersResponse) GetOrders() []*DeliveryOrder {
 	if x != nil {
-		return x.Rider
+		return x.Orders
 	}
 	return nil
 }
 
-// 骑手接单请求
-type AcceptOrderRequest struct {
+func (x *ListRiderOrdersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListRiderOrdersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRiderOrdersResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRiderOrdersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// 查询骑手收入请求
+type GetRiderEarningsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	RiderId       int64                  `protobuf:"varint,2,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	RiderId       int64                  `protobuf:"varint,1,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 开始日期（格式2006-01-02）
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 结束日期（含）
+	Granularity   string                 `protobuf:"bytes,4,opt,name=granularity,proto3" json:"granularity,omitempty"`              // 明细粒度：day/week（默认day）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AcceptOrderRequest) Reset() {
-	*x = AcceptOrderRequest{}
-	mi := &file_rider_proto_msgTypes[9]
+func (x *GetRiderEarningsRequest) Reset() {
+	*x = GetRiderEarningsRequest{}
+	mi := &file_rider_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptOrderRequest) String() string {
+func (x *GetRiderEarningsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptOrderRequest) ProtoMessage() {}
+func (*GetRiderEarningsRequest) ProtoMessage() {}
 
-func (x *AcceptOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rider_proto_msgTypes[9]
+func (x *GetRiderEarningsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,51 +1556,65 @@ func (x *AcceptOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptOrderRequest.ProtoReflect.Descriptor instead.
-func (*AcceptOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rider_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetRiderEarningsRequest.ProtoReflect.Descriptor instead.
+func (*GetRiderEarningsRequest) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *AcceptOrderRequest) GetOrderId() int64 {
+func (x *GetRiderEarningsRequest) GetRiderId() int64 {
 	if x != nil {
-		return x.OrderId
+		return x.RiderId
 	}
 	return 0
 }
 
-func (x *AcceptOrderRequest) GetRiderId() int64 {
+func (x *GetRiderEarningsRequest) GetStartDate() string {
 	if x != nil {
-		return x.RiderId
+		return x.StartDate
 	}
-	return 0
+	return ""
 }
 
-// 更新配送状态请求
-type UpdateDeliveryStatusRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrderId        int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	RiderId        int64                  `protobuf:"varint,2,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
-	DeliveryStatus string                 `protobuf:"bytes,3,opt,name=delivery_status,json=deliveryStatus,proto3" json:"delivery_status,omitempty"`
-	Time           string                 `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"` // 状态变更时间（取餐/完成时间）
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetRiderEarningsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
 }
 
-func (x *UpdateDeliveryStatusRequest) Reset() {
-	*x = UpdateDeliveryStatusRequest{}
-	mi := &file_rider_proto_msgTypes[10]
+func (x *GetRiderEarningsRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+// 查询骑手收入响应
+type GetRiderEarningsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Total         *EarningSummary        `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`     // 合计
+	Periods       []*EarningSummary      `protobuf:"bytes,4,rep,name=periods,proto3" json:"periods,omitempty"` // 每日/每周明细
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRiderEarningsResponse) Reset() {
+	*x = GetRiderEarningsResponse{}
+	mi := &file_rider_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDeliveryStatusRequest) String() string {
+func (x *GetRiderEarningsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDeliveryStatusRequest) ProtoMessage() {}
+func (*GetRiderEarningsResponse) ProtoMessage() {}
 
-func (x *UpdateDeliveryStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rider_proto_msgTypes[10]
+func (x *GetRiderEarningsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -780,64 +1625,64 @@ func (x *UpdateDeliveryStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDeliveryStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDeliveryStatusRequest) Descriptor() ([]byte, []int) {
-	return file_rider_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetRiderEarningsResponse.ProtoReflect.Descriptor instead.
+func (*GetRiderEarningsResponse) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UpdateDeliveryStatusRequest) GetOrderId() int64 {
+func (x *GetRiderEarningsResponse) GetCode() int32 {
 	if x != nil {
-		return x.OrderId
+		return x.Code
 	}
 	return 0
 }
 
-func (x *UpdateDeliveryStatusRequest) GetRiderId() int64 {
+func (x *GetRiderEarningsResponse) GetMsg() string {
 	if x != nil {
-		return x.RiderId
+		return x.Msg
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateDeliveryStatusRequest) GetDeliveryStatus() string {
+func (x *GetRiderEarningsResponse) GetTotal() *EarningSummary {
 	if x != nil {
-		return x.DeliveryStatus
+		return x.Total
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateDeliveryStatusRequest) GetTime() string {
+func (x *GetRiderEarningsResponse) GetPeriods() []*EarningSummary {
 	if x != nil {
-		return x.Time
+		return x.Periods
 	}
-	return ""
+	return nil
 }
 
-// 查询待接订单列表请求
-type ListPendingOrdersRequest struct {
+// 查询骑手结算单请求
+type ListRiderStatementsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Area          string                 `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"` // 配送区域（可选）
+	RiderId       int64                  `protobuf:"varint,1,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
 	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPendingOrdersRequest) Reset() {
-	*x = ListPendingOrdersRequest{}
-	mi := &file_rider_proto_msgTypes[11]
+func (x *ListRiderStatementsRequest) Reset() {
+	*x = ListRiderStatementsRequest{}
+	mi := &file_rider_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPendingOrdersRequest) String() string {
+func (x *ListRiderStatementsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPendingOrdersRequest) ProtoMessage() {}
+func (*ListRiderStatementsRequest) ProtoMessage() {}
 
-func (x *ListPendingOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rider_proto_msgTypes[11]
+func (x *ListRiderStatementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,38 +1693,38 @@ func (x *ListPendingOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPendingOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListPendingOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rider_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListRiderStatementsRequest.ProtoReflect.Descriptor instead.
+func (*ListRiderStatementsRequest) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListPendingOrdersRequest) GetArea() string {
+func (x *ListRiderStatementsRequest) GetRiderId() int64 {
 	if x != nil {
-		return x.Area
+		return x.RiderId
 	}
-	return ""
+	return 0
 }
 
-func (x *ListPendingOrdersRequest) GetPage() int32 {
+func (x *ListRiderStatementsRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *ListPendingOrdersRequest) GetPageSize() int32 {
+func (x *ListRiderStatementsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-// 查询待接订单列表响应
-type ListPendingOrdersResponse struct {
+// 查询骑手结算单响应
+type ListRiderStatementsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Orders        []*DeliveryOrder       `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
+	Statements    []*RiderStatement      `protobuf:"bytes,3,rep,name=statements,proto3" json:"statements,omitempty"`
 	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
 	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
@@ -887,21 +1732,21 @@ type ListPendingOrdersResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPendingOrdersResponse) Reset() {
-	*x = ListPendingOrdersResponse{}
-	mi := &file_rider_proto_msgTypes[12]
+func (x *ListRiderStatementsResponse) Reset() {
+	*x = ListRiderStatementsResponse{}
+	mi := &file_rider_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPendingOrdersResponse) String() string {
+func (x *ListRiderStatementsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPendingOrdersResponse) ProtoMessage() {}
+func (*ListRiderStatementsResponse) ProtoMessage() {}
 
-func (x *ListPendingOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rider_proto_msgTypes[12]
+func (x *ListRiderStatementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,79 +1757,76 @@ func (x *ListPendingOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPendingOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListPendingOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rider_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListRiderStatementsResponse.ProtoReflect.Descriptor instead.
+func (*ListRiderStatementsResponse) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListPendingOrdersResponse) GetCode() int32 {
+func (x *ListRiderStatementsResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *ListPendingOrdersResponse) GetMsg() string {
+func (x *ListRiderStatementsResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *ListPendingOrdersResponse) GetOrders() []*DeliveryOrder {
+func (x *ListRiderStatementsResponse) GetStatements() []*RiderStatement {
 	if x != nil {
-		return x.Orders
+		return x.Statements
 	}
 	return nil
 }
 
-func (x *ListPendingOrdersResponse) GetTotal() int32 {
+func (x *ListRiderStatementsResponse) GetTotal() int32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *ListPendingOrdersResponse) GetPage() int32 {
+func (x *ListRiderStatementsResponse) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *ListPendingOrdersResponse) GetPageSize() int32 {
+func (x *ListRiderStatementsResponse) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-// 查询骑手配送订单请求
-type ListRiderOrdersRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	RiderId        int64                  `protobuf:"varint,1,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
-	DeliveryStatus string                 `protobuf:"bytes,2,opt,name=delivery_status,json=deliveryStatus,proto3" json:"delivery_status,omitempty"` // 配送状态（可选）
-	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize       int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// 查询骑手结算单详情请求
+type GetRiderStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatementId   int64                  `protobuf:"varint,1,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRiderOrdersRequest) Reset() {
-	*x = ListRiderOrdersRequest{}
-	mi := &file_rider_proto_msgTypes[13]
+func (x *GetRiderStatementRequest) Reset() {
+	*x = GetRiderStatementRequest{}
+	mi := &file_rider_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRiderOrdersRequest) String() string {
+func (x *GetRiderStatementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRiderOrdersRequest) ProtoMessage() {}
+func (*GetRiderStatementRequest) ProtoMessage() {}
 
-func (x *ListRiderOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rider_proto_msgTypes[13]
+func (x *GetRiderStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -995,67 +1837,44 @@ func (x *ListRiderOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRiderOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListRiderOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rider_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ListRiderOrdersRequest) GetRiderId() int64 {
-	if x != nil {
-		return x.RiderId
-	}
-	return 0
-}
-
-func (x *ListRiderOrdersRequest) GetDeliveryStatus() string {
-	if x != nil {
-		return x.DeliveryStatus
-	}
-	return ""
-}
-
-func (x *ListRiderOrdersRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use GetRiderStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetRiderStatementRequest) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListRiderOrdersRequest) GetPageSize() int32 {
+func (x *GetRiderStatementRequest) GetStatementId() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.StatementId
 	}
 	return 0
 }
 
-// 查询骑手配送订单响应
-type ListRiderOrdersResponse struct {
+// 查询骑手结算单详情响应
+type GetRiderStatementResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Orders        []*DeliveryOrder       `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Statement     *RiderStatement        `protobuf:"bytes,3,opt,name=statement,proto3" json:"statement,omitempty"`
+	Earnings      []*RiderEarning        `protobuf:"bytes,4,rep,name=earnings,proto3" json:"earnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRiderOrdersResponse) Reset() {
-	*x = ListRiderOrdersResponse{}
-	mi := &file_rider_proto_msgTypes[14]
+func (x *GetRiderStatementResponse) Reset() {
+	*x = GetRiderStatementResponse{}
+	mi := &file_rider_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRiderOrdersResponse) String() string {
+func (x *GetRiderStatementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRiderOrdersResponse) ProtoMessage() {}
+func (*GetRiderStatementResponse) ProtoMessage() {}
 
-func (x *ListRiderOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rider_proto_msgTypes[14]
+func (x *GetRiderStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rider_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,51 +1885,37 @@ func (x *ListRiderOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRiderOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListRiderOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rider_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetRiderStatementResponse.ProtoReflect.Descriptor instead.
+func (*GetRiderStatementResponse) Descriptor() ([]byte, []int) {
+	return file_rider_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ListRiderOrdersResponse) GetCode() int32 {
+func (x *GetRiderStatementResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *ListRiderOrdersResponse) GetMsg() string {
+func (x *GetRiderStatementResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *ListRiderOrdersResponse) GetOrders() []*DeliveryOrder {
+func (x *GetRiderStatementResponse) GetStatement() *RiderStatement {
 	if x != nil {
-		return x.Orders
+		return x.Statement
 	}
 	return nil
 }
 
-func (x *ListRiderOrdersResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListRiderOrdersResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListRiderOrdersResponse) GetPageSize() int32 {
+func (x *GetRiderStatementResponse) GetEarnings() []*RiderEarning {
 	if x != nil {
-		return x.PageSize
+		return x.Earnings
 	}
-	return 0
+	return nil
 }
 
 var File_rider_proto protoreflect.FileDescriptor
@@ -1148,7 +1953,49 @@ const file_rider_proto_rawDesc = "" +
 	"acceptTime\x12\x1f\n" +
 	"\vpickup_time\x18\v \x01(\tR\n" +
 	"pickupTime\x12#\n" +
-	"\rcomplete_time\x18\f \x01(\tR\fcompleteTime\"6\n" +
+	"\rcomplete_time\x18\f \x01(\tR\fcompleteTime\"\xe5\x01\n" +
+	"\x0eEarningSummary\x12!\n" +
+	"\fperiod_start\x18\x01 \x01(\tR\vperiodStart\x12%\n" +
+	"\x0edelivery_count\x18\x02 \x01(\x03R\rdeliveryCount\x12\x19\n" +
+	"\bbase_fee\x18\x03 \x01(\x02R\abaseFee\x12%\n" +
+	"\x0edistance_bonus\x18\x04 \x01(\x02R\rdistanceBonus\x12\x1d\n" +
+	"\n" +
+	"peak_bonus\x18\x05 \x01(\x02R\tpeakBonus\x12\x10\n" +
+	"\x03tip\x18\x06 \x01(\x02R\x03tip\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x02R\x06amount\"\xe9\x02\n" +
+	"\fRiderEarning\x12\x1d\n" +
+	"\n" +
+	"earning_id\x18\x01 \x01(\x03R\tearningId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x19\n" +
+	"\border_no\x18\x03 \x01(\tR\aorderNo\x12+\n" +
+	"\x11delivery_distance\x18\x04 \x01(\x05R\x10deliveryDistance\x12\x19\n" +
+	"\bbase_fee\x18\x05 \x01(\x02R\abaseFee\x12%\n" +
+	"\x0edistance_bonus\x18\x06 \x01(\x02R\rdistanceBonus\x12'\n" +
+	"\x0fpeak_multiplier\x18\a \x01(\x02R\x0epeakMultiplier\x12\x1d\n" +
+	"\n" +
+	"peak_bonus\x18\b \x01(\x02R\tpeakBonus\x12\x10\n" +
+	"\x03tip\x18\t \x01(\x02R\x03tip\x12\x16\n" +
+	"\x06amount\x18\n" +
+	" \x01(\x02R\x06amount\x12#\n" +
+	"\rcomplete_time\x18\v \x01(\tR\fcompleteTime\"\x9e\x03\n" +
+	"\x0eRiderStatement\x12!\n" +
+	"\fstatement_id\x18\x01 \x01(\x03R\vstatementId\x12!\n" +
+	"\fstatement_no\x18\x02 \x01(\tR\vstatementNo\x12\x19\n" +
+	"\brider_id\x18\x03 \x01(\x03R\ariderId\x12!\n" +
+	"\fperiod_start\x18\x04 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x05 \x01(\tR\tperiodEnd\x12%\n" +
+	"\x0edelivery_count\x18\x06 \x01(\x03R\rdeliveryCount\x12\x19\n" +
+	"\bbase_fee\x18\a \x01(\x02R\abaseFee\x12%\n" +
+	"\x0edistance_bonus\x18\b \x01(\x02R\rdistanceBonus\x12\x1d\n" +
+	"\n" +
+	"peak_bonus\x18\t \x01(\x02R\tpeakBonus\x12\x10\n" +
+	"\x03tip\x18\n" +
+	" \x01(\x02R\x03tip\x12\x16\n" +
+	"\x06amount\x18\v \x01(\x02R\x06amount\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12\x1f\n" +
+	"\vcreate_time\x18\r \x01(\tR\n" +
+	"createTime\"6\n" +
 	"\x0eCommonResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x02 \x01(\tR\x03msg\"\xa6\x01\n" +
@@ -1184,32 +2031,76 @@ const file_rider_proto_rawDesc = "" +
 	"\border_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\x12\"\n" +
 	"\brider_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\ariderId\x120\n" +
 	"\x0fdelivery_status\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\x0edeliveryStatus\x12\x12\n" +
-	"\x04time\x18\x04 \x01(\tR\x04time\"s\n" +
+	"\x04time\x18\x04 \x01(\tR\x04time\"\xaa\x01\n" +
 	"\x18ListPendingOrdersRequest\x12\x12\n" +
 	"\x04area\x18\x01 \x01(\tR\x04area\x12\x1b\n" +
-	"\x04page\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\x04page\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12&\n" +
 	"\tpage_size\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\n" +
-	"R\bpageSize\"\xb6\x01\n" +
+	"R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"with_total\x18\x05 \x01(\bR\twithTotal\"\xd7\x01\n" +
 	"\x19ListPendingOrdersResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x02 \x01(\tR\x03msg\x12,\n" +
 	"\x06orders\x18\x03 \x03(\v2\x14.rider.DeliveryOrderR\x06orders\x12\x14\n" +
 	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\"\xaa\x01\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vnext_cursor\x18\a \x01(\tR\n" +
+	"nextCursor\"\xe1\x01\n" +
 	"\x16ListRiderOrdersRequest\x12\"\n" +
 	"\brider_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\ariderId\x12'\n" +
 	"\x0fdelivery_status\x18\x02 \x01(\tR\x0edeliveryStatus\x12\x1b\n" +
-	"\x04page\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\x04page\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12&\n" +
 	"\tpage_size\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\n" +
-	"R\bpageSize\"\xb4\x01\n" +
+	"R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"with_total\x18\x06 \x01(\bR\twithTotal\"\xd5\x01\n" +
 	"\x17ListRiderOrdersResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x02 \x01(\tR\x03msg\x12,\n" +
 	"\x06orders\x18\x03 \x03(\v2\x14.rider.DeliveryOrderR\x06orders\x12\x14\n" +
 	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x06 \x01(\x05R\bpageSize2\xa4\x04\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vnext_cursor\x18\a \x01(\tR\n" +
+	"nextCursor\"\xab\x01\n" +
+	"\x17GetRiderEarningsRequest\x12\"\n" +
+	"\brider_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\ariderId\x12&\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\n" +
+	"R\tstartDate\x12\"\n" +
+	"\bend_date\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\n" +
+	"R\aendDate\x12 \n" +
+	"\vgranularity\x18\x04 \x01(\tR\vgranularity\"\x9e\x01\n" +
+	"\x18GetRiderEarningsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12+\n" +
+	"\x05total\x18\x03 \x01(\v2\x15.rider.EarningSummaryR\x05total\x12/\n" +
+	"\aperiods\x18\x04 \x03(\v2\x15.rider.EarningSummaryR\aperiods\"\x85\x01\n" +
+	"\x1aListRiderStatementsRequest\x12\"\n" +
+	"\brider_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\ariderId\x12\x1b\n" +
+	"\x04page\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\n" +
+	"R\bpageSize\"\xc1\x01\n" +
+	"\x1bListRiderStatementsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x125\n" +
+	"\n" +
+	"statements\x18\x03 \x03(\v2\x15.rider.RiderStatementR\n" +
+	"statements\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\"F\n" +
+	"\x18GetRiderStatementRequest\x12*\n" +
+	"\fstatement_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\vstatementId\"\xa7\x01\n" +
+	"\x19GetRiderStatementResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x123\n" +
+	"\tstatement\x18\x03 \x01(\v2\x15.rider.RiderStatementR\tstatement\x12/\n" +
+	"\bearnings\x18\x04 \x03(\v2\x13.rider.RiderEarningR\bearnings2\xaf\x06\n" +
 	"\fRiderService\x12J\n" +
 	"\rRiderRegister\x12\x1b.rider.RiderRegisterRequest\x1a\x1c.rider.RiderRegisterResponse\x12A\n" +
 	"\n" +
@@ -1218,7 +2109,10 @@ const file_rider_proto_rawDesc = "" +
 	"\vAcceptOrder\x12\x19.rider.AcceptOrderRequest\x1a\x15.rider.CommonResponse\x12Q\n" +
 	"\x14UpdateDeliveryStatus\x12\".rider.UpdateDeliveryStatusRequest\x1a\x15.rider.CommonResponse\x12V\n" +
 	"\x11ListPendingOrders\x12\x1f.rider.ListPendingOrdersRequest\x1a .rider.ListPendingOrdersResponse\x12P\n" +
-	"\x0fListRiderOrders\x12\x1d.rider.ListRiderOrdersRequest\x1a\x1e.rider.ListRiderOrdersResponseB#Z!./internal/rider/proto;riderProtob\x06proto3"
+	"\x0fListRiderOrders\x12\x1d.rider.ListRiderOrdersRequest\x1a\x1e.rider.ListRiderOrdersResponse\x12S\n" +
+	"\x10GetRiderEarnings\x12\x1e.rider.GetRiderEarningsRequest\x1a\x1f.rider.GetRiderEarningsResponse\x12\\\n" +
+	"\x13ListRiderStatements\x12!.rider.ListRiderStatementsRequest\x1a\".rider.ListRiderStatementsResponse\x12V\n" +
+	"\x11GetRiderStatement\x12\x1f.rider.GetRiderStatementRequest\x1a .rider.GetRiderStatementResponseB#Z!./internal/rider/proto;riderProtob\x06proto3"
 
 var (
 	file_rider_proto_rawDescOnce sync.Once
@@ -1232,47 +2126,67 @@ func file_rider_proto_rawDescGZIP() []byte {
 	return file_rider_proto_rawDescData
 }
 
-var file_rider_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_rider_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_rider_proto_goTypes = []any{
 	(*Rider)(nil),                       // 0: rider.Rider
 	(*DeliveryOrder)(nil),               // 1: rider.DeliveryOrder
-	(*CommonResponse)(nil),              // 2: rider.CommonResponse
-	(*RiderRegisterRequest)(nil),        // 3: rider.RiderRegisterRequest
-	(*RiderRegisterResponse)(nil),       // 4: rider.RiderRegisterResponse
-	(*RiderLoginRequest)(nil),           // 5: rider.RiderLoginRequest
-	(*RiderLoginResponse)(nil),          // 6: rider.RiderLoginResponse
-	(*GetRiderInfoRequest)(nil),         // 7: rider.GetRiderInfoRequest
-	(*GetRiderInfoResponse)(nil),        // 8: rider.GetRiderInfoResponse
-	(*AcceptOrderRequest)(nil),          // 9: rider.AcceptOrderRequest
-	(*UpdateDeliveryStatusRequest)(nil), // 10: rider.UpdateDeliveryStatusRequest
-	(*ListPendingOrdersRequest)(nil),    // 11: rider.ListPendingOrdersRequest
-	(*ListPendingOrdersResponse)(nil),   // 12: rider.ListPendingOrdersResponse
-	(*ListRiderOrdersRequest)(nil),      // 13: rider.ListRiderOrdersRequest
-	(*ListRiderOrdersResponse)(nil),     // 14: rider.ListRiderOrdersResponse
+	(*EarningSummary)(nil),              // 2: rider.EarningSummary
+	(*RiderEarning)(nil),                // 3: rider.RiderEarning
+	(*RiderStatement)(nil),              // 4: rider.RiderStatement
+	(*CommonResponse)(nil),              // 5: rider.CommonResponse
+	(*RiderRegisterRequest)(nil),        // 6: rider.RiderRegisterRequest
+	(*RiderRegisterResponse)(nil),       // 7: rider.RiderRegisterResponse
+	(*RiderLoginRequest)(nil),           // 8: rider.RiderLoginRequest
+	(*RiderLoginResponse)(nil),          // 9: rider.RiderLoginResponse
+	(*GetRiderInfoRequest)(nil),         // 10: rider.GetRiderInfoRequest
+	(*GetRiderInfoResponse)(nil),        // 11: rider.GetRiderInfoResponse
+	(*AcceptOrderRequest)(nil),          // 12: rider.AcceptOrderRequest
+	(*UpdateDeliveryStatusRequest)(nil), // 13: rider.UpdateDeliveryStatusRequest
+	(*ListPendingOrdersRequest)(nil),    // 14: rider.ListPendingOrdersRequest
+	(*ListPendingOrdersResponse)(nil),   // 15: rider.ListPendingOrdersResponse
+	(*ListRiderOrdersRequest)(nil),      // 16: rider.ListRiderOrdersRequest
+	(*ListRiderOrdersResponse)(nil),     // 17: rider.ListRiderOrdersResponse
+	(*GetRiderEarningsRequest)(nil),     // 18: rider.GetRiderEarningsRequest
+	(*GetRiderEarningsResponse)(nil),    // 19: rider.GetRiderEarningsResponse
+	(*ListRiderStatementsRequest)(nil),  // 20: rider.ListRiderStatementsRequest
+	(*ListRiderStatementsResponse)(nil), // 21: rider.ListRiderStatementsResponse
+	(*GetRiderStatementRequest)(nil),    // 22: rider.GetRiderStatementRequest
+	(*GetRiderStatementResponse)(nil),   // 23: rider.GetRiderStatementResponse
 }
 var file_rider_proto_depIdxs = []int32{
 	0,  // 0: rider.GetRiderInfoResponse.rider:type_name -> rider.Rider
 	1,  // 1: rider.ListPendingOrdersResponse.orders:type_name -> rider.DeliveryOrder
 	1,  // 2: rider.ListRiderOrdersResponse.orders:type_name -> rider.DeliveryOrder
-	3,  // 3: rider.RiderService.RiderRegister:input_type -> rider.RiderRegisterRequest
-	5,  // 4: rider.RiderService.RiderLogin:input_type -> rider.RiderLoginRequest
-	7,  // 5: rider.RiderService.GetRiderInfo:input_type -> rider.GetRiderInfoRequest
-	9,  // 6: rider.RiderService.AcceptOrder:input_type -> rider.AcceptOrderRequest
-	10, // 7: rider.RiderService.UpdateDeliveryStatus:input_type -> rider.UpdateDeliveryStatusRequest
-	11, // 8: rider.RiderService.ListPendingOrders:input_type -> rider.ListPendingOrdersRequest
-	13, // 9: rider.RiderService.ListRiderOrders:input_type -> rider.ListRiderOrdersRequest
-	4,  // 10: rider.RiderService.RiderRegister:output_type -> rider.RiderRegisterResponse
-	6,  // 11: rider.RiderService.RiderLogin:output_type -> rider.RiderLoginResponse
-	8,  // 12: rider.RiderService.GetRiderInfo:output_type -> rider.GetRiderInfoResponse
-	2,  // 13: rider.RiderService.AcceptOrder:output_type -> rider.CommonResponse
-	2,  // 14: rider.RiderService.UpdateDeliveryStatus:output_type -> rider.CommonResponse
-	12, // 15: rider.RiderService.ListPendingOrders:output_type -> rider.ListPendingOrdersResponse
-	14, // 16: rider.RiderService.ListRiderOrders:output_type -> rider.ListRiderOrdersResponse
-	10, // [10:17] is the sub-list for method output_type
-	3,  // [3:10] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	2,  // 3: rider.GetRiderEarningsResponse.total:type_name -> rider.EarningSummary
+	2,  // 4: rider.GetRiderEarningsResponse.periods:type_name -> rider.EarningSummary
+	4,  // 5: rider.ListRiderStatementsResponse.statements:type_name -> rider.RiderStatement
+	4,  // 6: rider.GetRiderStatementResponse.statement:type_name -> rider.RiderStatement
+	3,  // 7: rider.GetRiderStatementResponse.earnings:type_name -> rider.RiderEarning
+	6,  // 8: rider.RiderService.RiderRegister:input_type -> rider.RiderRegisterRequest
+	8,  // 9: rider.RiderService.RiderLogin:input_type -> rider.RiderLoginRequest
+	10, // 10: rider.RiderService.GetRiderInfo:input_type -> rider.GetRiderInfoRequest
+	12, // 11: rider.RiderService.AcceptOrder:input_type -> rider.AcceptOrderRequest
+	13, // 12: rider.RiderService.UpdateDeliveryStatus:input_type -> rider.UpdateDeliveryStatusRequest
+	14, // 13: rider.RiderService.ListPendingOrders:input_type -> rider.ListPendingOrdersRequest
+	16, // 14: rider.RiderService.ListRiderOrders:input_type -> rider.ListRiderOrdersRequest
+	18, // 15: rider.RiderService.GetRiderEarnings:input_type -> rider.GetRiderEarningsRequest
+	20, // 16: rider.RiderService.ListRiderStatements:input_type -> rider.ListRiderStatementsRequest
+	22, // 17: rider.RiderService.GetRiderStatement:input_type -> rider.GetRiderStatementRequest
+	7,  // 18: rider.RiderService.RiderRegister:output_type -> rider.RiderRegisterResponse
+	9,  // 19: rider.RiderService.RiderLogin:output_type -> rider.RiderLoginResponse
+	11, // 20: rider.RiderService.GetRiderInfo:output_type -> rider.GetRiderInfoResponse
+	5,  // 21: rider.RiderService.AcceptOrder:output_type -> rider.CommonResponse
+	5,  // 22: rider.RiderService.UpdateDeliveryStatus:output_type -> rider.CommonResponse
+	15, // 23: rider.RiderService.ListPendingOrders:output_type -> rider.ListPendingOrdersResponse
+	17, // 24: rider.RiderService.ListRiderOrders:output_type -> rider.ListRiderOrdersResponse
+	19, // 25: rider.RiderService.GetRiderEarnings:output_type -> rider.GetRiderEarningsResponse
+	21, // 26: rider.RiderService.ListRiderStatements:output_type -> rider.ListRiderStatementsResponse
+	23, // 27: rider.RiderService.GetRiderStatement:output_type -> rider.GetRiderStatementResponse
+	18, // [18:28] is the sub-list for method output_type
+	8,  // [8:18] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_rider_proto_init() }
@@ -1286,7 +2200,7 @@ func file_rider_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rider_proto_rawDesc), len(file_rider_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
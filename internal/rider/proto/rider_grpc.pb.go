@@ -26,6 +26,9 @@ const (
 	RiderService_UpdateDeliveryStatus_FullMethodName = "/rider.RiderService/UpdateDeliveryStatus"
 	RiderService_ListPendingOrders_FullMethodName    = "/rider.RiderService/ListPendingOrders"
 	RiderService_ListRiderOrders_FullMethodName      = "/rider.RiderService/ListRiderOrders"
+	RiderService_GetRiderEarnings_FullMethodName     = "/rider.RiderService/GetRiderEarnings"
+	RiderService_ListRiderStatements_FullMethodName  = "/rider.RiderService/ListRiderStatements"
+	RiderService_GetRiderStatement_FullMethodName    = "/rider.RiderService/GetRiderStatement"
 )
 
 // RiderServiceClient is the client API for RiderService service.
@@ -46,6 +49,12 @@ type RiderServiceClient interface {
 	ListPendingOrders(ctx context.Context, in *ListPendingOrdersRequest, opts ...grpc.CallOption) (*ListPendingOrdersResponse, error)
 	// 骑手查询自己的配送订单
 	ListRiderOrders(ctx context.Context, in *ListRiderOrdersRequest, opts ...grpc.CallOption) (*ListRiderOrdersResponse, error)
+	// 查询骑手收入（按日/周汇总）
+	GetRiderEarnings(ctx context.Context, in *GetRiderEarningsRequest, opts ...grpc.CallOption) (*GetRiderEarningsResponse, error)
+	// 查询骑手周结算单
+	ListRiderStatements(ctx context.Context, in *ListRiderStatementsRequest, opts ...grpc.CallOption) (*ListRiderStatementsResponse, error)
+	// 查询骑手结算单详情及收入流水
+	GetRiderStatement(ctx context.Context, in *GetRiderStatementRequest, opts ...grpc.CallOption) (*GetRiderStatementResponse, error)
 }
 
 type riderServiceClient struct {
@@ -126,6 +135,36 @@ func (c *riderServiceClient) ListRiderOrders(ctx context.Context, in *ListRiderO
 	return out, nil
 }
 
+func (c *riderServiceClient) GetRiderEarnings(ctx context.Context, in *GetRiderEarningsRequest, opts ...grpc.CallOption) (*GetRiderEarningsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRiderEarningsResponse)
+	err := c.cc.Invoke(ctx, RiderService_GetRiderEarnings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *riderServiceClient) ListRiderStatements(ctx context.Context, in *ListRiderStatementsRequest, opts ...grpc.CallOption) (*ListRiderStatementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRiderStatementsResponse)
+	err := c.cc.Invoke(ctx, RiderService_ListRiderStatements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *riderServiceClient) GetRiderStatement(ctx context.Context, in *GetRiderStatementRequest, opts ...grpc.CallOption) (*GetRiderStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRiderStatementResponse)
+	err := c.cc.Invoke(ctx, RiderService_GetRiderStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RiderServiceServer is the server API for RiderService service.
 // All implementations must embed UnimplementedRiderServiceServer
 // for forward compatibility.
@@ -144,6 +183,12 @@ type RiderServiceServer interface {
 	ListPendingOrders(context.Context, *ListPendingOrdersRequest) (*ListPendingOrdersResponse, error)
 	// 骑手查询自己的配送订单
 	ListRiderOrders(context.Context, *ListRiderOrdersRequest) (*ListRiderOrdersResponse, error)
+	// 查询骑手收入（按日/周汇总）
+	GetRiderEarnings(context.Context, *GetRiderEarningsRequest) (*GetRiderEarningsResponse, error)
+	// 查询骑手周结算单
+	ListRiderStatements(context.Context, *ListRiderStatementsRequest) (*ListRiderStatementsResponse, error)
+	// 查询骑手结算单详情及收入流水
+	GetRiderStatement(context.Context, *GetRiderStatementRequest) (*GetRiderStatementResponse, error)
 	mustEmbedUnimplementedRiderServiceServer()
 }
 
@@ -175,6 +220,15 @@ func (UnimplementedRiderServiceServer) ListPendingOrders(context.Context, *ListP
 func (UnimplementedRiderServiceServer) ListRiderOrders(context.Context, *ListRiderOrdersRequest) (*ListRiderOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRiderOrders not implemented")
 }
+func (UnimplementedRiderServiceServer) GetRiderEarnings(context.Context, *GetRiderEarningsRequest) (*GetRiderEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRiderEarnings not implemented")
+}
+func (UnimplementedRiderServiceServer) ListRiderStatements(context.Context, *ListRiderStatementsRequest) (*ListRiderStatementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRiderStatements not implemented")
+}
+func (UnimplementedRiderServiceServer) GetRiderStatement(context.Context, *GetRiderStatementRequest) (*GetRiderStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRiderStatement not implemented")
+}
 func (UnimplementedRiderServiceServer) mustEmbedUnimplementedRiderServiceServer() {}
 func (UnimplementedRiderServiceServer) testEmbeddedByValue()                      {}
 
@@ -322,6 +376,60 @@ func _RiderService_ListRiderOrders_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RiderService_GetRiderEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRiderEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiderServiceServer).GetRiderEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RiderService_GetRiderEarnings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiderServiceServer).GetRiderEarnings(ctx, req.(*GetRiderEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RiderService_ListRiderStatements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRiderStatementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiderServiceServer).ListRiderStatements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RiderService_ListRiderStatements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiderServiceServer).ListRiderStatements(ctx, req.(*ListRiderStatementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RiderService_GetRiderStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRiderStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiderServiceServer).GetRiderStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RiderService_GetRiderStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiderServiceServer).GetRiderStatement(ctx, req.(*GetRiderStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RiderService_ServiceDesc is the grpc.ServiceDesc for RiderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -357,6 +465,18 @@ var RiderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRiderOrders",
 			Handler:    _RiderService_ListRiderOrders_Handler,
 		},
+		{
+			MethodName: "GetRiderEarnings",
+			Handler:    _RiderService_GetRiderEarnings_Handler,
+		},
+		{
+			MethodName: "ListRiderStatements",
+			Handler:    _RiderService_ListRiderStatements_Handler,
+		},
+		{
+			MethodName: "GetRiderStatement",
+			Handler:    _RiderService_GetRiderStatement_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rider.proto",
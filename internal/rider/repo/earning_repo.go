@@ -0,0 +1,176 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DailyEarning 骑手单日收入汇总
+type DailyEarning struct {
+	Date          time.Time
+	DeliveryCount int64
+	BaseFee       float64
+	DistanceBonus float64
+	PeakBonus     float64
+	Tip           float64
+	Amount        float64
+}
+
+// EarningRepo 骑手收入数据访问接口
+type EarningRepo interface {
+	SumDailyEarnings(ctx context.Context, riderID int64, start, end time.Time) ([]DailyEarning, error) // 按送达日期汇总[start,end)的收入
+	GenerateStatements(ctx context.Context, before time.Time) (int, error)                             // 将before之前的未出账流水按骑手+周汇总为结算单，返回涉及结算单数
+	ListStatements(ctx context.Context, riderID int64, page, pageSize int32) ([]*model.RiderStatement, int64, error)
+	GetStatement(ctx context.Context, statementID int64) (*model.RiderStatement, error) // 不存在返回nil
+	ListStatementEarnings(ctx context.Context, statementID int64) ([]*model.RiderEarning, error)
+}
+
+// earningRepo 实现
+type earningRepo struct{}
+
+// NewEarningRepo 创建实例
+func NewEarningRepo() EarningRepo {
+	return &earningRepo{}
+}
+
+// SumDailyEarnings 按送达日期汇总骑手收入
+func (r *earningRepo) SumDailyEarnings(ctx context.Context, riderID int64, start, end time.Time) ([]DailyEarning, error) {
+	var earnings []DailyEarning
+	err := db.Mysql.WithContext(ctx).Model(&model.RiderEarning{}).
+		Select("DATE(complete_time) AS date, COUNT(*) AS delivery_count, SUM(base_fee) AS base_fee, "+
+			"SUM(distance_bonus) AS distance_bonus, SUM(peak_bonus) AS peak_bonus, SUM(tip) AS tip, SUM(amount) AS amount").
+		Where("rider_id = ? AND complete_time >= ? AND complete_time < ?", riderID, start, end).
+		Group("DATE(complete_time)").Order("date").Scan(&earnings).Error
+	if err != nil {
+		zap.L().Error("汇总骑手收入失败", zap.Int64("rider_id", riderID), zap.Time("start", start), zap.Error(err))
+		return nil, utils.NewDBError("汇总骑手收入失败：" + err.Error())
+	}
+	return earnings, nil
+}
+
+// GenerateStatements 汇总未出账的收入流水（幂等：迟到的流水会并入已有结算单并重算合计）
+func (r *earningRepo) GenerateStatements(ctx context.Context, before time.Time) (int, error) {
+	// 1. 查询待出账的骑手+周（周一为一周开始）
+	var groups []struct {
+		RiderID     int64
+		PeriodStart time.Time
+	}
+	err := db.Mysql.WithContext(ctx).Model(&model.RiderEarning{}).
+		Select("rider_id, DATE_SUB(DATE(complete_time), INTERVAL WEEKDAY(complete_time) DAY) AS period_start").
+		Where("statement_id = 0 AND complete_time < ?", before).
+		Group("rider_id, period_start").Scan(&groups).Error
+	if err != nil {
+		zap.L().Error("查询待出账收入流水失败", zap.Time("before", before), zap.Error(err))
+		return 0, utils.NewDBError("查询待出账收入流水失败：" + err.Error())
+	}
+
+	// 2. 逐个骑手+周生成结算单
+	for i, g := range groups {
+		periodEnd := g.PeriodStart.AddDate(0, 0, 6)
+		err = db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// 2.1 锁定或创建结算单
+			statement := model.RiderStatement{RiderID: g.RiderID, PeriodStart: g.PeriodStart, PeriodEnd: periodEnd, Status: "待打款"}
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("rider_id = ? AND period_start = ?", g.RiderID, g.PeriodStart).
+				FirstOrCreate(&statement).Error; err != nil {
+				return err
+			}
+
+			// 2.2 归集流水
+			if err := tx.Model(&model.RiderEarning{}).
+				Where("rider_id = ? AND statement_id = 0 AND complete_time >= ? AND complete_time < ?", g.RiderID, g.PeriodStart, periodEnd.AddDate(0, 0, 1)).
+				Update("statement_id", statement.StatementID).Error; err != nil {
+				return err
+			}
+
+			// 2.3 按流水重算合计
+			var sum struct {
+				DeliveryCount int64
+				BaseFee       float64
+				DistanceBonus float64
+				PeakBonus     float64
+				Tip           float64
+				Amount        float64
+			}
+			if err := tx.Model(&model.RiderEarning{}).
+				Select("COUNT(*) AS delivery_count, COALESCE(SUM(base_fee), 0) AS base_fee, COALESCE(SUM(distance_bonus), 0) AS distance_bonus, "+
+					"COALESCE(SUM(peak_bonus), 0) AS peak_bonus, COALESCE(SUM(tip), 0) AS tip, COALESCE(SUM(amount), 0) AS amount").
+				Where("statement_id = ?", statement.StatementID).Scan(&sum).Error; err != nil {
+				return err
+			}
+			return tx.Model(&model.RiderStatement{}).Where("statement_id = ?", statement.StatementID).
+				Updates(map[string]interface{}{
+					"delivery_count": sum.DeliveryCount,
+					"base_fee":       sum.BaseFee,
+					"distance_bonus": sum.DistanceBonus,
+					"peak_bonus":     sum.PeakBonus,
+					"tip":            sum.Tip,
+					"amount":         sum.Amount,
+				}).Error
+		})
+		if err != nil {
+			zap.L().Error("生成骑手结算单失败", zap.Int64("rider_id", g.RiderID), zap.Time("period_start", g.PeriodStart), zap.Error(err))
+			return i, utils.NewDBError("生成骑手结算单失败：" + err.Error())
+		}
+	}
+	return len(groups), nil
+}
+
+// ListStatements 分页查询骑手结算单
+func (r *earningRepo) ListStatements(ctx context.Context, riderID int64, page, pageSize int32) ([]*model.RiderStatement, int64, error) {
+	var (
+		statements []*model.RiderStatement
+		total      int64
+	)
+	query := db.Mysql.WithContext(ctx).Model(&model.RiderStatement{}).Where("rider_id = ?", riderID)
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("统计骑手结算单总数失败", zap.Int64("rider_id", riderID), zap.Error(err))
+		return nil, 0, utils.NewDBError("查询结算单失败：" + err.Error())
+	}
+
+	// 分页查询
+	offset := (page - 1) * pageSize
+	if err := query.Offset(int(offset)).Limit(int(pageSize)).
+		Order("period_start DESC").Find(&statements).Error; err != nil {
+		zap.L().Error("查询骑手结算单列表失败", zap.Int64("rider_id", riderID), zap.Error(err))
+		return nil, 0, utils.NewDBError("查询结算单失败：" + err.Error())
+	}
+
+	return statements, total, nil
+}
+
+// GetStatement 根据ID查询骑手结算单
+func (r *earningRepo) GetStatement(ctx context.Context, statementID int64) (*model.RiderStatement, error) {
+	var statement model.RiderStatement
+	tx := db.Mysql.WithContext(ctx).Where("statement_id = ?", statementID).First(&statement)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		zap.L().Error("查询骑手结算单失败", zap.Int64("statement_id", statementID), zap.Error(tx.Error))
+		return nil, utils.NewDBError("查询结算单失败：" + tx.Error.Error())
+	}
+	return &statement, nil
+}
+
+// ListStatementEarnings 查询结算单收入流水（按送达时间正序）
+func (r *earningRepo) ListStatementEarnings(ctx context.Context, statementID int64) ([]*model.RiderEarning, error) {
+	var earnings []*model.RiderEarning
+	err := db.Mysql.WithContext(ctx).Where("statement_id = ?", statementID).
+		Order("complete_time, earning_id").Find(&earnings).Error
+	if err != nil {
+		zap.L().Error("查询骑手收入流水失败", zap.Int64("statement_id", statementID), zap.Error(err))
+		return nil, utils.NewDBError("查询收入流水失败：" + err.Error())
+	}
+	return earnings, nil
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// RiderEarning 骑手收入流水表（每个完成的配送订单一条）
+type RiderEarning struct {
+	EarningID        int64     `gorm:"column:earning_id;primaryKey;autoIncrement" json:"earning_id"`
+	RiderID          int64     `gorm:"column:rider_id;not null;index:idx_earning_rider_time,priority:1;comment:'骑手ID'" json:"rider_id"`
+	OrderID          int64     `gorm:"column:order_id;not null;uniqueIndex;comment:'订单ID'" json:"order_id"`
+	OrderNo          string    `gorm:"column:order_no;not null;size:64;comment:'订单编号'" json:"order_no"`
+	DeliveryDistance int32     `gorm:"column:delivery_distance;not null;default:0;comment:'配送距离（米）'" json:"delivery_distance"`
+	BaseFee          float64   `gorm:"column:base_fee;not null;type:decimal(10,2);comment:'基础收入'" json:"base_fee"`
+	DistanceBonus    float64   `gorm:"column:distance_bonus;not null;default:0;type:decimal(10,2);comment:'距离补贴'" json:"distance_bonus"`
+	PeakMultiplier   float64   `gorm:"column:peak_multiplier;not null;default:1;type:decimal(4,2);comment:'高峰倍数'" json:"peak_multiplier"`
+	PeakBonus        float64   `gorm:"column:peak_bonus;not null;default:0;type:decimal(10,2);comment:'高峰加成'" json:"peak_bonus"`
+	Tip              float64   `gorm:"column:tip;not null;default:0;type:decimal(10,2);comment:'小费'" json:"tip"`
+	Amount           float64   `gorm:"column:amount;not null;type:decimal(10,2);comment:'收入合计'" json:"amount"`
+	StatementID      int64     `gorm:"column:statement_id;not null;default:0;index;comment:'结算单ID（0表示未出账）'" json:"statement_id"`
+	CompleteTime     time.Time `gorm:"column:complete_time;not null;index:idx_earning_rider_time,priority:2;comment:'送达时间'" json:"complete_time"`
+	CreateTime       time.Time `gorm:"column:create_time;autoCreateTime;comment:'创建时间'" json:"create_time"`
+}
+
+// TableName 表名
+func (e *RiderEarning) TableName() string {
+	return "t_rider_earning"
+}
+
+// RiderStatement 骑手周结算单（按送达时间所在周汇总收入流水，周一为一周开始）
+type RiderStatement struct {
+	StatementID   int64     `gorm:"column:statement_id;primaryKey;autoIncrement" json:"statement_id"`
+	StatementNo   string    `gorm:"column:statement_no;not null;uniqueIndex;size:64;comment:'结算单号'" json:"statement_no"`
+	RiderID       int64     `gorm:"column:rider_id;not null;uniqueIndex:uk_rider_period,priority:1;comment:'骑手ID'" json:"rider_id"`
+	PeriodStart   time.Time `gorm:"column:period_start;not null;type:date;uniqueIndex:uk_rider_period,priority:2;comment:'结算周期开始日期'" json:"period_start"`
+	PeriodEnd     time.Time `gorm:"column:period_end;not null;type:date;comment:'结算周期结束日期'" json:"period_end"`
+	DeliveryCount int64     `gorm:"column:delivery_count;not null;default:0;comment:'配送单数'" json:"delivery_count"`
+	BaseFee       float64   `gorm:"column:base_fee;not null;default:0;type:decimal(12,2);comment:'基础收入合计'" json:"base_fee"`
+	DistanceBonus float64   `gorm:"column:distance_bonus;not null;default:0;type:decimal(12,2);comment:'距离补贴合计'" json:"distance_bonus"`
+	PeakBonus     float64   `gorm:"column:peak_bonus;not null;default:0;type:decimal(12,2);comment:'高峰加成合计'" json:"peak_bonus"`
+	Tip           float64   `gorm:"column:tip;not null;default:0;type:decimal(12,2);comment:'小费合计'" json:"tip"`
+	Amount        float64   `gorm:"column:amount;not null;default:0;type:decimal(12,2);comment:'收入合计'" json:"amount"`
+	Status        string    `gorm:"column:status;not null;size:16;default:'待打款';comment:'状态：待打款/已打款'" json:"status"`
+	CreateTime    time.Time `gorm:"column:create_time;autoCreateTime;comment:'创建时间'" json:"create_time"`
+	UpdateTime    time.Time `gorm:"column:update_time;autoUpdateTime;comment:'更新时间'" json:"update_time"`
+}
+
+// TableName 表名
+func (s *RiderStatement) TableName() string {
+	return "t_rider_statement"
+}
+
+// BeforeCreate 钩子：生成唯一结算单号
+func (s *RiderStatement) BeforeCreate(tx *gorm.DB) error {
+	// 生成规则：R + 周期开始日期 + 随机数
+	s.StatementNo = "R" + s.PeriodStart.Format("20060102") + utils.RandomString(8)
+	return nil
+}
@@ -3,12 +3,15 @@ package repo
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/repo/model"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/pagination"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // RiderRepo 骑手数据访问接口
@@ -17,13 +20,15 @@ type RiderRepo interface {
 	GetRiderByPhone(ctx context.Context, phone string) (*model.Rider, error)
 	GetRiderByID(ctx context.Context, riderID int64) (*model.Rider, error)
 	UpdateRiderStatus(ctx context.Context, riderID int64, status string) error
-	UpdateOrderCount(ctx context.Context, riderID int64, num int32) error
+	UpdateScore(ctx context.Context, riderID int64, score float64, ratingCount int64) error // 更新评分（仅评分次数增加时生效）
+	CountCompletedDeliveries(ctx context.Context, riderID int64) (int64, error)             // 按收入流水统计已完成配送单数
 
 	CreateDeliveryOrder(ctx context.Context, order *model.DeliveryOrder) error
-	UpdateDeliveryOrder(ctx context.Context, orderID, riderID int64, status, timeStr string) error
+	UpdateDeliveryOrder(ctx context.Context, orderID, riderID int64, fromStatus, status, timeStr string) error // 条件更新：接单时须未分配骑手，其余须为本骑手且当前状态为fromStatus
+	CompleteDelivery(ctx context.Context, earning *model.RiderEarning) error                                   // 事务更新配送订单为已完成并写入收入流水（按订单ID幂等）
 	GetDeliveryOrderByOrderID(ctx context.Context, orderID int64) (*model.DeliveryOrder, error)
-	ListPendingOrders(ctx context.Context, area string, page, pageSize int32) ([]*model.DeliveryOrder, int64, error)
-	ListRiderOrders(ctx context.Context, riderID int64, status string, page, pageSize int32) ([]*model.DeliveryOrder, int64, error)
+	ListPendingOrders(ctx context.Context, area string, page pagination.Param) ([]*model.DeliveryOrder, pagination.Result, error)
+	ListRiderOrders(ctx context.Context, riderID int64, status string, page pagination.Param) ([]*model.DeliveryOrder, pagination.Result, error)
 }
 
 // riderRepo 实现
@@ -87,18 +92,32 @@ func (r *riderRepo) UpdateRiderStatus(ctx context.Context, riderID int64, status
 	return nil
 }
 
-// UpdateOrderCount 更新骑手配送订单数
-func (r *riderRepo) UpdateOrderCount(ctx context.Context, riderID int64, num int32) error {
+// UpdateScore 更新骑手评分（消息可能乱序或重复，仅当评分次数大于当前值时更新）
+func (r *riderRepo) UpdateScore(ctx context.Context, riderID int64, score float64, ratingCount int64) error {
 	tx := db.Mysql.WithContext(ctx).Model(&model.Rider{}).
-		Where("rider_id = ?", riderID).
-		Update("order_count", gorm.Expr("order_count + ?", num))
+		Where("rider_id = ? AND rating_count < ?", riderID, ratingCount).
+		Updates(map[string]interface{}{
+			"score":        score,
+			"rating_count": ratingCount,
+		})
 	if tx.Error != nil {
-		zap.L().Error("更新骑手订单数失败", zap.Int64("rider_id", riderID), zap.Int32("num", num), zap.Error(tx.Error))
-		return utils.NewDBError("更新订单数失败：" + tx.Error.Error())
+		zap.L().Error("更新骑手评分失败", zap.Int64("rider_id", riderID), zap.Float64("score", score), zap.Error(tx.Error))
+		return utils.NewDBError("更新骑手评分失败：" + tx.Error.Error())
 	}
 	return nil
 }
 
+// CountCompletedDeliveries 统计骑手已完成配送单数
+func (r *riderRepo) CountCompletedDeliveries(ctx context.Context, riderID int64) (int64, error) {
+	var count int64
+	tx := db.Mysql.WithContext(ctx).Model(&model.RiderEarning{}).Where("rider_id = ?", riderID).Count(&count)
+	if tx.Error != nil {
+		zap.L().Error("统计骑手配送单数失败", zap.Int64("rider_id", riderID), zap.Error(tx.Error))
+		return 0, utils.NewDBError("统计配送单数失败：" + tx.Error.Error())
+	}
+	return count, nil
+}
+
 // CreateDeliveryOrder 创建配送订单
 func (r *riderRepo) CreateDeliveryOrder(ctx context.Context, order *model.DeliveryOrder) error {
 	tx := db.Mysql.WithContext(ctx).Create(order)
@@ -109,13 +128,15 @@ func (r *riderRepo) CreateDeliveryOrder(ctx context.Context, order *model.Delive
 	return nil
 }
 
-// UpdateDeliveryOrder 更新配送订单状态
-func (r *riderRepo) UpdateDeliveryOrder(ctx context.Context, orderID, riderID int64, status, timeStr string) error {
+// UpdateDeliveryOrder 更新配送订单状态（带骑手及当前状态条件，防止并发接单覆盖其他骑手）
+func (r *riderRepo) UpdateDeliveryOrder(ctx context.Context, orderID, riderID int64, fromStatus, status, timeStr string) error {
 	updateData := map[string]interface{}{
 		"delivery_status": status,
 	}
+	expectRiderID := riderID
 	switch status {
 	case "待取餐":
+		expectRiderID = 0 // 接单：仅未分配骑手的配送订单
 		updateData["accept_time"] = timeStr
 		updateData["rider_id"] = riderID
 		updateData["rider_name"] = "" // 后续补充骑手姓名
@@ -126,18 +147,44 @@ func (r *riderRepo) UpdateDeliveryOrder(ctx context.Context, orderID, riderID in
 	}
 
 	tx := db.Mysql.WithContext(ctx).Model(&model.DeliveryOrder{}).
-		Where("order_id = ?", orderID).
+		Where("order_id = ? AND rider_id = ? AND delivery_status = ?", orderID, expectRiderID, fromStatus).
 		Updates(updateData)
 	if tx.Error != nil {
 		zap.L().Error("更新配送订单状态失败", zap.Int64("order_id", orderID), zap.String("status", status), zap.Error(tx.Error))
 		return utils.NewDBError("更新配送状态失败：" + tx.Error.Error())
 	}
 	if tx.RowsAffected == 0 {
-		return utils.NewBizError("配送订单不存在")
+		return utils.NewBizError("配送订单状态已变更，请刷新后重试")
 	}
 	return nil
 }
 
+// CompleteDelivery 事务更新配送订单为已完成（条件更新：仅本骑手配送中的订单，同时记录小费）并写入收入流水（订单ID唯一，重复写入跳过）
+func (r *riderRepo) CompleteDelivery(ctx context.Context, earning *model.RiderEarning) error {
+	err := db.Mysql.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&model.DeliveryOrder{}).
+			Where("order_id = ? AND rider_id = ? AND delivery_status = ?", earning.OrderID, earning.RiderID, "配送中").
+			Updates(map[string]interface{}{
+				"delivery_status": "已完成",
+				"complete_time":   earning.CompleteTime.Format("2006-01-02 15:04:05"),
+				"tip_amount":      earning.Tip,
+			})
+		if res.Error != nil {
+			zap.L().Error("更新配送订单为已完成失败", zap.Int64("order_id", earning.OrderID), zap.Error(res.Error))
+			return utils.NewDBError("更新配送状态失败：" + res.Error.Error())
+		}
+		if res.RowsAffected == 0 {
+			return utils.NewBizError("配送订单状态已变更，请刷新后重试")
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(earning).Error; err != nil {
+			zap.L().Error("写入骑手收入流水失败", zap.Any("earning", earning), zap.Error(err))
+			return utils.NewDBError("写入收入流水失败：" + err.Error())
+		}
+		return nil
+	})
+	return err
+}
+
 // GetDeliveryOrderByOrderID 根据订单ID查询配送订单
 func (r *riderRepo) GetDeliveryOrderByOrderID(ctx context.Context, orderID int64) (*model.DeliveryOrder, error) {
 	var order model.DeliveryOrder
@@ -152,62 +199,57 @@ func (r *riderRepo) GetDeliveryOrderByOrderID(ctx context.Context, orderID int64
 	return &order, nil
 }
 
-// ListPendingOrders 查询待接订单列表（未分配骑手的订单）
-func (r *riderRepo) ListPendingOrders(ctx context.Context, area string, page, pageSize int32) ([]*model.DeliveryOrder, int64, error) {
-	var (
-		orders []*model.DeliveryOrder
-		total  int64
-	)
+// deliveryKeyset 配送订单列表按创建时间、ID倒序分页
+var deliveryKeyset = pagination.Keyset{TimeColumn: "created_at", IDColumn: "id"}
 
+// ListPendingOrders 查询待接订单列表（未分配骑手的订单）
+func (r *riderRepo) ListPendingOrders(ctx context.Context, area string, page pagination.Param) ([]*model.DeliveryOrder, pagination.Result, error) {
 	// 构建查询条件：未分配骑手（rider_id=0）
 	query := db.Mysql.WithContext(ctx).Model(&model.DeliveryOrder{}).Where("rider_id = 0")
 	if area != "" {
 		query = query.Where("address LIKE ?", "%"+area+"%")
 	}
 
-	// 统计总数
-	if err := query.Count(&total).Error; err != nil {
-		zap.L().Error("统计待接订单总数失败", zap.String("area", area), zap.Error(err))
-		return nil, 0, utils.NewDBError("查询订单失败：" + err.Error())
-	}
-
-	// 分页查询
-	offset := (page - 1) * pageSize
-	if err := query.Offset(int(offset)).Limit(int(pageSize)).
-		Order("created_at DESC").Find(&orders).Error; err != nil {
+	orders, result, err := listDeliveryOrders(query, page)
+	if err != nil {
 		zap.L().Error("查询待接订单列表失败", zap.String("area", area), zap.Error(err))
-		return nil, 0, utils.NewDBError("查询订单失败：" + err.Error())
+		return nil, pagination.Result{}, err
 	}
-
-	return orders, total, nil
+	return orders, result, nil
 }
 
 // ListRiderOrders 查询骑手配送订单
-func (r *riderRepo) ListRiderOrders(ctx context.Context, riderID int64, status string, page, pageSize int32) ([]*model.DeliveryOrder, int64, error) {
-	var (
-		orders []*model.DeliveryOrder
-		total  int64
-	)
-
+func (r *riderRepo) ListRiderOrders(ctx context.Context, riderID int64, status string, page pagination.Param) ([]*model.DeliveryOrder, pagination.Result, error) {
 	// 构建查询条件
 	query := db.Mysql.WithContext(ctx).Model(&model.DeliveryOrder{}).Where("rider_id = ?", riderID)
 	if status != "" {
 		query = query.Where("delivery_status = ?", status)
 	}
 
-	// 统计总数
-	if err := query.Count(&total).Error; err != nil {
-		zap.L().Error("统计骑手订单总数失败", zap.Int64("rider_id", riderID), zap.Error(err))
-		return nil, 0, utils.NewDBError("查询订单失败：" + err.Error())
-	}
-
-	// 分页查询
-	offset := (page - 1) * pageSize
-	if err := query.Offset(int(offset)).Limit(int(pageSize)).
-		Order("created_at DESC").Find(&orders).Error; err != nil {
+	orders, result, err := listDeliveryOrders(query, page)
+	if err != nil {
 		zap.L().Error("查询骑手订单列表失败", zap.Int64("rider_id", riderID), zap.Error(err))
-		return nil, 0, utils.NewDBError("查询订单失败：" + err.Error())
+		return nil, pagination.Result{}, err
 	}
+	return orders, result, nil
+}
 
-	return orders, total, nil
+// listDeliveryOrders 按需统计总数并分页查询配送订单
+func listDeliveryOrders(query *gorm.DB, page pagination.Param) ([]*model.DeliveryOrder, pagination.Result, error) {
+	total, err := pagination.Count(query, page)
+	if err != nil {
+		return nil, pagination.Result{}, utils.NewDBError("查询订单失败：" + err.Error())
+	}
+	query, err = deliveryKeyset.Apply(query, page)
+	if err != nil {
+		return nil, pagination.Result{}, err
+	}
+	var orders []*model.DeliveryOrder
+	if err = query.Find(&orders).Error; err != nil {
+		return nil, pagination.Result{}, utils.NewDBError("查询订单失败：" + err.Error())
+	}
+	orders, next := pagination.Trim(orders, page.PageSize, func(o *model.DeliveryOrder) (time.Time, int64) {
+		return o.CreatedAt, o.ID
+	})
+	return orders, pagination.Result{Total: total, NextCursor: next}, nil
 }
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/middleware"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// maxEarningsRangeDays 收入查询最大天数
+const maxEarningsRangeDays = 366
+
+// 入参结构体
+type GetRiderEarningsParam struct {
+	RiderID     int64  `validate:"required,gt=0"`
+	StartDate   string `validate:"required,datetime=2006-01-02"`
+	EndDate     string `validate:"required,datetime=2006-01-02"`
+	Granularity string `validate:"omitempty,oneof=day week"` // 明细粒度（默认day）
+}
+
+type ListRiderStatementsParam struct {
+	RiderID  int64 `validate:"required,gt=0"`
+	Page     int32 `validate:"required,gte=1"`
+	PageSize int32 `validate:"required,gte=10,lte=100"`
+}
+
+// 响应结构体
+type EarningSummaryResult struct {
+	PeriodStart   string  `json:"period_start"` // 按日为当天，按周为周一
+	DeliveryCount int64   `json:"delivery_count"`
+	BaseFee       float64 `json:"base_fee"`
+	DistanceBonus float64 `json:"distance_bonus"`
+	PeakBonus     float64 `json:"peak_bonus"`
+	Tip           float64 `json:"tip"`
+	Amount        float64 `json:"amount"`
+}
+
+type RiderEarningsResult struct {
+	RiderID     int64                  `json:"rider_id"`
+	StartDate   string                 `json:"start_date"`
+	EndDate     string                 `json:"end_date"`
+	Granularity string                 `json:"granularity"`
+	Total       EarningSummaryResult   `json:"total"`
+	Periods     []EarningSummaryResult `json:"periods"` // 无收入的日期/周补零
+}
+
+type RiderStatementResult struct {
+	StatementID   int64   `json:"statement_id"`
+	StatementNo   string  `json:"statement_no"`
+	RiderID       int64   `json:"rider_id"`
+	PeriodStart   string  `json:"period_start"`
+	PeriodEnd     string  `json:"period_end"`
+	DeliveryCount int64   `json:"delivery_count"`
+	BaseFee       float64 `json:"base_fee"`
+	DistanceBonus float64 `json:"distance_bonus"`
+	PeakBonus     float64 `json:"peak_bonus"`
+	Tip           float64 `json:"tip"`
+	Amount        float64 `json:"amount"`
+	Status        string  `json:"status"`
+	CreateTime    string  `json:"create_time"`
+}
+
+type RiderEarningResult struct {
+	EarningID        int64   `json:"earning_id"`
+	OrderID          int64   `json:"order_id"`
+	OrderNo          string  `json:"order_no"`
+	DeliveryDistance int32   `json:"delivery_distance"`
+	BaseFee          float64 `json:"base_fee"`
+	DistanceBonus    float64 `json:"distance_bonus"`
+	PeakMultiplier   float64 `json:"peak_multiplier"`
+	PeakBonus        float64 `json:"peak_bonus"`
+	Tip              float64 `json:"tip"`
+	Amount           float64 `json:"amount"`
+	CompleteTime     string  `json:"complete_time"`
+}
+
+type ListRiderStatementsResult struct {
+	Statements []RiderStatementResult `json:"statements"`
+	Total      int32                  `json:"total"`
+	Page       int32                  `json:"page"`
+	PageSize   int32                  `json:"page_size"`
+}
+
+type RiderStatementDetailResult struct {
+	Statement RiderStatementResult `json:"statement"`
+	Earnings  []RiderEarningResult `json:"earnings"`
+}
+
+// EarningService 骑手收入业务逻辑接口
+type EarningService interface {
+	GetRiderEarnings(ctx context.Context, param GetRiderEarningsParam) (RiderEarningsResult, error)
+	ListRiderStatements(ctx context.Context, param ListRiderStatementsParam) (ListRiderStatementsResult, error)
+	GetRiderStatement(ctx context.Context, statementID int64) (RiderStatementDetailResult, error)
+	GenerateWeeklyStatements(ctx context.Context) (int, error) // 生成上周及更早的周结算单（定时任务），返回涉及结算单数
+}
+
+// earningService 实现
+type earningService struct {
+	earningRepo repo.EarningRepo
+	validate    *validator.Validate
+}
+
+// NewEarningService 创建实例
+func NewEarningService(earningRepo repo.EarningRepo) EarningService {
+	return &earningService{
+		earningRepo: earningRepo,
+		validate:    validator.New(),
+	}
+}
+
+// GetRiderEarnings 查询骑手收入（骑手仅可查询自己，平台运营可查询任意骑手）
+func (s *earningService) GetRiderEarnings(ctx context.Context, param GetRiderEarningsParam) (RiderEarningsResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("查询骑手收入参数校验失败", zap.Any("param", param), zap.Error(err))
+		return RiderEarningsResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+	startDate, _ := time.ParseInLocation("2006-01-02", param.StartDate, time.Local)
+	endDate, _ := time.ParseInLocation("2006-01-02", param.EndDate, time.Local)
+	if endDate.Before(startDate) {
+		return RiderEarningsResult{}, utils.NewParamError("结束日期不能早于开始日期")
+	}
+	if endDate.Sub(startDate) >= maxEarningsRangeDays*24*time.Hour {
+		return RiderEarningsResult{}, utils.NewParamError("查询日期跨度不能超过366天")
+	}
+	if param.Granularity == "" {
+		param.Granularity = "day"
+	}
+
+	// 2. 鉴权
+	if err := checkRiderAccess(ctx, param.RiderID); err != nil {
+		return RiderEarningsResult{}, err
+	}
+
+	// 3. 按日汇总
+	daily, err := s.earningRepo.SumDailyEarnings(ctx, param.RiderID, startDate, endDate.AddDate(0, 0, 1))
+	if err != nil {
+		return RiderEarningsResult{}, err
+	}
+
+	// 4. 按粒度归并（无收入的周期补零）
+	result := RiderEarningsResult{
+		RiderID:     param.RiderID,
+		StartDate:   param.StartDate,
+		EndDate:     param.EndDate,
+		Granularity: param.Granularity,
+	}
+	periodOf := func(day time.Time) time.Time { return day }
+	step := 1
+	if param.Granularity == "week" {
+		periodOf = weekStart
+		step = 7
+	}
+	periods := make(map[string]*EarningSummaryResult)
+	for _, d := range daily {
+		key := periodOf(d.Date).Format("2006-01-02")
+		p, ok := periods[key]
+		if !ok {
+			p = &EarningSummaryResult{PeriodStart: key}
+			periods[key] = p
+		}
+		addEarning(p, d)
+		addEarning(&result.Total, d)
+	}
+	for day := periodOf(startDate); !day.After(endDate); day = day.AddDate(0, 0, step) {
+		key := day.Format("2006-01-02")
+		p, ok := periods[key]
+		if !ok {
+			p = &EarningSummaryResult{PeriodStart: key}
+		}
+		roundEarning(p)
+		result.Periods = append(result.Periods, *p)
+	}
+	result.Total.PeriodStart = param.StartDate
+	roundEarning(&result.Total)
+	return result, nil
+}
+
+// ListRiderStatements 查询骑手周结算单
+func (s *earningService) ListRiderStatements(ctx context.Context, param ListRiderStatementsParam) (ListRiderStatementsResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("查询骑手结算单参数校验失败", zap.Any("param", param), zap.Error(err))
+		return ListRiderStatementsResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+
+	// 2. 鉴权
+	if err := checkRiderAccess(ctx, param.RiderID); err != nil {
+		return ListRiderStatementsResult{}, err
+	}
+
+	// 3. 查询结算单
+	statements, total, err := s.earningRepo.ListStatements(ctx, param.RiderID, param.Page, param.PageSize)
+	if err != nil {
+		return ListRiderStatementsResult{}, err
+	}
+
+	// 4. 组装结果
+	var results []RiderStatementResult
+	for _, st := range statements {
+		results = append(results, toRiderStatementResult(st))
+	}
+	return ListRiderStatementsResult{
+		Statements: results,
+		Total:      int32(total),
+		Page:       param.Page,
+		PageSize:   param.PageSize,
+	}, nil
+}
+
+// GetRiderStatement 查询骑手结算单及收入流水
+func (s *earningService) GetRiderStatement(ctx context.Context, statementID int64) (RiderStatementDetailResult, error) {
+	// 1. 参数校验
+	if statementID <= 0 {
+		return RiderStatementDetailResult{}, utils.NewParamError("结算单ID不合法")
+	}
+
+	// 2. 查询结算单并校验归属
+	statement, err := s.earningRepo.GetStatement(ctx, statementID)
+	if err != nil {
+		return RiderStatementDetailResult{}, err
+	}
+	if statement == nil {
+		return RiderStatementDetailResult{}, utils.NewBizError("结算单不存在")
+	}
+	if err = checkRiderAccess(ctx, statement.RiderID); err != nil {
+		return RiderStatementDetailResult{}, err
+	}
+
+	// 3. 查询收入流水
+	earnings, err := s.earningRepo.ListStatementEarnings(ctx, statementID)
+	if err != nil {
+		return RiderStatementDetailResult{}, err
+	}
+	result := RiderStatementDetailResult{
+		Statement: toRiderStatementResult(statement),
+		Earnings:  make([]RiderEarningResult, 0, len(earnings)),
+	}
+	for _, e := range earnings {
+		result.Earnings = append(result.Earnings, RiderEarningResult{
+			EarningID:        e.EarningID,
+			OrderID:          e.OrderID,
+			OrderNo:          e.OrderNo,
+			DeliveryDistance: e.DeliveryDistance,
+			BaseFee:          e.BaseFee,
+			DistanceBonus:    e.DistanceBonus,
+			PeakMultiplier:   e.PeakMultiplier,
+			PeakBonus:        e.PeakBonus,
+			Tip:              e.Tip,
+			Amount:           e.Amount,
+			CompleteTime:     e.CompleteTime.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return result, nil
+}
+
+// GenerateWeeklyStatements 将本周之前的收入流水汇总为周结算单
+func (s *earningService) GenerateWeeklyStatements(ctx context.Context) (int, error) {
+	count, err := s.earningRepo.GenerateStatements(ctx, weekStart(time.Now()))
+	if err != nil {
+		return count, err
+	}
+	if count > 0 {
+		zap.L().Info("生成骑手周结算单完成", zap.Int("statements", count))
+	}
+	return count, nil
+}
+
+// checkRiderAccess 骑手仅可访问自己的收入数据，平台运营可访问全部
+func checkRiderAccess(ctx context.Context, riderID int64) error {
+	claims, err := middleware.CheckRole(ctx, "rider", "admin")
+	if err != nil {
+		return err
+	}
+	if claims.Role == "rider" {
+		return middleware.CheckIdentity(ctx, "rider", riderID)
+	}
+	return nil
+}
+
+// addEarning 累加日收入
+func addEarning(sum *EarningSummaryResult, d repo.DailyEarning) {
+	sum.DeliveryCount += d.DeliveryCount
+	sum.BaseFee += d.BaseFee
+	sum.DistanceBonus += d.DistanceBonus
+	sum.PeakBonus += d.PeakBonus
+	sum.Tip += d.Tip
+	sum.Amount += d.Amount
+}
+
+// roundEarning 金额保留两位小数
+func roundEarning(sum *EarningSummaryResult) {
+	sum.BaseFee = utils.RoundMoney(sum.BaseFee)
+	sum.DistanceBonus = utils.RoundMoney(sum.DistanceBonus)
+	sum.PeakBonus = utils.RoundMoney(sum.PeakBonus)
+	sum.Tip = utils.RoundMoney(sum.Tip)
+	sum.Amount = utils.RoundMoney(sum.Amount)
+}
+
+// toRiderStatementResult 模型 → 领域层结果
+func toRiderStatementResult(statement *model.RiderStatement) RiderStatementResult {
+	return RiderStatementResult{
+		StatementID:   statement.StatementID,
+		StatementNo:   statement.StatementNo,
+		RiderID:       statement.RiderID,
+		PeriodStart:   statement.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:     statement.PeriodEnd.Format("2006-01-02"),
+		DeliveryCount: statement.DeliveryCount,
+		BaseFee:       statement.BaseFee,
+		DistanceBonus: statement.DistanceBonus,
+		PeakBonus:     statement.PeakBonus,
+		Tip:           statement.Tip,
+		Amount:        statement.Amount,
+		Status:        statement.Status,
+		CreateTime:    statement.CreateTime.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// weekStart 取所在周周一零点（本地时区）
+func weekStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+	offset := (int(day.Weekday()) + 6) % 7 // 周一为0
+	return day.AddDate(0, 0, -offset)
+}
@@ -2,13 +2,19 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
 	"time"
 
+	"github.com/IBM/sarama"
 	orderProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/order/proto"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/client"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/earning"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/repo"
 	"github.com/JokerYuan-lang/go-meituan-microservice/internal/rider/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/pagination"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
 	"github.com/go-playground/validator/v10"
 	"go.uber.org/zap"
@@ -39,16 +45,20 @@ type UpdateDeliveryStatusParam struct {
 }
 
 type ListPendingOrdersParam struct {
-	Area     string `validate:"omitempty"`
-	Page     int32  `validate:"required,gte=1"`
-	PageSize int32  `validate:"required,gte=10,lte=100"`
+	Area      string `validate:"omitempty"`
+	Page      int32  `validate:"gte=0"` // 0表示游标分页
+	PageSize  int32  `validate:"required,gte=10,lte=100"`
+	Cursor    string `validate:"omitempty,max=256"`
+	WithTotal bool
 }
 
 type ListRiderOrdersParam struct {
 	RiderID        int64  `validate:"required,gt=0"`
 	DeliveryStatus string `validate:"omitempty"`
-	Page           int32  `validate:"required,gte=1"`
+	Page           int32  `validate:"gte=0"` // 0表示游标分页
 	PageSize       int32  `validate:"required,gte=10,lte=100"`
+	Cursor         string `validate:"omitempty,max=256"`
+	WithTotal      bool
 }
 
 // 响应结构体
@@ -86,10 +96,11 @@ type DeliveryOrderResult struct {
 }
 
 type ListOrdersResult struct {
-	Orders   []DeliveryOrderResult `json:"orders"`
-	Total    int32                 `json:"total"`
-	Page     int32                 `json:"page"`
-	PageSize int32                 `json:"page_size"`
+	Orders     []DeliveryOrderResult `json:"orders"`
+	Total      int32                 `json:"total"` // 未统计时为-1
+	Page       int32                 `json:"page"`
+	PageSize   int32                 `json:"page_size"`
+	NextCursor string                `json:"next_cursor"`
 }
 
 // RiderService 骑手业务逻辑接口
@@ -101,6 +112,7 @@ type RiderService interface {
 	UpdateDeliveryStatus(ctx context.Context, param UpdateDeliveryStatusParam) error
 	ListPendingOrders(ctx context.Context, param ListPendingOrdersParam) (ListOrdersResult, error)
 	ListRiderOrders(ctx context.Context, param ListRiderOrdersParam) (ListOrdersResult, error)
+	HandleRatingUpdated(ctx context.Context, msg *sarama.ConsumerMessage) error // 消费评分更新消息，同步骑手评分
 }
 
 // riderService 实现
@@ -218,11 +230,15 @@ func (s *riderService) GetRiderInfo(ctx context.Context, riderID int64) (RiderIn
 		return RiderInfoResult{}, utils.NewParamError("骑手ID不能为空且大于0")
 	}
 
-	// 查询骑手
+	// 查询骑手及已完成配送单数
 	rider, err := s.riderRepo.GetRiderByID(ctx, riderID)
 	if err != nil {
 		return RiderInfoResult{}, err
 	}
+	orderCount, err := s.riderRepo.CountCompletedDeliveries(ctx, riderID)
+	if err != nil {
+		return RiderInfoResult{}, err
+	}
 
 	// 组装结果
 	result := RiderInfoResult{
@@ -231,7 +247,7 @@ func (s *riderService) GetRiderInfo(ctx context.Context, riderID int64) (RiderIn
 		Phone:      rider.Phone,
 		Avatar:     rider.Avatar,
 		Score:      rider.Score,
-		OrderCount: rider.OrderCount,
+		OrderCount: int32(orderCount),
 		Status:     rider.Status,
 		CreatedAt:  rider.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:  rider.UpdatedAt.Format("2006-01-02 15:04:05"),
@@ -257,27 +273,16 @@ func (s *riderService) AcceptOrder(ctx context.Context, param AcceptOrderParam)
 		return utils.NewBizError("骑手当前离线，无法接单")
 	}
 
-	// 1. 更新配送订单状态为「待取餐」
-	now := time.Now().Format("2006-01-02 15:04:05")
-	if err := s.riderRepo.UpdateDeliveryOrder(ctx, param.OrderID, param.RiderID, "待取餐", now); err != nil {
+	// 1. 调用订单服务更新订单状态为「待配送」（订单服务校验状态并记录骑手，以其结果为准）
+	if err = s.updateOrderStatus(ctx, param.OrderID, param.RiderID, "待配送"); err != nil {
 		return err
 	}
 
-	// 2. 调用订单服务更新订单状态为「待配送」
-	updateStatusReq := &orderProto.UpdateOrderStatusRequest{
-		OrderId:  param.OrderID,
-		Status:   "待配送",
-		Operator: "rider_" + strconv.FormatInt(param.RiderID, 10),
-	}
-	_, err = client.OrderClient.UpdateOrderStatus(ctx, updateStatusReq)
-	if err != nil {
-		zap.L().Error("调用订单服务更新状态失败", zap.Int64("order_id", param.OrderID), zap.Error(err))
-		return utils.NewSystemError("接单失败，订单服务异常")
-	}
-
-	// 3. 更新骑手订单数+1
-	if err := s.riderRepo.UpdateOrderCount(ctx, param.RiderID, 1); err != nil {
-		zap.L().Warn("更新骑手订单数失败", zap.Int64("rider_id", param.RiderID), zap.Error(err))
+	// 2. 更新配送订单（仅未分配骑手时生效）
+	now := time.Now().Format("2006-01-02 15:04:05")
+	if err = s.riderRepo.UpdateDeliveryOrder(ctx, param.OrderID, param.RiderID, "待取餐", "待取餐", now); err != nil {
+		zap.L().Error("订单已分配骑手但更新配送订单失败", zap.Int64("order_id", param.OrderID), zap.Int64("rider_id", param.RiderID), zap.Error(err))
+		return err
 	}
 
 	zap.L().Info("骑手接单成功", zap.Int64("order_id", param.OrderID), zap.Int64("rider_id", param.RiderID))
@@ -292,41 +297,48 @@ func (s *riderService) UpdateDeliveryStatus(ctx context.Context, param UpdateDel
 		return utils.NewParamError("参数错误：" + err.Error())
 	}
 
-	// 校验状态合法性
-	validStatus := []string{"待取餐", "配送中", "已完成"}
+	// 校验状态合法性（接单走AcceptOrder）
+	validStatus := []string{"配送中", "已完成"}
 	if !utils.ContainsString(validStatus, param.DeliveryStatus) {
 		return utils.NewParamError("配送状态不合法")
 	}
 
-	// 1. 更新配送订单状态
+	// 1. 送达：订单完成后记录本单收入
+	if param.DeliveryStatus == "已完成" {
+		if err := s.completeDelivery(ctx, param.OrderID, param.RiderID); err != nil {
+			return err
+		}
+		zap.L().Info("更新配送状态成功", zap.Int64("order_id", param.OrderID), zap.String("status", param.DeliveryStatus))
+		return nil
+	}
+
+	// 2. 取餐：先由订单服务校验骑手及状态，再更新配送订单
+	if err := s.updateOrderStatus(ctx, param.OrderID, param.RiderID, "配送中"); err != nil {
+		return err
+	}
 	now := time.Now().Format("2006-01-02 15:04:05")
-	if err := s.riderRepo.UpdateDeliveryOrder(ctx, param.OrderID, param.RiderID, param.DeliveryStatus, now); err != nil {
+	if err := s.riderRepo.UpdateDeliveryOrder(ctx, param.OrderID, param.RiderID, "待取餐", "配送中", now); err != nil {
 		return err
 	}
 
-	// 2. 同步更新订单服务状态
-	var orderStatus string
-	switch param.DeliveryStatus {
-	case "配送中":
-		orderStatus = "配送中"
-	case "已完成":
-		orderStatus = "已完成"
-	default:
-		orderStatus = param.DeliveryStatus
-	}
+	zap.L().Info("更新配送状态成功", zap.Int64("order_id", param.OrderID), zap.String("status", param.DeliveryStatus))
+	return nil
+}
 
-	updateStatusReq := &orderProto.UpdateOrderStatusRequest{
-		OrderId:  param.OrderID,
-		Status:   orderStatus,
-		Operator: "rider_" + strconv.FormatInt(param.RiderID, 10),
-	}
-	_, err := client.OrderClient.UpdateOrderStatus(ctx, updateStatusReq)
+// updateOrderStatus 调用订单服务流转订单状态（订单服务校验骑手归属及当前状态）
+func (s *riderService) updateOrderStatus(ctx context.Context, orderID, riderID int64, status string) error {
+	updateResp, err := client.OrderClient.UpdateOrderStatus(ctx, &orderProto.UpdateOrderStatusRequest{
+		OrderId:  orderID,
+		Status:   status,
+		Operator: "rider_" + strconv.FormatInt(riderID, 10),
+	})
 	if err != nil {
-		zap.L().Error("调用订单服务更新状态失败", zap.Int64("order_id", param.OrderID), zap.Error(err))
+		zap.L().Error("调用订单服务更新状态失败", zap.Int64("order_id", orderID), zap.Error(err))
 		return utils.NewSystemError("更新配送状态失败，订单服务异常")
 	}
-
-	zap.L().Info("更新配送状态成功", zap.Int64("order_id", param.OrderID), zap.String("status", param.DeliveryStatus))
+	if updateResp.Code != utils.ErrCodeSuccess {
+		return utils.NewAppError(int(updateResp.Code), updateResp.Msg)
+	}
 	return nil
 }
 
@@ -339,7 +351,12 @@ func (s *riderService) ListPendingOrders(ctx context.Context, param ListPendingO
 	}
 
 	// 查询待接订单
-	orders, total, err := s.riderRepo.ListPendingOrders(ctx, param.Area, param.Page, param.PageSize)
+	orders, page, err := s.riderRepo.ListPendingOrders(ctx, param.Area, pagination.Param{
+		Page:      param.Page,
+		PageSize:  param.PageSize,
+		Cursor:    param.Cursor,
+		WithTotal: param.WithTotal,
+	})
 	if err != nil {
 		return ListOrdersResult{}, err
 	}
@@ -364,10 +381,11 @@ func (s *riderService) ListPendingOrders(ctx context.Context, param ListPendingO
 	}
 
 	result := ListOrdersResult{
-		Orders:   resultOrders,
-		Total:    int32(total),
-		Page:     param.Page,
-		PageSize: param.PageSize,
+		Orders:     resultOrders,
+		Total:      int32(page.Total),
+		Page:       param.Page,
+		PageSize:   param.PageSize,
+		NextCursor: page.NextCursor,
 	}
 
 	return result, nil
@@ -382,7 +400,12 @@ func (s *riderService) ListRiderOrders(ctx context.Context, param ListRiderOrder
 	}
 
 	// 查询骑手订单
-	orders, total, err := s.riderRepo.ListRiderOrders(ctx, param.RiderID, param.DeliveryStatus, param.Page, param.PageSize)
+	orders, page, err := s.riderRepo.ListRiderOrders(ctx, param.RiderID, param.DeliveryStatus, pagination.Param{
+		Page:      param.Page,
+		PageSize:  param.PageSize,
+		Cursor:    param.Cursor,
+		WithTotal: param.WithTotal,
+	})
 	if err != nil {
 		return ListOrdersResult{}, err
 	}
@@ -407,11 +430,84 @@ func (s *riderService) ListRiderOrders(ctx context.Context, param ListRiderOrder
 	}
 
 	result := ListOrdersResult{
-		Orders:   resultOrders,
-		Total:    int32(total),
-		Page:     param.Page,
-		PageSize: param.PageSize,
+		Orders:     resultOrders,
+		Total:      int32(page.Total),
+		Page:       param.Page,
+		PageSize:   param.PageSize,
+		NextCursor: page.NextCursor,
 	}
 
 	return result, nil
 }
+
+// completeDelivery 送达：订单服务完成订单后，按配送距离、送达时段及小费计算本单收入，与配送状态在同一事务写入
+// 订单已完成但本地写入失败时可重试：订单已是本骑手的已完成订单则跳过流转，收入按订单ID幂等
+func (s *riderService) completeDelivery(ctx context.Context, orderID, riderID int64) error {
+	// 1. 校验配送订单归属及状态
+	delivery, err := s.riderRepo.GetDeliveryOrderByOrderID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if delivery.RiderID != riderID {
+		return utils.NewAuthError("无权限操作其他骑手的配送订单")
+	}
+	if delivery.DeliveryStatus != "配送中" {
+		return utils.NewBizError("配送订单当前状态不允许完成")
+	}
+
+	// 2. 查询订单配送距离及小费（以订单服务下单时的计费为准）
+	resp, err := client.OrderClient.GetOrderByID(ctx, &orderProto.GetOrderRequest{OrderId: orderID})
+	if err != nil {
+		zap.L().Error("调用订单服务查询订单失败", zap.Int64("order_id", orderID), zap.Error(err))
+		return utils.NewSystemError("完成配送失败，订单服务异常")
+	}
+	if resp.Code != utils.ErrCodeSuccess || resp.Order == nil {
+		return utils.NewBizError("查询订单失败：" + resp.Msg)
+	}
+	if resp.Order.RiderId != riderID {
+		return utils.NewAuthError("订单不属于该骑手")
+	}
+
+	// 3. 订单服务流转为已完成（校验骑手及订单状态），成功后才入账
+	if resp.Order.Status != "已完成" {
+		if err = s.updateOrderStatus(ctx, orderID, riderID, "已完成"); err != nil {
+			return err
+		}
+	}
+
+	// 4. 计算收入并写入流水
+	now := time.Now()
+	b := earning.Calculate(config.Cfg.Rider, resp.Order.DeliveryDistance, now, float64(resp.Order.TipAmount))
+	record := &model.RiderEarning{
+		RiderID:          riderID,
+		OrderID:          orderID,
+		OrderNo:          delivery.OrderNo,
+		DeliveryDistance: resp.Order.DeliveryDistance,
+		BaseFee:          b.BaseFee,
+		DistanceBonus:    b.DistanceBonus,
+		PeakMultiplier:   b.PeakMultiplier,
+		PeakBonus:        b.PeakBonus,
+		Tip:              b.Tip,
+		Amount:           b.Amount,
+		CompleteTime:     now,
+	}
+	if err = s.riderRepo.CompleteDelivery(ctx, record); err != nil {
+		return err
+	}
+
+	zap.L().Info("骑手配送收入入账", zap.Int64("order_id", orderID), zap.Int64("rider_id", riderID), zap.Float64("amount", b.Amount))
+	return nil
+}
+
+// HandleRatingUpdated 消费评分更新消息（订单服务按评价增量计算评分后投递），仅处理骑手评分
+func (s *riderService) HandleRatingUpdated(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var event kafka.RatingUpdatedEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil || event.TargetID == 0 {
+		zap.L().Error("评分更新消息格式错误", zap.ByteString("value", msg.Value), zap.Error(err))
+		return nil // 格式错误无法重试，直接跳过
+	}
+	if event.TargetType != kafka.RatingTargetRider {
+		return nil
+	}
+	return s.riderRepo.UpdateScore(ctx, event.TargetID, event.Score, event.RatingCount)
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	searchProto "github.com/JokerYuan-lang/go-meituan-microservice/internal/search/proto"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/search/service"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// SearchHandler 搜索服务gRPC处理器
+type SearchHandler struct {
+	searchProto.UnimplementedSearchServiceServer
+	searchService service.SearchService
+}
+
+// NewSearchHandler 创建实例
+func NewSearchHandler(searchService service.SearchService) *SearchHandler {
+	return &SearchHandler{
+		searchService: searchService,
+	}
+}
+
+// Search 搜索商家/商品
+func (h *SearchHandler) Search(ctx context.Context, req *searchProto.SearchRequest) (*searchProto.SearchResponse, error) {
+	result, err := h.searchService.Search(ctx, service.SearchParam{
+		Type:        req.Type,
+		Keyword:     req.Keyword,
+		Category:    req.Category,
+		MinPrice:    float64(req.MinPrice),
+		MaxPrice:    float64(req.MaxPrice),
+		OpenNow:     req.OpenNow,
+		Longitude:   req.Longitude,
+		Latitude:    req.Latitude,
+		MaxDistance: req.MaxDistance,
+		SortBy:      req.SortBy,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+	})
+	if err != nil {
+		var appError *utils.AppError
+		ok := errors.As(err, &appError)
+		if !ok {
+			zap.L().Error("搜索未知错误", zap.Error(err))
+			return &searchProto.SearchResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &searchProto.SearchResponse{
+			Code: int32(appError.Code),
+			Msg:  appError.Message,
+		}, nil
+	}
+
+	protoMerchants := make([]*searchProto.MerchantHit, 0, len(result.Merchants))
+	for _, m := range result.Merchants {
+		protoMerchants = append(protoMerchants, &searchProto.MerchantHit{
+			MerchantId:     m.MerchantID,
+			Name:           m.Name,
+			Address:        m.Address,
+			Logo:           m.Logo,
+			Score:          float32(m.Score),
+			OrderCount:     m.OrderCount,
+			IsOpen:         m.IsOpen,
+			MinOrderAmount: float32(m.MinOrderAmount),
+			Distance:       m.Distance,
+		})
+	}
+	protoProducts := make([]*searchProto.ProductHit, 0, len(result.Products))
+	for _, p := range result.Products {
+		protoProducts = append(protoProducts, &searchProto.ProductHit{
+			ProductId:    p.ProductID,
+			MerchantId:   p.MerchantID,
+			MerchantName: p.MerchantName,
+			Name:         p.Name,
+			Description:  p.Description,
+			ImageUrl:     p.ImageURL,
+			Price:        float32(p.Price),
+			IsSoldOut:    p.IsSoldOut,
+			Score:        float32(p.Score),
+			IsOpen:       p.IsOpen,
+			Distance:     p.Distance,
+		})
+	}
+	return &searchProto.SearchResponse{
+		Code:      utils.ErrCodeSuccess,
+		Msg:       "搜索成功",
+		Total:     result.Total,
+		Merchants: protoMerchants,
+		Products:  protoProducts,
+	}, nil
+}
@@ -0,0 +1,444 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
+	"go.uber.org/zap"
+)
+
+// ESIndexer Elasticsearch索引（通过REST接口访问，中文分词依赖IK插件）
+type ESIndexer struct {
+	baseURL        string
+	username       string
+	password       string
+	merchantIndex  string
+	productIndex   string
+	analyzer       string
+	searchAnalyzer string
+	httpClient     *http.Client
+}
+
+// NewESIndexer 创建实例
+func NewESIndexer(cfg config.ESConfig) *ESIndexer {
+	cfg = cfg.WithDefaults()
+	return &ESIndexer{
+		baseURL:        fmt.Sprintf("http://%s:%d", cfg.Host, cfg.Port),
+		username:       cfg.Username,
+		password:       cfg.Password,
+		merchantIndex:  cfg.IndexPrefix + "_merchant",
+		productIndex:   cfg.IndexPrefix + "_product",
+		analyzer:       cfg.Analyzer,
+		searchAnalyzer: cfg.SearchAnalyzer,
+		httpClient:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// esGeoPoint ES地理坐标
+type esGeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// esMerchant 商家文档（附加坐标、跨天营业字段供查询使用）
+type esMerchant struct {
+	*MerchantDoc
+	Location  esGeoPoint `json:"location"`
+	Overnight bool       `json:"overnight"`
+}
+
+// esProduct 商品文档
+type esProduct struct {
+	*ProductDoc
+	Location  esGeoPoint `json:"location"`
+	Overnight bool       `json:"overnight"`
+}
+
+// EnsureIndices 索引不存在时创建
+func (e *ESIndexer) EnsureIndices(ctx context.Context) error {
+	for index, mapping := range e.mappings() {
+		status, _, err := e.do(ctx, http.MethodHead, "/"+index, nil)
+		if err != nil {
+			return err
+		}
+		if status == http.StatusOK {
+			continue
+		}
+		if err = e.expect(ctx, http.MethodPut, "/"+index, mapping); err != nil {
+			return fmt.Errorf("创建索引%s失败：%w", index, err)
+		}
+		zap.L().Info("创建搜索索引", zap.String("index", index))
+	}
+	return nil
+}
+
+// ResetIndices 删除并重建索引
+func (e *ESIndexer) ResetIndices(ctx context.Context) error {
+	for index := range e.mappings() {
+		status, body, err := e.do(ctx, http.MethodDelete, "/"+index, nil)
+		if err != nil {
+			return err
+		}
+		if status != http.StatusOK && status != http.StatusNotFound {
+			return fmt.Errorf("删除索引%s失败：%d %s", index, status, body)
+		}
+	}
+	return e.EnsureIndices(ctx)
+}
+
+// mappings 索引映射：文本字段使用中文分词，分类名称额外建keyword子字段用于精确筛选
+func (e *ESIndexer) mappings() map[string]map[string]interface{} {
+	text := map[string]interface{}{"type": "text", "analyzer": e.analyzer, "search_analyzer": e.searchAnalyzer}
+	categories := map[string]interface{}{
+		"type": "text", "analyzer": e.analyzer, "search_analyzer": e.searchAnalyzer,
+		"fields": map[string]interface{}{"raw": map[string]interface{}{"type": "keyword"}},
+	}
+	common := map[string]interface{}{
+		"merchant_id":  map[string]interface{}{"type": "long"},
+		"name":         text,
+		"categories":   categories,
+		"location":     map[string]interface{}{"type": "geo_point"},
+		"longitude":    map[string]interface{}{"type": "double", "index": false},
+		"latitude":     map[string]interface{}{"type": "double", "index": false},
+		"is_open":      map[string]interface{}{"type": "boolean"},
+		"open_minute":  map[string]interface{}{"type": "integer"},
+		"close_minute": map[string]interface{}{"type": "integer"},
+		"overnight":    map[string]interface{}{"type": "boolean"},
+		"score":        map[string]interface{}{"type": "double"},
+	}
+	merchant := map[string]interface{}{
+		"address":          text,
+		"logo":             map[string]interface{}{"type": "keyword", "index": false},
+		"min_order_amount": map[string]interface{}{"type": "double"},
+		"order_count":      map[string]interface{}{"type": "integer"},
+	}
+	product := map[string]interface{}{
+		"product_id":    map[string]interface{}{"type": "long"},
+		"merchant_name": text,
+		"description":   text,
+		"image_url":     map[string]interface{}{"type": "keyword", "index": false},
+		"price":         map[string]interface{}{"type": "double"},
+		"is_sold_out":   map[string]interface{}{"type": "boolean"},
+	}
+	for k, v := range common {
+		merchant[k] = v
+		product[k] = v
+	}
+	wrap := func(properties map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"settings": map[string]interface{}{"number_of_shards": 1},
+			"mappings": map[string]interface{}{"dynamic": "strict", "properties": properties},
+		}
+	}
+	return map[string]map[string]interface{}{
+		e.merchantIndex: wrap(merchant),
+		e.productIndex:  wrap(product),
+	}
+}
+
+func (e *ESIndexer) UpsertMerchant(ctx context.Context, doc *MerchantDoc) error {
+	body := esMerchant{
+		MerchantDoc: doc,
+		Location:    esGeoPoint{Lat: doc.Latitude, Lon: doc.Longitude},
+		Overnight:   doc.Overnight(),
+	}
+	return e.expect(ctx, http.MethodPut, "/"+e.merchantIndex+"/_doc/"+strconv.FormatInt(doc.MerchantID, 10), body)
+}
+
+// DeleteMerchant 删除商家及其全部商品
+func (e *ESIndexer) DeleteMerchant(ctx context.Context, merchantID int64) error {
+	status, body, err := e.do(ctx, http.MethodDelete, "/"+e.merchantIndex+"/_doc/"+strconv.FormatInt(merchantID, 10), nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK && status != http.StatusNotFound {
+		return fmt.Errorf("删除商家文档失败：%d %s", status, body)
+	}
+	return e.deleteByQuery(ctx, e.productIndex, map[string]interface{}{
+		"term": map[string]interface{}{"merchant_id": merchantID},
+	})
+}
+
+// SyncMerchantProducts 批量写入商家商品，并删除商家下不在docs中的商品
+func (e *ESIndexer) SyncMerchantProducts(ctx context.Context, merchantID int64, docs []*ProductDoc) error {
+	keepIDs := make([]int64, 0, len(docs))
+	if len(docs) > 0 {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		for _, doc := range docs {
+			keepIDs = append(keepIDs, doc.ProductID)
+			action := map[string]interface{}{"index": map[string]interface{}{"_index": e.productIndex, "_id": strconv.FormatInt(doc.ProductID, 10)}}
+			if err := enc.Encode(action); err != nil {
+				return err
+			}
+			if err := enc.Encode(e.productBody(doc)); err != nil {
+				return err
+			}
+		}
+		if err := e.bulk(ctx, buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return e.deleteByQuery(ctx, e.productIndex, map[string]interface{}{
+		"bool": map[string]interface{}{
+			"filter":   []interface{}{map[string]interface{}{"term": map[string]interface{}{"merchant_id": merchantID}}},
+			"must_not": []interface{}{map[string]interface{}{"terms": map[string]interface{}{"product_id": keepIDs}}},
+		},
+	})
+}
+
+func (e *ESIndexer) UpsertProduct(ctx context.Context, doc *ProductDoc) error {
+	return e.expect(ctx, http.MethodPut, "/"+e.productIndex+"/_doc/"+strconv.FormatInt(doc.ProductID, 10), e.productBody(doc))
+}
+
+func (e *ESIndexer) DeleteProduct(ctx context.Context, productID int64) error {
+	status, body, err := e.do(ctx, http.MethodDelete, "/"+e.productIndex+"/_doc/"+strconv.FormatInt(productID, 10), nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK && status != http.StatusNotFound {
+		return fmt.Errorf("删除商品文档失败：%d %s", status, body)
+	}
+	return nil
+}
+
+// productBody 商品文档请求体
+func (e *ESIndexer) productBody(doc *ProductDoc) esProduct {
+	return esProduct{
+		ProductDoc: doc,
+		Location:   esGeoPoint{Lat: doc.Latitude, Lon: doc.Longitude},
+		Overnight:  doc.Overnight(),
+	}
+}
+
+// Search 构造bool查询：关键词走全文检索，其余条件放filter（不参与打分，可缓存）
+func (e *ESIndexer) Search(ctx context.Context, query Query) (Result, error) {
+	var filters []interface{}
+	if query.Category != "" {
+		filters = append(filters, map[string]interface{}{"term": map[string]interface{}{"categories.raw": query.Category}})
+	}
+	if query.OpenNow {
+		filters = append(filters, openNowFilter(query.NowMinute))
+	}
+	if query.HasLocation() && query.MaxDistance > 0 {
+		filters = append(filters, map[string]interface{}{"geo_distance": map[string]interface{}{
+			"distance": fmt.Sprintf("%.0fm", query.MaxDistance),
+			"location": esGeoPoint{Lat: query.Latitude, Lon: query.Longitude},
+		}})
+	}
+
+	index := e.merchantIndex
+	fields := []string{"name^3", "categories^2", "address"}
+	if query.Type == TypeProduct {
+		index = e.productIndex
+		fields = []string{"name^3", "merchant_name^2", "categories^2", "description"}
+		if query.MinPrice > 0 || query.MaxPrice > 0 {
+			priceRange := map[string]interface{}{"gte": query.MinPrice}
+			if query.MaxPrice > 0 {
+				priceRange["lte"] = query.MaxPrice
+			}
+			filters = append(filters, map[string]interface{}{"range": map[string]interface{}{"price": priceRange}})
+		}
+	}
+
+	boolQuery := map[string]interface{}{"filter": filters}
+	if query.Keyword != "" {
+		boolQuery["must"] = []interface{}{map[string]interface{}{"multi_match": map[string]interface{}{
+			"query":                query.Keyword,
+			"fields":               fields,
+			"minimum_should_match": "75%",
+		}}}
+	}
+	idField := "merchant_id"
+	if query.Type == TypeProduct {
+		idField = "product_id"
+	}
+	request := map[string]interface{}{
+		"from":             query.Offset,
+		"size":             query.Limit,
+		"track_total_hits": true,
+		"query":            map[string]interface{}{"bool": boolQuery},
+		"sort":             e.sortClause(query, idField),
+	}
+
+	status, body, err := e.do(ctx, http.MethodPost, "/"+index+"/_search", request)
+	if err != nil {
+		return Result{}, err
+	}
+	if status != http.StatusOK {
+		return Result{}, fmt.Errorf("搜索失败：%d %s", status, body)
+	}
+	var resp struct {
+		Hits struct {
+			Total struct {
+				Value int64 `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				Source json.RawMessage `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return Result{}, fmt.Errorf("解析搜索结果失败：%w", err)
+	}
+
+	result := Result{Total: resp.Hits.Total.Value}
+	for _, hit := range resp.Hits.Hits {
+		if query.Type == TypeProduct {
+			doc := &ProductDoc{}
+			if err = json.Unmarshal(hit.Source, doc); err != nil {
+				return Result{}, fmt.Errorf("解析商品文档失败：%w", err)
+			}
+			result.Products = append(result.Products, doc)
+		} else {
+			doc := &MerchantDoc{}
+			if err = json.Unmarshal(hit.Source, doc); err != nil {
+				return Result{}, fmt.Errorf("解析商家文档失败：%w", err)
+			}
+			result.Merchants = append(result.Merchants, doc)
+		}
+	}
+	return result, nil
+}
+
+// sortClause 排序子句（最后按ID升序，保证分页稳定）
+func (e *ESIndexer) sortClause(query Query, idField string) []interface{} {
+	var sorts []interface{}
+	switch query.SortBy {
+	case SortDistance:
+		sorts = append(sorts, map[string]interface{}{"_geo_distance": map[string]interface{}{
+			"location": esGeoPoint{Lat: query.Latitude, Lon: query.Longitude},
+			"order":    "asc",
+			"unit":     "m",
+		}})
+	case SortPriceAsc:
+		sorts = append(sorts, map[string]interface{}{"price": "asc"})
+	case SortPriceDesc:
+		sorts = append(sorts, map[string]interface{}{"price": "desc"})
+	case SortScore:
+		sorts = append(sorts, map[string]interface{}{"score": "desc"})
+	default:
+		sorts = append(sorts, "_score", map[string]interface{}{"score": "desc"})
+	}
+	return append(sorts, map[string]interface{}{idField: "asc"})
+}
+
+// openNowFilter 营业中筛选：商家营业且当前时间在营业时段内（未配置营业时段视为全天营业）
+func openNowFilter(minute int32) map[string]interface{} {
+	term := func(field string, value interface{}) map[string]interface{} {
+		return map[string]interface{}{"term": map[string]interface{}{field: value}}
+	}
+	rng := func(field, op string, value int32) map[string]interface{} {
+		return map[string]interface{}{"range": map[string]interface{}{field: map[string]interface{}{op: value}}}
+	}
+	return map[string]interface{}{"bool": map[string]interface{}{
+		"filter": []interface{}{term("is_open", true)},
+		"should": []interface{}{
+			term("open_minute", -1),
+			map[string]interface{}{"bool": map[string]interface{}{"filter": []interface{}{
+				term("overnight", false), rng("open_minute", "lte", minute), rng("close_minute", "gt", minute),
+			}}},
+			map[string]interface{}{"bool": map[string]interface{}{
+				"filter":               []interface{}{term("overnight", true)},
+				"should":               []interface{}{rng("open_minute", "lte", minute), rng("close_minute", "gt", minute)},
+				"minimum_should_match": 1,
+			}},
+		},
+		"minimum_should_match": 1,
+	}}
+}
+
+// bulk 批量写入（任一文档失败即返回错误）
+func (e *ESIndexer) bulk(ctx context.Context, ndjson []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.baseURL+"/_bulk", bytes.NewReader(ndjson))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	status, body, err := e.send(req)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("批量写入失败：%d %s", status, body)
+	}
+	var resp struct {
+		Errors bool `json:"errors"`
+	}
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("解析批量写入结果失败：%w", err)
+	}
+	if resp.Errors {
+		return fmt.Errorf("批量写入部分失败：%s", truncate(body, 512))
+	}
+	return nil
+}
+
+// deleteByQuery 按条件删除文档
+func (e *ESIndexer) deleteByQuery(ctx context.Context, index string, query map[string]interface{}) error {
+	return e.expect(ctx, http.MethodPost, "/"+index+"/_delete_by_query?conflicts=proceed", map[string]interface{}{"query": query})
+}
+
+// expect 发送请求，非2xx响应返回错误
+func (e *ESIndexer) expect(ctx context.Context, method, path string, payload interface{}) error {
+	status, body, err := e.do(ctx, method, path, payload)
+	if err != nil {
+		return err
+	}
+	if status < 200 || status >= 300 {
+		return fmt.Errorf("ES请求失败：%s %s %d %s", method, path, status, truncate(body, 512))
+	}
+	return nil
+}
+
+// do 发送JSON请求，返回状态码及响应体
+func (e *ESIndexer) do(ctx context.Context, method, path string, payload interface{}) (int, []byte, error) {
+	var reader io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return 0, nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, e.baseURL+path, reader)
+	if err != nil {
+		return 0, nil, err
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return e.send(req)
+}
+
+// send 发送请求（配置了用户名时使用Basic认证）
+func (e *ESIndexer) send(req *http.Request) (int, []byte, error) {
+	if e.username != "" {
+		req.SetBasicAuth(e.username, e.password)
+	}
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("ES请求失败：%w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, nil, fmt.Errorf("读取ES响应失败：%w", err)
+	}
+	return resp.StatusCode, body, nil
+}
+
+// truncate 截断响应体（避免错误信息过长）
+func truncate(body []byte, n int) string {
+	if len(body) <= n {
+		return string(body)
+	}
+	return string(body[:n]) + "..."
+}
@@ -0,0 +1,97 @@
+package indexer
+
+import (
+	"context"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+)
+
+// 搜索对象类型
+const (
+	TypeMerchant = "merchant"
+	TypeProduct  = "product"
+)
+
+// 排序方式
+const (
+	SortRelevance = "relevance"  // 相关度（无关键词时按评分）
+	SortDistance  = "distance"   // 距离由近到远（需传用户坐标）
+	SortScore     = "score"      // 评分由高到低
+	SortPriceAsc  = "price_asc"  // 价格由低到高（仅商品）
+	SortPriceDesc = "price_desc" // 价格由高到低（仅商品）
+)
+
+// MerchantDoc 商家索引文档
+type MerchantDoc struct {
+	MerchantID     int64    `json:"merchant_id"`
+	Name           string   `json:"name"`
+	Address        string   `json:"address"`
+	Logo           string   `json:"logo"`
+	Categories     []string `json:"categories"` // 菜单分类名称
+	Longitude      float64  `json:"longitude"`
+	Latitude       float64  `json:"latitude"`
+	IsOpen         bool     `json:"is_open"`
+	MinOrderAmount float64  `json:"min_order_amount"`
+	Score          float64  `json:"score"`
+	OrderCount     int32    `json:"order_count"`
+	utils.OpenHours
+}
+
+// ProductDoc 商品索引文档（冗余商家坐标及营业信息，用于营业中筛选和距离排序）
+type ProductDoc struct {
+	ProductID    int64    `json:"product_id"`
+	MerchantID   int64    `json:"merchant_id"`
+	MerchantName string   `json:"merchant_name"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	ImageURL     string   `json:"image_url"`
+	Categories   []string `json:"categories"` // 所属菜单分类名称
+	Price        float64  `json:"price"`
+	IsSoldOut    bool     `json:"is_sold_out"`
+	Score        float64  `json:"score"`
+	Longitude    float64  `json:"longitude"`
+	Latitude     float64  `json:"latitude"`
+	IsOpen       bool     `json:"is_open"`
+	utils.OpenHours
+}
+
+// Query 搜索条件（零值表示不限）
+type Query struct {
+	Type        string
+	Keyword     string
+	Category    string  // 菜单分类名称（精确匹配）
+	MinPrice    float64 // 仅商品
+	MaxPrice    float64 // 仅商品
+	OpenNow     bool    // 仅返回营业中的商家/商品
+	NowMinute   int32   // 当前时间（当日分钟数），OpenNow时使用
+	Longitude   float64 // 用户坐标（距离排序、距离筛选）
+	Latitude    float64
+	MaxDistance float64 // 最大距离（米），需传用户坐标
+	SortBy      string
+	Offset      int
+	Limit       int
+}
+
+// HasLocation 是否传入用户坐标
+func (q Query) HasLocation() bool {
+	return q.Longitude != 0 || q.Latitude != 0
+}
+
+// Result 搜索结果（按Query.Type返回商家或商品）
+type Result struct {
+	Total     int64
+	Merchants []*MerchantDoc
+	Products  []*ProductDoc
+}
+
+// Indexer 搜索索引接口（生产环境使用Elasticsearch，测试使用内存实现）
+type Indexer interface {
+	EnsureIndices(ctx context.Context) error // 索引不存在时按映射创建
+	ResetIndices(ctx context.Context) error  // 删除并重建索引（全量重建前调用）
+	UpsertMerchant(ctx context.Context, doc *MerchantDoc) error
+	DeleteMerchant(ctx context.Context, merchantID int64) error                           // 同时删除商家全部商品
+	SyncMerchantProducts(ctx context.Context, merchantID int64, docs []*ProductDoc) error // 覆盖商家全部商品（删除不在docs中的商品）
+	UpsertProduct(ctx context.Context, doc *ProductDoc) error
+	DeleteProduct(ctx context.Context, productID int64) error
+	Search(ctx context.Context, query Query) (Result, error)
+}
@@ -0,0 +1,294 @@
+package indexer
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+)
+
+// MemoryIndexer 内存索引（测试及本地调试用）：中文按单字+二元组建索引、按二元组检索，英文数字按单词切分
+type MemoryIndexer struct {
+	mu        sync.RWMutex
+	merchants map[int64]*MerchantDoc
+	products  map[int64]*ProductDoc
+}
+
+// NewMemoryIndexer 创建实例
+func NewMemoryIndexer() *MemoryIndexer {
+	return &MemoryIndexer{
+		merchants: make(map[int64]*MerchantDoc),
+		products:  make(map[int64]*ProductDoc),
+	}
+}
+
+func (m *MemoryIndexer) EnsureIndices(ctx context.Context) error {
+	return nil
+}
+
+func (m *MemoryIndexer) ResetIndices(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.merchants = make(map[int64]*MerchantDoc)
+	m.products = make(map[int64]*ProductDoc)
+	return nil
+}
+
+func (m *MemoryIndexer) UpsertMerchant(ctx context.Context, doc *MerchantDoc) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	copied := *doc
+	m.merchants[doc.MerchantID] = &copied
+	return nil
+}
+
+func (m *MemoryIndexer) DeleteMerchant(ctx context.Context, merchantID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.merchants, merchantID)
+	for id, p := range m.products {
+		if p.MerchantID == merchantID {
+			delete(m.products, id)
+		}
+	}
+	return nil
+}
+
+func (m *MemoryIndexer) SyncMerchantProducts(ctx context.Context, merchantID int64, docs []*ProductDoc) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, p := range m.products {
+		if p.MerchantID == merchantID {
+			delete(m.products, id)
+		}
+	}
+	for _, doc := range docs {
+		copied := *doc
+		m.products[doc.ProductID] = &copied
+	}
+	return nil
+}
+
+func (m *MemoryIndexer) UpsertProduct(ctx context.Context, doc *ProductDoc) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	copied := *doc
+	m.products[doc.ProductID] = &copied
+	return nil
+}
+
+func (m *MemoryIndexer) DeleteProduct(ctx context.Context, productID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.products, productID)
+	return nil
+}
+
+// memoryHit 命中文档及排序依据
+type memoryHit struct {
+	id        int64
+	relevance float64
+	distance  float64
+	score     float64
+	price     float64
+	merchant  *MerchantDoc
+	product   *ProductDoc
+}
+
+// Search 逐个文档匹配筛选条件，关键词的全部词元均需命中，按命中字段权重（名称最高）计算相关度
+func (m *MemoryIndexer) Search(ctx context.Context, query Query) (Result, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	terms := tokenize(query.Keyword, false)
+	var hits []memoryHit
+	if query.Type == TypeMerchant {
+		for _, doc := range m.merchants {
+			if !matchCommon(query, doc.Categories, doc.IsOpen, doc.OpenHours) {
+				continue
+			}
+			hit := memoryHit{id: doc.MerchantID, score: doc.Score, merchant: doc}
+			if !matchLocation(query, doc.Longitude, doc.Latitude, &hit.distance) {
+				continue
+			}
+			if hit.relevance = relevance(terms, []weightedField{
+				{doc.Name, 3}, {strings.Join(doc.Categories, " "), 2}, {doc.Address, 1},
+			}); len(terms) > 0 && hit.relevance == 0 {
+				continue
+			}
+			hits = append(hits, hit)
+		}
+	} else {
+		for _, doc := range m.products {
+			if !matchCommon(query, doc.Categories, doc.IsOpen, doc.OpenHours) {
+				continue
+			}
+			if doc.Price < query.MinPrice || (query.MaxPrice > 0 && doc.Price > query.MaxPrice) {
+				continue
+			}
+			hit := memoryHit{id: doc.ProductID, score: doc.Score, price: doc.Price, product: doc}
+			if !matchLocation(query, doc.Longitude, doc.Latitude, &hit.distance) {
+				continue
+			}
+			if hit.relevance = relevance(terms, []weightedField{
+				{doc.Name, 3}, {doc.MerchantName, 2}, {strings.Join(doc.Categories, " "), 2}, {doc.Description, 1},
+			}); len(terms) > 0 && hit.relevance == 0 {
+				continue
+			}
+			hits = append(hits, hit)
+		}
+	}
+
+	sortHits(hits, query.SortBy)
+	result := Result{Total: int64(len(hits))}
+	if query.Offset >= len(hits) {
+		return result, nil
+	}
+	hits = hits[query.Offset:]
+	if query.Limit > 0 && len(hits) > query.Limit {
+		hits = hits[:query.Limit]
+	}
+	for _, hit := range hits {
+		if hit.merchant != nil {
+			copied := *hit.merchant
+			result.Merchants = append(result.Merchants, &copied)
+		} else {
+			copied := *hit.product
+			result.Products = append(result.Products, &copied)
+		}
+	}
+	return result, nil
+}
+
+// matchCommon 分类、营业中筛选
+func matchCommon(query Query, categories []string, isOpen bool, hours utils.OpenHours) bool {
+	if query.Category != "" && !utils.ContainsString(categories, query.Category) {
+		return false
+	}
+	if query.OpenNow && (!isOpen || !hours.Contains(query.NowMinute)) {
+		return false
+	}
+	return true
+}
+
+// matchLocation 计算距离并按最大距离筛选
+func matchLocation(query Query, lng, lat float64, distance *float64) bool {
+	if !query.HasLocation() {
+		return true
+	}
+	*distance = utils.Distance(query.Longitude, query.Latitude, lng, lat)
+	return query.MaxDistance <= 0 || *distance <= query.MaxDistance
+}
+
+// sortHits 按排序方式排序（相同时按ID升序，保证分页稳定）
+func sortHits(hits []memoryHit, sortBy string) {
+	sort.Slice(hits, func(i, j int) bool {
+		a, b := hits[i], hits[j]
+		switch sortBy {
+		case SortDistance:
+			if a.distance != b.distance {
+				return a.distance < b.distance
+			}
+		case SortPriceAsc:
+			if a.price != b.price {
+				return a.price < b.price
+			}
+		case SortPriceDesc:
+			if a.price != b.price {
+				return a.price > b.price
+			}
+		case SortScore:
+			if a.score != b.score {
+				return a.score > b.score
+			}
+		default:
+			if a.relevance != b.relevance {
+				return a.relevance > b.relevance
+			}
+			if a.score != b.score {
+				return a.score > b.score
+			}
+		}
+		return a.id < b.id
+	})
+}
+
+// weightedField 参与相关度计算的字段及权重
+type weightedField struct {
+	text   string
+	weight float64
+}
+
+// relevance 关键词词元命中的字段权重之和（存在未命中的词元时返回0）
+func relevance(terms []string, fields []weightedField) float64 {
+	var total float64
+	matched := make(map[string]bool, len(terms))
+	for _, f := range fields {
+		fieldTerms := make(map[string]bool)
+		for _, t := range tokenize(f.text, true) {
+			fieldTerms[t] = true
+		}
+		for _, t := range terms {
+			if fieldTerms[t] {
+				total += f.weight
+				matched[t] = true
+			}
+		}
+	}
+	if len(matched) < len(termSet(terms)) {
+		return 0
+	}
+	return total
+}
+
+// termSet 词元去重
+func termSet(terms []string) map[string]bool {
+	set := make(map[string]bool, len(terms))
+	for _, t := range terms {
+		set[t] = true
+	}
+	return set
+}
+
+// tokenize 分词：连续汉字切分为二元组（建索引时额外保留单字，检索时仅单个汉字保留单字），其余按字母数字切分为小写单词
+func tokenize(text string, forIndex bool) []string {
+	var tokens []string
+	var han []rune
+	var word []rune
+	flushHan := func() {
+		if len(han) == 1 || forIndex {
+			for _, r := range han {
+				tokens = append(tokens, string(r))
+			}
+		}
+		for i := 0; i+1 < len(han); i++ {
+			tokens = append(tokens, string(han[i:i+2]))
+		}
+		han = han[:0]
+	}
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flushWord()
+			han = append(han, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushHan()
+			word = append(word, r)
+		default:
+			flushHan()
+			flushWord()
+		}
+	}
+	flushHan()
+	flushWord()
+	return tokens
+}
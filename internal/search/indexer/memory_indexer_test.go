@@ -0,0 +1,141 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		forIndex bool
+		want     []string
+	}{
+		{"检索中文切分为二元组", "黄焖鸡米饭", false, []string{"黄焖", "焖鸡", "鸡米", "米饭"}},
+		{"建索引中文保留单字", "鸡米饭", true, []string{"鸡", "米", "饭", "鸡米", "米饭"}},
+		{"检索单个汉字保留单字", "鸡", false, []string{"鸡"}},
+		{"英文数字转小写单词", "Coca-Cola 500ml", false, []string{"coca", "cola", "500ml"}},
+		{"中英文混排", "可乐Cola大杯", false, []string{"可乐", "cola", "大杯"}},
+		{"标点及空白", " ，、 ", false, nil},
+		{"空字符串", "", true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tokenize(tt.text, tt.forIndex))
+		})
+	}
+}
+
+// newTestIndexer 两个商家各两件商品：商家1（黄焖鸡）、商家2（麻辣烫）
+func newTestIndexer(t *testing.T) *MemoryIndexer {
+	ctx := context.Background()
+	idx := NewMemoryIndexer()
+	require.NoError(t, idx.UpsertMerchant(ctx, &MerchantDoc{MerchantID: 1, Name: "杨铭宇黄焖鸡米饭", Categories: []string{"招牌", "饮品"}, IsOpen: true, Score: 4.5}))
+	require.NoError(t, idx.UpsertMerchant(ctx, &MerchantDoc{MerchantID: 2, Name: "张亮麻辣烫", Categories: []string{"招牌"}, IsOpen: true, Score: 4.8}))
+	require.NoError(t, idx.SyncMerchantProducts(ctx, 1, []*ProductDoc{
+		{ProductID: 11, MerchantID: 1, MerchantName: "杨铭宇黄焖鸡米饭", Name: "黄焖鸡米饭", Categories: []string{"招牌"}, Price: 22, IsOpen: true},
+		{ProductID: 12, MerchantID: 1, MerchantName: "杨铭宇黄焖鸡米饭", Name: "可口可乐", Categories: []string{"饮品"}, Price: 3, IsOpen: true},
+	}))
+	require.NoError(t, idx.SyncMerchantProducts(ctx, 2, []*ProductDoc{
+		{ProductID: 21, MerchantID: 2, MerchantName: "张亮麻辣烫", Name: "麻辣烫套餐", Categories: []string{"招牌"}, Price: 25, IsOpen: true},
+		{ProductID: 22, MerchantID: 2, MerchantName: "张亮麻辣烫", Name: "鸡肉丸", Categories: []string{"招牌"}, Price: 6, IsOpen: true},
+	}))
+	return idx
+}
+
+// productIDs 结果中的商品ID（按返回顺序）
+func productIDs(result Result) []int64 {
+	var ids []int64
+	for _, p := range result.Products {
+		ids = append(ids, p.ProductID)
+	}
+	return ids
+}
+
+func TestMemoryIndexerSearchFilter(t *testing.T) {
+	tests := []struct {
+		name  string
+		query Query
+		want  []int64
+	}{
+		{"商品名命中权重高于商家名", Query{Type: TypeProduct, Keyword: "黄焖鸡"}, []int64{11, 12}},
+		{"单字关键词命中商品及商家名", Query{Type: TypeProduct, Keyword: "鸡", SortBy: SortPriceAsc}, []int64{12, 22, 11}},
+		{"按商家名检索商品", Query{Type: TypeProduct, Keyword: "麻辣烫", SortBy: SortPriceAsc}, []int64{22, 21}},
+		{"分类精确匹配", Query{Type: TypeProduct, Category: "饮品"}, []int64{12}},
+		{"价格区间", Query{Type: TypeProduct, MinPrice: 5, MaxPrice: 23, SortBy: SortPriceDesc}, []int64{11, 22}},
+		{"关键词须全部命中", Query{Type: TypeProduct, Keyword: "鸡肉 可乐"}, nil},
+		{"无命中", Query{Type: TypeProduct, Keyword: "披萨"}, nil},
+	}
+	idx := newTestIndexer(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := idx.Search(context.Background(), tt.query)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, productIDs(result))
+			assert.Equal(t, int64(len(tt.want)), result.Total)
+		})
+	}
+}
+
+func TestMemoryIndexerMerchantScope(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name   string
+		change func(idx *MemoryIndexer) error
+		want   []int64
+	}{
+		{"覆盖商家商品不影响其他商家", func(idx *MemoryIndexer) error {
+			return idx.SyncMerchantProducts(ctx, 1, []*ProductDoc{{ProductID: 13, MerchantID: 1, Name: "卤蛋", Price: 2}})
+		}, []int64{13, 21, 22}},
+		{"删除商家同时删除其商品", func(idx *MemoryIndexer) error {
+			return idx.DeleteMerchant(ctx, 2)
+		}, []int64{11, 12}},
+		{"删除单个商品", func(idx *MemoryIndexer) error {
+			return idx.DeleteProduct(ctx, 12)
+		}, []int64{11, 21, 22}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idx := newTestIndexer(t)
+			require.NoError(t, tt.change(idx))
+			result, err := idx.Search(ctx, Query{Type: TypeProduct})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, productIDs(result))
+		})
+	}
+
+	idx := newTestIndexer(t)
+	require.NoError(t, idx.DeleteMerchant(ctx, 2))
+	result, err := idx.Search(ctx, Query{Type: TypeMerchant, Category: "招牌"})
+	require.NoError(t, err)
+	require.Len(t, result.Merchants, 1)
+	assert.Equal(t, int64(1), result.Merchants[0].MerchantID)
+}
+
+func TestMemoryIndexerPagination(t *testing.T) {
+	tests := []struct {
+		name   string
+		offset int
+		limit  int
+		want   []int64
+	}{
+		{"第一页", 0, 3, []int64{11, 12, 21}},
+		{"第二页不足一页", 3, 3, []int64{22}},
+		{"超出结果数", 4, 3, nil},
+		{"不限条数", 1, 0, []int64{12, 21, 22}},
+	}
+	idx := newTestIndexer(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 无关键词且评分相同时按ID升序，保证翻页不重不漏
+			result, err := idx.Search(context.Background(), Query{Type: TypeProduct, Offset: tt.offset, Limit: tt.limit})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, productIDs(result))
+			assert.Equal(t, int64(4), result.Total)
+		})
+	}
+}
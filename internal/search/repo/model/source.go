@@ -0,0 +1,50 @@
+package model
+
+import "gorm.io/gorm"
+
+// 搜索服务只读商家、商品服务的源表用于构建索引（不做迁移，字段与源表保持一致）
+
+// Merchant 商家表（t_merchant）
+type Merchant struct {
+	MerchantID     int64          `gorm:"column:merchant_id;primaryKey"`
+	Name           string         `gorm:"column:name"`
+	Address        string         `gorm:"column:address"`
+	Logo           string         `gorm:"column:logo"`
+	BusinessHours  string         `gorm:"column:business_hours"`
+	Longitude      float64        `gorm:"column:longitude"`
+	Latitude       float64        `gorm:"column:latitude"`
+	MinOrderAmount float64        `gorm:"column:min_order_amount"`
+	Score          float64        `gorm:"column:score"`
+	OrderCount     int32          `gorm:"column:order_count"`
+	IsOpen         bool           `gorm:"column:is_open"`
+	DeletedAt      gorm.DeletedAt `gorm:"column:deleted_at"`
+}
+
+// TableName 表名
+func (m *Merchant) TableName() string {
+	return "t_merchant"
+}
+
+// Product 商品表（t_product）
+type Product struct {
+	ProductID   int64          `gorm:"column:product_id;primaryKey"`
+	MerchantID  int64          `gorm:"column:merchant_id"`
+	Name        string         `gorm:"column:name"`
+	Description string         `gorm:"column:description"`
+	Price       float64        `gorm:"column:price"`
+	ImageURL    string         `gorm:"column:image_url"`
+	IsSoldOut   bool           `gorm:"column:is_sold_out"`
+	Score       float64        `gorm:"column:score"`
+	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at"`
+}
+
+// TableName 表名
+func (p *Product) TableName() string {
+	return "t_product"
+}
+
+// ProductCategoryName 商品所属菜单分类名称（t_product_category关联t_category）
+type ProductCategoryName struct {
+	ProductID int64  `gorm:"column:product_id"`
+	Name      string `gorm:"column:name"`
+}
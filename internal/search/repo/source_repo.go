@@ -0,0 +1,98 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/search/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/db"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SourceRepo 索引数据源（只读商家、商品源表）
+type SourceRepo interface {
+	GetMerchant(ctx context.Context, merchantID int64) (*model.Merchant, error)             // 不存在或已删除返回nil
+	ListMerchants(ctx context.Context, afterID int64, limit int) ([]*model.Merchant, error) // 按ID升序分批读取（全量重建用）
+	GetProduct(ctx context.Context, productID int64) (*model.Product, error)                // 不存在或已删除返回nil
+	ListMerchantProducts(ctx context.Context, merchantID int64) ([]*model.Product, error)
+	ListCategoryNames(ctx context.Context, productIDs []int64) (map[int64][]string, error) // 商品ID → 所属分类名称
+}
+
+// sourceRepo 实现
+type sourceRepo struct{}
+
+// NewSourceRepo 创建实例
+func NewSourceRepo() SourceRepo {
+	return &sourceRepo{}
+}
+
+func (r *sourceRepo) GetMerchant(ctx context.Context, merchantID int64) (*model.Merchant, error) {
+	var merchant model.Merchant
+	err := db.Mysql.WithContext(ctx).Where("merchant_id = ?", merchantID).First(&merchant).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		zap.L().Error("查询商家源数据失败", zap.Int64("merchant_id", merchantID), zap.Error(err))
+		return nil, utils.NewDBError("查询商家失败：" + err.Error())
+	}
+	return &merchant, nil
+}
+
+func (r *sourceRepo) ListMerchants(ctx context.Context, afterID int64, limit int) ([]*model.Merchant, error) {
+	var merchants []*model.Merchant
+	err := db.Mysql.WithContext(ctx).Where("merchant_id > ?", afterID).
+		Order("merchant_id").Limit(limit).Find(&merchants).Error
+	if err != nil {
+		zap.L().Error("分批查询商家源数据失败", zap.Int64("after_id", afterID), zap.Error(err))
+		return nil, utils.NewDBError("查询商家失败：" + err.Error())
+	}
+	return merchants, nil
+}
+
+func (r *sourceRepo) GetProduct(ctx context.Context, productID int64) (*model.Product, error) {
+	var product model.Product
+	err := db.Mysql.WithContext(ctx).Where("product_id = ?", productID).First(&product).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		zap.L().Error("查询商品源数据失败", zap.Int64("product_id", productID), zap.Error(err))
+		return nil, utils.NewDBError("查询商品失败：" + err.Error())
+	}
+	return &product, nil
+}
+
+func (r *sourceRepo) ListMerchantProducts(ctx context.Context, merchantID int64) ([]*model.Product, error) {
+	var products []*model.Product
+	err := db.Mysql.WithContext(ctx).Where("merchant_id = ?", merchantID).Order("product_id").Find(&products).Error
+	if err != nil {
+		zap.L().Error("查询商家商品源数据失败", zap.Int64("merchant_id", merchantID), zap.Error(err))
+		return nil, utils.NewDBError("查询商品失败：" + err.Error())
+	}
+	return products, nil
+}
+
+// ListCategoryNames 查询商品所属分类名称（忽略已删除分类）
+func (r *sourceRepo) ListCategoryNames(ctx context.Context, productIDs []int64) (map[int64][]string, error) {
+	names := make(map[int64][]string)
+	if len(productIDs) == 0 {
+		return names, nil
+	}
+	var rows []*model.ProductCategoryName
+	err := db.Mysql.WithContext(ctx).Table("t_product_category AS pc").
+		Select("pc.product_id, c.name").
+		Joins("JOIN t_category AS c ON c.category_id = pc.category_id").
+		Where("pc.product_id IN ? AND c.deleted_at IS NULL", productIDs).
+		Order("c.sort_order, c.category_id").Scan(&rows).Error
+	if err != nil {
+		zap.L().Error("查询商品分类源数据失败", zap.Int("products", len(productIDs)), zap.Error(err))
+		return nil, utils.NewDBError("查询商品分类失败：" + err.Error())
+	}
+	for _, row := range rows {
+		names[row.ProductID] = append(names[row.ProductID], row.Name)
+	}
+	return names, nil
+}
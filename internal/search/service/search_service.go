@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/search/indexer"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/search/repo"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/search/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+const (
+	maxSearchWindow  = 1000 // 最多可翻到的结果数（避免深分页）
+	reindexBatchSize = 100  // 全量重建时每批读取的商家数
+)
+
+// 入参结构体
+type SearchParam struct {
+	Type        string  `validate:"required,oneof=merchant product"`
+	Keyword     string  `validate:"omitempty,max=64"`
+	Category    string  `validate:"omitempty,max=32"`
+	MinPrice    float64 `validate:"gte=0"`
+	MaxPrice    float64 `validate:"omitempty,gtefield=MinPrice"`
+	OpenNow     bool
+	Longitude   float64 `validate:"gte=-180,lte=180"`
+	Latitude    float64 `validate:"gte=-90,lte=90"`
+	MaxDistance int32   `validate:"gte=0,lte=50000"` // 米
+	SortBy      string  `validate:"omitempty,oneof=relevance distance score price_asc price_desc"`
+	Page        int32   `validate:"required,gte=1"`
+	PageSize    int32   `validate:"required,gte=1,lte=50"`
+}
+
+// 响应结构体
+type SearchResult struct {
+	Total     int64               `json:"total"`
+	Merchants []MerchantHitResult `json:"merchants"`
+	Products  []ProductHitResult  `json:"products"`
+}
+
+type MerchantHitResult struct {
+	MerchantID     int64   `json:"merchant_id"`
+	Name           string  `json:"name"`
+	Address        string  `json:"address"`
+	Logo           string  `json:"logo"`
+	Score          float64 `json:"score"`
+	OrderCount     int32   `json:"order_count"`
+	IsOpen         bool    `json:"is_open"`
+	MinOrderAmount float64 `json:"min_order_amount"`
+	Distance       int32   `json:"distance"`
+}
+
+type ProductHitResult struct {
+	ProductID    int64   `json:"product_id"`
+	MerchantID   int64   `json:"merchant_id"`
+	MerchantName string  `json:"merchant_name"`
+	Name         string  `json:"name"`
+	Description  string  `json:"description"`
+	ImageURL     string  `json:"image_url"`
+	Price        float64 `json:"price"`
+	IsSoldOut    bool    `json:"is_sold_out"`
+	Score        float64 `json:"score"`
+	IsOpen       bool    `json:"is_open"`
+	Distance     int32   `json:"distance"`
+}
+
+// ReindexResult 全量重建结果
+type ReindexResult struct {
+	Merchants int `json:"merchants"`
+	Products  int `json:"products"`
+}
+
+// SearchService 搜索业务逻辑接口
+type SearchService interface {
+	Search(ctx context.Context, param SearchParam) (SearchResult, error)
+	Reindex(ctx context.Context) (ReindexResult, error)                      // 删除并全量重建索引
+	HandleSearchSync(ctx context.Context, msg *sarama.ConsumerMessage) error // 消费索引同步消息，回源更新索引
+}
+
+// searchService 实现
+type searchService struct {
+	sourceRepo repo.SourceRepo
+	indexer    indexer.Indexer
+	validate   *validator.Validate
+}
+
+// NewSearchService 创建实例
+func NewSearchService(sourceRepo repo.SourceRepo, idx indexer.Indexer) SearchService {
+	return &searchService{
+		sourceRepo: sourceRepo,
+		indexer:    idx,
+		validate:   validator.New(),
+	}
+}
+
+// Search 搜索商家/商品
+func (s *searchService) Search(ctx context.Context, param SearchParam) (SearchResult, error) {
+	// 1. 参数校验
+	if err := s.validate.Struct(param); err != nil {
+		zap.L().Warn("搜索参数校验失败", zap.Any("param", param), zap.Error(err))
+		return SearchResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+	query := indexer.Query{
+		Type:        param.Type,
+		Keyword:     param.Keyword,
+		Category:    param.Category,
+		MinPrice:    param.MinPrice,
+		MaxPrice:    param.MaxPrice,
+		OpenNow:     param.OpenNow,
+		NowMinute:   utils.MinuteOfDay(time.Now()),
+		Longitude:   param.Longitude,
+		Latitude:    param.Latitude,
+		MaxDistance: float64(param.MaxDistance),
+		SortBy:      param.SortBy,
+		Offset:      int((param.Page - 1) * param.PageSize),
+		Limit:       int(param.PageSize),
+	}
+	if param.Type == indexer.TypeMerchant && (param.MinPrice > 0 || param.MaxPrice > 0 ||
+		param.SortBy == indexer.SortPriceAsc || param.SortBy == indexer.SortPriceDesc) {
+		return SearchResult{}, utils.NewParamError("价格筛选及排序仅支持搜索商品")
+	}
+	if !query.HasLocation() && (param.SortBy == indexer.SortDistance || param.MaxDistance > 0) {
+		return SearchResult{}, utils.NewParamError("按距离排序或筛选需传入用户坐标")
+	}
+	if query.Offset+query.Limit > maxSearchWindow {
+		return SearchResult{}, utils.NewParamError("最多查看前1000条结果，请细化搜索条件")
+	}
+
+	// 2. 查询索引
+	hits, err := s.indexer.Search(ctx, query)
+	if err != nil {
+		zap.L().Error("搜索失败", zap.Any("param", param), zap.Error(err))
+		return SearchResult{}, utils.NewSystemError("搜索服务异常")
+	}
+
+	// 3. 组装结果（距离、当前营业状态按请求时刻计算）
+	result := SearchResult{Total: hits.Total}
+	for _, doc := range hits.Merchants {
+		result.Merchants = append(result.Merchants, MerchantHitResult{
+			MerchantID:     doc.MerchantID,
+			Name:           doc.Name,
+			Address:        doc.Address,
+			Logo:           doc.Logo,
+			Score:          doc.Score,
+			OrderCount:     doc.OrderCount,
+			IsOpen:         doc.IsOpen && doc.Contains(query.NowMinute),
+			MinOrderAmount: doc.MinOrderAmount,
+			Distance:       distanceTo(query, doc.Longitude, doc.Latitude),
+		})
+	}
+	for _, doc := range hits.Products {
+		result.Products = append(result.Products, ProductHitResult{
+			ProductID:    doc.ProductID,
+			MerchantID:   doc.MerchantID,
+			MerchantName: doc.MerchantName,
+			Name:         doc.Name,
+			Description:  doc.Description,
+			ImageURL:     doc.ImageURL,
+			Price:        doc.Price,
+			IsSoldOut:    doc.IsSoldOut,
+			Score:        doc.Score,
+			IsOpen:       doc.IsOpen && doc.Contains(query.NowMinute),
+			Distance:     distanceTo(query, doc.Longitude, doc.Latitude),
+		})
+	}
+	return result, nil
+}
+
+// Reindex 删除并全量重建索引（按商家分批回源，重建期间搜索结果不完整）
+func (s *searchService) Reindex(ctx context.Context) (ReindexResult, error) {
+	var result ReindexResult
+	if err := s.indexer.ResetIndices(ctx); err != nil {
+		zap.L().Error("重建搜索索引失败", zap.Error(err))
+		return result, utils.NewSystemError("重建索引失败：" + err.Error())
+	}
+	var afterID int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		merchants, err := s.sourceRepo.ListMerchants(ctx, afterID, reindexBatchSize)
+		if err != nil {
+			return result, err
+		}
+		for _, merchant := range merchants {
+			count, err := s.indexMerchant(ctx, merchant)
+			if err != nil {
+				return result, err
+			}
+			result.Merchants++
+			result.Products += count
+		}
+		if len(merchants) < reindexBatchSize {
+			break
+		}
+		afterID = merchants[len(merchants)-1].MerchantID
+	}
+	zap.L().Info("搜索索引重建完成", zap.Int("merchants", result.Merchants), zap.Int("products", result.Products))
+	return result, nil
+}
+
+// HandleSearchSync 消费索引同步消息：回源读取最新数据后覆盖索引（源数据不存在则删除）
+func (s *searchService) HandleSearchSync(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var event kafka.SearchSyncEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil || event.TargetID == 0 {
+		zap.L().Error("索引同步消息格式错误", zap.ByteString("value", msg.Value), zap.Error(err))
+		return nil // 格式错误无法重试，直接跳过
+	}
+
+	var err error
+	switch event.TargetType {
+	case kafka.SearchTargetMerchant:
+		err = s.syncMerchant(ctx, event.TargetID)
+	case kafka.SearchTargetProduct:
+		err = s.syncProduct(ctx, event.TargetID)
+	default:
+		zap.L().Warn("未知的索引同步对象类型", zap.Any("event", event))
+		return nil
+	}
+	if err != nil {
+		zap.L().Error("同步搜索索引失败", zap.Any("event", event), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// syncMerchant 同步商家及其全部商品（商家信息冗余在商品文档中）
+func (s *searchService) syncMerchant(ctx context.Context, merchantID int64) error {
+	merchant, err := s.sourceRepo.GetMerchant(ctx, merchantID)
+	if err != nil {
+		return err
+	}
+	if merchant == nil {
+		return s.indexer.DeleteMerchant(ctx, merchantID)
+	}
+	_, err = s.indexMerchant(ctx, merchant)
+	return err
+}
+
+// syncProduct 同步单个商品（商品或所属商家已删除时从索引删除）
+func (s *searchService) syncProduct(ctx context.Context, productID int64) error {
+	product, err := s.sourceRepo.GetProduct(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if product == nil {
+		return s.indexer.DeleteProduct(ctx, productID)
+	}
+	merchant, err := s.sourceRepo.GetMerchant(ctx, product.MerchantID)
+	if err != nil {
+		return err
+	}
+	if merchant == nil {
+		return s.indexer.DeleteProduct(ctx, productID)
+	}
+	categories, err := s.sourceRepo.ListCategoryNames(ctx, []int64{productID})
+	if err != nil {
+		return err
+	}
+	return s.indexer.UpsertProduct(ctx, toProductDoc(product, merchant, categories[productID]))
+}
+
+// indexMerchant 写入商家文档并覆盖其全部商品文档，返回商品数
+func (s *searchService) indexMerchant(ctx context.Context, merchant *model.Merchant) (int, error) {
+	products, err := s.sourceRepo.ListMerchantProducts(ctx, merchant.MerchantID)
+	if err != nil {
+		return 0, err
+	}
+	productIDs := make([]int64, 0, len(products))
+	for _, p := range products {
+		productIDs = append(productIDs, p.ProductID)
+	}
+	categories, err := s.sourceRepo.ListCategoryNames(ctx, productIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	// 商家分类为其商品所属分类的并集（保持首次出现顺序）
+	docs := make([]*indexer.ProductDoc, 0, len(products))
+	var merchantCategories []string
+	for _, p := range products {
+		for _, name := range categories[p.ProductID] {
+			if !utils.ContainsString(merchantCategories, name) {
+				merchantCategories = append(merchantCategories, name)
+			}
+		}
+		docs = append(docs, toProductDoc(p, merchant, categories[p.ProductID]))
+	}
+
+	if err = s.indexer.UpsertMerchant(ctx, &indexer.MerchantDoc{
+		MerchantID:     merchant.MerchantID,
+		Name:           merchant.Name,
+		Address:        merchant.Address,
+		Logo:           merchant.Logo,
+		Categories:     merchantCategories,
+		Longitude:      merchant.Longitude,
+		Latitude:       merchant.Latitude,
+		IsOpen:         merchant.IsOpen,
+		MinOrderAmount: merchant.MinOrderAmount,
+		Score:          merchant.Score,
+		OrderCount:     merchant.OrderCount,
+		OpenHours:      utils.ParseOpenHours(merchant.BusinessHours),
+	}); err != nil {
+		return 0, err
+	}
+	if err = s.indexer.SyncMerchantProducts(ctx, merchant.MerchantID, docs); err != nil {
+		return 0, err
+	}
+	return len(docs), nil
+}
+
+// toProductDoc 商品源数据转换为索引文档
+func toProductDoc(p *model.Product, merchant *model.Merchant, categories []string) *indexer.ProductDoc {
+	return &indexer.ProductDoc{
+		ProductID:    p.ProductID,
+		MerchantID:   p.MerchantID,
+		MerchantName: merchant.Name,
+		Name:         p.Name,
+		Description:  p.Description,
+		ImageURL:     p.ImageURL,
+		Categories:   categories,
+		Price:        p.Price,
+		IsSoldOut:    p.IsSoldOut,
+		Score:        p.Score,
+		Longitude:    merchant.Longitude,
+		Latitude:     merchant.Latitude,
+		IsOpen:       merchant.IsOpen,
+		OpenHours:    utils.ParseOpenHours(merchant.BusinessHours),
+	}
+}
+
+// distanceTo 用户到商家的距离（米，未传用户坐标时为0）
+func distanceTo(query indexer.Query, lng, lat float64) int32 {
+	if !query.HasLocation() {
+		return 0
+	}
+	return int32(utils.Distance(query.Longitude, query.Latitude, lng, lat))
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/search/indexer"
+	"github.com/JokerYuan-lang/go-meituan-microservice/internal/search/repo/model"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSourceRepo 内存数据源
+type fakeSourceRepo struct {
+	merchants  map[int64]*model.Merchant
+	products   map[int64]*model.Product
+	categories map[int64][]string
+}
+
+func (f *fakeSourceRepo) GetMerchant(ctx context.Context, merchantID int64) (*model.Merchant, error) {
+	return f.merchants[merchantID], nil
+}
+
+func (f *fakeSourceRepo) ListMerchants(ctx context.Context, afterID int64, limit int) ([]*model.Merchant, error) {
+	var merchants []*model.Merchant
+	for id, m := range f.merchants {
+		if id > afterID {
+			merchants = append(merchants, m)
+		}
+	}
+	sort.Slice(merchants, func(i, j int) bool { return merchants[i].MerchantID < merchants[j].MerchantID })
+	if len(merchants) > limit {
+		merchants = merchants[:limit]
+	}
+	return merchants, nil
+}
+
+func (f *fakeSourceRepo) GetProduct(ctx context.Context, productID int64) (*model.Product, error) {
+	return f.products[productID], nil
+}
+
+func (f *fakeSourceRepo) ListMerchantProducts(ctx context.Context, merchantID int64) ([]*model.Product, error) {
+	var products []*model.Product
+	for _, p := range f.products {
+		if p.MerchantID == merchantID {
+			products = append(products, p)
+		}
+	}
+	sort.Slice(products, func(i, j int) bool { return products[i].ProductID < products[j].ProductID })
+	return products, nil
+}
+
+func (f *fakeSourceRepo) ListCategoryNames(ctx context.Context, productIDs []int64) (map[int64][]string, error) {
+	names := make(map[int64][]string)
+	for _, id := range productIDs {
+		if c, ok := f.categories[id]; ok {
+			names[id] = c
+		}
+	}
+	return names, nil
+}
+
+func newFakeSourceRepo() *fakeSourceRepo {
+	return &fakeSourceRepo{
+		merchants: map[int64]*model.Merchant{
+			1: {MerchantID: 1, Name: "杨铭宇黄焖鸡米饭", IsOpen: true},
+			2: {MerchantID: 2, Name: "张亮麻辣烫", IsOpen: false},
+		},
+		products: map[int64]*model.Product{
+			11: {ProductID: 11, MerchantID: 1, Name: "黄焖鸡米饭", Price: 22},
+			12: {ProductID: 12, MerchantID: 1, Name: "可口可乐", Price: 3},
+			21: {ProductID: 21, MerchantID: 2, Name: "麻辣烫套餐", Price: 25},
+		},
+		categories: map[int64][]string{11: {"招牌"}, 12: {"饮品"}, 21: {"招牌"}},
+	}
+}
+
+// syncMessage 构造索引同步消息
+func syncMessage(t *testing.T, event kafka.SearchSyncEvent) *sarama.ConsumerMessage {
+	value, err := json.Marshal(event)
+	require.NoError(t, err)
+	return &sarama.ConsumerMessage{Value: value}
+}
+
+// searchProductIDs 按商品搜索并返回命中的商品ID
+func searchProductIDs(t *testing.T, svc SearchService, param SearchParam) []int64 {
+	param.Type = indexer.TypeProduct
+	param.Page, param.PageSize = 1, 50
+	result, err := svc.Search(context.Background(), param)
+	require.NoError(t, err)
+	var ids []int64
+	for _, p := range result.Products {
+		ids = append(ids, p.ProductID)
+	}
+	return ids
+}
+
+func TestSearchServiceProductSync(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name   string
+		change func(source *fakeSourceRepo)
+		msg    kafka.SearchSyncEvent
+		param  SearchParam
+		want   []int64
+	}{
+		{"新增商品按商品同步", func(source *fakeSourceRepo) {
+			source.products[13] = &model.Product{ProductID: 13, MerchantID: 1, Name: "鸡腿饭", Price: 18}
+			source.categories[13] = []string{"招牌"}
+		}, kafka.SearchSyncEvent{TargetType: kafka.SearchTargetProduct, TargetID: 13}, SearchParam{Category: "招牌"}, []int64{11, 13, 21}},
+		{"商品改名后旧关键词不再命中", func(source *fakeSourceRepo) {
+			source.products[12].Name = "雪碧"
+		}, kafka.SearchSyncEvent{TargetType: kafka.SearchTargetProduct, TargetID: 12}, SearchParam{Keyword: "可乐"}, nil},
+		{"商品删除后从索引移除", func(source *fakeSourceRepo) {
+			delete(source.products, 11)
+		}, kafka.SearchSyncEvent{TargetType: kafka.SearchTargetProduct, TargetID: 11}, SearchParam{}, []int64{12, 21}},
+		{"商家删除后移除其全部商品", func(source *fakeSourceRepo) {
+			delete(source.merchants, 1)
+		}, kafka.SearchSyncEvent{TargetType: kafka.SearchTargetMerchant, TargetID: 1}, SearchParam{}, []int64{21}},
+		{"商家开店后商品冗余的营业状态随之更新", func(source *fakeSourceRepo) {
+			source.merchants[2].IsOpen = true
+		}, kafka.SearchSyncEvent{TargetType: kafka.SearchTargetMerchant, TargetID: 2}, SearchParam{OpenNow: true}, []int64{11, 12, 21}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := newFakeSourceRepo()
+			svc := NewSearchService(source, indexer.NewMemoryIndexer())
+			_, err := svc.Reindex(ctx)
+			require.NoError(t, err)
+
+			tt.change(source)
+			require.NoError(t, svc.HandleSearchSync(ctx, syncMessage(t, tt.msg)))
+			assert.Equal(t, tt.want, searchProductIDs(t, svc, tt.param))
+		})
+	}
+}
+
+func TestSearchServiceReindex(t *testing.T) {
+	ctx := context.Background()
+	svc := NewSearchService(newFakeSourceRepo(), indexer.NewMemoryIndexer())
+	result, err := svc.Reindex(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, ReindexResult{Merchants: 2, Products: 3}, result)
+
+	// 商家分类为其商品分类的并集；商品文档冗余商家名称，可按商家名检索
+	merchants, err := svc.Search(ctx, SearchParam{Type: indexer.TypeMerchant, Category: "饮品", Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, merchants.Merchants, 1)
+	assert.Equal(t, int64(1), merchants.Merchants[0].MerchantID)
+	assert.Equal(t, []int64{21}, searchProductIDs(t, svc, SearchParam{Keyword: "麻辣烫"}))
+	assert.Equal(t, []int64{11}, searchProductIDs(t, svc, SearchParam{OpenNow: true, MinPrice: 10}))
+}
@@ -154,6 +154,8 @@ func (h *UserHandler) AddAddress(ctx context.Context, req *userProto.AddAddressR
 		District:  req.District,
 		Detail:    req.Detail,
 		IsDefault: req.IsDefault,
+		Longitude: req.Longitude,
+		Latitude:  req.Latitude,
 	}
 
 	// 2. 调用service层方法
@@ -212,19 +214,7 @@ func (h *UserHandler) ListAddresses(ctx context.Context, req *userProto.ListAddr
 	// 4. service返回结果 → proto响应转换
 	var protoAddrs []*userProto.Address
 	for _, result := range results {
-		protoAddrs = append(protoAddrs, &userProto.Address{
-			AddressId: result.AddressID,
-			UserId:    result.UserID,
-			Receiver:  result.Receiver,
-			Phone:     result.Phone,
-			Province:  result.Province,
-			City:      result.City,
-			District:  result.District,
-			Detail:    result.Detail,
-			IsDefault: result.IsDefault,
-			CreatedAt: result.CreatedAt,
-			UpdatedAt: result.UpdatedAt,
-		})
+		protoAddrs = append(protoAddrs, toProtoAddress(result))
 	}
 
 	return &userProto.ListAddressesResponse{
@@ -283,6 +273,8 @@ func (h *UserHandler) UpdateAddress(ctx context.Context, req *userProto.UpdateAd
 		District:  req.District,
 		Detail:    req.Detail,
 		IsDefault: req.IsDefault,
+		Longitude: req.Longitude,
+		Latitude:  req.Latitude,
 	}
 
 	// 2. 调用service
@@ -381,3 +373,54 @@ func (h *UserHandler) SetDefaultAddress(ctx context.Context, req *userProto.SetD
 		Msg:  "设置成功",
 	}, nil
 }
+
+// GetAddress gRPC查询单个收货地址接口
+func (h *UserHandler) GetAddress(ctx context.Context, req *userProto.GetAddressRequest) (*userProto.GetAddressResponse, error) {
+	// 1. 调用service层方法
+	result, err := h.userService.GetAddress(ctx, service.GetAddressParam{
+		UserID:    req.UserId,
+		AddressID: req.AddressId,
+	})
+
+	// 2. 错误处理
+	if err != nil {
+		var appErr *utils.AppError
+		ok := errors.As(err, &appErr)
+		if !ok {
+			zap.L().Error("查询地址接口未知错误", zap.Error(err), zap.Int64("address_id", req.AddressId))
+			return &userProto.GetAddressResponse{
+				Code: utils.ErrCodeSystem,
+				Msg:  "系统错误",
+			}, nil
+		}
+		return &userProto.GetAddressResponse{
+			Code: int32(appErr.Code),
+			Msg:  appErr.Message,
+		}, nil
+	}
+
+	return &userProto.GetAddressResponse{
+		Code:    utils.ErrCodeSuccess,
+		Msg:     "查询成功",
+		Address: toProtoAddress(result),
+	}, nil
+}
+
+// toProtoAddress 领域层地址 → proto地址
+func toProtoAddress(result service.AddressResult) *userProto.Address {
+	return &userProto.Address{
+		AddressId: result.AddressID,
+		UserId:    result.UserID,
+		Receiver:  result.Receiver,
+		Phone:     result.Phone,
+		Province:  result.Province,
+		City:      result.City,
+		District:  result.District,
+		Detail:    result.Detail,
+		IsDefault: result.IsDefault,
+		CreatedAt: result.CreatedAt,
+		UpdatedAt: result.UpdatedAt,
+		Longitude: result.Longitude,
+		Latitude:  result.Latitude,
+	}
+}
@@ -610,6 +610,8 @@ type Address struct {
 	IsDefault     bool                   `protobuf:"varint,9,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,12,opt,name=longitude,proto3" json:"longitude,omitempty"` // 经度（用于计算配送距离）
+	Latitude      float64                `protobuf:"fixed64,13,opt,name=latitude,proto3" json:"latitude,omitempty"`   // 纬度
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -721,6 +723,20 @@ func (x *Address) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Address) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Address) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
 // 添加地址请求
 type AddAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -732,6 +748,8 @@ type AddAddressRequest struct {
 	District      string                 `protobuf:"bytes,6,opt,name=district,proto3" json:"district,omitempty"`
 	Detail        string                 `protobuf:"bytes,7,opt,name=detail,proto3" json:"detail,omitempty"`
 	IsDefault     bool                   `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,9,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,10,opt,name=latitude,proto3" json:"latitude,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -822,6 +840,20 @@ func (x *AddAddressRequest) GetIsDefault() bool {
 	return false
 }
 
+func (x *AddAddressRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *AddAddressRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
 // 添加地址响应
 type AddAddressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1001,6 +1033,8 @@ type UpdateAddressRequest struct {
 	District      string                 `protobuf:"bytes,7,opt,name=district,proto3" json:"district,omitempty"`
 	Detail        string                 `protobuf:"bytes,8,opt,name=detail,proto3" json:"detail,omitempty"`
 	IsDefault     bool                   `protobuf:"varint,9,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,10,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,11,opt,name=latitude,proto3" json:"latitude,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1098,6 +1132,20 @@ func (x *UpdateAddressRequest) GetIsDefault() bool {
 	return false
 }
 
+func (x *UpdateAddressRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *UpdateAddressRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
 // 更新地址响应
 type UpdateAddressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1363,6 +1411,120 @@ func (x *SetDefaultAddressResponse) GetMsg() string {
 	return ""
 }
 
+// 查询单个地址请求
+type GetAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AddressId     int64                  `protobuf:"varint,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressRequest) Reset() {
+	*x = GetAddressRequest{}
+	mi := &file_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressRequest) ProtoMessage() {}
+
+func (x *GetAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetAddressRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetAddressRequest) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+// 查询单个地址响应
+type GetAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Address       *Address               `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressResponse) Reset() {
+	*x = GetAddressResponse{}
+	mi := &file_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressResponse) ProtoMessage() {}
+
+func (x *GetAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressResponse.ProtoReflect.Descriptor instead.
+func (*GetAddressResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetAddressResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAddressResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetAddressResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
 var File_user_proto protoreflect.FileDescriptor
 
 const file_user_proto_rawDesc = "" +
@@ -1408,7 +1570,7 @@ const file_user_proto_rawDesc = "" +
 	"\x06avatar\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\x06avatar\">\n" +
 	"\x16UpdateUserInfoResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg\"\xb4\x02\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\"\xee\x02\n" +
 	"\aAddress\x12\x1d\n" +
 	"\n" +
 	"address_id\x18\x01 \x01(\x03R\taddressId\x12\x17\n" +
@@ -1425,7 +1587,9 @@ const file_user_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\tR\tupdatedAt\"\xaf\x02\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\x12\x1c\n" +
+	"\tlongitude\x18\f \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\blatitude\x18\r \x01(\x01R\blatitude\"\x9b\x03\n" +
 	"\x11AddAddressRequest\x12 \n" +
 	"\auser_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12%\n" +
 	"\breceiver\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x18 R\breceiver\x12*\n" +
@@ -1435,7 +1599,10 @@ const file_user_proto_rawDesc = "" +
 	"\bdistrict\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\bdistrict\x12\x1f\n" +
 	"\x06detail\x18\a \x01(\tB\a\xfaB\x04r\x02\x10\x05R\x06detail\x12\x1d\n" +
 	"\n" +
-	"is_default\x18\b \x01(\bR\tisDefault\"Y\n" +
+	"is_default\x18\b \x01(\bR\tisDefault\x125\n" +
+	"\tlongitude\x18\t \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\x123\n" +
+	"\blatitude\x18\n" +
+	" \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\"Y\n" +
 	"\x12AddAddressResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x1d\n" +
@@ -1446,7 +1613,7 @@ const file_user_proto_rawDesc = "" +
 	"\x15ListAddressesResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x02 \x01(\tR\x03msg\x12+\n" +
-	"\taddresses\x18\x03 \x03(\v2\r.user.AddressR\taddresses\"\xda\x02\n" +
+	"\taddresses\x18\x03 \x03(\v2\r.user.AddressR\taddresses\"\xc6\x03\n" +
 	"\x14UpdateAddressRequest\x12&\n" +
 	"\n" +
 	"address_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\taddressId\x12 \n" +
@@ -1458,7 +1625,10 @@ const file_user_proto_rawDesc = "" +
 	"\bdistrict\x18\a \x01(\tB\a\xfaB\x04r\x02\x10\x02R\bdistrict\x12\x1f\n" +
 	"\x06detail\x18\b \x01(\tB\a\xfaB\x04r\x02\x10\x05R\x06detail\x12\x1d\n" +
 	"\n" +
-	"is_default\x18\t \x01(\bR\tisDefault\"=\n" +
+	"is_default\x18\t \x01(\bR\tisDefault\x125\n" +
+	"\tlongitude\x18\n" +
+	" \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\x123\n" +
+	"\blatitude\x18\v \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\"=\n" +
 	"\x15UpdateAddressResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x02 \x01(\tR\x03msg\"`\n" +
@@ -1475,7 +1645,15 @@ const file_user_proto_rawDesc = "" +
 	"address_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\taddressId\"A\n" +
 	"\x19SetDefaultAddressResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg2\x80\x05\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\"]\n" +
+	"\x11GetAddressRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12&\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\taddressId\"c\n" +
+	"\x12GetAddressResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12'\n" +
+	"\aaddress\x18\x03 \x01(\v2\r.user.AddressR\aaddress2\xc1\x05\n" +
 	"\vUserService\x129\n" +
 	"\bRegister\x12\x15.user.RegisterRequest\x1a\x16.user.RegisterResponse\x120\n" +
 	"\x05Login\x12\x12.user.LoginRequest\x1a\x13.user.LoginResponse\x12B\n" +
@@ -1486,7 +1664,9 @@ const file_user_proto_rawDesc = "" +
 	"\rListAddresses\x12\x1a.user.ListAddressesRequest\x1a\x1b.user.ListAddressesResponse\x12H\n" +
 	"\rUpdateAddress\x12\x1a.user.UpdateAddressRequest\x1a\x1b.user.UpdateAddressResponse\x12H\n" +
 	"\rDeleteAddress\x12\x1a.user.DeleteAddressRequest\x1a\x1b.user.DeleteAddressResponse\x12T\n" +
-	"\x11SetDefaultAddress\x12\x1e.user.SetDefaultAddressRequest\x1a\x1f.user.SetDefaultAddressResponseB!Z\x1f./internal/user/proto;userProtob\x06proto3"
+	"\x11SetDefaultAddress\x12\x1e.user.SetDefaultAddressRequest\x1a\x1f.user.SetDefaultAddressResponse\x12?\n" +
+	"\n" +
+	"GetAddress\x12\x17.user.GetAddressRequest\x1a\x18.user.GetAddressResponseB!Z\x1f./internal/user/proto;userProtob\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -1500,7 +1680,7 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_user_proto_goTypes = []any{
 	(*RegisterRequest)(nil),           // 0: user.RegisterRequest
 	(*RegisterResponse)(nil),          // 1: user.RegisterResponse
@@ -1522,33 +1702,38 @@ var file_user_proto_goTypes = []any{
 	(*DeleteAddressResponse)(nil),     // 17: user.DeleteAddressResponse
 	(*SetDefaultAddressRequest)(nil),  // 18: user.SetDefaultAddressRequest
 	(*SetDefaultAddressResponse)(nil), // 19: user.SetDefaultAddressResponse
+	(*GetAddressRequest)(nil),         // 20: user.GetAddressRequest
+	(*GetAddressResponse)(nil),        // 21: user.GetAddressResponse
 }
 var file_user_proto_depIdxs = []int32{
 	6,  // 0: user.GetUserInfoResponse.data:type_name -> user.UserInfo
 	9,  // 1: user.ListAddressesResponse.addresses:type_name -> user.Address
-	0,  // 2: user.UserService.Register:input_type -> user.RegisterRequest
-	2,  // 3: user.UserService.Login:input_type -> user.LoginRequest
-	4,  // 4: user.UserService.GetUserInfo:input_type -> user.GetUserInfoRequest
-	7,  // 5: user.UserService.UpdateUserInfo:input_type -> user.UpdateUserInfoRequest
-	10, // 6: user.UserService.AddAddress:input_type -> user.AddAddressRequest
-	12, // 7: user.UserService.ListAddresses:input_type -> user.ListAddressesRequest
-	14, // 8: user.UserService.UpdateAddress:input_type -> user.UpdateAddressRequest
-	16, // 9: user.UserService.DeleteAddress:input_type -> user.DeleteAddressRequest
-	18, // 10: user.UserService.SetDefaultAddress:input_type -> user.SetDefaultAddressRequest
-	1,  // 11: user.UserService.Register:output_type -> user.RegisterResponse
-	3,  // 12: user.UserService.Login:output_type -> user.LoginResponse
-	5,  // 13: user.UserService.GetUserInfo:output_type -> user.GetUserInfoResponse
-	8,  // 14: user.UserService.UpdateUserInfo:output_type -> user.UpdateUserInfoResponse
-	11, // 15: user.UserService.AddAddress:output_type -> user.AddAddressResponse
-	13, // 16: user.UserService.ListAddresses:output_type -> user.ListAddressesResponse
-	15, // 17: user.UserService.UpdateAddress:output_type -> user.UpdateAddressResponse
-	17, // 18: user.UserService.DeleteAddress:output_type -> user.DeleteAddressResponse
-	19, // 19: user.UserService.SetDefaultAddress:output_type -> user.SetDefaultAddressResponse
-	11, // [11:20] is the sub-list for method output_type
-	2,  // [2:11] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	9,  // 2: user.GetAddressResponse.address:type_name -> user.Address
+	0,  // 3: user.UserService.Register:input_type -> user.RegisterRequest
+	2,  // 4: user.UserService.Login:input_type -> user.LoginRequest
+	4,  // 5: user.UserService.GetUserInfo:input_type -> user.GetUserInfoRequest
+	7,  // 6: user.UserService.UpdateUserInfo:input_type -> user.UpdateUserInfoRequest
+	10, // 7: user.UserService.AddAddress:input_type -> user.AddAddressRequest
+	12, // 8: user.UserService.ListAddresses:input_type -> user.ListAddressesRequest
+	14, // 9: user.UserService.UpdateAddress:input_type -> user.UpdateAddressRequest
+	16, // 10: user.UserService.DeleteAddress:input_type -> user.DeleteAddressRequest
+	18, // 11: user.UserService.SetDefaultAddress:input_type -> user.SetDefaultAddressRequest
+	20, // 12: user.UserService.GetAddress:input_type -> user.GetAddressRequest
+	1,  // 13: user.UserService.Register:output_type -> user.RegisterResponse
+	3,  // 14: user.UserService.Login:output_type -> user.LoginResponse
+	5,  // 15: user.UserService.GetUserInfo:output_type -> user.GetUserInfoResponse
+	8,  // 16: user.UserService.UpdateUserInfo:output_type -> user.UpdateUserInfoResponse
+	11, // 17: user.UserService.AddAddress:output_type -> user.AddAddressResponse
+	13, // 18: user.UserService.ListAddresses:output_type -> user.ListAddressesResponse
+	15, // 19: user.UserService.UpdateAddress:output_type -> user.UpdateAddressResponse
+	17, // 20: user.UserService.DeleteAddress:output_type -> user.DeleteAddressResponse
+	19, // 21: user.UserService.SetDefaultAddress:output_type -> user.SetDefaultAddressResponse
+	21, // 22: user.UserService.GetAddress:output_type -> user.GetAddressResponse
+	13, // [13:23] is the sub-list for method output_type
+	3,  // [3:13] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -1562,7 +1747,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,6 +28,7 @@ const (
 	UserService_UpdateAddress_FullMethodName     = "/user.UserService/UpdateAddress"
 	UserService_DeleteAddress_FullMethodName     = "/user.UserService/DeleteAddress"
 	UserService_SetDefaultAddress_FullMethodName = "/user.UserService/SetDefaultAddress"
+	UserService_GetAddress_FullMethodName        = "/user.UserService/GetAddress"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -54,6 +55,8 @@ type UserServiceClient interface {
 	DeleteAddress(ctx context.Context, in *DeleteAddressRequest, opts ...grpc.CallOption) (*DeleteAddressResponse, error)
 	// 设置默认地址（需要鉴权）
 	SetDefaultAddress(ctx context.Context, in *SetDefaultAddressRequest, opts ...grpc.CallOption) (*SetDefaultAddressResponse, error)
+	// 查询单个收货地址（校验归属，下单用）
+	GetAddress(ctx context.Context, in *GetAddressRequest, opts ...grpc.CallOption) (*GetAddressResponse, error)
 }
 
 type userServiceClient struct {
@@ -154,6 +157,16 @@ func (c *userServiceClient) SetDefaultAddress(ctx context.Context, in *SetDefaul
 	return out, nil
 }
 
+func (c *userServiceClient) GetAddress(ctx context.Context, in *GetAddressRequest, opts ...grpc.CallOption) (*GetAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAddressResponse)
+	err := c.cc.Invoke(ctx, UserService_GetAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -178,6 +191,8 @@ type UserServiceServer interface {
 	DeleteAddress(context.Context, *DeleteAddressRequest) (*DeleteAddressResponse, error)
 	// 设置默认地址（需要鉴权）
 	SetDefaultAddress(context.Context, *SetDefaultAddressRequest) (*SetDefaultAddressResponse, error)
+	// 查询单个收货地址（校验归属，下单用）
+	GetAddress(context.Context, *GetAddressRequest) (*GetAddressResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -215,6 +230,9 @@ func (UnimplementedUserServiceServer) DeleteAddress(context.Context, *DeleteAddr
 func (UnimplementedUserServiceServer) SetDefaultAddress(context.Context, *SetDefaultAddressRequest) (*SetDefaultAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultAddress not implemented")
 }
+func (UnimplementedUserServiceServer) GetAddress(context.Context, *GetAddressRequest) (*GetAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddress not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -398,6 +416,24 @@ func _UserService_SetDefaultAddress_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAddress(ctx, req.(*GetAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -441,6 +477,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetDefaultAddress",
 			Handler:    _UserService_SetDefaultAddress_Handler,
 		},
+		{
+			MethodName: "GetAddress",
+			Handler:    _UserService_GetAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
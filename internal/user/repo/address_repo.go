@@ -74,7 +74,7 @@ func (a *addressRepo) UpdateDefaultAddress(ctx context.Context, userID int64, ad
 }
 
 func (a *addressRepo) GetAddressByID(ctx context.Context, addressID int64) (*model.Address, error) {
-	var addr *model.Address
+	addr := &model.Address{}
 	tx := db.Mysql.WithContext(ctx).Where("address_id = ?", addressID).First(addr)
 	if err := tx.Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
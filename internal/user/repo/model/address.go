@@ -17,6 +17,8 @@ type Address struct {
 	District  string         `gorm:"column:district;not null;size:16" json:"district"`
 	Detail    string         `gorm:"column:detail;not null;size:255" json:"detail"`
 	IsDefault bool           `gorm:"column:is_default;not null;default:false" json:"is_default"`
+	Longitude float64        `gorm:"column:longitude;not null;default:0;type:decimal(10,6)" json:"longitude"`
+	Latitude  float64        `gorm:"column:latitude;not null;default:0;type:decimal(10,6)" json:"latitude"`
 	CreatedAt time.Time      `gorm:"column:created_at;autoCreateTime" json:"created_at"`
 	UpdatedAt time.Time      `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index" json:"-"`
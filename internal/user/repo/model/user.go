@@ -14,7 +14,7 @@ type User struct {
 	Password  string         `gorm:"column:password;not null;size:128;comment:'bcrypt加密后的密码'" json:"-"` // 前端不返回密码
 	Phone     string         `gorm:"column:phone;not null;size:16;uniqueIndex;comment:'手机号'" json:"phone"`
 	Avatar    string         `gorm:"column:avatar;size:255;default:'https://picsum.photos/200';comment:'头像'" json:"avatar"`
-	Role      string         `gorm:"column:role;not null;size:16;default:'user';comment:'角色：user/merchant/rider'" json:"role"`
+	Role      string         `gorm:"column:role;not null;size:16;default:'user';comment:'角色：user/admin，admin仅由运营或迁移设置'" json:"role"`
 	CreatedAt time.Time      `gorm:"column:created_at;autoCreateTime;comment:'创建时间'" json:"created_at"`
 	UpdatedAt time.Time      `gorm:"column:updated_at;autoUpdateTime;comment:'更新时间'" json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index;comment:'软删除时间'" json:"-"`
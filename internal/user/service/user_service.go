@@ -33,26 +33,30 @@ type UpdateUserInfoParam struct {
 }
 
 type AddAddressParam struct {
-	UserID    int64  `validate:"required,gt=0"`
-	Receiver  string `validate:"required,min=2,max=32"`
-	Phone     string `validate:"required,regexp=^1[3-9]\\d{9}$"`
-	Province  string `validate:"required,min=2"`
-	City      string `validate:"required,min=2"`
-	District  string `validate:"required,min=2"`
-	Detail    string `validate:"required,min=5"`
-	IsDefault bool   `validate:"required"`
+	UserID    int64   `validate:"required,gt=0"`
+	Receiver  string  `validate:"required,min=2,max=32"`
+	Phone     string  `validate:"required,regexp=^1[3-9]\\d{9}$"`
+	Province  string  `validate:"required,min=2"`
+	City      string  `validate:"required,min=2"`
+	District  string  `validate:"required,min=2"`
+	Detail    string  `validate:"required,min=5"`
+	IsDefault bool    `validate:"required"`
+	Longitude float64 `validate:"gte=-180,lte=180"`
+	Latitude  float64 `validate:"gte=-90,lte=90"`
 }
 
 type UpdateAddressParam struct {
-	AddressID int64  `validate:"required,gt=0"`
-	UserID    int64  `validate:"required,gt=0"`
-	Receiver  string `validate:"required,min=2,max=32"`
-	Phone     string `validate:"required,regexp=^1[3-9]\\d{9}$"`
-	Province  string `validate:"required,min=2"`
-	City      string `validate:"required,min=2"`
-	District  string `validate:"required,min=2"`
-	Detail    string `validate:"required,min=5"`
-	IsDefault bool   `validate:"required"`
+	AddressID int64   `validate:"required,gt=0"`
+	UserID    int64   `validate:"required,gt=0"`
+	Receiver  string  `validate:"required,min=2,max=32"`
+	Phone     string  `validate:"required,regexp=^1[3-9]\\d{9}$"`
+	Province  string  `validate:"required,min=2"`
+	City      string  `validate:"required,min=2"`
+	District  string  `validate:"required,min=2"`
+	Detail    string  `validate:"required,min=5"`
+	IsDefault bool    `validate:"required"`
+	Longitude float64 `validate:"gte=-180,lte=180"`
+	Latitude  float64 `validate:"gte=-90,lte=90"`
 }
 
 type DeleteAddressParam struct {
@@ -60,6 +64,11 @@ type DeleteAddressParam struct {
 	UserID    int64 `validate:"required,gt=0"`
 }
 
+type GetAddressParam struct {
+	UserID    int64 `validate:"required,gt=0"`
+	AddressID int64 `validate:"required,gt=0"`
+}
+
 type SetDefaultAddressParam struct {
 	UserID    int64 `validate:"required,gt=0"`
 	AddressID int64 `validate:"required,gt=0"`
@@ -74,17 +83,19 @@ type LoginResult struct {
 }
 
 type AddressResult struct {
-	AddressID int64  `json:"address_id"`
-	UserID    int64  `json:"user_id"`
-	Receiver  string `json:"receiver"`
-	Phone     string `json:"phone"`
-	Province  string `json:"province"`
-	City      string `json:"city"`
-	District  string `json:"district"`
-	Detail    string `json:"detail"`
-	IsDefault bool   `json:"is_default"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
+	AddressID int64   `json:"address_id"`
+	UserID    int64   `json:"user_id"`
+	Receiver  string  `json:"receiver"`
+	Phone     string  `json:"phone"`
+	Province  string  `json:"province"`
+	City      string  `json:"city"`
+	District  string  `json:"district"`
+	Detail    string  `json:"detail"`
+	IsDefault bool    `json:"is_default"`
+	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
 }
 
 type UserInfoResult struct {
@@ -107,6 +118,7 @@ type UserService interface {
 	UpdateAddress(ctx context.Context, param UpdateAddressParam) error
 	DeleteAddress(ctx context.Context, param DeleteAddressParam) error
 	SetDefaultAddress(ctx context.Context, param SetDefaultAddressParam) error
+	GetAddress(ctx context.Context, param GetAddressParam) (AddressResult, error)
 }
 
 // userService 接口实现
@@ -192,7 +204,7 @@ func (s *userService) Login(ctx context.Context, param LoginParam) (LoginResult,
 		return LoginResult{}, utils.NewBizError("手机号或密码错误")
 	}
 
-	// 4. 生成JWT Token
+	// 4. 生成JWT Token（角色取自t_user.role，admin仅由运营/迁移设置）
 	jwtClaims := &utils.UserClaims{
 		UserID:   strconv.FormatInt(user.UserID, 10),
 		Username: user.Username,
@@ -264,6 +276,8 @@ func (s *userService) AddAddress(ctx context.Context, param AddAddressParam) (in
 		District:  param.District,
 		Detail:    param.Detail,
 		IsDefault: param.IsDefault,
+		Longitude: param.Longitude,
+		Latitude:  param.Latitude,
 	}
 
 	// 3. 调用repo创建地址
@@ -299,19 +313,7 @@ func (s *userService) ListAddresses(ctx context.Context, userID int64) ([]Addres
 	// 3. 转换为领域层返回结果
 	var results []AddressResult
 	for _, addr := range addrs {
-		results = append(results, AddressResult{
-			AddressID: addr.AddressID,
-			UserID:    addr.UserID,
-			Receiver:  addr.Receiver,
-			Phone:     addr.Phone,
-			Province:  addr.Province,
-			City:      addr.City,
-			District:  addr.District,
-			Detail:    addr.Detail,
-			IsDefault: addr.IsDefault,
-			CreatedAt: addr.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt: addr.UpdatedAt.Format("2006-01-02 15:04:05"),
-		})
+		results = append(results, toAddressResult(addr))
 	}
 
 	return results, nil
@@ -371,6 +373,8 @@ func (s *userService) UpdateAddress(ctx context.Context, param UpdateAddressPara
 	addr.District = param.District
 	addr.Detail = param.Detail
 	addr.IsDefault = param.IsDefault
+	addr.Longitude = param.Longitude
+	addr.Latitude = param.Latitude
 
 	// 4. 调用repo更新
 	if err := s.addressRepo.UpdateAddress(ctx, addr); err != nil {
@@ -410,3 +414,39 @@ func (s *userService) SetDefaultAddress(ctx context.Context, param SetDefaultAdd
 	// 2. 调用repo设置默认地址（事务保证）
 	return s.addressRepo.UpdateDefaultAddress(ctx, param.UserID, param.AddressID)
 }
+
+// GetAddress 查询单个收货地址（校验地址归属）
+func (s *userService) GetAddress(ctx context.Context, param GetAddressParam) (AddressResult, error) {
+	if err := validate.Struct(param); err != nil {
+		zap.L().Warn("查询地址参数校验失败", zap.Any("param", param), zap.Error(err))
+		return AddressResult{}, utils.NewParamError("参数错误：" + err.Error())
+	}
+
+	addr, err := s.addressRepo.GetAddressByID(ctx, param.AddressID)
+	if err != nil {
+		return AddressResult{}, err
+	}
+	if addr == nil || addr.UserID != param.UserID {
+		return AddressResult{}, utils.NewBizError("地址不存在或不属于该用户")
+	}
+	return toAddressResult(addr), nil
+}
+
+// toAddressResult 地址模型 → 领域层结果
+func toAddressResult(addr *model.Address) AddressResult {
+	return AddressResult{
+		AddressID: addr.AddressID,
+		UserID:    addr.UserID,
+		Receiver:  addr.Receiver,
+		Phone:     addr.Phone,
+		Province:  addr.Province,
+		City:      addr.City,
+		District:  addr.District,
+		Detail:    addr.Detail,
+		IsDefault: addr.IsDefault,
+		Longitude: addr.Longitude,
+		Latitude:  addr.Latitude,
+		CreatedAt: addr.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt: addr.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,144 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/redis"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+// loadTimeout 回源超时时间（回源与发起请求的ctx解耦，不随单个请求取消）
+const loadTimeout = 5 * time.Second
+
+// Cache Redis旁路缓存（Cache-Aside）
+// 读：先查Redis，未命中时回源并回填，同一key的并发回源合并为一次（防击穿）；
+// 回源返回业务错误（如数据不存在）时缓存空值（防穿透）；过期时间加随机抖动（防雪崩）。
+// 写：调用方更新数据库后删除对应key，无法逐个删除的（如分页列表）通过递增版本号整体失效。
+// Redis异常时降级为直接回源，不影响业务。
+type Cache struct {
+	ttl     time.Duration
+	nullTTL time.Duration
+	jitter  float64
+	flight  singleflight.Group
+}
+
+// New 创建实例
+func New(cfg config.CacheConfig) *Cache {
+	cfg = cfg.WithDefaults()
+	return &Cache{
+		ttl:     time.Duration(cfg.TTLSeconds) * time.Second,
+		nullTTL: time.Duration(cfg.NullTTLSeconds) * time.Second,
+		jitter:  cfg.Jitter,
+	}
+}
+
+// entry 缓存条目（Null为true表示数据不存在，Msg为回源时的业务错误信息）
+type entry struct {
+	Null bool            `json:"null,omitempty"`
+	Msg  string          `json:"msg,omitempty"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// Load 读取缓存，未命中时调用load回源并回填（结果按JSON序列化）
+func Load[T any](ctx context.Context, c *Cache, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	var zero T
+
+	// 1. 查缓存（数据损坏时视为未命中）
+	raw, err := redis.RedisClient.Get(ctx, key).Bytes()
+	switch {
+	case err == nil:
+		value, err := decode[T](raw)
+		var appErr *utils.AppError
+		if err == nil || errors.As(err, &appErr) {
+			return value, err
+		}
+		zap.L().Warn("缓存数据格式错误，重新回源", zap.String("key", key), zap.Error(err))
+	case !redis.IsNil(err):
+		zap.L().Warn("读取缓存失败，降级回源", zap.String("key", key), zap.Error(err))
+	}
+
+	// 2. 回源（同一key并发请求只有一个访问数据库；首个请求取消不影响其他等待者，各请求按自身ctx等待）
+	ch := c.flight.DoChan(key, func() (interface{}, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loadTimeout)
+		defer cancel()
+		value, err := load(loadCtx)
+		if err != nil {
+			var appErr *utils.AppError
+			if !errors.As(err, &appErr) || appErr.Code != utils.ErrCodeBiz {
+				return nil, err
+			}
+			data, _ := json.Marshal(entry{Null: true, Msg: appErr.Message})
+			c.set(loadCtx, key, data, c.nullTTL)
+			return data, nil
+		}
+		payload, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		data, _ := json.Marshal(entry{Data: payload})
+		c.set(loadCtx, key, data, c.ttl)
+		return data, nil
+	})
+	select {
+	case <-ctx.Done():
+		return zero, utils.NewSystemError("读取数据已取消：" + ctx.Err().Error())
+	case res := <-ch:
+		if res.Err != nil {
+			return zero, res.Err
+		}
+		return decode[T](res.Val.([]byte))
+	}
+}
+
+// decode 解析缓存条目，空值条目返回对应业务错误
+func decode[T any](raw []byte) (T, error) {
+	var value T
+	var e entry
+	if err := json.Unmarshal(raw, &e); err != nil {
+		return value, err
+	}
+	if e.Null {
+		return value, utils.NewBizError(e.Msg)
+	}
+	err := json.Unmarshal(e.Data, &value)
+	return value, err
+}
+
+// set 写入缓存（过期时间加随机抖动），失败仅记录日志
+func (c *Cache) set(ctx context.Context, key string, data []byte, ttl time.Duration) {
+	ttl = time.Duration(float64(ttl) * (1 + c.jitter*(2*rand.Float64()-1)))
+	if err := redis.RedisClient.Set(ctx, key, data, ttl).Err(); err != nil {
+		zap.L().Warn("写入缓存失败", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// Delete 删除缓存（数据变更后调用），失败仅记录日志，依赖过期时间兜底
+func (c *Cache) Delete(ctx context.Context, keys ...string) {
+	if err := redis.RedisClient.Del(ctx, keys...).Err(); err != nil {
+		zap.L().Warn("删除缓存失败", zap.Strings("keys", keys), zap.Error(err))
+	}
+}
+
+// Version 查询命名空间当前版本号（未设置时为0），调用方将版本号拼入key
+func (c *Cache) Version(ctx context.Context, namespace string) (int64, error) {
+	ver, err := redis.RedisClient.Get(ctx, namespace+":ver").Int64()
+	if err != nil && !redis.IsNil(err) {
+		zap.L().Warn("查询缓存版本号失败", zap.String("namespace", namespace), zap.Error(err))
+		return 0, err
+	}
+	return ver, nil
+}
+
+// BumpVersion 递增命名空间版本号，使该命名空间下的旧key全部失效（旧key依赖过期时间清理）
+func (c *Cache) BumpVersion(ctx context.Context, namespace string) {
+	if err := redis.RedisClient.Incr(ctx, namespace+":ver").Err(); err != nil {
+		zap.L().Warn("递增缓存版本号失败", zap.String("namespace", namespace), zap.Error(err))
+	}
+}
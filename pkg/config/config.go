@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -15,13 +16,17 @@ var Cfg *Config
 // 配置结构体（对应配置文件）
 
 type Config struct {
-	MySQL MySQLConfig `mapstructure:"mysql"`
-	Redis RedisConfig `mapstructure:"redis"`
-	Kafka KafkaConfig `mapstructure:"kafka"`
-	ES    ESConfig    `mapstructure:"es"`
-	GRPC  GRPCConfig  `mapstructure:"grpc"`
-	Log   LogConfig   `mapstructure:"log"`
-	Jwt   JwtConfig   `mapstructure:"jwt"`
+	MySQL   MySQLConfig   `mapstructure:"mysql"`
+	Redis   RedisConfig   `mapstructure:"redis"`
+	Cache   CacheConfig   `mapstructure:"cache"`
+	Kafka   KafkaConfig   `mapstructure:"kafka"`
+	ES      ESConfig      `mapstructure:"es"`
+	GRPC    GRPCConfig    `mapstructure:"grpc"`
+	Log     LogConfig     `mapstructure:"log"`
+	Jwt     JwtConfig     `mapstructure:"jwt"`
+	Payment PaymentConfig `mapstructure:"payment"`
+	Pricing PricingConfig `mapstructure:"pricing"`
+	Rider   RiderConfig   `mapstructure:"rider"`
 }
 
 // MySQL配置
@@ -43,6 +48,28 @@ type RedisConfig struct {
 	DB       int    `mapstructure:"db"`
 }
 
+// 缓存配置
+
+type CacheConfig struct {
+	TTLSeconds     int     `mapstructure:"ttl_seconds"`      // 数据缓存时间（秒）
+	NullTTLSeconds int     `mapstructure:"null_ttl_seconds"` // 空值缓存时间（秒），防缓存穿透
+	Jitter         float64 `mapstructure:"jitter"`           // 过期时间随机抖动比例（0~1），防缓存雪崩
+}
+
+// WithDefaults 未配置项使用默认值
+func (c CacheConfig) WithDefaults() CacheConfig {
+	if c.TTLSeconds <= 0 {
+		c.TTLSeconds = 300
+	}
+	if c.NullTTLSeconds <= 0 {
+		c.NullTTLSeconds = 30
+	}
+	if c.Jitter <= 0 || c.Jitter >= 1 {
+		c.Jitter = 0.1
+	}
+	return c
+}
+
 // Kafka配置
 
 type KafkaConfig struct {
@@ -52,10 +79,27 @@ type KafkaConfig struct {
 // ES配置
 
 type ESConfig struct {
-	Host     string `mapstructure:"host"`
-	Port     int    `mapstructure:"port"`
-	Username string `mapstructure:"username"`
-	Password string `mapstructure:"password"`
+	Host           string `mapstructure:"host"`
+	Port           int    `mapstructure:"port"`
+	Username       string `mapstructure:"username"`
+	Password       string `mapstructure:"password"`
+	IndexPrefix    string `mapstructure:"index_prefix"`    // 索引名前缀
+	Analyzer       string `mapstructure:"analyzer"`        // 建索引分词器（需安装IK插件）
+	SearchAnalyzer string `mapstructure:"search_analyzer"` // 搜索分词器
+}
+
+// WithDefaults 未配置项使用默认值
+func (c ESConfig) WithDefaults() ESConfig {
+	if c.IndexPrefix == "" {
+		c.IndexPrefix = "meituan"
+	}
+	if c.Analyzer == "" {
+		c.Analyzer = "ik_max_word" // 细粒度切分，提高召回
+	}
+	if c.SearchAnalyzer == "" {
+		c.SearchAnalyzer = "ik_smart" // 粗粒度切分，提高准确率
+	}
+	return c
 }
 
 // GRPC配置
@@ -66,6 +110,8 @@ type GRPCConfig struct {
 	OrderPort    int `mapstructure:"order_port"`
 	MerchantPort int `mapstructure:"merchant_port"`
 	RiderPort    int `mapstructure:"rider_port"`
+	PaymentPort  int `mapstructure:"payment_port"`
+	SearchPort   int `mapstructure:"search_port"`
 }
 
 // 日志配置
@@ -76,8 +122,116 @@ type LogConfig struct {
 }
 
 type JwtConfig struct {
-	Secret string `mapstructure:"secret"`
-	Expire int    `mapstructure:"expire"`
+	Secret      string `mapstructure:"secret"`
+	Expire      int    `mapstructure:"expire"`
+	ServiceName string `mapstructure:"service_name"` // 后台任务、消息消费调用下游时的服务身份名称，为空则不签发服务Token
+}
+
+// 支付配置
+
+type PaymentConfig struct {
+	Gateway        string  `mapstructure:"gateway"`         // 支付网关（mock）
+	ExpireMinutes  int     `mapstructure:"expire_minutes"`  // 未支付订单过期时间（分钟）
+	MockSecret     string  `mapstructure:"mock_secret"`     // mock网关签名密钥
+	MockAutoPay    bool    `mapstructure:"mock_auto_pay"`   // mock网关是否自动回调支付成功
+	CommissionRate float64 `mapstructure:"commission_rate"` // 平台默认佣金比例（商家未单独配置时使用）
+}
+
+// ExpireDuration 未支付订单过期时长（未配置默认15分钟）
+func (c PaymentConfig) ExpireDuration() time.Duration {
+	if c.ExpireMinutes <= 0 {
+		return 15 * time.Minute
+	}
+	return time.Duration(c.ExpireMinutes) * time.Minute
+}
+
+// DefaultCommissionRate 平台默认佣金比例（未配置默认18%）
+func (c PaymentConfig) DefaultCommissionRate() float64 {
+	if c.CommissionRate <= 0 || c.CommissionRate >= 1 {
+		return 0.18
+	}
+	return c.CommissionRate
+}
+
+// 订单计费配置
+
+type PricingConfig struct {
+	BaseDeliveryFee float64         `mapstructure:"base_delivery_fee"` // 起步配送费（元）
+	BaseDistanceKm  float64         `mapstructure:"base_distance_km"`  // 起步距离（公里）
+	PerKmFee        float64         `mapstructure:"per_km_fee"`        // 超出起步距离每公里加价（元）
+	MaxDistanceKm   float64         `mapstructure:"max_distance_km"`   // 最大配送距离（公里）
+	PeakSurcharges  []PeakSurcharge `mapstructure:"peak_surcharges"`   // 时段加价
+}
+
+// PeakSurcharge 时段加价（时间格式HH:MM，End小于Start表示跨天）
+
+type PeakSurcharge struct {
+	Start string  `mapstructure:"start"`
+	End   string  `mapstructure:"end"`
+	Fee   float64 `mapstructure:"fee"`
+}
+
+// WithDefaults 未配置项使用默认值
+func (c PricingConfig) WithDefaults() PricingConfig {
+	if c.BaseDeliveryFee <= 0 {
+		c.BaseDeliveryFee = 3
+	}
+	if c.BaseDistanceKm <= 0 {
+		c.BaseDistanceKm = 3
+	}
+	if c.PerKmFee <= 0 {
+		c.PerKmFee = 1
+	}
+	if c.MaxDistanceKm <= 0 {
+		c.MaxDistanceKm = 10
+	}
+	if c.PeakSurcharges == nil {
+		c.PeakSurcharges = []PeakSurcharge{
+			{Start: "11:00", End: "13:00", Fee: 1}, // 午高峰
+			{Start: "22:00", End: "06:00", Fee: 2}, // 夜间
+		}
+	}
+	return c
+}
+
+// 骑手收入配置
+
+type RiderConfig struct {
+	BaseFee        float64      `mapstructure:"base_fee"`         // 每单基础收入（元）
+	BaseDistanceKm float64      `mapstructure:"base_distance_km"` // 基础距离（公里）
+	PerKmBonus     float64      `mapstructure:"per_km_bonus"`     // 超出基础距离每公里补贴（元）
+	PeakMultiplier float64      `mapstructure:"peak_multiplier"`  // 高峰时段倍数（作用于基础收入+距离补贴）
+	PeakHours      []TimeWindow `mapstructure:"peak_hours"`       // 高峰时段
+}
+
+// TimeWindow 时段（时间格式HH:MM，End小于Start表示跨天）
+
+type TimeWindow struct {
+	Start string `mapstructure:"start"`
+	End   string `mapstructure:"end"`
+}
+
+// WithDefaults 未配置项使用默认值
+func (c RiderConfig) WithDefaults() RiderConfig {
+	if c.BaseFee <= 0 {
+		c.BaseFee = 4
+	}
+	if c.BaseDistanceKm <= 0 {
+		c.BaseDistanceKm = 3
+	}
+	if c.PerKmBonus <= 0 {
+		c.PerKmBonus = 1
+	}
+	if c.PeakMultiplier < 1 {
+		c.PeakMultiplier = 1.5
+	}
+	if c.PeakHours == nil {
+		c.PeakHours = []TimeWindow{
+			{Start: "11:00", End: "13:00"}, // 午高峰
+			{Start: "17:00", End: "19:00"}, // 晚高峰
+		}
+	}
+	return c
 }
 
 func InitConfig(configPath string) error {
@@ -24,7 +24,8 @@ func InitMysql() {
 		cfg.DBName,
 	)
 	db, err := gorm.Open(mysql.Open(mysqlDSN), &gorm.Config{
-		Logger: gormLogger.Default.LogMode(gormLogger.Info),
+		Logger:         gormLogger.Default.LogMode(gormLogger.Info),
+		TranslateError: true, // 唯一键冲突等转换为gorm错误（如gorm.ErrDuplicatedKey）
 	})
 	if err != nil {
 		zap.L().Fatal("MySQL连接失败", zap.Error(err))
@@ -0,0 +1,79 @@
+package export
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+)
+
+// 导出格式
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// formulaPrefixes 表格软件视为公式起始的字符
+const formulaPrefixes = "=+-@\t\r"
+
+// Cell 单元格（文本以公式字符开头时写入前加单引号，防止CSV/公式注入）
+type Cell struct {
+	Value   string
+	Numeric bool // 是否按数字写入（值须为合法数字，否则按文本转义写入）
+}
+
+// number 是否按数字原样写入
+func (c Cell) number() bool {
+	if !c.Numeric || c.Value == "" {
+		return false
+	}
+	_, err := strconv.ParseFloat(c.Value, 64)
+	return err == nil
+}
+
+// text 单元格写入值：数字原样返回，文本以公式字符开头时加单引号前缀
+func (c Cell) text() string {
+	if c.number() || c.Value == "" || !strings.ContainsRune(formulaPrefixes, rune(c.Value[0])) {
+		return c.Value
+	}
+	return "'" + c.Value
+}
+
+// UnescapeText 去除导出时为防公式注入添加的单引号前缀（重新导入导出文件用）
+func UnescapeText(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.ContainsRune(formulaPrefixes, rune(value[1])) {
+		return value[1:]
+	}
+	return value
+}
+
+// Writer 表格逐行写入器：写入即输出到底层io.Writer，不在内存中保留已写入的行
+type Writer interface {
+	WriteRow(cells []Cell) error
+	Close() error // 写入文件尾并刷新（不关闭底层io.Writer）
+}
+
+// NewWriter 按格式创建写入器
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	default:
+		return nil, utils.NewParamError("不支持的导出格式：" + format)
+	}
+}
+
+// ContentType 导出格式对应的MIME类型
+func ContentType(format string) string {
+	switch format {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return "application/octet-stream"
+	}
+}
@@ -0,0 +1,104 @@
+package kafka
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// MessageHandler 消息处理函数（返回error会重试，重试仍失败则转入死信Topic）
+type MessageHandler func(ctx context.Context, msg *sarama.ConsumerMessage) error
+
+// DeadLetterTopic 死信Topic（处理失败的消息原样转入，需人工处理或重新投递到原Topic）
+func DeadLetterTopic(topic string) string {
+	return topic + "_dlq"
+}
+
+// consumerGroupHandler sarama消费者组回调实现
+type consumerGroupHandler struct {
+	handler MessageHandler
+}
+
+func (h *consumerGroupHandler) Setup(sarama.ConsumerGroupSession) error   { return nil }
+func (h *consumerGroupHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *consumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		err := utils.Retry(3, time.Second, func() error {
+			return h.handler(session.Context(), msg)
+		})
+		if err != nil {
+			zap.L().Error("kafka消息处理失败，转入死信Topic", zap.String("topic", msg.Topic), zap.ByteString("key", msg.Key),
+				zap.ByteString("value", msg.Value), zap.Error(err))
+			// 死信投递成功后才提交位点；会话结束时未提交的消息会被重新消费
+			if !sendDeadLetter(session.Context(), msg, err) {
+				return nil
+			}
+		}
+		session.MarkMessage(msg, "")
+	}
+	return nil
+}
+
+// sendDeadLetter 将处理失败的消息投递到死信Topic，失败时持续重试（阻塞当前分区，不跳过消息），ctx取消返回false
+func sendDeadLetter(ctx context.Context, msg *sarama.ConsumerMessage, handleErr error) bool {
+	dlq := &sarama.ProducerMessage{
+		Topic: DeadLetterTopic(msg.Topic),
+		Key:   sarama.ByteEncoder(msg.Key),
+		Value: sarama.ByteEncoder(msg.Value),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte("origin_topic"), Value: []byte(msg.Topic)},
+			{Key: []byte("origin_partition"), Value: []byte(strconv.Itoa(int(msg.Partition)))},
+			{Key: []byte("origin_offset"), Value: []byte(strconv.FormatInt(msg.Offset, 10))},
+			{Key: []byte("error"), Value: []byte(handleErr.Error())},
+		},
+	}
+	interval := time.Second
+	for {
+		_, _, err := Producer.SendMessage(dlq)
+		if err == nil {
+			return true
+		}
+		zap.L().Error("投递死信消息失败，稍后重试", zap.String("topic", dlq.Topic), zap.Int64("offset", msg.Offset), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(interval):
+		}
+		if interval < time.Minute {
+			interval *= 2
+		}
+	}
+}
+
+// StartConsumer 启动消费者组，在后台持续消费topics，ctx取消后退出
+func StartConsumer(ctx context.Context, groupID string, topics []string, handler MessageHandler) {
+	consumerConfig := sarama.NewConfig()
+	consumerConfig.Version = sarama.V2_0_0_0
+	consumerConfig.Consumer.Offsets.Initial = sarama.OffsetOldest //新消费者组从最早位点开始消费
+
+	group, err := sarama.NewConsumerGroup(config.Cfg.Kafka.Brokers, groupID, consumerConfig)
+	if err != nil {
+		zap.L().Fatal("kafka消费者初始化失败", zap.String("group", groupID), zap.Error(err))
+	}
+
+	go func() {
+		defer func() {
+			_ = group.Close()
+		}()
+		for {
+			if err := group.Consume(ctx, topics, &consumerGroupHandler{handler: handler}); err != nil {
+				zap.L().Error("kafka消费异常", zap.String("group", groupID), zap.Strings("topics", topics), zap.Error(err))
+			}
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+	zap.L().Info("kafka消费者启动成功", zap.String("group", groupID), zap.Strings("topics", topics))
+}
@@ -0,0 +1,119 @@
+package kafka
+
+import "time"
+
+// 业务Topic定义
+const (
+	TopicStockRestore   = "order_stock_restore" // 库存恢复补偿（同步恢复失败的订单项）
+	TopicOrderRefund    = "order_refund"        // 订单退款（取消/拒单/缺货后触发）
+	TopicPaymentPaid    = "payment_paid"        // 支付成功（订单流转为待接单）
+	TopicOrderCompleted = "order_completed"     // 订单完成（触发商家结算）
+	TopicRatingUpdated  = "rating_updated"      // 评分更新（商家/商品/骑手评分变化）
+	TopicSearchSync     = "search_sync"         // 搜索索引同步（商家/商品变更）
+	TopicFlashStock     = "flash_stock"         // 秒杀库存流水（Redis扣减后异步落库）
+)
+
+// StockRestoreEvent 库存恢复补偿消息
+type StockRestoreEvent struct {
+	RestoreNo string `json:"restore_no"` // 恢复流水号（商品服务按流水号幂等）
+	OrderID   int64  `json:"order_id"`
+	ProductID int64  `json:"product_id"`
+	SkuID     int64  `json:"sku_id"` // 规格ID（0表示单规格商品）
+	UserID    int64  `json:"user_id"`
+	Num       int32  `json:"num"`
+}
+
+// 退款类型
+const (
+	RefundTypeFull    = "全额退款"
+	RefundTypePartial = "部分退款"
+)
+
+// 退款场景
+const (
+	RefundSceneCancel  = "用户取消"
+	RefundSceneReject  = "商家拒单"
+	RefundSceneExpire  = "超时取消"
+	RefundSceneMissing = "商品缺货"
+)
+
+// OrderRefundEvent 订单退款消息
+type OrderRefundEvent struct {
+	RefundNo   string            `json:"refund_no"` // 退款单号（幂等键）
+	OrderID    int64             `json:"order_id"`
+	OrderNo    string            `json:"order_no"`
+	UserID     int64             `json:"user_id"`
+	Amount     float64           `json:"amount"` // 本次退款金额
+	RefundType string            `json:"refund_type"`
+	Scene      string            `json:"scene"`
+	Reason     string            `json:"reason"`
+	Items      []RefundItemEvent `json:"items,omitempty"` // 部分退款的订单项
+}
+
+// RefundItemEvent 退款订单项
+type RefundItemEvent struct {
+	ItemID      int64   `json:"item_id"`
+	ProductID   int64   `json:"product_id"`
+	ProductName string  `json:"product_name"`
+	Quantity    int32   `json:"quantity"`
+	Amount      float64 `json:"amount"`
+}
+
+// PaymentPaidEvent 支付成功消息
+type PaymentPaidEvent struct {
+	PaymentNo string    `json:"payment_no"`
+	OrderID   int64     `json:"order_id"`
+	Amount    float64   `json:"amount"`
+	PaidTime  time.Time `json:"paid_time"`
+}
+
+// OrderCompletedEvent 订单完成消息（部分退款期限结束后投递，金额均已扣除部分退款）
+type OrderCompletedEvent struct {
+	OrderID          int64     `json:"order_id"`
+	OrderNo          string    `json:"order_no"`
+	MerchantID       int64     `json:"merchant_id"`
+	ItemAmount       float64   `json:"item_amount"`       // 商品金额（扣除已退款商品）
+	PackingFee       float64   `json:"packing_fee"`       // 打包费
+	DeliveryFee      float64   `json:"delivery_fee"`      // 配送费
+	MerchantDiscount float64   `json:"merchant_discount"` // 商家承担的优惠
+	PlatformDiscount float64   `json:"platform_discount"` // 平台承担的优惠
+	TipAmount        float64   `json:"tip_amount"`        // 骑手小费（全额归骑手，不参与商家结算）
+	PaidAmount       float64   `json:"paid_amount"`       // 用户实付（扣除退款）
+	CompletedTime    time.Time `json:"completed_time"`
+}
+
+// 评分对象类型
+const (
+	RatingTargetMerchant = "merchant"
+	RatingTargetProduct  = "product"
+	RatingTargetRider    = "rider"
+)
+
+// RatingUpdatedEvent 评分更新消息（消费方按RatingCount判断新旧，只接受更大的值）
+type RatingUpdatedEvent struct {
+	TargetType  string  `json:"target_type"` // merchant/product/rider
+	TargetID    int64   `json:"target_id"`
+	Score       float64 `json:"score"`        // 贝叶斯平均分（保留一位小数）
+	RatingCount int64   `json:"rating_count"` // 累计评分次数
+}
+
+// 搜索索引同步对象类型
+const (
+	SearchTargetMerchant = "merchant" // 商家及其全部商品（菜单分类变化时也按商家同步）
+	SearchTargetProduct  = "product"
+)
+
+// SearchSyncEvent 搜索索引同步消息（仅携带ID，消费方回源读取最新数据，重复或乱序消费结果一致）
+type SearchSyncEvent struct {
+	TargetType string `json:"target_type"` // merchant/product
+	TargetID   int64  `json:"target_id"`
+}
+
+// FlashStockEvent 秒杀库存流水消息（StockNo为幂等键）
+type FlashStockEvent struct {
+	StockNo     string `json:"stock_no"`
+	FlashSaleID int64  `json:"flash_sale_id"`
+	ProductID   int64  `json:"product_id"`
+	UserID      int64  `json:"user_id"`
+	Delta       int32  `json:"delta"` // 扣减为负，恢复为正
+}
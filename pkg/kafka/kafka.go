@@ -1,6 +1,9 @@
 package kafka
 
 import (
+	"encoding/json"
+	"strconv"
+
 	"github.com/IBM/sarama"
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
 	"go.uber.org/zap"
@@ -35,3 +38,21 @@ func SendMessage(topic string, key string, value string) (int32, int64, error) {
 	partition, offset, err := Producer.SendMessage(msg)
 	return partition, offset, err
 }
+
+// SendJSON 序列化为JSON后发送消息
+func SendJSON(topic string, key string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	_, _, err = SendMessage(topic, key, string(data))
+	return err
+}
+
+// NotifySearchSync 投递搜索索引同步消息（失败仅记录日志，索引可通过重建修复）
+func NotifySearchSync(targetType string, targetID int64) {
+	event := SearchSyncEvent{TargetType: targetType, TargetID: targetID}
+	if err := SendJSON(TopicSearchSync, targetType+":"+strconv.FormatInt(targetID, 10), event); err != nil {
+		zap.L().Warn("投递搜索索引同步消息失败", zap.Any("event", event), zap.Error(err))
+	}
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/config"
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// serviceCredentialKey 服务凭证标记在context中的key
+type serviceCredentialKey struct{}
+
+// WithServiceCredential 标记ctx以服务身份调用下游（仅供后台任务、消息消费等无上游请求的内部调用方显式使用）
+func WithServiceCredential(ctx context.Context) context.Context {
+	return context.WithValue(ctx, serviceCredentialKey{}, true)
+}
+
+// GRPCAuthForwardInterceptor 客户端拦截器：透传上游请求的Authorization头（服务间调用沿用调用方身份），
+// 无上游请求时仅对WithServiceCredential标记的ctx签发服务身份Token，否则不携带鉴权信息
+func GRPCAuthForwardInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if authHeaders := md.Get("Authorization"); len(authHeaders) > 0 {
+				ctx = metadata.AppendToOutgoingContext(ctx, "Authorization", authHeaders[0])
+				return invoker(ctx, method, req, reply, cc, opts...)
+			}
+		}
+		if useService, _ := ctx.Value(serviceCredentialKey{}).(bool); !useService {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		serviceName := config.Cfg.Jwt.ServiceName
+		if serviceName == "" {
+			zap.L().Error("未配置服务身份，无法签发服务Token", zap.String("method", method))
+			return utils.NewSystemError("未配置服务身份（jwt.service_name）")
+		}
+		token, err := utils.GenerateToken(&utils.UserClaims{
+			UserID:   "0",
+			Username: serviceName,
+			Role:     "system",
+		})
+		if err != nil {
+			zap.L().Error("生成服务Token失败", zap.String("method", method), zap.Error(err))
+			return err
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, "Authorization", "Bearer "+token)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
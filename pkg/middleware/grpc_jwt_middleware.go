@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
@@ -12,39 +13,109 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// ClaimsKey 鉴权通过后JWT Claims在context中的key
+const ClaimsKey = "token"
+
 func GRPCJwtMiddleware() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		noAuthMethods := map[string]bool{
-			"/user.UserService/Register": true,
-			"/user.UserService/Login":    true,
-		}
-		if noAuthMethods[info.FullMethod] {
-			return handler(ctx, req)
-		}
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			zap.L().Warn("gRPC请求未携带Metadata", zap.String("method", info.FullMethod))
-			return nil, status.Error(codes.Unauthenticated, "未携带鉴权信息")
-		}
-		authHeaders := md.Get("Authorization")
-		if len(authHeaders) == 0 {
-			zap.L().Warn("gRPC请求未携带Authorization头", zap.String("method", info.FullMethod))
-			return nil, status.Error(codes.Unauthenticated, "未携带Token")
-		}
-		authHeader := authHeaders[0]
-		tokenParts := strings.Split(authHeader, " ")
-		if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
-			zap.L().Warn("Authorization头格式错误", zap.String("header", authHeader), zap.String("method", info.FullMethod))
-			return nil, status.Error(codes.Unauthenticated, "Token格式错误（应为Bearer <token>）")
-		}
-		tokenStr := tokenParts[1]
-		//解析tokenStr
-		claims, err := utils.ParseToken(tokenStr)
+		ctx, err = authenticate(ctx, info.FullMethod)
 		if err != nil {
-			zap.L().Warn("JWT Token解析失败", zap.String("token", tokenStr), zap.Error(err), zap.String("method", info.FullMethod))
-			return nil, status.Error(codes.Unauthenticated, "Token无效："+err.Error())
+			return nil, err
 		}
-		ctx = context.WithValue(ctx, "token", claims)
 		return handler(ctx, req)
 	}
 }
+
+// GRPCJwtStreamMiddleware 流式接口JWT鉴权（校验规则同GRPCJwtMiddleware）
+func GRPCJwtStreamMiddleware() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authedStream 携带鉴权后context的ServerStream
+type authedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context 返回鉴权后的context
+func (s *authedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate 解析Authorization头中的JWT，将Claims写入context（免鉴权接口原样返回）
+func authenticate(ctx context.Context, method string) (context.Context, error) {
+	noAuthMethods := map[string]bool{
+		"/user.UserService/Register": true,
+		"/user.UserService/Login":    true,
+		// 支付网关回调（网关签名校验）
+		"/payment.PaymentService/PaymentCallback": true,
+	}
+	if noAuthMethods[method] {
+		return ctx, nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		zap.L().Warn("gRPC请求未携带Metadata", zap.String("method", method))
+		return nil, status.Error(codes.Unauthenticated, "未携带鉴权信息")
+	}
+	authHeaders := md.Get("Authorization")
+	if len(authHeaders) == 0 {
+		zap.L().Warn("gRPC请求未携带Authorization头", zap.String("method", method))
+		return nil, status.Error(codes.Unauthenticated, "未携带Token")
+	}
+	authHeader := authHeaders[0]
+	tokenParts := strings.Split(authHeader, " ")
+	if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
+		zap.L().Warn("Authorization头格式错误", zap.String("header", authHeader), zap.String("method", method))
+		return nil, status.Error(codes.Unauthenticated, "Token格式错误（应为Bearer <token>）")
+	}
+	tokenStr := tokenParts[1]
+	//解析tokenStr
+	claims, err := utils.ParseToken(tokenStr)
+	if err != nil {
+		zap.L().Warn("JWT Token解析失败", zap.String("token", tokenStr), zap.Error(err), zap.String("method", method))
+		return nil, status.Error(codes.Unauthenticated, "Token无效："+err.Error())
+	}
+	return context.WithValue(ctx, ClaimsKey, claims), nil
+}
+
+// GetClaims 从context中获取当前请求的JWT Claims
+func GetClaims(ctx context.Context) (*utils.UserClaims, bool) {
+	claims, ok := ctx.Value(ClaimsKey).(*utils.UserClaims)
+	return claims, ok && claims != nil
+}
+
+// CheckIdentity 校验当前请求身份为指定角色及ID
+func CheckIdentity(ctx context.Context, role string, id int64) error {
+	claims, ok := GetClaims(ctx)
+	if !ok {
+		return utils.NewAuthError("未获取到鉴权信息")
+	}
+	if claims.Role != role || claims.UserID != strconv.FormatInt(id, 10) {
+		zap.L().Warn("请求身份不匹配", zap.String("role", claims.Role), zap.String("user_id", claims.UserID),
+			zap.String("expect_role", role), zap.Int64("expect_id", id))
+		return utils.NewAuthError("无权限操作")
+	}
+	return nil
+}
+
+// CheckRole 校验当前请求角色属于roles之一，返回Claims
+func CheckRole(ctx context.Context, roles ...string) (*utils.UserClaims, error) {
+	claims, ok := GetClaims(ctx)
+	if !ok {
+		return nil, utils.NewAuthError("未获取到鉴权信息")
+	}
+	for _, role := range roles {
+		if claims.Role == role {
+			return claims, nil
+		}
+	}
+	zap.L().Warn("请求角色无权限", zap.String("role", claims.Role), zap.Strings("expect_roles", roles))
+	return nil, utils.NewAuthError("无权限操作")
+}
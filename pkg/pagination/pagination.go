@@ -0,0 +1,108 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/JokerYuan-lang/go-meituan-microservice/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// Param 分页参数
+// Page>0 为页码模式（兼容旧接口，总是统计总数）；Page=0 或 Cursor 非空为游标模式，
+// 游标模式按（时间, ID）做keyset分页，仅在 WithTotal 为 true 时统计总数
+type Param struct {
+	Page      int32
+	PageSize  int32
+	Cursor    string
+	WithTotal bool
+}
+
+// Result 分页结果
+type Result struct {
+	Total      int64  // 总条数（未统计时为-1）
+	NextCursor string // 下一页游标（没有下一页时为空）
+}
+
+// CursorMode 是否为游标模式
+func (p Param) CursorMode() bool {
+	return p.Cursor != "" || p.Page <= 0
+}
+
+// NeedTotal 是否需要统计总数
+func (p Param) NeedTotal() bool {
+	return !p.CursorMode() || p.WithTotal
+}
+
+// cursor 游标内容（对调用方不透明）
+type cursor struct {
+	Time time.Time `json:"t"`
+	ID   int64     `json:"i"`
+}
+
+// EncodeCursor 生成游标
+func EncodeCursor(t time.Time, id int64) string {
+	data, _ := json.Marshal(cursor{Time: t, ID: id})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor 解析游标
+func decodeCursor(s string) (cursor, error) {
+	var c cursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, utils.NewParamError("分页游标不合法")
+	}
+	if err = json.Unmarshal(data, &c); err != nil || c.ID <= 0 {
+		return c, utils.NewParamError("分页游标不合法")
+	}
+	return c, nil
+}
+
+// Keyset 游标分页排序列（默认倒序）
+type Keyset struct {
+	TimeColumn string
+	IDColumn   string
+	Asc        bool // 是否正序
+}
+
+// Count 按需统计总数，不需要时返回-1
+func Count(query *gorm.DB, p Param) (int64, error) {
+	if !p.NeedTotal() {
+		return -1, nil
+	}
+	var total int64
+	err := query.Session(&gorm.Session{}).Count(&total).Error
+	return total, err
+}
+
+// Apply 为查询追加排序及分页条件；多取一条用于判断是否存在下一页，配合 Trim 使用
+func (k Keyset) Apply(query *gorm.DB, p Param) (*gorm.DB, error) {
+	query = query.Session(&gorm.Session{})
+	op, dir := "<", "DESC"
+	if k.Asc {
+		op, dir = ">", "ASC"
+	}
+	if p.Cursor != "" {
+		c, err := decodeCursor(p.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", k.TimeColumn, op, k.TimeColumn, k.IDColumn, op), c.Time, c.Time, c.ID)
+	} else if !p.CursorMode() {
+		query = query.Offset(int((p.Page - 1) * p.PageSize))
+	}
+	return query.Order(k.TimeColumn + " " + dir).Order(k.IDColumn + " " + dir).Limit(int(p.PageSize) + 1), nil
+}
+
+// Trim 去掉 Apply 多取的一条，返回当前页数据及下一页游标
+func Trim[T any](rows []T, pageSize int32, key func(T) (time.Time, int64)) ([]T, string) {
+	if pageSize <= 0 || len(rows) <= int(pageSize) {
+		return rows, ""
+	}
+	rows = rows[:pageSize]
+	t, id := key(rows[len(rows)-1])
+	return rows, EncodeCursor(t, id)
+}
@@ -2,6 +2,7 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -39,3 +40,8 @@ func Get(key string) (string, error) {
 func Del(key string) error {
 	return RedisClient.Del(ctx, key).Err()
 }
+
+// IsNil 判断是否为key不存在错误
+func IsNil(err error) bool {
+	return errors.Is(err, redis.Nil)
+}
@@ -0,0 +1,16 @@
+package utils
+
+import "math"
+
+// earthRadius 地球平均半径（米）
+const earthRadius = 6371000.0
+
+// Distance 按Haversine公式计算两个经纬度坐标间的球面距离（米）
+func Distance(lng1, lat1, lng2, lat2 float64) float64 {
+	rad := math.Pi / 180
+	dLat := (lat2 - lat1) * rad
+	dLng := (lng2 - lng1) * rad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
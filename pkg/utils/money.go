@@ -0,0 +1,8 @@
+package utils
+
+import "math"
+
+// RoundMoney 金额四舍五入保留两位小数（分）
+func RoundMoney(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
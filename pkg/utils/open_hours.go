@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OpenHours 营业时段（当日分钟数，Close小于等于Open表示跨天；Open为-1表示未配置，视为全天营业）
+type OpenHours struct {
+	OpenMinute  int32 `json:"open_minute"`
+	CloseMinute int32 `json:"close_minute"`
+}
+
+// ParseOpenHours 解析营业时间（格式HH:MM-HH:MM，如"09:00-22:00"、"22:00-02:00"），无法解析时视为全天营业
+func ParseOpenHours(businessHours string) OpenHours {
+	start, end, ok := strings.Cut(strings.TrimSpace(businessHours), "-")
+	if !ok {
+		return OpenHours{OpenMinute: -1, CloseMinute: -1}
+	}
+	open, okOpen := ParseClock(start)
+	closing, okClose := ParseClock(end)
+	if !okOpen || !okClose {
+		return OpenHours{OpenMinute: -1, CloseMinute: -1}
+	}
+	return OpenHours{OpenMinute: open, CloseMinute: closing}
+}
+
+// ParseClock 解析HH:MM为当日分钟数（支持24:00）
+func ParseClock(s string) (int32, bool) {
+	h, m, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok {
+		return 0, false
+	}
+	hour, err := strconv.Atoi(h)
+	if err != nil || hour < 0 || hour > 24 {
+		return 0, false
+	}
+	minute, err := strconv.Atoi(m)
+	if err != nil || minute < 0 || minute > 59 || (hour == 24 && minute > 0) {
+		return 0, false
+	}
+	return int32(hour*60 + minute), true
+}
+
+// Overnight 是否跨天营业
+func (h OpenHours) Overnight() bool {
+	return h.OpenMinute >= 0 && h.CloseMinute <= h.OpenMinute
+}
+
+// Contains 判断当日分钟数是否在营业时段内
+func (h OpenHours) Contains(minute int32) bool {
+	if h.OpenMinute < 0 {
+		return true
+	}
+	if h.Overnight() {
+		return minute >= h.OpenMinute || minute < h.CloseMinute
+	}
+	return minute >= h.OpenMinute && minute < h.CloseMinute
+}
+
+// MinuteOfDay 时间对应的当日分钟数
+func MinuteOfDay(t time.Time) int32 {
+	return int32(t.Hour()*60 + t.Minute())
+}
+
+// InClockRange 判断时间是否在HH:MM时段[start, end)内（end早于start表示跨天），时段无法解析时返回false
+func InClockRange(start, end string, t time.Time) bool {
+	startMinute, okStart := ParseClock(start)
+	endMinute, okEnd := ParseClock(end)
+	if !okStart || !okEnd {
+		return false
+	}
+	minute := MinuteOfDay(t)
+	if startMinute <= endMinute {
+		return minute >= startMinute && minute < endMinute
+	}
+	return minute >= startMinute || minute < endMinute
+}
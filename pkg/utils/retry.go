@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Retry 按指数退避重试执行fn，直到成功或达到最大次数，返回最后一次的错误
+func Retry(attempts int, interval time.Duration, fn func() error) error {
+	var err error
+	for i := 0; i < attempts; i++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if i < attempts-1 {
+			zap.L().Warn("执行失败，准备重试", zap.Int("attempt", i+1), zap.Duration("interval", interval), zap.Error(err))
+			time.Sleep(interval)
+			interval *= 2
+		}
+	}
+	return err
+}
@@ -25,3 +25,11 @@ func ContainsString(slice []string, s string) bool {
 	}
 	return false
 }
+
+// MaskPhone 手机号脱敏（保留前3位和后4位，如 138****5678）
+func MaskPhone(phone string) string {
+	if len(phone) != 11 {
+		return phone
+	}
+	return phone[:3] + "****" + phone[7:]
+}